@@ -45,6 +45,8 @@ const v2ExternalServiceKinds: { [key in ExternalServiceKind]: number } = {
     PYTHONPACKAGES: 16,
     RUBYPACKAGES: 17,
     RUSTPACKAGES: 18,
+    MERCURIAL: 19,
+    GITEA: 20,
 }
 
 /**
@@ -43,6 +43,8 @@ const v2CodeHostKind: { [key in ExternalServiceKind]: number } = {
     PYTHONPACKAGES: 15,
     RUBYPACKAGES: 16,
     RUSTPACKAGES: 17,
+    MERCURIAL: 18,
+    GITEA: 19,
 }
 
 /**
@@ -40,6 +40,9 @@ type Config struct {
 	KeepWorkspaces                                 bool
 	DockerHostMountPath                            string
 	UseFirecracker                                 bool
+	UsePodman                                      bool
+	PodmanUserNS                                   string
+	PodmanPidsLimit                                int
 	JobNumCPUs                                     int
 	JobMemory                                      string
 	FirecrackerDiskSpace                           string
@@ -120,6 +123,9 @@ func (c *Config) Load() {
 	c.QueuePollInterval = c.GetInterval("EXECUTOR_QUEUE_POLL_INTERVAL", "1s", "Interval between dequeue requests.")
 	c.MaximumNumJobs = c.GetInt("EXECUTOR_MAXIMUM_NUM_JOBS", "1", "Number of virtual machines or containers that can be running at once.")
 	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux" && !IsKubernetes()), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only. Kubernetes is not supported.")
+	c.UsePodman = c.GetBool("EXECUTOR_USE_PODMAN", "false", "Whether to run commands in rootless Podman containers instead of Docker containers. Requires podman. Firecracker must be disabled.")
+	c.PodmanUserNS = c.Get("EXECUTOR_PODMAN_USERNS", "keep-id", "The user namespace mode of Podman containers. Every job gets a user namespace of its own; 'keep-id' maps the executor user into it, 'auto' allocates a unique UID range per job.")
+	c.PodmanPidsLimit = c.GetInt("EXECUTOR_PODMAN_PIDS_LIMIT", "0", "The maximum number of processes a Podman container can run. A value of zero sets no limit.")
 	c.FirecrackerImage = c.Get("EXECUTOR_FIRECRACKER_IMAGE", DefaultFirecrackerImage, "The base image to use for virtual machines.")
 	c.FirecrackerKernelImage = c.Get("EXECUTOR_FIRECRACKER_KERNEL_IMAGE", DefaultFirecrackerKernelImage, "The base image containing the kernel binary to use for virtual machines.")
 	c.FirecrackerSandboxImage = c.Get("EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", DefaultFirecrackerSandboxImage, "The OCI image for the ignite VM sandbox.")
@@ -312,6 +318,21 @@ func (c *Config) Validate() error {
 		c.AddError(errors.Wrap(c.kubernetesNodeTolerationsUnmarshalError, "invalid EXECUTOR_KUBERNETES_NODE_TOLERATIONS, failed to parse"))
 	}
 
+	if c.UsePodman {
+		if runtime.GOOS != "linux" {
+			c.AddError(errors.New("EXECUTOR_USE_PODMAN is only supported on linux hosts."))
+		}
+		if c.UseFirecracker {
+			c.AddError(errors.New("EXECUTOR_USE_PODMAN and EXECUTOR_USE_FIRECRACKER cannot both be enabled, set EXECUTOR_USE_FIRECRACKER=false"))
+		}
+		if IsKubernetes() {
+			c.AddError(errors.New("EXECUTOR_USE_PODMAN is not supported in Kubernetes"))
+		}
+		if c.PodmanPidsLimit < 0 {
+			c.AddError(errors.New("EXECUTOR_PODMAN_PIDS_LIMIT must not be negative"))
+		}
+	}
+
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
 		if runtime.GOOS != "linux" {
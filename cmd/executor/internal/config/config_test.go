@@ -24,6 +24,10 @@ func TestConfig_Load(t *testing.T) {
 			return "10"
 		case "EXECUTOR_USE_FIRECRACKER":
 			return "true"
+		case "EXECUTOR_USE_PODMAN":
+			return "true"
+		case "EXECUTOR_PODMAN_PIDS_LIMIT":
+			return "512"
 		case "EXECUTOR_KEEP_WORKSPACES":
 			return "true"
 		case "EXECUTOR_JOB_NUM_CPUS":
@@ -90,6 +94,9 @@ func TestConfig_Load(t *testing.T) {
 	assert.Equal(t, 10*time.Second, cfg.QueuePollInterval)
 	assert.Equal(t, 10, cfg.MaximumNumJobs)
 	assert.True(t, cfg.UseFirecracker)
+	assert.True(t, cfg.UsePodman)
+	assert.Equal(t, "EXECUTOR_PODMAN_USERNS", cfg.PodmanUserNS)
+	assert.Equal(t, 512, cfg.PodmanPidsLimit)
 	assert.Equal(t, "EXECUTOR_FIRECRACKER_IMAGE", cfg.FirecrackerImage)
 	assert.Equal(t, "EXECUTOR_FIRECRACKER_KERNEL_IMAGE", cfg.FirecrackerKernelImage)
 	assert.Equal(t, "EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", cfg.FirecrackerSandboxImage)
@@ -200,6 +207,9 @@ func TestConfig_Load_Defaults(t *testing.T) {
 	assert.Empty(t, cfg.QueueNamesStr)
 	assert.Equal(t, time.Second, cfg.QueuePollInterval)
 	assert.Equal(t, 1, cfg.MaximumNumJobs)
+	assert.False(t, cfg.UsePodman)
+	assert.Equal(t, "keep-id", cfg.PodmanUserNS)
+	assert.Zero(t, cfg.PodmanPidsLimit)
 	assert.Equal(t, "sourcegraph/executor-vm:insiders", cfg.FirecrackerImage)
 	assert.Equal(t, "sourcegraph/ignite-kernel:5.10.135-amd64", cfg.FirecrackerKernelImage)
 	assert.Equal(t, "sourcegraph/ignite:v0.10.5", cfg.FirecrackerSandboxImage)
@@ -352,6 +362,26 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			expectedErr: errors.New("EXECUTOR_QUEUE_NAMES contains invalid queue name 'batches;codeintel', valid names are 'batches, codeintel' and should be comma-separated"),
 		},
+		{
+			name: "EXECUTOR_USE_PODMAN and EXECUTOR_USE_FIRECRACKER both enabled",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_USE_FIRECRACKER":
+					return "true"
+				case "EXECUTOR_USE_PODMAN":
+					return "true"
+				default:
+					return defaultValue
+				}
+			},
+			expectedErr: errors.New("EXECUTOR_USE_PODMAN and EXECUTOR_USE_FIRECRACKER cannot both be enabled, set EXECUTOR_USE_FIRECRACKER=false"),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -25,7 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
-func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFirecracker bool, logger log.Logger) queue.TelemetryOptions {
+func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFirecracker, usePodman bool, logger log.Logger) queue.TelemetryOptions {
 	t := queue.TelemetryOptions{
 		OS:              runtime.GOOS,
 		Architecture:    runtime.GOARCH,
@@ -45,9 +45,12 @@ func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFir
 			logger.Error("Failed to get src-cli version", log.Error(err))
 		}
 
-		t.DockerVersion, err = util.GetDockerVersion(ctx, runner)
-		if err != nil {
-			logger.Error("Failed to get docker version", log.Error(err))
+		// There is no docker daemon to ask when running with Podman.
+		if !usePodman {
+			t.DockerVersion, err = util.GetDockerVersion(ctx, runner)
+			if err != nil {
+				logger.Error("Failed to get docker version", log.Error(err))
+			}
 		}
 	}
 
@@ -71,6 +74,7 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 			DockerOptions:      dockerOptions(c),
 			FirecrackerOptions: firecrackerOptions(c),
 			KubernetesOptions:  kubernetesOptions(c),
+			PodmanOptions:      podmanOptions(c),
 		},
 		GitServicePath: "/.executors/git",
 		QueueOptions:   queueOptions(c, queueTelemetryOptions),
@@ -127,6 +131,17 @@ func firecrackerOptions(c *config.Config) runner.FirecrackerOptions {
 	}
 }
 
+func podmanOptions(c *config.Config) runner.PodmanOptions {
+	return runner.PodmanOptions{
+		Enabled: c.UsePodman,
+		ContainerOptions: command.PodmanOptions{
+			DockerOptions: dockerOptions(c),
+			UserNS:        c.PodmanUserNS,
+			PidsLimit:     c.PodmanPidsLimit,
+		},
+	}
+}
+
 func resourceOptions(c *config.Config) command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:             c.JobNumCPUs,
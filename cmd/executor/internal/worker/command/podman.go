@@ -0,0 +1,89 @@
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+)
+
+// PodmanOptions are the options that are specific to running a container with
+// rootless Podman.
+type PodmanOptions struct {
+	DockerOptions
+	// UserNS is the user namespace mode of the container. Every container gets a
+	// user namespace of its own. With "keep-id", the user running the executor is
+	// mapped to the same UID in the container, so the job can write to the
+	// workspace without any host privileges. With "auto", Podman allocates a
+	// unique UID range for each job.
+	UserNS string
+	// PidsLimit is the maximum number of processes a container can run. A value
+	// of zero sets no limit.
+	PidsLimit int
+}
+
+// NewPodmanSpec constructs the command to run on the host in order to invoke
+// the given spec. If the spec does not specify an image, then the command will
+// be run _directly_ on the host. Otherwise, the command will be run inside a
+// one-shot rootless Podman container subject to the resource limits specified
+// in the given options.
+func NewPodmanSpec(workingDir string, image string, scriptPath string, spec Spec, options PodmanOptions) Spec {
+	if image == "" {
+		return NewDockerSpec(workingDir, image, scriptPath, spec, options.DockerOptions)
+	}
+
+	hostDir := workingDir
+	if options.Resources.DockerHostMountPath != "" {
+		hostDir = filepath.Join(options.Resources.DockerHostMountPath, filepath.Base(workingDir))
+	}
+
+	return Spec{
+		Key:       spec.Key,
+		Command:   formatPodmanCommand(hostDir, image, scriptPath, spec, options),
+		Operation: spec.Operation,
+	}
+}
+
+func formatPodmanCommand(hostDir string, image string, scriptPath string, spec Spec, options PodmanOptions) []string {
+	return Flatten(
+		"podman",
+		"run",
+		"--rm",
+		podmanAuthFileFlag(options.ConfigPath),
+		podmanUserNSFlag(options.UserNS),
+		dockerHostGatewayFlag(options.AddHostGateway),
+		dockerResourceFlags(options.Resources),
+		podmanPidsLimitFlag(options.PidsLimit),
+		dockerVolumeFlags(hostDir),
+		dockerWorkingDirectoryFlags(spec.Dir),
+		dockerEnvFlags(spec.Env),
+		dockerEntrypointFlags,
+		image,
+		filepath.Join("/data", files.ScriptsPath, scriptPath),
+	)
+}
+
+// podmanAuthFileFlag points Podman at the docker config file written by the
+// runner. Unlike docker, Podman reads registry credentials from a file passed
+// to the run command instead of a config directory.
+func podmanAuthFileFlag(configPath string) []string {
+	if configPath == "" {
+		return nil
+	}
+	return []string{"--authfile", filepath.Join(configPath, "config.json")}
+}
+
+func podmanUserNSFlag(userNS string) []string {
+	if userNS == "" {
+		return nil
+	}
+	return []string{fmt.Sprintf("--userns=%s", userNS)}
+}
+
+func podmanPidsLimitFlag(limit int) []string {
+	if limit <= 0 {
+		return nil
+	}
+	return []string{"--pids-limit", strconv.Itoa(limit)}
+}
@@ -63,25 +63,36 @@ func (r *dockerRunner) Setup(ctx context.Context) error {
 
 	// If docker auth config is present, write it.
 	if len(r.dockerAuthConfig.Auths) > 0 {
-		d, err := json.Marshal(r.dockerAuthConfig)
-		if err != nil {
-			return err
-		}
-
-		dockerConfigPath, err := os.MkdirTemp(r.tmpDir, "docker_auth")
+		dockerConfigPath, err := writeDockerAuthConfig(r.tmpDir, r.dockerAuthConfig)
 		if err != nil {
 			return err
 		}
 		r.options.ConfigPath = dockerConfigPath
-
-		if err = os.WriteFile(filepath.Join(r.options.ConfigPath, "config.json"), d, os.ModePerm); err != nil {
-			return err
-		}
 	}
 
 	return nil
 }
 
+// writeDockerAuthConfig writes the given auth config to a config.json file in a
+// new directory within dir and returns the path to that directory.
+func writeDockerAuthConfig(dir string, dockerAuthConfig types.DockerAuthConfig) (string, error) {
+	d, err := json.Marshal(dockerAuthConfig)
+	if err != nil {
+		return "", err
+	}
+
+	dockerConfigPath, err := os.MkdirTemp(dir, "docker_auth")
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.WriteFile(filepath.Join(dockerConfigPath, "config.json"), d, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	return dockerConfigPath, nil
+}
+
 func (r *dockerRunner) Teardown(ctx context.Context) error {
 	if err := os.RemoveAll(r.tmpDir); err != nil {
 		r.internalLogger.Error(
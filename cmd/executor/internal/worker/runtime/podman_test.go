@@ -0,0 +1,77 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestPodmanRuntime_Name(t *testing.T) {
+	r := podmanRuntime{}
+	assert.Equal(t, "podman", string(r.Name()))
+}
+
+func TestPodmanRuntime_NewRunnerSpecs(t *testing.T) {
+	operations := command.NewOperations(observation.TestContextTB(t))
+
+	ws := NewMockWorkspace()
+	ws.ScriptFilenamesFunc.SetDefaultReturn([]string{"script-1.sh", "script-2.sh"})
+
+	job := types.Job{
+		DockerSteps: []types.DockerStep{
+			{
+				Key:      "key-1",
+				Image:    "my-image",
+				Commands: []string{"echo", "hello"},
+				Dir:      ".",
+				Env:      []string{"FOO=bar"},
+			},
+			{
+				Image:    "my-other-image",
+				Commands: []string{"echo", "world"},
+				Dir:      "sub",
+			},
+		},
+	}
+
+	r := &podmanRuntime{operations: operations}
+	actual, err := r.NewRunnerSpecs(ws, job)
+	require.NoError(t, err)
+
+	// Podman steps share the docker step keys, so that logs are rendered the
+	// same way regardless of the container runtime.
+	expected := []runner.Spec{
+		{
+			Job: job,
+			CommandSpecs: []command.Spec{
+				{
+					Key:       "step.docker.key-1",
+					Dir:       ".",
+					Env:       []string{"FOO=bar"},
+					Operation: operations.Exec,
+				},
+			},
+			Image:      "my-image",
+			ScriptPath: "script-1.sh",
+		},
+		{
+			Job: job,
+			CommandSpecs: []command.Spec{
+				{
+					Key:       "step.docker.1",
+					Dir:       "sub",
+					Operation: operations.Exec,
+				},
+			},
+			Image:      "my-other-image",
+			ScriptPath: "script-2.sh",
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
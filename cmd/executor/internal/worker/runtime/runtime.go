@@ -118,6 +118,27 @@ func New(
 		}, nil
 	}
 
+	if runnerOpts.PodmanOptions.Enabled {
+		// We explicitly want a Podman runtime. So validation must pass.
+		if err := util.ValidatePodmanTools(runner); err != nil {
+			var errMissingTools *util.ErrMissingTools
+			if errors.As(err, &errMissingTools) {
+				logger.Error("runtime 'podman' is not supported: missing required tools", log.Strings("podmanTools", errMissingTools.Tools))
+			} else {
+				logger.Error("failed to determine if podman tools are configured", log.Error(err))
+			}
+			return nil, err
+		}
+		logger.Info("using runtime 'podman'")
+		return &podmanRuntime{
+			operations:   ops,
+			filesStore:   filesStore,
+			cloneOptions: cloneOpts,
+			podmanOpts:   runnerOpts.PodmanOptions.ContainerOptions,
+			cmd:          cmd,
+		}, nil
+	}
+
 	// Default to Docker runtime.
 	if err := util.ValidateDockerTools(runner); err != nil {
 		var errMissingTools *util.ErrMissingTools
@@ -150,6 +171,7 @@ const (
 	NameFirecracker Name = "firecracker"
 	NameKubernetes  Name = "kubernetes"
 	NameShell       Name = "shell"
+	NamePodman      Name = "podman"
 )
 
 // CommandKey returns the fully formatted key for the command.
@@ -158,7 +180,7 @@ func CommandKey(name Name, rawStepKey string, index int) string {
 	case NameKubernetes:
 		return kubernetesKey(rawStepKey, index)
 	default:
-		// shell, docker, and firecracker all use the same key format.
+		// shell, docker, podman, and firecracker all use the same key format.
 		return dockerKey(rawStepKey, index)
 	}
 }
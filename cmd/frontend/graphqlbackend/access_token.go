@@ -7,7 +7,9 @@ import (
 	"github.com/graph-gophers/graphql-go/relay"
 
 	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 )
 
@@ -56,6 +58,26 @@ func (r *accessTokenResolver) Subject(ctx context.Context) (*UserResolver, error
 
 func (r *accessTokenResolver) Scopes() []string { return r.accessToken.Scopes }
 
+func (r *accessTokenResolver) EffectiveScopes() []string {
+	return authz.EffectiveScopes(r.accessToken.Scopes)
+}
+
+func (r *accessTokenResolver) Repositories(ctx context.Context) (*[]*RepositoryResolver, error) {
+	if len(r.accessToken.RepoIDs) == 0 {
+		return nil, nil
+	}
+	// Repositories the viewer can no longer access are left out.
+	repos, err := r.db.Repos().GetByIDs(ctx, r.accessToken.RepoIDs...)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*RepositoryResolver, 0, len(repos))
+	for _, repo := range repos {
+		resolvers = append(resolvers, NewRepositoryResolver(r.db, gitserver.NewClient("graphql.accesstoken"), repo))
+	}
+	return &resolvers, nil
+}
+
 func (r *accessTokenResolver) Note() string { return r.accessToken.Note }
 
 func (r *accessTokenResolver) Creator(ctx context.Context) (*UserResolver, error) {
@@ -0,0 +1,278 @@
+package graphqlbackend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go/types"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// rootFieldScopes maps the root fields of the schema that can be used with
+// fine-grained access token scopes to the scope they require, keyed by the
+// operation type and the field name. All other root fields require
+// authz.ScopeUserAll.
+var rootFieldScopes = map[string]string{
+	"query.search": authz.ScopeSearchRead,
+
+	"query.repository":         authz.ScopeRepoRead,
+	"query.repositories":       authz.ScopeRepoRead,
+	"query.repositoryRedirect": authz.ScopeRepoRead,
+
+	"query.insightsDashboards":       authz.ScopeInsightsRead,
+	"query.insightViews":             authz.ScopeInsightsRead,
+	"query.insightSeriesQueryStatus": authz.ScopeInsightsRead,
+	"query.searchQueryAggregate":     authz.ScopeInsightsRead,
+
+	"query.batchChanges":                          authz.ScopeBatchChangesWrite,
+	"query.batchChange":                           authz.ScopeBatchChangesWrite,
+	"query.batchSpecs":                            authz.ScopeBatchChangesWrite,
+	"query.globalChangesetsStats":                 authz.ScopeBatchChangesWrite,
+	"query.batchChangesCodeHosts":                 authz.ScopeBatchChangesWrite,
+	"query.availableBulkOperations":               authz.ScopeBatchChangesWrite,
+	"query.resolveWorkspacesForBatchSpec":         authz.ScopeBatchChangesWrite,
+	"query.maxUnlicensedChangesets":               authz.ScopeBatchChangesWrite,
+	"query.getChangesetsByIDs":                    authz.ScopeBatchChangesWrite,
+	"mutation.createChangesetSpec":                authz.ScopeBatchChangesWrite,
+	"mutation.createChangesetSpecs":               authz.ScopeBatchChangesWrite,
+	"mutation.syncChangeset":                      authz.ScopeBatchChangesWrite,
+	"mutation.reenqueueChangeset":                 authz.ScopeBatchChangesWrite,
+	"mutation.createBatchChange":                  authz.ScopeBatchChangesWrite,
+	"mutation.createBatchSpec":                    authz.ScopeBatchChangesWrite,
+	"mutation.createEmptyBatchChange":             authz.ScopeBatchChangesWrite,
+	"mutation.upsertEmptyBatchChange":             authz.ScopeBatchChangesWrite,
+	"mutation.createBatchSpecFromRaw":             authz.ScopeBatchChangesWrite,
+	"mutation.replaceBatchSpecInput":              authz.ScopeBatchChangesWrite,
+	"mutation.upsertBatchSpecInput":               authz.ScopeBatchChangesWrite,
+	"mutation.deleteBatchSpec":                    authz.ScopeBatchChangesWrite,
+	"mutation.executeBatchSpec":                   authz.ScopeBatchChangesWrite,
+	"mutation.applyBatchChange":                   authz.ScopeBatchChangesWrite,
+	"mutation.closeBatchChange":                   authz.ScopeBatchChangesWrite,
+	"mutation.moveBatchChange":                    authz.ScopeBatchChangesWrite,
+	"mutation.deleteBatchChange":                  authz.ScopeBatchChangesWrite,
+	"mutation.detachChangesets":                   authz.ScopeBatchChangesWrite,
+	"mutation.createChangesetComments":            authz.ScopeBatchChangesWrite,
+	"mutation.reenqueueChangesets":                authz.ScopeBatchChangesWrite,
+	"mutation.mergeChangesets":                    authz.ScopeBatchChangesWrite,
+	"mutation.closeChangesets":                    authz.ScopeBatchChangesWrite,
+	"mutation.publishChangesets":                  authz.ScopeBatchChangesWrite,
+	"mutation.cancelBatchSpecExecution":           authz.ScopeBatchChangesWrite,
+	"mutation.cancelBatchSpecWorkspaceExecution":  authz.ScopeBatchChangesWrite,
+	"mutation.retryBatchSpecWorkspaceExecution":   authz.ScopeBatchChangesWrite,
+	"mutation.retryBatchSpecExecution":            authz.ScopeBatchChangesWrite,
+	"mutation.enqueueBatchSpecWorkspaceExecution": authz.ScopeBatchChangesWrite,
+	"mutation.toggleBatchSpecAutoApply":           authz.ScopeBatchChangesWrite,
+}
+
+// typeScopes maps the types of the schema that belong to a fine-grained scope,
+// or that expose data of the user account, to the scope they require. Fields
+// of these types require the scope wherever they are selected, so that they
+// can't be reached through the root fields of other scopes, e.g. the creator of
+// a batch change.
+var typeScopes = func() map[string]string {
+	scopes := map[string]string{
+		"AccessToken":     authz.ScopeUserAll,
+		"ExternalAccount": authz.ScopeUserAll,
+		"ExternalService": authz.ScopeUserAll,
+		"Org":             authz.ScopeUserAll,
+		"Session":         authz.ScopeUserAll,
+		"Settings":        authz.ScopeUserAll,
+		"SettingsCascade": authz.ScopeUserAll,
+		"User":            authz.ScopeUserAll,
+		"UserEmail":       authz.ScopeUserAll,
+	}
+	for _, name := range schemaTypeNames(batchesSchema) {
+		scopes[name] = authz.ScopeBatchChangesWrite
+	}
+	for _, name := range schemaTypeNames(insightsSchema + insightsAggregationsSchema) {
+		scopes[name] = authz.ScopeInsightsRead
+	}
+	// Code navigation is part of reading a repository, the other code
+	// intelligence types expose its configuration and uploads.
+	for _, file := range []string{"codeintel.autoindexing.graphql", "codeintel.policies.graphql", "codeintel.ranking.graphql"} {
+		schema, err := codeIntelSchema.ReadFile(file)
+		if err != nil {
+			panic(err)
+		}
+		for _, name := range schemaTypeNames(string(schema)) {
+			scopes[name] = authz.ScopeUserAll
+		}
+	}
+	return scopes
+}()
+
+// publicFields are the fields of types in typeScopes that are available to
+// all access tokens. They identify a user or organization, e.g. the author of
+// a changeset, without exposing anything else about them.
+var publicFields = map[string]map[string]struct{}{
+	"User": {"id": {}, "databaseID": {}, "username": {}, "displayName": {}, "avatarURL": {}, "url": {}, "namespaceName": {}},
+	"Org":  {"id": {}, "name": {}, "displayName": {}, "url": {}, "namespaceName": {}},
+}
+
+var schemaTypeDefinition = lazyregexp.New(`(?m)^(?:type|interface|union)\s+(\w+)`)
+
+// schemaTypeNames returns the names of the output types defined in schema.
+func schemaTypeNames(schema string) []string {
+	var names []string
+	for _, m := range schemaTypeDefinition.FindAllStringSubmatch(schema, -1) {
+		names = append(names, m[1])
+	}
+	return names
+}
+
+// CheckAccessTokenScopes returns an error if the actor in ctx authenticated
+// with an access token that doesn't grant the scopes required by the fields
+// selected by query.
+//
+// Root fields require the scope in rootFieldScopes, and fields of the types in
+// typeScopes require the scope of their type wherever they are selected.
+// Introspection is allowed for all tokens.
+func CheckAccessTokenScopes(ctx context.Context, schema *types.Schema, query string) error {
+	if actor.FromContext(ctx).AccessTokenScopes == nil {
+		return nil
+	}
+
+	scopes, err := requiredScopes(schema, query)
+	if err != nil {
+		return err
+	}
+	for _, scope := range scopes {
+		if err := authz.CheckActorScope(ctx, scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requiredScopes returns the scopes required by the fields selected by all
+// operations in query.
+func requiredScopes(schema *types.Schema, query string) ([]string, error) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: query,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing query")
+	}
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		if frag, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[frag.Name.Value] = frag
+		}
+	}
+
+	var scopes []string
+	require := func(scope string) {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		var rootType string
+		if t, ok := schema.EntryPoints[op.Operation]; ok {
+			rootType = t.TypeName()
+		}
+
+		seen := make(map[string]struct{})
+		// visit walks the selections of set on the type typeName. It is empty
+		// if the type is unknown, invalid queries are rejected when they are
+		// executed.
+		var visit func(typeName string, root bool, set *ast.SelectionSet)
+		visit = func(typeName string, root bool, set *ast.SelectionSet) {
+			if set == nil {
+				return
+			}
+			for _, sel := range set.Selections {
+				switch sel := sel.(type) {
+				case *ast.Field:
+					// Introspection fields and __typename are available on
+					// every type.
+					name := sel.Name.Value
+					if strings.HasPrefix(name, "__") {
+						continue
+					}
+					if root {
+						scope, ok := rootFieldScopes[op.Operation+"."+name]
+						if !ok {
+							scope = authz.ScopeUserAll
+						}
+						require(scope)
+					} else if scope, ok := typeScopes[typeName]; ok {
+						if _, public := publicFields[typeName][name]; !public {
+							require(scope)
+						}
+					}
+					visit(fieldTypeName(schema, typeName, name), false, sel.SelectionSet)
+				case *ast.InlineFragment:
+					if sel.TypeCondition != nil && !root {
+						visit(sel.TypeCondition.Name.Value, false, sel.SelectionSet)
+					} else {
+						visit(typeName, root, sel.SelectionSet)
+					}
+				case *ast.FragmentSpread:
+					frag, ok := fragments[sel.Name.Value]
+					if !ok {
+						continue
+					}
+					key := fmt.Sprintf("%s/%t", frag.Name.Value, root)
+					if _, ok := seen[key]; ok {
+						continue
+					}
+					seen[key] = struct{}{}
+					if root {
+						visit(typeName, root, frag.SelectionSet)
+					} else {
+						visit(frag.TypeCondition.Name.Value, false, frag.SelectionSet)
+					}
+				}
+			}
+		}
+		visit(rootType, true, op.SelectionSet)
+	}
+
+	return scopes, nil
+}
+
+// fieldTypeName returns the name of the type of field on the type typeName,
+// or an empty string if it doesn't exist.
+func fieldTypeName(schema *types.Schema, typeName, field string) string {
+	var fields types.FieldsDefinition
+	switch t := schema.Types[typeName].(type) {
+	case *types.ObjectTypeDefinition:
+		fields = t.Fields
+	case *types.InterfaceTypeDefinition:
+		fields = t.Fields
+	}
+	f := fields.Get(field)
+	if f == nil {
+		return ""
+	}
+
+	typ := f.Type
+	for {
+		switch t := typ.(type) {
+		case *types.List:
+			typ = t.OfType
+		case *types.NonNull:
+			typ = t.OfType
+		case types.NamedType:
+			return t.TypeName()
+		default:
+			return ""
+		}
+	}
+}
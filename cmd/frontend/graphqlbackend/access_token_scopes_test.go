@@ -0,0 +1,121 @@
+package graphqlbackend
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+func TestCheckAccessTokenScopes(t *testing.T) {
+	schema := graphql.MustParseSchema(strings.Join([]string{mainSchema, batchesSchema, gitHubAppsSchema, insightsSchema}, "\n"), nil).ASTSchema()
+	withScopes := func(scopes ...string) context.Context {
+		return actor.WithActor(context.Background(), &actor.Actor{UID: 1, AccessTokenScopes: scopes})
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		query   string
+		wantErr bool
+	}{
+		{
+			name:  "not authenticated with an access token",
+			ctx:   actor.WithActor(context.Background(), &actor.Actor{UID: 1}),
+			query: `mutation { deleteUser(user: "VXNlcjox") { alwaysNil } }`,
+		},
+		{
+			name:  "user:all",
+			ctx:   withScopes(authz.ScopeUserAll),
+			query: `{ currentUser { username } }`,
+		},
+		{
+			name:  "search with search:read",
+			ctx:   withScopes(authz.ScopeSearchRead),
+			query: `query Search($q: String!) { search(query: $q) { results { matchCount } } }`,
+		},
+		{
+			name:    "search without search:read",
+			ctx:     withScopes(authz.ScopeRepoRead),
+			query:   `{ search(query: "foo") { results { matchCount } } }`,
+			wantErr: true,
+		},
+		{
+			name:  "introspection",
+			ctx:   withScopes(authz.ScopeSearchRead),
+			query: `{ __typename __schema { types { name } } }`,
+		},
+		{
+			name:    "root field without fine-grained scope",
+			ctx:     withScopes(authz.ScopeSearchRead, authz.ScopeRepoRead),
+			query:   `{ search(query: "foo") { results { matchCount } } currentUser { username } }`,
+			wantErr: true,
+		},
+		{
+			name:    "root field in fragment",
+			ctx:     withScopes(authz.ScopeRepoRead),
+			query:   `query { ...F } fragment F on Query { ... on Query { users { totalCount } } }`,
+			wantErr: true,
+		},
+		{
+			name:  "batch changes mutation",
+			ctx:   withScopes(authz.ScopeBatchChangesWrite),
+			query: `mutation { applyBatchChange(batchSpec: "QmF0Y2hTcGVjOjE=") { id } }`,
+		},
+		{
+			name:  "public fields of a nested user",
+			ctx:   withScopes(authz.ScopeBatchChangesWrite),
+			query: `{ batchChange(namespace: "VXNlcjox", name: "b") { creator { id username avatarURL } } }`,
+		},
+		{
+			name:    "private fields of a nested user",
+			ctx:     withScopes(authz.ScopeBatchChangesWrite),
+			query:   `{ batchChange(namespace: "VXNlcjox", name: "b") { creator { accessTokens { totalCount } } } }`,
+			wantErr: true,
+		},
+		{
+			name:    "private fields of a nested user in fragments",
+			ctx:     withScopes(authz.ScopeBatchChangesWrite),
+			query:   `{ batchChange(namespace: "VXNlcjox", name: "b") { ...B } } fragment B on BatchChange { creator { ... on User { emails { email } } } }`,
+			wantErr: true,
+		},
+		{
+			name:    "nested type of another scope",
+			ctx:     withScopes(authz.ScopeRepoRead),
+			query:   `{ repository(name: "r") { name batchChanges { nodes { name } } } }`,
+			wantErr: true,
+		},
+		{
+			name:  "nested type with its scope",
+			ctx:   withScopes(authz.ScopeRepoRead, authz.ScopeBatchChangesWrite),
+			query: `{ repository(name: "r") { name batchChanges { nodes { name } } } }`,
+		},
+		{
+			name:    "query and mutation field with the same name",
+			ctx:     withScopes(authz.ScopeSearchRead),
+			query:   `mutation { search { id } }`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid query",
+			ctx:     withScopes(authz.ScopeSearchRead),
+			query:   `{ search(`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckAccessTokenScopes(test.ctx, schema, test.query)
+			if test.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -27,6 +28,7 @@ type createAccessTokenInput struct {
 	Scopes          []string
 	Note            string
 	DurationSeconds *int32
+	Repositories    *[]graphql.ID
 }
 
 func (r *schemaResolver) CreateAccessToken(ctx context.Context, args *createAccessTokenInput) (*createAccessTokenResult, error) {
@@ -88,13 +90,16 @@ func (r *schemaResolver) CreateAccessToken(ctx context.Context, args *createAcce
 	}
 
 	// Validate scopes.
-	var hasUserAllScope bool
+	var hasUserAllScope, hasUserScope bool
 	seenScope := map[string]struct{}{}
 	sort.Strings(args.Scopes)
 	for _, scope := range args.Scopes {
 		switch scope {
 		case authz.ScopeUserAll:
 			hasUserAllScope = true
+			hasUserScope = true
+		case authz.ScopeSearchRead, authz.ScopeRepoRead, authz.ScopeBatchChangesWrite, authz.ScopeCodeIntelUpload, authz.ScopeInsightsRead:
+			hasUserScope = true
 		case authz.ScopeSiteAdminSudo:
 			// 🚨 SECURITY: Only site admins may create a token with the "site-admin:sudo" scope.
 			if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
@@ -111,12 +116,43 @@ func (r *schemaResolver) CreateAccessToken(ctx context.Context, args *createAcce
 		}
 		seenScope[scope] = struct{}{}
 	}
-	if !hasUserAllScope {
-		return nil, errors.Errorf("all access tokens must have scope %q", authz.ScopeUserAll)
+	if !hasUserScope {
+		return nil, errors.Errorf("access tokens must have at least one of the scopes %q", authz.UserScopes)
+	}
+	if _, ok := seenScope[authz.ScopeSiteAdminSudo]; ok && !hasUserAllScope {
+		return nil, errors.Errorf("access tokens with scope %q must also have scope %q", authz.ScopeSiteAdminSudo, authz.ScopeUserAll)
+	}
+
+	var repoIDs []api.RepoID
+	if args.Repositories != nil {
+		if len(*args.Repositories) == 0 {
+			return nil, errors.New("repositories must not be empty, omit it to allow access to all repositories")
+		}
+		repoIDs, err = UnmarshalRepositoryIDs(*args.Repositories)
+		if err != nil {
+			return nil, err
+		}
+		// 🚨 SECURITY: GetByIDs only returns the repositories the current user can access, so
+		// this also prevents probing for the existence of repositories through token creation.
+		repos, err := r.db.Repos().GetByIDs(ctx, repoIDs...)
+		if err != nil {
+			return nil, err
+		}
+		if len(repos) != len(repoIDs) {
+			return nil, errors.New("one or more of the repositories do not exist")
+		}
 	}
 
 	uid := actor.FromContext(ctx).UID
-	id, token, err := r.db.AccessTokens().Create(ctx, userID, args.Scopes, args.Note, uid, expiresAt)
+	var (
+		id    int64
+		token string
+	)
+	if len(repoIDs) > 0 {
+		id, token, err = r.db.AccessTokens().CreateForRepos(ctx, userID, args.Scopes, repoIDs, args.Note, uid, expiresAt)
+	} else {
+		id, token, err = r.db.AccessTokens().Create(ctx, userID, args.Scopes, args.Note, uid, expiresAt)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -151,16 +187,20 @@ func (r *schemaResolver) CreateAccessToken(ctx context.Context, args *createAcce
 		}()
 	}
 
-	return &createAccessTokenResult{id: marshalAccessTokenID(id), token: token}, err
+	return &createAccessTokenResult{id: marshalAccessTokenID(id), token: token, scopes: args.Scopes}, err
 }
 
 type createAccessTokenResult struct {
-	id    graphql.ID
-	token string
+	id     graphql.ID
+	token  string
+	scopes []string
 }
 
 func (r *createAccessTokenResult) ID() graphql.ID { return r.id }
 func (r *createAccessTokenResult) Token() string  { return r.token }
+func (r *createAccessTokenResult) EffectiveScopes() []string {
+	return authz.EffectiveScopes(r.scopes)
+}
 
 type deleteAccessTokenInput struct {
 	ByID    *graphql.ID
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	mockrequire "github.com/derision-test/go-mockgen/v2/testutil/require"
 	"github.com/graph-gophers/graphql-go"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/hexops/autogold/v2"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -188,6 +190,57 @@ func TestMutation_CreateAccessToken(t *testing.T) {
 		}
 	})
 
+	t.Run("authenticated as user, using fine-grained scopes restricted to repositories", func(t *testing.T) {
+		accessTokens := dbmocks.NewMockAccessTokenStore()
+		accessTokens.CreateForReposFunc.SetDefaultHook(func(_ context.Context, subjectUserID int32, scopes []string, repoIDs []api.RepoID, note string, creatorUserID int32, expiresAt time.Time) (int64, string, error) {
+			if want := []string{authz.ScopeRepoRead, authz.ScopeSearchRead}; !reflect.DeepEqual(scopes, want) {
+				t.Errorf("got %q, want %q", scopes, want)
+			}
+			if want := []api.RepoID{1, 2}; !reflect.DeepEqual(repoIDs, want) {
+				t.Errorf("got %v, want %v", repoIDs, want)
+			}
+			return 1, "t", nil
+		})
+		repos := dbmocks.NewMockRepoStore()
+		repos.GetByIDsFunc.SetDefaultReturn([]*types.Repo{{ID: 1}, {ID: 2}}, nil)
+
+		db := dbmocks.NewMockDB()
+		db.AccessTokensFunc.SetDefaultReturn(accessTokens)
+		db.ReposFunc.SetDefaultReturn(repos)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		result, err := newSchemaResolver(db, gitserver.NewTestClient(t), nil).CreateAccessToken(ctx, &createAccessTokenInput{
+			User:            uid1GQLID,
+			Scopes:          []string{authz.ScopeSearchRead, authz.ScopeRepoRead},
+			Note:            "n",
+			DurationSeconds: &defaultTokenDuration,
+			Repositories:    &[]graphql.ID{MarshalRepositoryID(1), MarshalRepositoryID(2)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "t", result.Token())
+		require.Equal(t, []string{authz.ScopeSearchRead, authz.ScopeRepoRead}, result.EffectiveScopes())
+		mockrequire.Called(t, accessTokens.CreateForReposFunc)
+	})
+
+	t.Run("authenticated as user, restricted to inaccessible repositories", func(t *testing.T) {
+		repos := dbmocks.NewMockRepoStore()
+		repos.GetByIDsFunc.SetDefaultReturn([]*types.Repo{{ID: 1}}, nil)
+
+		db := dbmocks.NewMockDB()
+		db.ReposFunc.SetDefaultReturn(repos)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		result, err := newSchemaResolver(db, gitserver.NewTestClient(t), nil).CreateAccessToken(ctx, &createAccessTokenInput{
+			User:            uid1GQLID,
+			Scopes:          []string{authz.ScopeRepoRead},
+			Note:            "n",
+			DurationSeconds: &defaultTokenDuration,
+			Repositories:    &[]graphql.ID{MarshalRepositoryID(1), MarshalRepositoryID(2)},
+		})
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
 	t.Run("authenticated as user, using site-admin-only scopes", func(t *testing.T) {
 		users := dbmocks.NewMockUserStore()
 		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: false}, nil)
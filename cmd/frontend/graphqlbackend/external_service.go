@@ -67,6 +67,8 @@ var availabilityCheck = map[string]bool{
 	extsvc.KindBitbucketCloud:  true,
 	extsvc.KindAzureDevOps:     true,
 	extsvc.KindPerforce:        true,
+
+	extsvc.KindGitea: true,
 }
 
 func externalServiceByID(ctx context.Context, db database.DB, gqlID graphql.ID) (*externalServiceResolver, error) {
@@ -31,8 +31,9 @@ func (fm *FileMatchResolver) File() *GitTreeEntryResolver {
 	// (which would make it slow). This GitCommitResolver will return empty
 	// values for all other fields.
 	opts := GitTreeEntryResolverOpts{
-		Commit: fm.Commit(),
-		Stat:   CreateFileInfo(fm.Path, false),
+		Commit:     fm.Commit(),
+		Stat:       CreateFileInfo(fm.Path, false),
+		LFSPointer: true,
 	}
 	return NewGitTreeEntryResolver(fm.db, gitserver.NewClient("graphql.filematch.tree"), opts)
 }
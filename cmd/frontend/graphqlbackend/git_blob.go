@@ -10,15 +10,35 @@ import (
 )
 
 type GitTreeEntryBlameArgs struct {
-	StartLine        *int32
-	EndLine          *int32
-	IgnoreWhitespace bool
+	StartLine              *int32
+	EndLine                *int32
+	IgnoreWhitespace       bool
+	OldestRevision         *string
+	IgnoreRevisions        *[]string
+	UseIgnoreRevisionsFile bool
+	DetectMoves            bool
+	DetectCopies           bool
 }
 
 func (r *GitTreeEntryResolver) Blame(ctx context.Context, args *GitTreeEntryBlameArgs) ([]*hunkResolver, error) {
 	opts := &gitserver.BlameOptions{
-		NewestCommit:     api.CommitID(r.commit.OID()),
-		IgnoreWhitespace: args.IgnoreWhitespace,
+		NewestCommit:      api.CommitID(r.commit.OID()),
+		IgnoreWhitespace:  args.IgnoreWhitespace,
+		UseIgnoreRevsFile: args.UseIgnoreRevisionsFile,
+		DetectMoves:       args.DetectMoves,
+		DetectCopies:      args.DetectCopies,
+	}
+
+	if args.OldestRevision != nil {
+		oldest, err := r.gitserverClient.ResolveRevision(ctx, r.commit.repoResolver.RepoName(), *args.OldestRevision, gitserver.ResolveRevisionOptions{EnsureRevision: true})
+		if err != nil {
+			return nil, err
+		}
+		opts.OldestCommit = oldest
+	}
+
+	if args.IgnoreRevisions != nil {
+		opts.IgnoreRevs = *args.IgnoreRevisions
 	}
 
 	if (args.StartLine == nil) != (args.EndLine == nil) {
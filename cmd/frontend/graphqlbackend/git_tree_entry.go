@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/fs"
+	"math"
 	"net/url"
 	"os"
 	"path"
@@ -14,10 +15,8 @@ import (
 	"time"
 
 	"github.com/inconshreveable/log15" //nolint:logging // TODO move all logging to sourcegraph/log
-	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/externallink"
-	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/cloneurls"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/highlight"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/binary"
@@ -29,7 +28,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gosyntect"
 	"github.com/sourcegraph/sourcegraph/internal/symbols"
-	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/languages"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -43,10 +41,16 @@ type GitTreeEntryResolver struct {
 	db              database.DB
 	gitserverClient gitserver.Client
 	commit          *GitCommitResolver
+	// lfsPointer makes Content return Git LFS pointer files as they are stored
+	// in the repository, instead of the content of the LFS objects.
+	lfsPointer bool
 
 	contentOnce      sync.Once
 	fullContentBytes []byte
 	contentErr       error
+	// lfsTooLarge is set by Content if the entry is an LFS object larger than
+	// maxLFSContentSize, in which case fullContentBytes is empty.
+	lfsTooLarge bool
 	// stat is this tree entry's file info. Its Name method must return the full path relative to
 	// the root, not the basename.
 	stat fs.FileInfo
@@ -75,6 +79,10 @@ type GitTreeResolver struct {
 type GitTreeEntryResolverOpts struct {
 	Commit *GitCommitResolver
 	Stat   fs.FileInfo
+	// LFSPointer is set for entries whose content must match what search,
+	// symbols and diffs saw, which is the Git LFS pointer file and not the
+	// content of the LFS object it points to.
+	LFSPointer bool
 }
 
 type GitTreeContentPageArgs struct {
@@ -87,6 +95,7 @@ func NewGitTreeEntryResolver(db database.DB, gitserverClient gitserver.Client, o
 		db:              db,
 		commit:          opts.Commit,
 		stat:            opts.Stat,
+		lfsPointer:      opts.LFSPointer,
 		gitserverClient: gitserverClient,
 	}
 }
@@ -130,12 +139,24 @@ func (r *GitTreeEntryResolver) ByteSize(ctx context.Context) (int32, error) {
 		return 0, err
 	}
 
+	if r.lfsTooLarge {
+		lfs, err := r.LFS(ctx)
+		if err != nil || lfs == nil {
+			return 0, err
+		}
+		return int32(min(lfs.size, math.MaxInt32)), nil
+	}
+
 	return int32(len(r.fullContentBytes)), nil
 }
 
 func (r *GitTreeEntryResolver) Content(ctx context.Context, args *GitTreeContentPageArgs) (string, error) {
 	r.contentOnce.Do(func() {
-		fr, err := r.gitserverClient.NewFileReader(
+		newFileReader := r.gitserverClient.NewFileReader
+		if r.lfsPointer {
+			newFileReader = r.gitserverClient.NewLFSPointerReader
+		}
+		fr, err := newFileReader(
 			ctx,
 			r.commit.repoResolver.RepoName(),
 			api.CommitID(r.commit.OID()),
@@ -147,7 +168,25 @@ func (r *GitTreeEntryResolver) Content(ctx context.Context, args *GitTreeContent
 		}
 		defer fr.Close()
 
-		r.fullContentBytes, r.contentErr = io.ReadAll(fr)
+		// Pointer files we asked for and blobs that are too large to be LFS
+		// pointers are read as is.
+		if r.lfsPointer || r.stat.Size() >= int64(lfsBlobSizeCutoff) {
+			r.fullContentBytes, r.contentErr = io.ReadAll(fr)
+			return
+		}
+
+		// Smaller blobs may be pointers that gitserver resolved to the content
+		// of an LFS object, so we don't read more than maxLFSContentSize.
+		content, err := io.ReadAll(io.LimitReader(fr, int64(maxLFSContentSize)+1))
+		if err != nil {
+			r.contentErr = err
+			return
+		}
+		if len(content) > maxLFSContentSize {
+			r.lfsTooLarge = true
+			return
+		}
+		r.fullContentBytes = content
 	})
 
 	return string(pageContent(r.fullContentBytes, int32ToIntPtr(args.StartLine), int32ToIntPtr(args.EndLine))), r.contentErr
@@ -258,7 +297,7 @@ func (r *GitTreeEntryResolver) Binary(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
-	return binary.IsBinary(r.fullContentBytes), nil
+	return r.lfsTooLarge || binary.IsBinary(r.fullContentBytes), nil
 }
 
 var syntaxHighlightFileBlocklist = []string{
@@ -278,6 +317,9 @@ func (r *GitTreeEntryResolver) Highlight(ctx context.Context, args *HighlightArg
 	if err != nil {
 		return nil, err
 	}
+	if r.lfsTooLarge {
+		return nil, highlight.ErrBinary
+	}
 
 	// special handling in dotcom to prevent syntax highlighting large lock files
 	for _, f := range syntaxHighlightFileBlocklist {
@@ -339,18 +381,23 @@ func (r *GitTreeEntryResolver) url(ctx context.Context) *url.URL {
 		return r.urlPath(r.commit.repoRevURL())
 	}
 
-	tr, ctx := trace.New(ctx, "GitTreeEntryResolver.url", attribute.Bool("submodule", true))
-	defer tr.End()
-	submoduleURL := submodule.URL()
-	if strings.HasPrefix(submoduleURL, "../") {
-		submoduleURL = path.Join(r.Repository().Name(), submoduleURL)
-	}
-	repoName, err := cloneURLToRepoName(ctx, r.db, submoduleURL)
+	repo, err := submodule.repository(ctx)
 	if err != nil {
-		log15.Error("Failed to resolve submodule repository name from clone URL", "cloneURL", submodule.URL(), "err", err)
+		log15.Error("Failed to resolve submodule repository from clone URL", "cloneURL", submodule.URL(), "err", err)
+		return &url.URL{}
+	}
+	if repo != nil {
+		return &url.URL{Path: "/" + string(repo.Name) + "@" + submodule.Commit()}
+	}
+
+	// Submodules pointing at a code host repository that isn't synced yet, or
+	// that the user can't see, still link to where it would be. The name is
+	// derived from .gitmodules, which the user can read anyway.
+	repoName, err := submodule.repoName(ctx)
+	if err != nil || repoName == "" {
 		return &url.URL{}
 	}
-	return &url.URL{Path: "/" + repoName + "@" + submodule.Commit()}
+	return &url.URL{Path: "/" + string(repoName) + "@" + submodule.Commit()}
 }
 
 func (r *GitTreeEntryResolver) CanonicalURL() string {
@@ -425,25 +472,16 @@ func (r *GitTreeEntryResolver) RawZipArchiveURL() string {
 
 func (r *GitTreeEntryResolver) Submodule() *gitSubmoduleResolver {
 	if submoduleInfo, ok := r.stat.Sys().(gitdomain.Submodule); ok {
-		return &gitSubmoduleResolver{submodule: submoduleInfo}
+		return &gitSubmoduleResolver{
+			db:              r.db,
+			gitserverClient: r.gitserverClient,
+			parent:          r.Repository().RepoName(),
+			submodule:       submoduleInfo,
+		}
 	}
 	return nil
 }
 
-func cloneURLToRepoName(ctx context.Context, db database.DB, cloneURL string) (_ string, err error) {
-	tr, ctx := trace.New(ctx, "cloneURLToRepoName")
-	defer tr.EndWithErr(&err)
-
-	repoName, err := cloneurls.RepoSourceCloneURLToRepoName(ctx, db, cloneURL)
-	if err != nil {
-		return "", err
-	}
-	if repoName == "" {
-		return "", errors.Errorf("no matching code host found for %s", cloneURL)
-	}
-	return string(repoName), nil
-}
-
 func CreateFileInfo(path string, isDir bool) fs.FileInfo {
 	return fileInfo{path: path, isDir: isDir}
 }
@@ -565,11 +603,24 @@ func (r *GitTreeEntryResolver) SymbolInfo(ctx context.Context, args *symbolInfoA
 }
 
 func (r *GitTreeEntryResolver) LFS(ctx context.Context) (*lfsResolver, error) {
-	content, err := r.Content(ctx, &GitTreeContentPageArgs{})
+	// The content of the entry is the content of the LFS object if gitserver
+	// resolves LFS pointers, so we need to ask for the pointer explicitly.
+	fr, err := r.gitserverClient.NewLFSPointerReader(
+		ctx,
+		r.commit.repoResolver.RepoName(),
+		api.CommitID(r.commit.OID()),
+		r.Path(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer fr.Close()
+
+	content, err := io.ReadAll(io.LimitReader(fr, int64(lfsBlobSizeCutoff)))
 	if err != nil {
 		return nil, err
 	}
-	return parseLFSPointer(content), nil
+	return parseLFSPointer(string(content)), nil
 }
 
 func (r *GitTreeEntryResolver) Ownership(ctx context.Context, args ListOwnershipArgs) (OwnershipConnectionResolver, error) {
@@ -4,14 +4,21 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/highlight"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
@@ -32,6 +39,118 @@ func TestGitTreeEntry_RawZipArchiveURL(t *testing.T) {
 	}
 }
 
+func TestGitTreeEntry_Submodule(t *testing.T) {
+	ctx := context.Background()
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetFirstRepoNameByCloneURLFunc.SetDefaultHook(func(_ context.Context, cloneURL string) (api.RepoName, error) {
+		switch cloneURL {
+		case "https://github.com/sourcegraph/zoekt.git":
+			return "github.com/sourcegraph/zoekt", nil
+		case "https://github.com/sourcegraph/private.git":
+			return "github.com/sourcegraph/private", nil
+		}
+		return "", nil
+	})
+	// Only zoekt is visible to the current user.
+	repos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+		if name != "github.com/sourcegraph/zoekt" {
+			return nil, &database.RepoNotFoundErr{Name: name}
+		}
+		return &types.Repo{ID: 2, Name: name}, nil
+	})
+	externalServices := dbmocks.NewMockExternalServiceStore()
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.ExternalServicesFunc.SetDefaultReturn(externalServices)
+	gitserverClient := gitserver.NewMockClient()
+
+	newResolver := func(sm gitdomain.Submodule) *GitTreeEntryResolver {
+		return NewGitTreeEntryResolver(db, gitserverClient, GitTreeEntryResolverOpts{
+			Commit: &GitCommitResolver{
+				repoResolver: NewRepositoryResolver(db, gitserverClient, &types.Repo{Name: "github.com/sourcegraph/sourcegraph"}),
+			},
+			Stat: &fileutil.FileInfo{Name_: sm.Path, Mode_: gitdomain.ModeSubmodule, Sys_: sm},
+		})
+	}
+
+	t.Run("resolved", func(t *testing.T) {
+		r := newResolver(gitdomain.Submodule{
+			URL:      "https://github.com/sourcegraph/zoekt.git",
+			Path:     "vendor/zoekt",
+			CommitID: "deadbeef",
+		})
+		repo, err := r.Submodule().Repository(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, repo)
+		assert.Equal(t, "github.com/sourcegraph/zoekt", repo.Name())
+
+		url, err := r.URL(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "/github.com/sourcegraph/zoekt@deadbeef", url)
+	})
+
+	t.Run("resolved by gitserver", func(t *testing.T) {
+		r := newResolver(gitdomain.Submodule{
+			URL:      "git@github.com:sourcegraph/zoekt.git",
+			Path:     "vendor/zoekt",
+			CommitID: "deadbeef",
+			RepoName: "github.com/sourcegraph/zoekt",
+		})
+		repo, err := r.Submodule().Repository(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, repo)
+		assert.Equal(t, "github.com/sourcegraph/zoekt", repo.Name())
+	})
+
+	t.Run("relative", func(t *testing.T) {
+		repos.GetFirstRepoNameByCloneURLFunc.PushHook(func(_ context.Context, cloneURL string) (api.RepoName, error) {
+			assert.Equal(t, "github.com/sourcegraph/zoekt", cloneURL)
+			return "github.com/sourcegraph/zoekt", nil
+		})
+		r := newResolver(gitdomain.Submodule{
+			URL:      "../zoekt",
+			Path:     "vendor/zoekt",
+			CommitID: "deadbeef",
+		})
+		url, err := r.URL(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "/github.com/sourcegraph/zoekt@deadbeef", url)
+	})
+
+	t.Run("not visible", func(t *testing.T) {
+		r := newResolver(gitdomain.Submodule{
+			URL:      "https://github.com/sourcegraph/private.git",
+			Path:     "vendor/private",
+			CommitID: "deadbeef",
+		})
+		repo, err := r.Submodule().Repository(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, repo)
+
+		// The name is derived from the submodule URL, so the entry still
+		// links to where the repository would be.
+		url, err := r.URL(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "/github.com/sourcegraph/private@deadbeef", url)
+	})
+
+	t.Run("unresolved", func(t *testing.T) {
+		r := newResolver(gitdomain.Submodule{
+			URL:      "https://example.com/unknown.git",
+			Path:     "vendor/unknown",
+			CommitID: "deadbeef",
+		})
+		repo, err := r.Submodule().Repository(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, repo)
+
+		url, err := r.URL(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, url)
+	})
+}
+
 func TestGitTreeEntry_Content(t *testing.T) {
 	wantPath := "foobar.md"
 	wantContent := "foobar"
@@ -72,6 +191,74 @@ func TestGitTreeEntry_Content(t *testing.T) {
 	}
 }
 
+func TestGitTreeEntry_ContentLargeLFSObject(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	gitserverClient := gitserver.NewMockClient()
+
+	gitserverClient.NewFileReaderFunc.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(bytes.Repeat([]byte("a"), maxLFSContentSize+1))), nil
+	})
+	gitserverClient.NewLFSPointerReaderFunc.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(`version https://git-lfs.github.com/spec/v1
+oid sha256:d4653571a605ece26e88b83cfcfa2697968ee4b8e97ecf37c9d2715e5f94f5ac
+size 2147483648
+`)), nil
+	})
+	opts := GitTreeEntryResolverOpts{
+		Commit: &GitCommitResolver{
+			repoResolver: NewRepositoryResolver(db, gitserverClient, &types.Repo{Name: "my/repo"}),
+		},
+		Stat: CreateFileInfo("model.bin", false),
+	}
+	gitTree := NewGitTreeEntryResolver(db, gitserverClient, opts)
+	ctx := context.Background()
+
+	content, err := gitTree.Content(ctx, &GitTreeContentPageArgs{})
+	require.NoError(t, err)
+	assert.Empty(t, content)
+
+	isBinary, err := gitTree.Binary(ctx)
+	require.NoError(t, err)
+	assert.True(t, isBinary)
+
+	byteSize, err := gitTree.ByteSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(math.MaxInt32), byteSize)
+
+	totalLines, err := gitTree.TotalLines(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, totalLines)
+
+	_, err = gitTree.Highlight(ctx, &HighlightArgs{})
+	assert.ErrorIs(t, err, highlight.ErrBinary)
+}
+
+func TestGitTreeEntry_ContentLFSPointer(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	gitserverClient := gitserver.NewMockClient()
+
+	pointer := `version https://git-lfs.github.com/spec/v1
+oid sha256:d4653571a605ece26e88b83cfcfa2697968ee4b8e97ecf37c9d2715e5f94f5ac
+size 2147483648
+`
+	gitserverClient.NewLFSPointerReaderFunc.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(pointer)), nil
+	})
+	opts := GitTreeEntryResolverOpts{
+		Commit: &GitCommitResolver{
+			repoResolver: NewRepositoryResolver(db, gitserverClient, &types.Repo{Name: "my/repo"}),
+		},
+		Stat:       CreateFileInfo("model.bin", false),
+		LFSPointer: true,
+	}
+	gitTree := NewGitTreeEntryResolver(db, gitserverClient, opts)
+
+	content, err := gitTree.Content(context.Background(), &GitTreeContentPageArgs{})
+	require.NoError(t, err)
+	assert.Equal(t, pointer, content)
+	assert.Empty(t, gitserverClient.NewFileReaderFunc.History())
+}
+
 func TestGitTreeEntry_ContentPagination(t *testing.T) {
 	wantPath := "foobar.md"
 
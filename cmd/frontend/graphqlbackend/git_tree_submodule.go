@@ -1,11 +1,33 @@
 package graphqlbackend
 
 import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 type gitSubmoduleResolver struct {
+	db              database.DB
+	gitserverClient gitserver.Client
+	// parent is the name of the repository that contains the submodule.
+	parent    api.RepoName
 	submodule gitdomain.Submodule
+
+	nameOnce sync.Once
+	name     api.RepoName
+	nameErr  error
+
+	repoOnce sync.Once
+	repo     *types.Repo
+	repoErr  error
 }
 
 func (r *gitSubmoduleResolver) URL() string {
@@ -19,3 +41,59 @@ func (r *gitSubmoduleResolver) Commit() string {
 func (r *gitSubmoduleResolver) Path() string {
 	return r.submodule.Path
 }
+
+func (r *gitSubmoduleResolver) Repository(ctx context.Context) (*RepositoryResolver, error) {
+	repo, err := r.repository(ctx)
+	if err != nil || repo == nil {
+		return nil, err
+	}
+	return NewRepositoryResolver(r.db, r.gitserverClient, repo), nil
+}
+
+// repoName returns the name of the repository that the submodule URL points
+// to, or the empty string if no code host matches it. The repository doesn't
+// necessarily exist on this instance, or might not be visible to the current
+// user.
+func (r *gitSubmoduleResolver) repoName(ctx context.Context) (api.RepoName, error) {
+	r.nameOnce.Do(func() {
+		// gitserver already resolves the name in Stat and ReadDir, only
+		// resolve it here if it didn't find one.
+		if r.submodule.RepoName != "" {
+			r.name = r.submodule.RepoName
+			return
+		}
+		r.name, r.nameErr = repos.SubmoduleRepoName(ctx, r.db, r.parent, r.submodule.URL)
+	})
+	return r.name, r.nameErr
+}
+
+// repository returns the repository on this instance that the submodule URL
+// points to, or nil if there is none the current user can see.
+func (r *gitSubmoduleResolver) repository(ctx context.Context) (*types.Repo, error) {
+	r.repoOnce.Do(func() {
+		r.repo, r.repoErr = r.lookupRepository(ctx)
+	})
+	return r.repo, r.repoErr
+}
+
+func (r *gitSubmoduleResolver) lookupRepository(ctx context.Context) (_ *types.Repo, err error) {
+	tr, ctx := trace.New(ctx, "gitSubmoduleResolver.lookupRepository")
+	defer tr.EndWithErr(&err)
+
+	name, err := r.repoName(ctx)
+	if err != nil || name == "" {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: The repo store enforces repository permissions for the
+	// actor in ctx, so submodules pointing at repositories the user can't
+	// see are not resolved.
+	repo, err := r.db.Repos().GetByName(ctx, name)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return repo, nil
+}
@@ -39,6 +39,8 @@ func (r *schemaResolver) gitserverByID(ctx context.Context, id graphql.ID) (*git
 		address:             si.Address,
 		freeDiskSpaceBytes:  si.FreeSpace,
 		totalDiskSpaceBytes: si.TotalSpace,
+		tieredRepos:         si.TieredRepos,
+		tieredSizeBytes:     si.TieredSizeBytes,
 	}, nil
 }
 
@@ -59,6 +61,8 @@ func (r *schemaResolver) Gitservers(ctx context.Context) (graphqlutil.SliceConne
 			address:             info.Address,
 			freeDiskSpaceBytes:  info.FreeSpace,
 			totalDiskSpaceBytes: info.TotalSpace,
+			tieredRepos:         info.TieredRepos,
+			tieredSizeBytes:     info.TieredSizeBytes,
 		})
 	}
 	noOfResolvers := len(resolvers)
@@ -69,6 +73,8 @@ type gitserverResolver struct {
 	address             string
 	freeDiskSpaceBytes  uint64
 	totalDiskSpaceBytes uint64
+	tieredRepos         uint64
+	tieredSizeBytes     uint64
 }
 
 // ID returns a unique GraphQL ID for the gitserver instance.
@@ -100,3 +106,15 @@ func (g *gitserverResolver) FreeDiskSpaceBytes() BigInt {
 func (g *gitserverResolver) TotalDiskSpaceBytes() BigInt {
 	return BigInt(g.totalDiskSpaceBytes)
 }
+
+// TieredRepositoriesCount returns the number of repositories of the gitserver
+// that have been offloaded to cold storage.
+func (g *gitserverResolver) TieredRepositoriesCount() int32 {
+	return int32(g.tieredRepos)
+}
+
+// TieredRepositoriesSizeBytes returns the size of the repositories of the
+// gitserver that have been offloaded to cold storage.
+func (g *gitserverResolver) TieredRepositoriesSizeBytes() BigInt {
+	return BigInt(g.tieredSizeBytes)
+}
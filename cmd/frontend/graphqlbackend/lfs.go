@@ -23,6 +23,10 @@ var (
 	// this is the same size used by git-lfs to determine if it is worth
 	// parsing a file as a pointer.
 	lfsBlobSizeCutoff = 1024
+	// maxLFSContentSize is the largest LFS object we read into memory when
+	// gitserver resolves a pointer to its content. Larger objects are treated
+	// like other large binaries, only the raw endpoint streams them.
+	maxLFSContentSize = 10 * 1024 * 1024
 )
 
 func parseLFSPointer(b string) *lfsResolver {
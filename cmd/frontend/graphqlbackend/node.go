@@ -79,6 +79,16 @@ func (r *NodeResolver) ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, boo
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorTeamsWebhook() (MonitorTeamsWebhookResolver, bool) {
+	n, ok := r.Node.(MonitorTeamsWebhookResolver)
+	return n, ok
+}
+
+func (r *NodeResolver) ToMonitorIssue() (MonitorIssueResolver, bool) {
+	n, ok := r.Node.(MonitorIssueResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorActionEvent() (MonitorActionEventResolver, bool) {
 	n, ok := r.Node.(MonitorActionEventResolver)
 	return n, ok
@@ -382,6 +392,11 @@ func (r *NodeResolver) ToSearchJob() (SearchJobResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToSearchJobSchedule() (SearchJobScheduleResolver, bool) {
+	n, ok := r.Node.(SearchJobScheduleResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToCodeGraphData() (resolverstubs.CodeGraphDataResolver, bool) {
 	n, ok := r.Node.(resolverstubs.CodeGraphDataResolver)
 	return n, ok
@@ -17,6 +17,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+
+	// Register the repository, precise index, search job and code monitor
+	// event types, which are sent by other services.
+	_ "github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 )
 
 const outboundWebhookIDKind = "OutboundWebhook"
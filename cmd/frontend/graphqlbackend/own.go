@@ -58,6 +58,9 @@ type OwnResolver interface {
 	CodeownersIngestedFiles(context.Context, *CodeownersIngestedFilesArgs) (CodeownersIngestedFileConnectionResolver, error)
 	RepoIngestedCodeowners(context.Context, api.RepoID) (CodeownersIngestedFileResolver, error)
 
+	// Coverage reports.
+	RepoOwnershipCoverage(context.Context, *RepositoryResolver, *OwnershipCoverageArgs) ([]OwnershipCoverageSnapshotResolver, error)
+
 	// Codeowners mutations.
 	AddCodeownersFile(context.Context, *CodeownersFileArgs) (CodeownersIngestedFileResolver, error)
 	UpdateCodeownersFile(context.Context, *CodeownersFileArgs) (CodeownersIngestedFileResolver, error)
@@ -89,6 +92,35 @@ type OwnershipStatsResolver interface {
 	UpdatedAt(ctx context.Context) (*gqlutil.DateTime, error)
 }
 
+type OwnershipCoverageArgs struct {
+	Path  string
+	Since *gqlutil.DateTime
+	First int32
+}
+
+type OwnershipCoverageSnapshotResolver interface {
+	CreatedAt() gqlutil.DateTime
+	OID() GitObjectID
+	Coverage() OwnershipCoverageResolver
+	Directories() []OwnershipCoverageResolver
+	Teams(context.Context) ([]OwnershipCoverageTeamResolver, error)
+}
+
+type OwnershipCoverageResolver interface {
+	Path() string
+	TotalFiles() int32
+	TotalCodeownedFiles() int32
+	TotalAssignedOwnershipFiles() int32
+	TotalRecentContributorFiles() int32
+	TotalOwnedFiles() int32
+	OwnedFraction() float64
+}
+
+type OwnershipCoverageTeamResolver interface {
+	Team() *TeamResolver
+	OwnedFiles() int32
+}
+
 type Ownable interface {
 	ToGitBlob(context.Context) (*GitTreeEntryResolver, bool)
 }
@@ -123,6 +155,9 @@ type CodeownersFileEntryResolver interface {
 	Description() (string, error)
 	CodeownersFile(context.Context) (FileResolver, error)
 	RuleLineMatch(context.Context) (int32, error)
+	Section(context.Context) (*string, error)
+	SectionOptional(context.Context) (bool, error)
+	ApprovalsRequired(context.Context) (int32, error)
 }
 
 type RecentContributorOwnershipSignalResolver interface {
@@ -201,8 +201,9 @@ func (r *RepositoryComparisonResolver) FileDiffs(ctx context.Context, args *File
 // RepositoryComparisonResolver to produce the new file in a FileDiffResolver.
 func repositoryComparisonNewFile(db database.DB, r *fileDiffResolver) FileResolver {
 	opts := GitTreeEntryResolverOpts{
-		Commit: r.Head,
-		Stat:   CreateFileInfo(r.FileDiff.NewName, false),
+		Commit:     r.Head,
+		Stat:       CreateFileInfo(r.FileDiff.NewName, false),
+		LFSPointer: true,
 	}
 	return NewGitTreeEntryResolver(db, r.gitserverClient, opts)
 }
@@ -436,8 +437,9 @@ func (r *fileDiffResolver) OldFile() FileResolver {
 		return nil
 	}
 	opts := GitTreeEntryResolverOpts{
-		Commit: r.Base,
-		Stat:   CreateFileInfo(r.FileDiff.OrigName, false),
+		Commit:     r.Base,
+		Stat:       CreateFileInfo(r.FileDiff.OrigName, false),
+		LFSPointer: true,
 	}
 	return NewGitTreeEntryResolver(r.db, r.gitserverClient, opts)
 }
@@ -14,10 +14,13 @@ type SearchJobsResolver interface {
 	CreateSearchJob(ctx context.Context, args *CreateSearchJobArgs) (SearchJobResolver, error)
 	CancelSearchJob(ctx context.Context, args *CancelSearchJobArgs) (*EmptyResponse, error)
 	DeleteSearchJob(ctx context.Context, args *DeleteSearchJobArgs) (*EmptyResponse, error)
+	CreateSearchJobSchedule(ctx context.Context, args *CreateSearchJobScheduleArgs) (SearchJobScheduleResolver, error)
+	DeleteSearchJobSchedule(ctx context.Context, args *DeleteSearchJobScheduleArgs) (*EmptyResponse, error)
 
 	// Queries
 	SearchJobs(ctx context.Context, args *SearchJobsArgs) (*graphqlutil.ConnectionResolver[SearchJobResolver], error)
 	ValidateSearchJob(ctx context.Context, args *CreateSearchJobArgs) (*EmptyResponse, error)
+	SearchJobSchedules(ctx context.Context, args *SearchJobSchedulesArgs) ([]SearchJobScheduleResolver, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -47,6 +50,27 @@ type SearchJobResolver interface {
 	URL(ctx context.Context) (*string, error)
 	LogURL(ctx context.Context) (*string, error)
 	RepoStats(ctx context.Context) (SearchJobStatsResolver, error)
+	Schedule(ctx context.Context) (SearchJobScheduleResolver, error)
+	ResultCount() *int32
+	ResultsDiff() SearchJobResultsDiffResolver
+}
+
+type SearchJobScheduleResolver interface {
+	ID() graphql.ID
+	Query() string
+	Schedule() string
+	RetainRuns() int32
+	Creator(ctx context.Context) (*UserResolver, error)
+	CreatedAt() gqlutil.DateTime
+	NextRunAt() gqlutil.DateTime
+	Runs(ctx context.Context) ([]SearchJobResolver, error)
+}
+
+type SearchJobResultsDiffResolver interface {
+	PreviousRun(ctx context.Context) (SearchJobResolver, error)
+	Added() int32
+	Removed() int32
+	URL(ctx context.Context) (*string, error)
 }
 
 type SearchJobStatsResolver interface {
@@ -74,6 +98,20 @@ type DeleteSearchJobArgs struct {
 	ID graphql.ID
 }
 
+type CreateSearchJobScheduleArgs struct {
+	Query      string
+	Schedule   string
+	RetainRuns *int32
+}
+
+type DeleteSearchJobScheduleArgs struct {
+	ID graphql.ID
+}
+
+type SearchJobSchedulesArgs struct {
+	UserIDs *[]graphql.ID
+}
+
 type RetrySearchJobArgs struct {
 	ID graphql.ID
 }
@@ -144,8 +144,9 @@ func (r symbolResolver) Location() *locationResolver {
 	stat := CreateFileInfo(r.Symbol.Path, false)
 	sr := r.Symbol.Range()
 	opts := GitTreeEntryResolverOpts{
-		Commit: r.commit,
-		Stat:   stat,
+		Commit:     r.commit,
+		Stat:       stat,
+		LFSPointer: true,
 	}
 	return &locationResolver{
 		resource: NewGitTreeEntryResolver(r.db, gitserver.NewClient("graphql.symbols"), opts),
@@ -0,0 +1,69 @@
+package giteaoauth
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	oauth2Login "github.com/dghubble/gologin/v2/oauth2"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const sessionKey = "giteaoauth@0"
+
+// requestedScopes are the scopes requested from Gitea. Versions of Gitea and
+// Forgejo that don't support scoped OAuth2 tokens ignore them and grant full
+// access instead.
+var requestedScopes = []string{"read:user", "read:repository", "read:organization"}
+
+func parseProvider(logger log.Logger, db database.DB, callbackURL string, p *schema.GiteaAuthProvider, sourceCfg schema.AuthProviders) (provider *oauth.Provider, messages []string) {
+	parsedURL, err := url.Parse(p.Url)
+	if err != nil {
+		messages = append(messages, fmt.Sprintf("Could not parse Gitea URL %q. You will not be able to login via this Gitea instance.", p.Url))
+		return nil, messages
+	}
+	parsedURL = extsvc.NormalizeBaseURL(parsedURL)
+	codeHost := extsvc.NewCodeHost(parsedURL, extsvc.TypeGitea)
+
+	return oauth.NewProvider(oauth.ProviderOp{
+		AuthPrefix: authPrefix,
+		OAuth2Config: func() oauth2.Config {
+			return oauth2.Config{
+				RedirectURL:  callbackURL,
+				ClientID:     p.ClientID,
+				ClientSecret: p.ClientSecret,
+				Scopes:       requestedScopes,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:  codeHost.BaseURL.ResolveReference(&url.URL{Path: "login/oauth/authorize"}).String(),
+					TokenURL: codeHost.BaseURL.ResolveReference(&url.URL{Path: "login/oauth/access_token"}).String(),
+				},
+			}
+		},
+		SourceConfig: sourceCfg,
+		ServiceID:    codeHost.ServiceID,
+		ServiceType:  codeHost.ServiceType,
+		Login: func(oauth2Cfg oauth2.Config) http.Handler {
+			return oauth2Login.LoginHandler(&oauth2Cfg, nil)
+		},
+		Callback: func(oauth2Cfg oauth2.Config) http.Handler {
+			return oauth2Login.CallbackHandler(
+				&oauth2Cfg,
+				oauth.SessionIssuer(logger, db, &sessionIssuerHelper{
+					logger:      logger.Scoped("sessionIssuerHelper"),
+					db:          db,
+					CodeHost:    codeHost,
+					clientID:    p.ClientID,
+					allowSignup: p.AllowSignup,
+				}, sessionKey),
+				nil,
+			)
+		},
+	}), messages
+}
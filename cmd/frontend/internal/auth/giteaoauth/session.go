@@ -0,0 +1,193 @@
+package giteaoauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/hubspot"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/hubspot/hubspotutil"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/providers"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/session"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	esauth "github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/telemetry/telemetryrecorder"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const urnGiteaOAuth = "GiteaOAuth"
+
+type sessionIssuerHelper struct {
+	*extsvc.CodeHost
+	logger      log.Logger
+	clientID    string
+	db          database.DB
+	allowSignup *bool
+	client      gitea.Client
+}
+
+func (s *sessionIssuerHelper) AuthSucceededEventName() database.SecurityEventName {
+	return database.SecurityEventGiteaAuthSucceeded
+}
+
+func (s *sessionIssuerHelper) AuthFailedEventName() database.SecurityEventName {
+	return database.SecurityEventGiteaAuthFailed
+}
+
+func (s *sessionIssuerHelper) GetServiceID() string {
+	return s.ServiceID
+}
+
+func (s *sessionIssuerHelper) GetOrCreateUser(ctx context.Context, token *oauth2.Token, hubSpotProps *hubspot.ContactProperties) (newUserCreated bool, actr *actor.Actor, safeErrMsg string, err error) {
+	client := s.client
+	if client == nil {
+		client, err = gitea.NewClient(urnGiteaOAuth, s.BaseURL, nil, nil)
+		if err != nil {
+			return false, nil, "Could not initialize Gitea client", err
+		}
+	}
+
+	// The token used here is fresh from Gitea OAuth, so we don't bother with
+	// setting up token refreshing yet. If account creation/linking succeeds,
+	// the token will be stored in the database with the refresh token, and
+	// refreshing can happen from that point.
+	client, err = client.WithAuthenticator(&esauth.OAuthBearerToken{Token: token.AccessToken})
+	if err != nil {
+		return false, nil, "Could not initialize Gitea client", err
+	}
+
+	gUser, err := client.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return false, nil, "Could not read Gitea user from callback request.", errors.Wrap(err, "could not read user from gitea")
+	}
+
+	login, err := auth.NormalizeUsername(gUser.Login)
+	if err != nil {
+		return false, nil, fmt.Sprintf("Error normalizing the username %q. See https://sourcegraph.com/docs/admin/auth/#username-normalization.", login), err
+	}
+
+	var data extsvc.AccountData
+	if err := gitea.SetExternalAccountData(&data, gUser, token); err != nil {
+		return false, nil, "", err
+	}
+
+	emails, err := client.ListAuthenticatedUserEmails(ctx)
+	if err != nil {
+		return false, nil, "Could not read email addresses of Gitea user.", err
+	}
+
+	// AllowSignup defaults to true when not set.
+	signupAllowed := s.allowSignup == nil || *s.allowSignup
+
+	attempts, err := buildUserFetchAttempts(emails, signupAllowed)
+	if err != nil {
+		return false, nil, "Could not find verified email address for Gitea user.", err
+	}
+
+	var (
+		firstSafeErrMsg string
+		firstErr        error
+	)
+
+	recorder := telemetryrecorder.New(s.db)
+	for i, attempt := range attempts {
+		newUserCreated, userID, safeErrMsg, err := auth.GetAndSaveUser(ctx, s.logger, s.db, recorder, auth.GetAndSaveUserOp{
+			UserProps: database.NewUser{
+				Username:        login,
+				Email:           attempt.email,
+				EmailIsVerified: true,
+				DisplayName:     gUser.FullName,
+				AvatarURL:       gUser.AvatarURL,
+			},
+			ExternalAccount: extsvc.AccountSpec{
+				ServiceType: s.ServiceType,
+				ServiceID:   s.ServiceID,
+				ClientID:    s.clientID,
+				AccountID:   strconv.FormatInt(gUser.ID, 10),
+			},
+			ExternalAccountData: data,
+			CreateIfNotExist:    attempt.createIfNotExist,
+		})
+		if err == nil {
+			go hubspotutil.SyncUser(attempt.email, hubspotutil.SignupEventID, hubSpotProps)
+			return newUserCreated, actor.FromUser(userID), "", nil
+		}
+		if i == 0 {
+			firstSafeErrMsg, firstErr = safeErrMsg, err
+		}
+	}
+
+	// On failure, return the first error
+	verifiedEmails := make([]string, 0, len(attempts))
+	for _, attempt := range attempts {
+		verifiedEmails = append(verifiedEmails, attempt.email)
+	}
+	return false, nil, fmt.Sprintf("No Sourcegraph user exists matching any of the verified emails: %s.\n\nFirst error was: %s", strings.Join(verifiedEmails, ", "), firstSafeErrMsg), firstErr
+}
+
+type attempt struct {
+	email            string
+	createIfNotExist bool
+}
+
+func buildUserFetchAttempts(emails []*gitea.Email, allowSignup bool) ([]attempt, error) {
+	attempts := []attempt{}
+	var primary string
+	for _, email := range emails {
+		if !email.Verified {
+			continue
+		}
+		attempts = append(attempts, attempt{
+			email:            email.Email,
+			createIfNotExist: false,
+		})
+		if email.Primary {
+			primary = email.Email
+		}
+	}
+	if len(attempts) == 0 {
+		return nil, errors.New("no verified email")
+	}
+	// If allowSignup is true, we will create an account using the primary
+	// email address from Gitea, or the first verified one if the primary
+	// address isn't verified. Note that the order of attempts is important.
+	// If we manage to connect with an existing account we return early and
+	// don't attempt to create a new account.
+	if allowSignup {
+		if primary == "" {
+			primary = attempts[0].email
+		}
+		attempts = append(attempts, attempt{
+			email:            primary,
+			createIfNotExist: true,
+		})
+	}
+
+	return attempts, nil
+}
+
+func (s *sessionIssuerHelper) DeleteStateCookie(w http.ResponseWriter, r *http.Request) {
+	session.SetData(w, r, "oauthState", "")
+}
+
+func (s *sessionIssuerHelper) SessionData(token *oauth2.Token) oauth.SessionData {
+	return oauth.SessionData{
+		ID: providers.ConfigID{
+			ID:   s.ServiceID,
+			Type: s.ServiceType,
+		},
+		AccessToken: token.AccessToken,
+		TokenType:   token.Type(),
+	}
+}
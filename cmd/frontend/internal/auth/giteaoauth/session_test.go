@@ -0,0 +1,201 @@
+package giteaoauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestSessionIssuerHelper_GetOrCreateUser(t *testing.T) {
+	ratelimit.SetupForTest(t)
+
+	clientID := "client-id"
+
+	authSaveableUsers := map[string]int32{
+		"alice": 1,
+	}
+
+	signupNotAllowed := new(bool)
+
+	type input struct {
+		description string
+		user        *gitea.User
+		emails      []*gitea.Email
+		allowSignup *bool
+	}
+
+	cases := []struct {
+		inputs         []input
+		expActor       *actor.Actor
+		expErr         bool
+		expAuthUserOps []auth.GetAndSaveUserOp
+	}{
+		{
+			inputs: []input{{
+				description: "user with verified email, allowSignup not set, defaults to true -> existing user found",
+				user:        &gitea.User{ID: 101, Login: "alice", FullName: "Alice"},
+				emails: []*gitea.Email{
+					{Email: "alice@example.com", Verified: true, Primary: true},
+					{Email: "alice@example.org", Verified: false},
+				},
+			}},
+			expActor: &actor.Actor{UID: 1},
+			expAuthUserOps: []auth.GetAndSaveUserOp{{
+				UserProps: database.NewUser{
+					Username:        "alice",
+					Email:           "alice@example.com",
+					EmailIsVerified: true,
+					DisplayName:     "Alice",
+				},
+				CreateIfNotExist: false,
+			}},
+		},
+		{
+			inputs: []input{{
+				description: "unknown user, allowSignup not set -> sign up attempted with primary email",
+				user:        &gitea.User{ID: 102, Login: "bob"},
+				emails: []*gitea.Email{
+					{Email: "bob@example.org", Verified: true},
+					{Email: "bob@example.com", Verified: true, Primary: true},
+				},
+			}},
+			expErr: true,
+			expAuthUserOps: []auth.GetAndSaveUserOp{
+				{
+					UserProps:        database.NewUser{Username: "bob", Email: "bob@example.org", EmailIsVerified: true},
+					CreateIfNotExist: false,
+				},
+				{
+					UserProps:        database.NewUser{Username: "bob", Email: "bob@example.com", EmailIsVerified: true},
+					CreateIfNotExist: false,
+				},
+				{
+					UserProps:        database.NewUser{Username: "bob", Email: "bob@example.com", EmailIsVerified: true},
+					CreateIfNotExist: true,
+				},
+			},
+		},
+		{
+			inputs: []input{{
+				description: "unknown user, allowSignup set to false -> no account created",
+				user:        &gitea.User{ID: 102, Login: "bob"},
+				emails: []*gitea.Email{
+					{Email: "bob@example.com", Verified: true, Primary: true},
+				},
+				allowSignup: signupNotAllowed,
+			}},
+			expErr: true,
+			expAuthUserOps: []auth.GetAndSaveUserOp{{
+				UserProps:        database.NewUser{Username: "bob", Email: "bob@example.com", EmailIsVerified: true},
+				CreateIfNotExist: false,
+			}},
+		},
+		{
+			inputs: []input{{
+				description: "user without verified email -> no session created",
+				user:        &gitea.User{ID: 101, Login: "alice"},
+				emails: []*gitea.Email{
+					{Email: "alice@example.com", Verified: false, Primary: true},
+				},
+			}},
+			expErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		for _, ci := range c.inputs {
+			c, ci := c, ci
+
+			t.Run(ci.description, func(t *testing.T) {
+				srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case "/api/v1/user":
+						json.NewEncoder(w).Encode(ci.user)
+					case "/api/v1/user/emails":
+						json.NewEncoder(w).Encode(ci.emails)
+					default:
+						w.WriteHeader(http.StatusNotFound)
+					}
+				}))
+				t.Cleanup(srv.Close)
+
+				baseURL, _ := url.Parse(srv.URL)
+				codeHost := extsvc.NewCodeHost(baseURL, extsvc.TypeGitea)
+				client, err := gitea.NewClient(urnGiteaOAuth, baseURL, nil, httpcli.TestExternalDoer)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var gotAuthUserOps []auth.GetAndSaveUserOp
+				auth.MockGetAndSaveUser = func(ctx context.Context, op auth.GetAndSaveUserOp) (newUserCreated bool, userID int32, safeErrMsg string, err error) {
+					wantAccount := extsvc.AccountSpec{
+						ServiceType: extsvc.TypeGitea,
+						ServiceID:   codeHost.ServiceID,
+						ClientID:    clientID,
+						AccountID:   strconv.FormatInt(ci.user.ID, 10),
+					}
+					if diff := cmp.Diff(wantAccount, op.ExternalAccount); diff != "" {
+						t.Errorf("unexpected external account (-want +got):\n%s", diff)
+					}
+
+					gotAuthUserOps = append(gotAuthUserOps, auth.GetAndSaveUserOp{
+						UserProps:        op.UserProps,
+						CreateIfNotExist: op.CreateIfNotExist,
+					})
+
+					if uid, ok := authSaveableUsers[op.UserProps.Username]; ok {
+						return false, uid, "", nil
+					}
+					return false, 0, "safeErr", errors.New("auth.GetAndSaveUser error")
+				}
+				defer func() { auth.MockGetAndSaveUser = nil }()
+
+				s := &sessionIssuerHelper{
+					logger:      logtest.Scoped(t),
+					CodeHost:    codeHost,
+					clientID:    clientID,
+					db:          dbmocks.NewMockDB(),
+					allowSignup: ci.allowSignup,
+					client:      client,
+				}
+
+				tok := &oauth2.Token{AccessToken: "dummy-value-that-isnt-relevant-to-unit-correctness"}
+				_, actr, _, err := s.GetOrCreateUser(context.Background(), tok, nil)
+
+				if got, exp := actr, c.expActor; !reflect.DeepEqual(got, exp) {
+					t.Errorf("expected actor %v, got %v", exp, got)
+				}
+
+				if c.expErr && err == nil {
+					t.Errorf("expected err %v, but was nil", c.expErr)
+				} else if !c.expErr && err != nil {
+					t.Errorf("expected no error, but was %v", err)
+				}
+
+				if diff := cmp.Diff(c.expAuthUserOps, gotAuthUserOps); diff != "" {
+					t.Errorf("unexpected GetAndSaveUser calls (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
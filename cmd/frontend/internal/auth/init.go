@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/azureoauth"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/bitbucketcloudoauth"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/gerrit"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/giteaoauth"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/githuboauth"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/gitlaboauth"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/httpheader"
@@ -33,6 +34,7 @@ func Init(logger log.Logger, db database.DB) {
 	azureoauth.Init(logger, db)
 	bitbucketcloudoauth.Init(logger, db)
 	gerrit.Init()
+	giteaoauth.Init(logger, db)
 	githuboauth.Init(logger, db)
 	gitlaboauth.Init(logger, db)
 	httpheader.Init()
@@ -51,6 +53,7 @@ func Init(logger log.Logger, db database.DB) {
 		gitlaboauth.Middleware(db),
 		bitbucketcloudoauth.Middleware(db),
 		azureoauth.Middleware(db),
+		giteaoauth.Middleware(db),
 	)
 
 	// Warn about usage of auth providers that are not enabled by the license.
@@ -82,6 +85,8 @@ func Init(logger log.Logger, db database.DB) {
 				name = "Bitbucket Cloud OAuth"
 			case p.AzureDevOps != nil:
 				name = "Azure DevOps"
+			case p.Gitea != nil:
+				name = "Gitea OAuth"
 			case p.HttpHeader != nil:
 				name = "HTTP header"
 			case p.Openidconnect != nil:
@@ -82,6 +82,7 @@ func newExternalHTTPHandler(
 	appHandler := app.NewHandler(db, logger)
 	// 🚨 SECURITY: These all run after the auth handler so the client is authenticated.
 	appHandler = authz.PostAuthMiddleware(logger, db, appHandler)
+	appHandler = httpapi.AppScopeMiddleware(appHandler)
 	appHandler = featureflag.Middleware(db.FeatureFlags(), appHandler)
 	appHandler = actor.AnonymousUIDMiddleware(appHandler)
 	appHandler = authMiddlewares.App(appHandler) // 🚨 SECURITY: auth middleware
@@ -17,10 +17,13 @@ import (
 	batchesApitest "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/batches/resolvers/apitest"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/codemonitors/resolvers/apitest"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -1294,6 +1297,42 @@ query($userName: String!, $actionCursor:String!, $actionEventCursor:String!){
 }
 `
 
+func TestIssueRepositoryID(t *testing.T) {
+	repoGraphQLID := graphqlbackend.MarshalRepositoryID(1)
+
+	newResolver := func(siteAdmin bool) (*Resolver, *dbmocks.MockRepoStore) {
+		users := dbmocks.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: siteAdmin}, nil)
+		repos := dbmocks.NewMockRepoStore()
+		repos.GetFunc.SetDefaultReturn(&types.Repo{ID: 1, ExternalRepo: api.ExternalRepoSpec{ServiceType: extsvc.TypeGitHub}}, nil)
+
+		db := dbmocks.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+		db.ReposFunc.SetDefaultReturn(repos)
+		return &Resolver{logger: logtest.Scoped(t), db: db}, repos
+	}
+
+	t.Run("read-only user is rejected", func(t *testing.T) {
+		// The user can see the repository, but issues would be opened with
+		// the token of the code host connection.
+		r, repos := newResolver(false)
+		ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+		_, err := r.issueRepositoryID(ctx, repoGraphQLID)
+		require.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+		require.Empty(t, repos.GetFunc.History())
+	})
+
+	t.Run("site admin", func(t *testing.T) {
+		r, _ := newResolver(true)
+		ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+		repoID, err := r.issueRepositoryID(ctx, repoGraphQLID)
+		require.NoError(t, err)
+		require.Equal(t, api.RepoID(1), repoID)
+	})
+}
+
 func TestTriggerTestEmailAction(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -1356,3 +1395,26 @@ func TestValidateSlackURL(t *testing.T) {
 		require.Error(t, validateSlackURL(url))
 	}
 }
+
+func TestValidateTeamsURL(t *testing.T) {
+	valid := []string{
+		"https://contoso.webhook.office.com/webhookb2/1234@5678/IncomingWebhook/abcd/efgh",
+		"https://prod-00.westus.logic.azure.com/workflows/1234/triggers/manual/paths/invoke",
+		"https://default1234.environment.api.powerplatform.com/powerautomate/automations/direct/workflows/1234",
+	}
+
+	for _, url := range valid {
+		require.NoError(t, validateTeamsURL(url))
+	}
+
+	invalid := []string{
+		"http://contoso.webhook.office.com/webhookb2/1234",
+		"https://contoso.webhook.office.com:3443/webhookb2/1234",
+		"https://webhook.office.com.attacker.com/webhookb2/1234",
+		"https://internal:8989",
+	}
+
+	for _, url := range invalid {
+		require.Error(t, validateTeamsURL(url))
+	}
+}
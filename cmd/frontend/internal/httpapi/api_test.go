@@ -1,6 +1,7 @@
 package httpapi
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,10 @@ func init() {
 }
 
 func newTest(t *testing.T) *httptestutil.Client {
+	return httptestutil.NewTest(newTestHandler(t))
+}
+
+func newTestHandler(t *testing.T) http.Handler {
 	conf.Mock(&conf.Unified{})
 	t.Cleanup(func() {
 		conf.Mock(nil)
@@ -55,5 +60,5 @@ func newTest(t *testing.T) *httptestutil.Client {
 		},
 	)
 	require.NoError(t, err)
-	return httptestutil.NewTest(handler)
+	return handler
 }
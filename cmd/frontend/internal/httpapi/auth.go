@@ -124,12 +124,13 @@ func AccessTokenAuthMiddleware(db database.DB, baseLogger log.Logger, next http.
 			// Validate access token.
 			//
 			// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
-			// is allowed to do.
-			var requiredScope string
+			// is allowed to do. Tokens with only fine-grained scopes are accepted here, what they
+			// can access is checked by the handlers using the scopes stored on the actor.
+			var requiredScopes []string
 			if sudoUser == "" {
-				requiredScope = authz.ScopeUserAll
+				requiredScopes = authz.UserScopes
 			} else {
-				requiredScope = authz.ScopeSiteAdminSudo
+				requiredScopes = []string{authz.ScopeSiteAdminSudo}
 			}
 
 			info, err := licensing.GetConfiguredProductLicenseInfo()
@@ -139,11 +140,11 @@ func AccessTokenAuthMiddleware(db database.DB, baseLogger log.Logger, next http.
 			}
 
 			opts := database.TokenLookupOpts{
-				RequiredScope: requiredScope,
-				OnlyAdmin:     info.IsExpired(),
+				RequiredScopes: requiredScopes,
+				OnlyAdmin:      info.IsExpired(),
 			}
 
-			subjectUserID, err := db.AccessTokens().Lookup(r.Context(), token, opts)
+			accessToken, err := db.AccessTokens().Lookup(r.Context(), token, opts)
 			if err != nil {
 				if err == database.ErrAccessTokenNotFound || errors.HasType[database.InvalidTokenError](err) {
 					anonymousId, anonCookieSet := cookie.AnonymousUID(r)
@@ -167,6 +168,8 @@ func AccessTokenAuthMiddleware(db database.DB, baseLogger log.Logger, next http.
 				return
 			}
 
+			subjectUserID := accessToken.SubjectUserID
+
 			// FIXME: Can we find a way to do this only for SOAP users?
 			soapCount, err := db.UserExternalAccounts().Count(
 				r.Context(),
@@ -186,10 +189,15 @@ func AccessTokenAuthMiddleware(db database.DB, baseLogger log.Logger, next http.
 			}
 			sourcegraphOperator := soapCount > 0
 
-			// Determine the actor's user ID.
-			var actorUserID int32
+			// Determine the actor's user ID and the scopes the token grants it. Sudo tokens act
+			// with the full privileges of the impersonated user.
+			var (
+				actorUserID       int32
+				accessTokenScopes []string
+			)
 			if sudoUser == "" {
 				actorUserID = subjectUserID
+				accessTokenScopes = accessToken.Scopes
 			} else {
 				// 🚨 SECURITY: Confirm that the sudo token's subject is still a site admin, to
 				// prevent users from retaining site admin privileges after being demoted.
@@ -269,6 +277,8 @@ func AccessTokenAuthMiddleware(db database.DB, baseLogger log.Logger, next http.
 					&actor.Actor{
 						UID:                 actorUserID,
 						SourcegraphOperator: sourcegraphOperator,
+						AccessTokenScopes:   accessTokenScopes,
+						AccessTokenRepoIDs:  accessToken.RepoIDs,
 					},
 				),
 			)
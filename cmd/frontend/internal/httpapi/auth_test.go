@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"testing"
 
 	mockrequire "github.com/derision-test/go-mockgen/v2/testutil/require"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sgactor "github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
@@ -91,7 +93,7 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		req.Header.Set("Authorization", "token badbad")
 
 		accessTokens := dbmocks.NewMockAccessTokenStore()
-		accessTokens.LookupFunc.SetDefaultReturn(0, database.InvalidTokenError{})
+		accessTokens.LookupFunc.SetDefaultReturn(nil, database.InvalidTokenError{})
 		db.AccessTokensFunc.SetDefaultReturn(accessTokens)
 
 		securityEventLogs := dbmocks.NewMockSecurityEventLogsStore()
@@ -114,14 +116,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 			req.Header.Set("Authorization", headerValue)
 
 			accessTokens := dbmocks.NewMockAccessTokenStore()
-			accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+			accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 				if want := "abcdef"; tokenHexEncoded != want {
 					t.Errorf("got %q, want %q", tokenHexEncoded, want)
 				}
-				if want := authz.ScopeUserAll; opts.RequiredScope != want {
-					t.Errorf("got %q, want %q", opts.RequiredScope, want)
+				if want := authz.UserScopes; !reflect.DeepEqual(opts.RequiredScopes, want) {
+					t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 				}
-				return 123, nil
+				return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 			})
 			db.AccessTokensFunc.SetDefaultReturn(accessTokens)
 
@@ -137,14 +139,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		req = req.WithContext(sgactor.WithActor(context.Background(), &sgactor.Actor{UID: 456}))
 
 		accessTokens := dbmocks.NewMockAccessTokenStore()
-		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 			if want := "abcdef"; tokenHexEncoded != want {
 				t.Errorf("got %q, want %q", tokenHexEncoded, want)
 			}
-			if want := authz.ScopeUserAll; opts.RequiredScope != want {
-				t.Errorf("got %q, want %q", opts.RequiredScope, want)
+			if want := authz.UserScopes; !reflect.DeepEqual(opts.RequiredScopes, want) {
+				t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 			}
-			return 123, nil
+			return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 		})
 		db.AccessTokensFunc.SetDefaultReturn(accessTokens)
 
@@ -152,6 +154,29 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		mockrequire.Called(t, accessTokens.LookupFunc)
 	})
 
+	t.Run("valid token with fine-grained scopes", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.Header.Set("Authorization", "token abcdef")
+
+		accessTokens := dbmocks.NewMockAccessTokenStore()
+		accessTokens.LookupFunc.SetDefaultReturn(&database.AccessToken{
+			SubjectUserID: 123,
+			Scopes:        []string{authz.ScopeSearchRead},
+			RepoIDs:       []api.RepoID{1, 2},
+		}, nil)
+		db.AccessTokensFunc.SetDefaultReturn(accessTokens)
+
+		var got *sgactor.Actor
+		AccessTokenAuthMiddleware(db, logtest.NoOp(t), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = sgactor.FromContext(r.Context())
+		})).ServeHTTP(httptest.NewRecorder(), req)
+
+		require.NotNil(t, got)
+		require.Equal(t, int32(123), got.UID)
+		require.Equal(t, []string{authz.ScopeSearchRead}, got.AccessTokenScopes)
+		require.Equal(t, []api.RepoID{1, 2}, got.AccessTokenRepoIDs)
+	})
+
 	// Test that an access token overwrites the actor set by a prior auth middleware.
 	const (
 		sourceQueryParam = "query-param"
@@ -170,14 +195,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 			req = req.WithContext(sgactor.WithActor(context.Background(), &sgactor.Actor{UID: 456}))
 
 			accessTokens := dbmocks.NewMockAccessTokenStore()
-			accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+			accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 				if want := "abcdef"; tokenHexEncoded != want {
 					t.Errorf("got %q, want %q", tokenHexEncoded, want)
 				}
-				if want := authz.ScopeUserAll; opts.RequiredScope != want {
-					t.Errorf("got %q, want %q", opts.RequiredScope, want)
+				if want := authz.UserScopes; !reflect.DeepEqual(opts.RequiredScopes, want) {
+					t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 				}
-				return 123, nil
+				return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 			})
 			db.AccessTokensFunc.SetDefaultReturn(accessTokens)
 
@@ -191,14 +216,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		req.Header.Set("Authorization", `token-sudo token="abcdef",user="alice"`)
 
 		accessTokens := dbmocks.NewMockAccessTokenStore()
-		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 			if want := "abcdef"; tokenHexEncoded != want {
 				t.Errorf("got %q, want %q", tokenHexEncoded, want)
 			}
-			if want := authz.ScopeSiteAdminSudo; opts.RequiredScope != want {
-				t.Errorf("got %q, want %q", opts.RequiredScope, want)
+			if want := []string{authz.ScopeSiteAdminSudo}; !reflect.DeepEqual(opts.RequiredScopes, want) {
+				t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 			}
-			return 123, nil
+			return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 		})
 
 		users := dbmocks.NewMockUserStore()
@@ -239,14 +264,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		req.Header.Set("Authorization", `token-sudo token="abcdef",user="alice"`)
 
 		accessTokens := dbmocks.NewMockAccessTokenStore()
-		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 			if want := "abcdef"; tokenHexEncoded != want {
 				t.Errorf("got %q, want %q", tokenHexEncoded, want)
 			}
-			if want := authz.ScopeSiteAdminSudo; opts.RequiredScope != want {
-				t.Errorf("got %q, want %q", opts.RequiredScope, want)
+			if want := []string{authz.ScopeSiteAdminSudo}; !reflect.DeepEqual(opts.RequiredScopes, want) {
+				t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 			}
-			return 123, nil
+			return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 		})
 
 		users := dbmocks.NewMockUserStore()
@@ -291,14 +316,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		req.Header.Set("Authorization", `token-sudo token="abcdef",user="alice"`)
 
 		accessTokens := dbmocks.NewMockAccessTokenStore()
-		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 			if want := "abcdef"; tokenHexEncoded != want {
 				t.Errorf("got %q, want %q", tokenHexEncoded, want)
 			}
-			if want := authz.ScopeSiteAdminSudo; opts.RequiredScope != want {
-				t.Errorf("got %q, want %q", opts.RequiredScope, want)
+			if want := []string{authz.ScopeSiteAdminSudo}; !reflect.DeepEqual(opts.RequiredScopes, want) {
+				t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 			}
-			return 123, nil
+			return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 		})
 
 		users := dbmocks.NewMockUserStore()
@@ -332,14 +357,14 @@ func TestAccessTokenAuthMiddleware(t *testing.T) {
 		req.Header.Set("Authorization", `token-sudo token="abcdef",user="doesntexist"`)
 
 		accessTokens := dbmocks.NewMockAccessTokenStore()
-		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (subjectUserID int32, err error) {
+		accessTokens.LookupFunc.SetDefaultHook(func(_ context.Context, tokenHexEncoded string, opts database.TokenLookupOpts) (*database.AccessToken, error) {
 			if want := "abcdef"; tokenHexEncoded != want {
 				t.Errorf("got %q, want %q", tokenHexEncoded, want)
 			}
-			if want := authz.ScopeSiteAdminSudo; opts.RequiredScope != want {
-				t.Errorf("got %q, want %q", opts.RequiredScope, want)
+			if want := []string{authz.ScopeSiteAdminSudo}; !reflect.DeepEqual(opts.RequiredScopes, want) {
+				t.Errorf("got %q, want %q", opts.RequiredScopes, want)
 			}
-			return 123, nil
+			return &database.AccessToken{SubjectUserID: 123, Scopes: []string{authz.ScopeUserAll}}, nil
 		})
 
 		users := dbmocks.NewMockUserStore()
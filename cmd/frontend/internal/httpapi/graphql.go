@@ -153,6 +153,15 @@ func serveGraphQL(logger log.Logger, schema *graphql.Schema, rlw graphqlbackend.
 		traceData.uid = uid
 		traceData.anonymous = anonymous
 
+		// 🚨 SECURITY: Access tokens with fine-grained scopes may only be used
+		// for the parts of the schema covered by their scopes.
+		if err := graphqlbackend.CheckAccessTokenScopes(r.Context(), schema.ASTSchema(), params.Query); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return writeJSON(w, graphql.Response{
+				Errors: []*gqlerrors.QueryError{{Message: err.Error()}},
+			})
+		}
+
 		var cost *graphqlbackend.QueryCost
 		var costErr error
 
@@ -80,8 +80,9 @@ type Handlers struct {
 	CodeInsightsDataExportHandler http.Handler
 
 	// Search jobs
-	SearchJobsDataExportHandler http.Handler
-	SearchJobsLogsHandler       http.Handler
+	SearchJobsDataExportHandler  http.Handler
+	SearchJobsResultsDiffHandler http.Handler
+	SearchJobsLogsHandler        http.Handler
 
 	// Dotcom license check
 	NewDotcomLicenseCheckHandler enterprise.NewDotcomLicenseCheckHandler
@@ -106,6 +107,7 @@ func NewHandler(
 	m := mux.NewRouter().PathPrefix("/.api/").Subrouter()
 	m.StrictSlash(true)
 	m.Use(trace.Route)
+	m.Use(accessTokenScopeMiddleware)
 
 	jsonHandler := JsonMiddleware(&ErrorHandler{
 		Logger: logger,
@@ -168,7 +170,10 @@ func NewHandler(
 	m.Path("/src-cli/{rest:.*}").Methods("GET").Handler(newSrcCliVersionHandler(logger))
 	m.Path("/insights/export/{id}").Methods("GET").Handler(handlers.CodeInsightsDataExportHandler)
 	m.Path("/search/stream").Methods("GET").Handler(frontendsearch.StreamHandler(db))
-	m.Path("/search/export/{id}.jsonl").Methods("GET").Handler(handlers.SearchJobsDataExportHandler)
+	// The diff route has to be registered before the export route, which
+	// would otherwise match it with id "<id>.diff".
+	m.Path("/search/export/{id}.diff.csv").Methods("GET").Handler(handlers.SearchJobsResultsDiffHandler)
+	m.Path("/search/export/{id}.{format:jsonl|csv|parquet}").Methods("GET").Handler(handlers.SearchJobsDataExportHandler)
 	m.Path("/search/export/{id}.log").Methods("GET").Handler(handlers.SearchJobsLogsHandler)
 
 	m.Path("/completions/stream").Methods("POST").Handler(handlers.NewChatCompletionsStreamHandler())
@@ -0,0 +1,77 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/routevar"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+// routeScopes maps the path templates of the API routes that can be used with
+// fine-grained access token scopes to the scope they require. All other routes
+// require authz.ScopeUserAll.
+//
+// An empty scope means that the handler checks the scopes itself.
+var routeScopes = map[string]string{
+	"/.api/graphql": "",
+
+	"/.api/search/stream":                                 authz.ScopeSearchRead,
+	"/.api/compute/stream":                                authz.ScopeSearchRead,
+	"/.api/search/export/{id}.diff.csv":                   authz.ScopeSearchRead,
+	"/.api/search/export/{id}.{format:jsonl|csv|parquet}": authz.ScopeSearchRead,
+	"/.api/search/export/{id}.log":                        authz.ScopeSearchRead,
+	"/.api/blame/" + routevar.Repo + routevar.RepoRevSuffix + "/-/stream/{Path:.*}": authz.ScopeRepoRead,
+	"/.api/repos/" + routevar.Repo + "/" + routevar.RepoPathDelim + "/shield":       authz.ScopeRepoRead,
+
+	"/.api/files/batch-changes/{spec}/{file}": authz.ScopeBatchChangesWrite,
+	"/.api/files/batch-changes/{spec}":        authz.ScopeBatchChangesWrite,
+
+	"/.api/lsif/upload": authz.ScopeCodeIntelUpload,
+	"/.api/scip/upload": authz.ScopeCodeIntelUpload,
+
+	"/.api/insights/export/{id}": authz.ScopeInsightsRead,
+}
+
+// accessTokenScopeMiddleware rejects requests made with an access token that
+// doesn't grant the scope required by the matched route.
+func accessTokenScopeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope := authz.ScopeUserAll
+		if route := mux.CurrentRoute(r); route != nil {
+			if tmpl, err := route.GetPathTemplate(); err == nil {
+				if s, ok := routeScopes[tmpl]; ok {
+					scope = s
+				}
+			}
+		}
+
+		if scope != "" && !checkScope(w, r, scope) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// AppScopeMiddleware rejects requests made with an access token that doesn't
+// grant authz.ScopeUserAll. It is used for the app (HTML pages), which isn't
+// covered by fine-grained scopes.
+//
+// 🚨 SECURITY: This must run after the access token authentication middleware.
+func AppScopeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !checkScope(w, r, authz.ScopeUserAll) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func checkScope(w http.ResponseWriter, r *http.Request, scope string) bool {
+	if err := authz.CheckActorScope(r.Context(), scope); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+func TestRouteScopes(t *testing.T) {
+	router, ok := newTestHandler(t).(*mux.Router)
+	require.True(t, ok)
+
+	templates := map[string]bool{}
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		if tmpl, err := route.GetPathTemplate(); err == nil {
+			templates[tmpl] = true
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Every entry in routeScopes must match a route, otherwise the route it was
+	// meant for silently requires authz.ScopeUserAll.
+	for tmpl := range routeScopes {
+		require.True(t, templates[tmpl], "no route with path template %q", tmpl)
+	}
+}
+
+func TestAccessTokenScopeMiddleware(t *testing.T) {
+	handler := newTestHandler(t)
+
+	do := func(a *actor.Actor, method, path string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, nil)
+		req = req.WithContext(actor.WithActor(context.Background(), a))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("actor without access token", func(t *testing.T) {
+		rr := do(&actor.Actor{UID: 1}, "HEAD", "/.api/scip/upload")
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("token with required scope", func(t *testing.T) {
+		rr := do(&actor.Actor{UID: 1, AccessTokenScopes: []string{authz.ScopeCodeIntelUpload}}, "HEAD", "/.api/scip/upload")
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("token with user:all", func(t *testing.T) {
+		rr := do(&actor.Actor{UID: 1, AccessTokenScopes: []string{authz.ScopeUserAll}}, "HEAD", "/.api/scip/upload")
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("token without required scope", func(t *testing.T) {
+		rr := do(&actor.Actor{UID: 1, AccessTokenScopes: []string{authz.ScopeSearchRead}}, "HEAD", "/.api/scip/upload")
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("routes without a fine-grained scope require user:all", func(t *testing.T) {
+		rr := do(&actor.Actor{UID: 1, AccessTokenScopes: []string{authz.ScopeRepoRead}}, "POST", "/.api/repos/github.com/foo/bar/-/refresh")
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
@@ -36,6 +36,10 @@ const (
 const invalidQueryMsg = "Grouping is disabled because the search query is not valid."
 const fileUnsupportedFieldValueFmt = `Grouping by file is not available for searches with "%s:%s".`
 const authNotCommitDiffMsg = "Grouping by author is only available for diff and commit searches."
+const languageUnsupportedFieldValueFmt = `Grouping by language is not available for searches with "%s:%s".`
+const fileExtensionUnsupportedFieldValueFmt = `Grouping by file extension is not available for searches with "%s:%s".`
+const directoryUnsupportedFieldValueFmt = `Grouping by directory is not available for searches with "%s:%s".`
+const commitMonthNotCommitDiffMsg = "Grouping by commit month is only available for diff and commit searches."
 const repoMetadataNotRepoSelectMsg = "Grouping by repo metadata is only available for repository searches."
 const cgInvalidQueryMsg = "Grouping by capture group is only available for regexp searches that contain a capturing group."
 const cgMultipleQueryPatternMsg = "Grouping by capture group does not support search patterns with the following: and, or, negation."
@@ -140,7 +144,7 @@ func (r *searchAggregateResolver) Aggregations(ctx context.Context, args graphql
 		cappedAggregator.Add(amr.Key.Group, int32(amr.Count))
 	}
 
-	countingFunc, err := aggregation.GetCountFuncForMode(r.searchQuery, r.patternType, aggregationMode)
+	countingFunc, err := aggregation.GetCountFuncForMode(r.searchQuery, r.patternType, aggregationMode, int(args.DirectoryDepth))
 	if err != nil {
 		r.getLogger().Debug("no aggregation counting function for mode", log.String("mode", string(aggregationMode)), log.Error(err))
 		return &searchAggregationResultResolver{
@@ -361,11 +365,15 @@ func getNotAvailableReason(query, patternType string, mode types.SearchAggregati
 
 func getAggregateBy(mode types.SearchAggregationMode) canAggregateBy {
 	checkByMode := map[types.SearchAggregationMode]canAggregateBy{
-		types.REPO_AGGREGATION_MODE:          canAggregateByRepo,
-		types.PATH_AGGREGATION_MODE:          canAggregateByPath,
-		types.AUTHOR_AGGREGATION_MODE:        canAggregateByAuthor,
-		types.CAPTURE_GROUP_AGGREGATION_MODE: canAggregateByCaptureGroup,
-		types.REPO_METADATA_AGGREGATION_MODE: canAggregateByRepoMetadata,
+		types.REPO_AGGREGATION_MODE:            canAggregateByRepo,
+		types.PATH_AGGREGATION_MODE:            canAggregateByPath,
+		types.AUTHOR_AGGREGATION_MODE:          canAggregateByAuthor,
+		types.CAPTURE_GROUP_AGGREGATION_MODE:   canAggregateByCaptureGroup,
+		types.REPO_METADATA_AGGREGATION_MODE:   canAggregateByRepoMetadata,
+		types.LANGUAGE_AGGREGATION_MODE:        canAggregateByLanguage,
+		types.FILE_EXTENSION_AGGREGATION_MODE:  canAggregateByFileExtension,
+		types.DIRECTORY_DEPTH_AGGREGATION_MODE: canAggregateByDirectory,
+		types.COMMIT_MONTH_AGGREGATION_MODE:    canAggregateByCommitMonth,
 	}
 	canAggregateByFunc, ok := checkByMode[mode]
 	if !ok {
@@ -391,6 +399,25 @@ func canAggregateByRepo(searchQuery, patternType string) (bool, *notAvailableRea
 }
 
 func canAggregateByPath(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, fileUnsupportedFieldValueFmt)
+}
+
+func canAggregateByLanguage(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, languageUnsupportedFieldValueFmt)
+}
+
+func canAggregateByFileExtension(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, fileExtensionUnsupportedFieldValueFmt)
+}
+
+func canAggregateByDirectory(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByFile(searchQuery, patternType, directoryUnsupportedFieldValueFmt)
+}
+
+// canAggregateByFile checks whether the results of the query are files, as is
+// required by all the modes that group by a property of the file path.
+// unsupportedFmt is used to build the reason when they are not.
+func canAggregateByFile(searchQuery, patternType, unsupportedFmt string) (bool, *notAvailableReason, error) {
 	plan, err := querybuilder.ParseQuery(searchQuery, patternType)
 	if err != nil {
 		return false, &notAvailableReason{reason: invalidQueryMsg, reasonType: types.INVALID_QUERY}, errors.Wrapf(err, "ParseQuery")
@@ -401,7 +428,7 @@ func canAggregateByPath(searchQuery, patternType string) (bool, *notAvailableRea
 	for _, parameter := range parameters {
 		if parameter.Field == query.FieldSelect || parameter.Field == query.FieldType {
 			if strings.EqualFold(parameter.Value, "commit") || strings.EqualFold(parameter.Value, "diff") || strings.EqualFold(parameter.Value, "repo") {
-				reason := fmt.Sprintf(fileUnsupportedFieldValueFmt,
+				reason := fmt.Sprintf(unsupportedFmt,
 					parameter.Field, parameter.Value)
 				return false, &notAvailableReason{reason: reason, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
 			}
@@ -411,6 +438,17 @@ func canAggregateByPath(searchQuery, patternType string) (bool, *notAvailableRea
 }
 
 func canAggregateByAuthor(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByCommit(searchQuery, patternType, authNotCommitDiffMsg)
+}
+
+func canAggregateByCommitMonth(searchQuery, patternType string) (bool, *notAvailableReason, error) {
+	return canAggregateByCommit(searchQuery, patternType, commitMonthNotCommitDiffMsg)
+}
+
+// canAggregateByCommit checks whether the results of the query are commits, as
+// is required by all the modes that group by a property of the commit. notCommitMsg
+// is the reason used when they are not.
+func canAggregateByCommit(searchQuery, patternType, notCommitMsg string) (bool, *notAvailableReason, error) {
 	plan, err := querybuilder.ParseQuery(searchQuery, patternType)
 	if err != nil {
 		return false, &notAvailableReason{reason: invalidQueryMsg, reasonType: types.INVALID_QUERY}, errors.Wrapf(err, "ParseQuery")
@@ -425,7 +463,7 @@ func canAggregateByAuthor(searchQuery, patternType string) (bool, *notAvailableR
 			}
 		}
 	}
-	return false, &notAvailableReason{reason: authNotCommitDiffMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
+	return false, &notAvailableReason{reason: notCommitMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
 }
 
 func canAggregateByCaptureGroup(searchQuery, patternType string) (bool, *notAvailableReason, error) {
@@ -602,6 +640,14 @@ func buildDrilldownQuery(mode types.SearchAggregationMode, originalQuery string,
 		modifierFunc = querybuilder.AddFileFilter
 	case types.AUTHOR_AGGREGATION_MODE:
 		modifierFunc = querybuilder.AddAuthorFilter
+	case types.LANGUAGE_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddLanguageFilter
+	case types.FILE_EXTENSION_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddFileExtensionFilter
+	case types.DIRECTORY_DEPTH_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddDirectoryFilter
+	case types.COMMIT_MONTH_AGGREGATION_MODE:
+		modifierFunc = querybuilder.AddCommitMonthFilter
 	case types.CAPTURE_GROUP_AGGREGATION_MODE:
 		searchType, err := client.SearchTypeFromString(patternType)
 		if err != nil {
@@ -189,6 +189,74 @@ func Test_canAggregateByAuthor(t *testing.T) {
 	suite.Test_canAggregateBy()
 }
 
+func Test_canAggregateByFileProperties(t *testing.T) {
+	modes := []struct {
+		name               string
+		canAggregateByFunc canAggregateBy
+		unsupportedFmt     string
+	}{
+		{"language", canAggregateByLanguage, languageUnsupportedFieldValueFmt},
+		{"file extension", canAggregateByFileExtension, fileExtensionUnsupportedFieldValueFmt},
+		{"directory", canAggregateByDirectory, directoryUnsupportedFieldValueFmt},
+	}
+	for _, mode := range modes {
+		t.Run(mode.name, func(t *testing.T) {
+			testCases := []canAggregateTestCase{
+				{
+					name:         "can aggregate for query without parameters",
+					query:        "func(t *testing.T)",
+					canAggregate: true,
+				},
+				{
+					name:         "cannot aggregate for query with select:repo parameter",
+					query:        "repo:contains.path(README) select:repo",
+					reason:       fmt.Sprintf(mode.unsupportedFmt, "select", "repo"),
+					canAggregate: false,
+				},
+				{
+					name:         "cannot aggregate for query with type:diff parameter",
+					query:        "insights type:diff",
+					reason:       fmt.Sprintf(mode.unsupportedFmt, "type", "diff"),
+					canAggregate: false,
+				},
+			}
+			suite := canAggregateBySuite{
+				canAggregateByFunc: mode.canAggregateByFunc,
+				testCases:          testCases,
+				t:                  t,
+			}
+			suite.Test_canAggregateBy()
+		})
+	}
+}
+
+func Test_canAggregateByCommitMonth(t *testing.T) {
+	testCases := []canAggregateTestCase{
+		{
+			name:         "cannot aggregate for query without parameters",
+			query:        "func(t *testing.T)",
+			reason:       commitMonthNotCommitDiffMsg,
+			canAggregate: false,
+		},
+		{
+			name:         "can aggregate for query with type:commit parameter",
+			query:        "type:commit fix",
+			canAggregate: true,
+		},
+		{
+			name:         "can aggregate for query with type:diff parameter",
+			query:        "type:diff fix",
+			canAggregate: true,
+		},
+	}
+	suite := canAggregateBySuite{
+		canAggregateByFunc: canAggregateByCommitMonth,
+		testCases:          testCases,
+		t:                  t,
+	}
+	suite.Test_canAggregateBy()
+}
+
 func Test_canAggregateByCaptureGroup(t *testing.T) {
 	testCases := []canAggregateTestCase{
 		{
@@ -456,6 +524,34 @@ func Test_buildDrilldownQuery(t *testing.T) {
 			patternType: "standard",
 			mode:        types.PATH_AGGREGATION_MODE,
 		},
+		{
+			want:        autogold.Expect("lang:Go findme"),
+			query:       "findme",
+			drilldown:   "Go",
+			patternType: "standard",
+			mode:        types.LANGUAGE_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:\\.go$ findme"),
+			query:       "findme",
+			drilldown:   ".go",
+			patternType: "standard",
+			mode:        types.FILE_EXTENSION_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:^cmd/frontend/ findme"),
+			query:       "findme",
+			drilldown:   "cmd/frontend/",
+			patternType: "standard",
+			mode:        types.DIRECTORY_DEPTH_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("type:diff after:2022-04-01 before:2022-05-01 findme"),
+			query:       "findme type:diff",
+			drilldown:   "2022-04",
+			patternType: "standard",
+			mode:        types.COMMIT_MONTH_AGGREGATION_MODE,
+		},
 		{
 			want:        autogold.Expect("case:yes /fin(?:d m)e/"),
 			query:       "/fin(.*)e/",
@@ -41,7 +41,7 @@ func (c *Context) All(ctx context.Context) (inv Inventory, err error) {
 		}()
 	}
 
-	r, err := c.GitServerClient.ArchiveReader(ctx, c.Repo, gitserver.ArchiveOptions{Treeish: string(c.CommitID), Format: gitserver.ArchiveFormatTar})
+	r, err := c.GitServerClient.ArchiveReader(ctx, c.Repo, gitserver.ArchiveOptions{Treeish: string(c.CommitID), Format: gitserver.ArchiveFormatTar, LFSPointers: true})
 	if err != nil {
 		return Inventory{}, err
 	}
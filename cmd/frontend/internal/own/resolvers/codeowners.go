@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 
 	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
 )
@@ -26,13 +27,18 @@ func (r *ownResolver) computeCodeowners(ctx context.Context, blob *graphqlbacken
 	if err != nil {
 		return nil, err
 	}
-	var rule *codeownerspb.Rule
+	// Every section of the CODEOWNERS file yields its own matching rule.
+	var rules []*codeownerspb.Rule
 	if ruleset != nil {
-		rule = ruleset.Match(blob.Path())
+		rules = ruleset.FindOwners(blob.Path())
+	}
+	var hasOwners bool
+	for _, rule := range rules {
+		hasOwners = hasOwners || len(rule.GetOwner()) > 0
 	}
 	// Compute repo context if possible to allow better unification of references.
 	var repoContext *own.RepoContext
-	if len(rule.GetOwner()) > 0 {
+	if hasOwners {
 		spec, err := repo.ExternalRepo(ctx)
 		// Best effort resolution. We still want to serve the reason if external service cannot be resolved here.
 		if err == nil {
@@ -44,18 +50,20 @@ func (r *ownResolver) computeCodeowners(ctx context.Context, blob *graphqlbacken
 	}
 	// Return references
 	var rrs []reasonAndReference
-	for _, o := range rule.GetOwner() {
-		rrs = append(rrs, reasonAndReference{
-			reason: ownershipReason{
-				codeownersRule:   rule,
-				codeownersSource: ruleset.GetSource(),
-			},
-			reference: own.Reference{
-				RepoContext: repoContext,
-				Handle:      o.Handle,
-				Email:       o.Email,
-			},
-		})
+	for _, rule := range rules {
+		for _, o := range rule.GetOwner() {
+			rrs = append(rrs, reasonAndReference{
+				reason: ownershipReason{
+					codeownersRule:   rule,
+					codeownersSource: ruleset.GetSource(),
+				},
+				reference: own.Reference{
+					RepoContext: repoContext,
+					Handle:      o.Handle,
+					Email:       o.Email,
+				},
+			})
+		}
 	}
 	return rrs, nil
 }
@@ -63,7 +71,7 @@ func (r *ownResolver) computeCodeowners(ctx context.Context, blob *graphqlbacken
 type codeownersFileEntryResolver struct {
 	db              database.DB
 	source          codeowners.RulesetSource
-	matchLineNumber int32
+	rule            *codeownerspb.Rule
 	repo            *graphqlbackend.RepositoryResolver
 	gitserverClient gitserver.Client
 }
@@ -101,5 +109,24 @@ func (r *codeownersFileEntryResolver) CodeownersFile(ctx context.Context) (graph
 }
 
 func (r *codeownersFileEntryResolver) RuleLineMatch(_ context.Context) (int32, error) {
-	return r.matchLineNumber, nil
+	return r.rule.GetLineNumber(), nil
+}
+
+func (r *codeownersFileEntryResolver) Section(_ context.Context) (*string, error) {
+	if r.rule.GetSectionName() == "" {
+		return nil, nil
+	}
+	return pointers.Ptr(r.rule.GetSectionName()), nil
+}
+
+func (r *codeownersFileEntryResolver) SectionOptional(_ context.Context) (bool, error) {
+	return r.rule.GetSectionOptional(), nil
+}
+
+func (r *codeownersFileEntryResolver) ApprovalsRequired(_ context.Context) (int32, error) {
+	// Approval from the owners of optional sections is not required.
+	if r.rule.GetSectionOptional() {
+		return 0, nil
+	}
+	return max(r.rule.GetSectionApprovalsRequired(), 1), nil
 }
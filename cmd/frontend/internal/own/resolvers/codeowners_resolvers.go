@@ -41,11 +41,11 @@ func (r *ownResolver) AddCodeownersFile(ctx context.Context, args *graphqlbacken
 	if err := r.viewerCanAdminister(ctx); err != nil {
 		return nil, err
 	}
-	proto, err := parseInputString(args.Input.FileContents)
+	repo, err := r.getRepo(ctx, args.Input)
 	if err != nil {
 		return nil, err
 	}
-	repo, err := r.getRepo(ctx, args.Input)
+	proto, err := parseInputString(args.Input.FileContents, repo.ExternalRepo.ServiceType)
 	if err != nil {
 		return nil, err
 	}
@@ -74,11 +74,11 @@ func (r *ownResolver) UpdateCodeownersFile(ctx context.Context, args *graphqlbac
 	if err := r.viewerCanAdminister(ctx); err != nil {
 		return nil, err
 	}
-	proto, err := parseInputString(args.Input.FileContents)
+	repo, err := r.getRepo(ctx, args.Input)
 	if err != nil {
 		return nil, err
 	}
-	repo, err := r.getRepo(ctx, args.Input)
+	proto, err := parseInputString(args.Input.FileContents, repo.ExternalRepo.ServiceType)
 	if err != nil {
 		return nil, err
 	}
@@ -99,9 +99,9 @@ func (r *ownResolver) UpdateCodeownersFile(ctx context.Context, args *graphqlbac
 	}, nil
 }
 
-func parseInputString(fileContents string) (*codeownerspb.File, error) {
+func parseInputString(fileContents, codeHostType string) (*codeownerspb.File, error) {
 	fileReader := strings.NewReader(fileContents)
-	file, err := codeowners.Parse(fileReader)
+	file, err := codeowners.ParseForCodeHost(fileReader, codeHostType)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not parse input")
 	}
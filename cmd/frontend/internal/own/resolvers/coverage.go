@@ -0,0 +1,124 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (r *ownResolver) RepoOwnershipCoverage(ctx context.Context, repo *graphqlbackend.RepositoryResolver, args *graphqlbackend.OwnershipCoverageArgs) ([]graphqlbackend.OwnershipCoverageSnapshotResolver, error) {
+	if args.First < 0 {
+		return nil, errors.New("first must be non-negative")
+	}
+	opts := database.ListOwnershipCoverageSnapshotsOpts{
+		RepoID:      repo.IDInt32(),
+		Path:        strings.Trim(args.Path, "/"),
+		LimitOffset: &database.LimitOffset{Limit: int(args.First)},
+	}
+	if args.Since != nil {
+		opts.Since = args.Since.Time
+	}
+	snapshots, err := r.db.OwnershipCoverage().ListSnapshots(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]graphqlbackend.OwnershipCoverageSnapshotResolver, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		resolvers = append(resolvers, &ownershipCoverageSnapshotResolver{
+			db:       r.db,
+			path:     opts.Path,
+			snapshot: snapshot,
+		})
+	}
+	return resolvers, nil
+}
+
+type ownershipCoverageSnapshotResolver struct {
+	db       database.DB
+	path     string
+	snapshot *database.OwnershipCoverageSnapshot
+}
+
+func (r *ownershipCoverageSnapshotResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.snapshot.CreatedAt}
+}
+
+func (r *ownershipCoverageSnapshotResolver) OID() graphqlbackend.GitObjectID {
+	return graphqlbackend.GitObjectID(r.snapshot.CommitID)
+}
+
+func (r *ownershipCoverageSnapshotResolver) Coverage() graphqlbackend.OwnershipCoverageResolver {
+	for _, dir := range r.snapshot.Directories {
+		if dir.Path == r.path {
+			return &ownershipCoverageResolver{dir}
+		}
+	}
+	return nil
+}
+
+func (r *ownershipCoverageSnapshotResolver) Directories() []graphqlbackend.OwnershipCoverageResolver {
+	var resolvers []graphqlbackend.OwnershipCoverageResolver
+	for _, dir := range r.snapshot.Directories {
+		if dir.Path != r.path {
+			resolvers = append(resolvers, &ownershipCoverageResolver{dir})
+		}
+	}
+	return resolvers
+}
+
+func (r *ownershipCoverageSnapshotResolver) Teams(ctx context.Context) ([]graphqlbackend.OwnershipCoverageTeamResolver, error) {
+	resolvers := make([]graphqlbackend.OwnershipCoverageTeamResolver, 0, len(r.snapshot.Teams))
+	for _, t := range r.snapshot.Teams {
+		team, err := r.db.Teams().GetTeamByID(ctx, t.TeamID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Teams.GetTeamByID %d", t.TeamID)
+		}
+		resolvers = append(resolvers, &ownershipCoverageTeamResolver{
+			team:       graphqlbackend.NewTeamResolver(r.db, team),
+			ownedFiles: t.OwnedFileCount,
+		})
+	}
+	return resolvers, nil
+}
+
+type ownershipCoverageResolver struct {
+	dir database.OwnershipCoverageDirectory
+}
+
+func (r *ownershipCoverageResolver) Path() string { return r.dir.Path }
+
+func (r *ownershipCoverageResolver) TotalFiles() int32 { return int32(r.dir.TotalFileCount) }
+
+func (r *ownershipCoverageResolver) TotalCodeownedFiles() int32 {
+	return int32(r.dir.CodeownedFileCount)
+}
+
+func (r *ownershipCoverageResolver) TotalAssignedOwnershipFiles() int32 {
+	return int32(r.dir.AssignedOwnershipFileCount)
+}
+
+func (r *ownershipCoverageResolver) TotalRecentContributorFiles() int32 {
+	return int32(r.dir.RecentContributorFileCount)
+}
+
+func (r *ownershipCoverageResolver) TotalOwnedFiles() int32 { return int32(r.dir.TotalOwnedFileCount) }
+
+func (r *ownershipCoverageResolver) OwnedFraction() float64 {
+	if r.dir.TotalFileCount == 0 {
+		return 0
+	}
+	return float64(r.dir.TotalOwnedFileCount) / float64(r.dir.TotalFileCount)
+}
+
+type ownershipCoverageTeamResolver struct {
+	team       *graphqlbackend.TeamResolver
+	ownedFiles int
+}
+
+func (r *ownershipCoverageTeamResolver) Team() *graphqlbackend.TeamResolver { return r.team }
+
+func (r *ownershipCoverageTeamResolver) OwnedFiles() int32 { return int32(r.ownedFiles) }
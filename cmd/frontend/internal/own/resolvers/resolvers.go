@@ -541,7 +541,7 @@ func (r *ownershipResolver) Reasons(_ context.Context) ([]graphqlbackend.Ownersh
 					gitserverClient: r.gitserver,
 					source:          reason.codeownersSource,
 					repo:            r.repo,
-					matchLineNumber: reason.codeownersRule.GetLineNumber(),
+					rule:            reason.codeownersRule,
 				},
 			})
 
@@ -265,6 +265,149 @@ func TestBlobOwnershipPanelQueryPersonUnresolved(t *testing.T) {
 	})
 }
 
+// TestBlobOwnershipPanelQuerySections checks that the owners of every matching
+// CODEOWNERS section are returned, along with the section semantics.
+func TestBlobOwnershipPanelQuerySections(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fakeDB := fakedb.New()
+	db := fakeOwnDb()
+	fakeDB.Wire(db)
+	repoID := api.RepoID(1)
+	own := fakeOwnService{
+		Ruleset: codeowners.NewRuleset(
+			codeowners.GitRulesetSource{Repo: repoID, Commit: "deadbeef", Path: "CODEOWNERS"},
+			&codeownerspb.File{
+				Rule: []*codeownerspb.Rule{
+					{
+						Pattern: "*.js",
+						Owner: []*codeownerspb.Owner{
+							{Handle: "js-owner"},
+						},
+						LineNumber: 1,
+					},
+					{
+						Pattern: "*.js",
+						Owner: []*codeownerspb.Owner{
+							{Handle: "js-pm"},
+						},
+						SectionName:     "pm",
+						SectionOptional: true,
+						LineNumber:      3,
+					},
+					{
+						Pattern: "foo/",
+						Owner: []*codeownerspb.Owner{
+							{Handle: "foo-eng"},
+						},
+						SectionName:              "eng",
+						SectionApprovalsRequired: 2,
+						LineNumber:               5,
+					},
+				},
+			}),
+	}
+	ctx := userCtx(fakeDB.AddUser(types.User{SiteAdmin: true}))
+	repos := dbmocks.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+	backend.Mocks.Repos.ResolveRev = func(_ context.Context, repo api.RepoName, rev string) (api.CommitID, error) {
+		return "deadbeef", nil
+	}
+	git := fakeGitserver{}
+	schema, err := graphqlbackend.NewSchema(db, git, nil, []graphqlbackend.OptionalResolver{{OwnResolver: resolvers.NewWithService(db, git, own, logger)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	graphqlbackend.RunTest(t, &graphqlbackend.Test{
+		Schema:  schema,
+		Context: ctx,
+		Query: `
+			query FetchOwnership($repo: ID!, $revision: String!, $currentPath: String!) {
+				node(id: $repo) {
+					... on Repository {
+						commit(rev: $revision) {
+							blob(path: $currentPath) {
+								ownership {
+									nodes {
+										owner {
+											... on Person {
+												displayName
+											}
+										}
+										reasons {
+											... on CodeownersFileEntry {
+												ruleLineMatch
+												section
+												sectionOptional
+												approvalsRequired
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}`,
+		ExpectedResult: `{
+			"node": {
+				"commit": {
+					"blob": {
+						"ownership": {
+							"nodes": [
+								{
+									"owner": {
+										"displayName": "foo-eng"
+									},
+									"reasons": [
+										{
+											"ruleLineMatch": 5,
+											"section": "eng",
+											"sectionOptional": false,
+											"approvalsRequired": 2
+										}
+									]
+								},
+								{
+									"owner": {
+										"displayName": "js-owner"
+									},
+									"reasons": [
+										{
+											"ruleLineMatch": 1,
+											"section": null,
+											"sectionOptional": false,
+											"approvalsRequired": 1
+										}
+									]
+								},
+								{
+									"owner": {
+										"displayName": "js-pm"
+									},
+									"reasons": [
+										{
+											"ruleLineMatch": 3,
+											"section": "pm",
+											"sectionOptional": true,
+											"approvalsRequired": 0
+										}
+									]
+								}
+							]
+						}
+					}
+				}
+			}
+		}`,
+		Variables: map[string]any{
+			"repo":        string(graphqlbackend.MarshalRepositoryID(42)),
+			"revision":    "revision",
+			"currentPath": "foo/bar.js",
+		},
+	})
+}
+
 func TestBlobOwnershipPanelQueryIngested(t *testing.T) {
 	logger := logtest.Scoped(t)
 	fakeDB := fakedb.New()
@@ -1301,6 +1444,12 @@ func Test_SignalConfigurations(t *testing.T) {
 			  "description": "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			  "isEnabled": false,
 			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "coverage-reports",
+			  "description": "Periodically records ownership coverage by directory and team to show coverage trends over time.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
 			}
 		  ]
 		}`,
@@ -1363,6 +1512,11 @@ func Test_SignalConfigurations(t *testing.T) {
 				Name:        "analytics",
 				Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			},
+			{
+				ID:          4,
+				Name:        owntypes.CoverageReports,
+				Description: "Periodically records ownership coverage by directory and team to show coverage trends over time.",
+			},
 		}).Equal(t, configsFromDb)
 
 		readTest := baseReadTest
@@ -1395,6 +1549,12 @@ func Test_SignalConfigurations(t *testing.T) {
 			  "description": "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			  "isEnabled": false,
 			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "coverage-reports",
+			  "description": "Periodically records ownership coverage by directory and team to show coverage trends over time.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
 			}
 		  ]
 		}`
@@ -2274,6 +2434,118 @@ func TestDisplayOwnershipStats(t *testing.T) {
 	})
 }
 
+func TestRepoOwnershipCoverage(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetByNameFunc.SetDefaultReturn(&types.Repo{ID: 42, Name: "github.com/sourcegraph/own"}, nil)
+	db.ReposFunc.SetDefaultReturn(repos)
+	teams := dbmocks.NewMockTeamStore()
+	teams.GetTeamByIDFunc.SetDefaultHook(func(_ context.Context, id int32) (*types.Team, error) {
+		return &types.Team{ID: id, Name: "team-a"}, nil
+	})
+	db.TeamsFunc.SetDefaultReturn(teams)
+	coverage := dbmocks.NewMockOwnershipCoverageStore()
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	coverage.ListSnapshotsFunc.SetDefaultHook(func(_ context.Context, opts database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error) {
+		assert.Equal(t, database.ListOwnershipCoverageSnapshotsOpts{
+			RepoID:      42,
+			Path:        "src",
+			Since:       createdAt,
+			LimitOffset: &database.LimitOffset{Limit: 30},
+		}, opts)
+		return []*database.OwnershipCoverageSnapshot{{
+			ID:        1,
+			RepoID:    42,
+			CommitID:  "deadbeef",
+			CreatedAt: createdAt,
+			Directories: []database.OwnershipCoverageDirectory{
+				{Path: "src", OwnershipCoverageCounts: database.OwnershipCoverageCounts{
+					TotalFileCount:             4,
+					CodeownedFileCount:         2,
+					AssignedOwnershipFileCount: 1,
+					RecentContributorFileCount: 3,
+					TotalOwnedFileCount:        3,
+				}},
+				{Path: "src/main", OwnershipCoverageCounts: database.OwnershipCoverageCounts{
+					TotalFileCount: 2,
+				}},
+			},
+			Teams: []database.OwnershipCoverageTeam{{TeamID: 7, OwnedFileCount: 2}},
+		}}, nil
+	})
+	db.OwnershipCoverageFunc.SetDefaultReturn(coverage)
+
+	ctx := context.Background()
+	schema, err := graphqlbackend.NewSchema(db, nil, nil, []graphqlbackend.OptionalResolver{{OwnResolver: resolvers.NewWithService(db, nil, nil, logtest.NoOp(t))}})
+	require.NoError(t, err)
+	graphqlbackend.RunTest(t, &graphqlbackend.Test{
+		Schema:  schema,
+		Context: ctx,
+		Query: `
+			query GetOwnershipCoverage {
+				repository(name: "github.com/sourcegraph/own") {
+					ownershipCoverage(path: "/src/", since: "2023-01-01T00:00:00Z") {
+						createdAt
+						oid
+						coverage {
+							path
+							totalFiles
+							totalCodeownedFiles
+							totalAssignedOwnershipFiles
+							totalRecentContributorFiles
+							totalOwnedFiles
+							ownedFraction
+						}
+						directories {
+							path
+							ownedFraction
+						}
+						teams {
+							team {
+								name
+							}
+							ownedFiles
+						}
+					}
+				}
+			}`,
+		ExpectedResult: `
+			{
+				"repository": {
+					"ownershipCoverage": [
+						{
+							"createdAt": "2023-01-01T00:00:00Z",
+							"oid": "deadbeef",
+							"coverage": {
+								"path": "src",
+								"totalFiles": 4,
+								"totalCodeownedFiles": 2,
+								"totalAssignedOwnershipFiles": 1,
+								"totalRecentContributorFiles": 3,
+								"totalOwnedFiles": 3,
+								"ownedFraction": 0.75
+							},
+							"directories": [
+								{
+									"path": "src/main",
+									"ownedFraction": 0
+								}
+							],
+							"teams": [
+								{
+									"team": {
+										"name": "team-a"
+									},
+									"ownedFiles": 2
+								}
+							]
+						}
+					]
+				}
+			}`,
+	})
+}
+
 func createTeam(t *testing.T, ctx context.Context, db database.DB, teamName string) *types.Team {
 	t.Helper()
 	team, err := db.Teams().CreateTeam(ctx, &types.Team{Name: teamName})
@@ -33,14 +33,44 @@ func ServeSearchJobDownload(logger log.Logger, svc *service.Service) http.Handle
 			return
 		}
 
-		writerTo, err := svc.GetSearchJobResultsWriterTo(r.Context(), int64(jobID))
+		format, err := service.ParseResultsFormat(mux.Vars(r)["format"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		writerTo, err := svc.GetSearchJobResultsWriterTo(r.Context(), int64(jobID), format)
+		if err != nil {
+			httpError(w, err)
+			return
+		}
+
+		filename := filenamePrefix(jobID) + format.Extension()
+		writeResults(logger.With(log.Int64("jobID", jobID)), w, format.ContentType(), filename, writerTo)
+	}
+}
+
+// ServeSearchJobResultsDiff serves the results added and removed by a
+// scheduled search job, compared to the previous run of its schedule.
+func ServeSearchJobResultsDiff(logger log.Logger, svc *service.Service) http.HandlerFunc {
+	logger = logger.With(log.String("handler", "ServeSearchJobResultsDiff"))
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobIDStr := mux.Vars(r)["id"]
+		jobID, err := strconv.ParseInt(jobIDStr, 10, 64)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		csvWriterTo, err := svc.GetSearchJobResultsDiffWriterTo(r.Context(), jobID)
 		if err != nil {
 			httpError(w, err)
 			return
 		}
 
-		filename := filenamePrefix(jobID) + ".jsonl"
-		writeJSON(logger.With(log.Int64("jobID", jobID)), w, filename, writerTo)
+		filename := filenamePrefix(jobID) + ".diff.csv"
+		writeCSV(logger.With(log.Int64("jobID", jobID)), w, filename, csvWriterTo)
 	}
 }
 
@@ -113,8 +143,8 @@ func writeCSV(logger log.Logger, w http.ResponseWriter, filenameNoQuotes string,
 	}
 }
 
-func writeJSON(logger log.Logger, w http.ResponseWriter, filenameNoQuotes string, writerTo io.WriterTo) {
-	w.Header().Set("Content-Type", "application/jsonlines")
+func writeResults(logger log.Logger, w http.ResponseWriter, contentType, filenameNoQuotes string, writerTo io.WriterTo) {
+	w.Header().Set("Content-Type", contentType)
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filenameNoQuotes))
 	w.WriteHeader(200)
 	n, err := writerTo.WriteTo(w)
@@ -50,6 +50,7 @@ func Init(
 
 	enterpriseServices.SearchJobsResolver = resolvers.New(logger, db, svc)
 	enterpriseServices.SearchJobsDataExportHandler = httpapi.ServeSearchJobDownload(logger, svc)
+	enterpriseServices.SearchJobsResultsDiffHandler = httpapi.ServeSearchJobResultsDiff(logger, svc)
 	enterpriseServices.SearchJobsLogsHandler = httpapi.ServeSearchJobLogs(logger, svc)
 
 	return nil
@@ -66,6 +66,53 @@ func (r *Resolver) DeleteSearchJob(ctx context.Context, args *graphqlbackend.Del
 	return &graphqlbackend.EmptyResponse{}, r.svc.DeleteSearchJob(ctx, jobID)
 }
 
+func (r *Resolver) CreateSearchJobSchedule(ctx context.Context, args *graphqlbackend.CreateSearchJobScheduleArgs) (graphqlbackend.SearchJobScheduleResolver, error) {
+	var retainRuns int32
+	if args.RetainRuns != nil {
+		retainRuns = *args.RetainRuns
+	}
+
+	schedule, err := r.svc.CreateSearchJobSchedule(ctx, args.Query, args.Schedule, retainRuns)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSearchJobScheduleResolver(r.db, r.svc, schedule), nil
+}
+
+func (r *Resolver) DeleteSearchJobSchedule(ctx context.Context, args *graphqlbackend.DeleteSearchJobScheduleArgs) (*graphqlbackend.EmptyResponse, error) {
+	scheduleID, err := UnmarshalSearchJobScheduleID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, r.svc.DeleteSearchJobSchedule(ctx, scheduleID)
+}
+
+func (r *Resolver) SearchJobSchedules(ctx context.Context, args *graphqlbackend.SearchJobSchedulesArgs) ([]graphqlbackend.SearchJobScheduleResolver, error) {
+	var ids []int32
+	if args.UserIDs != nil {
+		for _, id := range *args.UserIDs {
+			userID, err := graphqlbackend.UnmarshalUserID(id)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, userID)
+		}
+	}
+
+	schedules, err := r.svc.ListSearchJobSchedules(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.SearchJobScheduleResolver, 0, len(schedules))
+	for _, schedule := range schedules {
+		resolvers = append(resolvers, newSearchJobScheduleResolver(r.db, r.svc, schedule))
+	}
+	return resolvers, nil
+}
+
 func newSearchJobConnectionResolver(ctx context.Context, db database.DB, service *service.Service, args *graphqlbackend.SearchJobsArgs) (*graphqlutil.ConnectionResolver[graphqlbackend.SearchJobResolver], error) {
 	var states []string
 	if args.States != nil {
@@ -228,6 +275,9 @@ func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
 		searchJobIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
 			return r.searchJobByID(ctx, id)
 		},
+		searchJobScheduleIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.searchJobScheduleByID(ctx, id)
+		},
 	}
 }
 
@@ -242,3 +292,15 @@ func (r *Resolver) searchJobByID(ctx context.Context, id graphql.ID) (graphqlbac
 	}
 	return newSearchJobResolver(r.db, r.svc, job), nil
 }
+
+func (r *Resolver) searchJobScheduleByID(ctx context.Context, id graphql.ID) (graphqlbackend.SearchJobScheduleResolver, error) {
+	scheduleID, err := UnmarshalSearchJobScheduleID(id)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := r.svc.GetSearchJobSchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobScheduleResolver(r.db, r.svc, schedule), nil
+}
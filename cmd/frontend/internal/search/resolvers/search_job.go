@@ -14,7 +14,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
@@ -98,3 +100,65 @@ func (r *searchJobResolver) RepoStats(ctx context.Context) (graphqlbackend.Searc
 	}
 	return &searchJobStatsResolver{repoRevStats}, nil
 }
+
+func (r *searchJobResolver) Schedule(ctx context.Context) (graphqlbackend.SearchJobScheduleResolver, error) {
+	if r.Job.ScheduleID == 0 {
+		return nil, nil
+	}
+	schedule, err := r.svc.GetSearchJobSchedule(ctx, r.Job.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobScheduleResolver(r.db, r.svc, schedule), nil
+}
+
+func (r *searchJobResolver) ResultCount() *int32 {
+	return r.Job.ResultCount
+}
+
+func (r *searchJobResolver) ResultsDiff() graphqlbackend.SearchJobResultsDiffResolver {
+	if r.Job.ResultsDiff == nil {
+		return nil
+	}
+	return &searchJobResultsDiffResolver{diff: r.Job.ResultsDiff, jobID: r.Job.ID, db: r.db, svc: r.svc}
+}
+
+type searchJobResultsDiffResolver struct {
+	diff  *types.ResultsDiff
+	jobID int64
+	db    database.DB
+	svc   *service.Service
+}
+
+func (r *searchJobResultsDiffResolver) PreviousRun(ctx context.Context) (graphqlbackend.SearchJobResolver, error) {
+	job, err := r.svc.GetSearchJob(ctx, r.diff.BaseJobID)
+	if err != nil {
+		// The previous run may have been deleted by the retention policy of
+		// the schedule.
+		if errors.Is(err, store.ErrNoResults) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
+func (r *searchJobResultsDiffResolver) Added() int32 {
+	return r.diff.Added
+}
+
+func (r *searchJobResultsDiffResolver) Removed() int32 {
+	return r.diff.Removed
+}
+
+func (r *searchJobResultsDiffResolver) URL(ctx context.Context) (*string, error) {
+	previousRun, err := r.PreviousRun(ctx)
+	if err != nil || previousRun == nil {
+		return nil, err
+	}
+	exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.diff.csv", r.jobID))
+	if err != nil {
+		return nil, err
+	}
+	return pointers.Ptr(exportPath), nil
+}
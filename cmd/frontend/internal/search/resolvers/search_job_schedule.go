@@ -0,0 +1,89 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+const searchJobScheduleIDKind = "SearchJobSchedule"
+
+func MarshalSearchJobScheduleID(id int64) graphql.ID {
+	return relay.MarshalID(searchJobScheduleIDKind, id)
+}
+
+func UnmarshalSearchJobScheduleID(id graphql.ID) (int64, error) {
+	var v int64
+	err := relay.UnmarshalSpec(id, &v)
+	return v, err
+}
+
+var _ graphqlbackend.SearchJobScheduleResolver = &searchJobScheduleResolver{}
+
+func newSearchJobScheduleResolver(db database.DB, svc *service.Service, schedule *types.ExhaustiveSearchJobSchedule) *searchJobScheduleResolver {
+	return &searchJobScheduleResolver{schedule: schedule, db: db, svc: svc}
+}
+
+// You should call newSearchJobScheduleResolver to construct an instance.
+type searchJobScheduleResolver struct {
+	schedule *types.ExhaustiveSearchJobSchedule
+	db       database.DB
+	svc      *service.Service
+}
+
+func (r *searchJobScheduleResolver) ID() graphql.ID {
+	return MarshalSearchJobScheduleID(r.schedule.ID)
+}
+
+func (r *searchJobScheduleResolver) Query() string {
+	return r.schedule.Query
+}
+
+func (r *searchJobScheduleResolver) Schedule() string {
+	return r.schedule.Schedule
+}
+
+func (r *searchJobScheduleResolver) RetainRuns() int32 {
+	return r.schedule.RetainRuns
+}
+
+func (r *searchJobScheduleResolver) Creator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	user, err := r.db.Users().GetByID(ctx, r.schedule.InitiatorID)
+	if err != nil {
+		// We return nil for deleted users and expect the client to handle this case.
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return graphqlbackend.NewUserResolver(ctx, r.db, user), nil
+}
+
+func (r *searchJobScheduleResolver) CreatedAt() gqlutil.DateTime {
+	return *gqlutil.FromTime(r.schedule.CreatedAt)
+}
+
+func (r *searchJobScheduleResolver) NextRunAt() gqlutil.DateTime {
+	return *gqlutil.FromTime(r.schedule.NextRunAt)
+}
+
+func (r *searchJobScheduleResolver) Runs(ctx context.Context) ([]graphqlbackend.SearchJobResolver, error) {
+	jobs, err := r.svc.ListSearchJobScheduleRuns(ctx, r.schedule.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.SearchJobResolver, 0, len(jobs))
+	for _, job := range jobs {
+		resolvers = append(resolvers, newSearchJobResolver(r.db, r.svc, job))
+	}
+	return resolvers, nil
+}
@@ -42,6 +42,10 @@ type JanitorConfig struct {
 	ShardID         string
 
 	DisableDeleteReposOnWrongShard bool
+
+	// ColdStorage is used to offload repos that haven't been accessed in a
+	// while. If nil, repos are never offloaded.
+	ColdStorage *ColdStorage
 }
 
 func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, fs gitserverfs.FS, gitBackendSource git.GitBackendSource, rcf *wrexec.RecordingCommandFactory, logger log.Logger) goroutine.BackgroundRoutine {
@@ -52,7 +56,7 @@ func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, fs gitse
 
 			gitserverAddrs := connection.NewGitserverAddresses(conf.Get())
 			// TODO: Should this return an error?
-			cleanupRepos(ctx, logger, db, fs, gitBackendSource, rcf, cfg.ShardID, gitserverAddrs, cfg.DisableDeleteReposOnWrongShard)
+			cleanupRepos(ctx, logger, db, fs, gitBackendSource, rcf, cfg.ShardID, gitserverAddrs, cfg.DisableDeleteReposOnWrongShard, cfg.ColdStorage)
 
 			return nil
 		}),
@@ -196,6 +200,8 @@ var (
 // 9. Perform sg-maintenance
 // 10. Git prune
 // 11. Set sizes of repos
+// 12. Maintain the object pools shared by forks
+// 13. Offload repos that haven't been accessed in a while to cold storage
 func cleanupRepos(
 	ctx context.Context,
 	logger log.Logger,
@@ -206,6 +212,7 @@ func cleanupRepos(
 	shardID string,
 	gitServerAddrs connection.GitserverAddresses,
 	disableDeleteReposOnWrongShard bool,
+	coldStorage *ColdStorage,
 ) {
 	logger = logger.Scoped("cleanup")
 
@@ -361,6 +368,10 @@ func cleanupRepos(
 		return true, err
 	}
 
+	maybeOffload := func(backend git.GitBackend, repoName api.RepoName, dir common.GitDir) (done bool, err error) {
+		return coldStorage.MaybeOffload(ctx, backend, repoName, dir)
+	}
+
 	ensureGitAttributes := func(backend git.GitBackend, repoName api.RepoName, dir common.GitDir) (done bool, err error) {
 		return false, git.SetGitAttributes(dir)
 	}
@@ -393,14 +404,15 @@ func cleanupRepos(
 			return false, nil
 		}
 
-		// We believe converting a Perforce depot to a Git repository is generally a
-		// very expensive operation, therefore we do not try to re-clone/redo the
-		// conversion only because it is old or slow to do "git gc".
+		// We believe converting a Perforce depot or a Mercurial repository to a Git
+		// repository is generally a very expensive operation, therefore we do not
+		// try to re-clone/redo the conversion only because it is old or slow to do
+		// "git gc".
 		repoType, err := git.GetRepositoryType(ctx, backend.Config())
 		if err != nil {
 			return false, err
 		}
-		if repoType == "perforce" {
+		if repoType == "perforce" || repoType == "mercurial" {
 			return false, nil
 		}
 
@@ -525,6 +537,15 @@ func cleanupRepos(
 		{"maybe remove corrupt", maybeRemoveCorrupt},
 		// Remove repo if DB does not contain it anymore
 		{"maybe remove non existing", maybeRemoveNonExisting},
+	}
+
+	if coldStorage != nil {
+		// Repos that nobody looks at don't need to take up disk space, and
+		// don't need any maintenance either.
+		cleanups = append(cleanups, cleanupFn{"maybe offload to cold storage", maybeOffload})
+	}
+
+	cleanups = append(cleanups, []cleanupFn{
 		// If git is interrupted it can leave lock files lying around. It does not clean
 		// these up, and instead fails commands.
 		{"remove stale locks", removeStaleLocks},
@@ -535,7 +556,7 @@ func cleanupRepos(
 		// happen if several git-gc operations are running at the same time.
 		// We only disable if sg is managing gc.
 		{"auto gc config", ensureAutoGC},
-	}
+	}...)
 
 	if gitGCMode == gitGCModeJanitorAutoGC {
 		// Runs a number of housekeeping tasks within the current repository, such as
@@ -610,6 +631,12 @@ func cleanupRepos(
 		logger.Error("error iterating over repositories", log.Error(err))
 	}
 
+	// Pools are maintained after their members, so that members which have
+	// been removed above are dropped from their pools. We do this even if
+	// fork pools are disabled, as existing forks still borrow objects from
+	// their pools.
+	cleanupPools(ctx, logger, fs)
+
 	if len(repoToSize) > 0 {
 		_, err := db.GitserverRepos().UpdateRepoSizes(ctx, logger, shardID, repoToSize)
 		if err != nil {
@@ -878,13 +905,17 @@ func pruneIfNeeded(rcf *wrexec.RecordingCommandFactory, repo api.RepoName, dir c
 
 func needsMaintenance(dir common.GitDir) (bool, string, error) {
 	// Bitmaps store reachability information about the set of objects in a
-	// packfile which speeds up clone and fetch operations.
-	hasBm, err := hasBitmap(dir)
-	if err != nil {
-		return false, "", err
-	}
-	if !hasBm {
-		return true, "bitmap", nil
+	// packfile which speeds up clone and fetch operations. They can only be
+	// written for packs that contain all reachable objects, which is never
+	// the case for repositories that borrow objects from an object pool.
+	if !hasAlternates(dir) {
+		hasBm, err := hasBitmap(dir)
+		if err != nil {
+			return false, "", err
+		}
+		if !hasBm {
+			return true, "bitmap", nil
+		}
 	}
 
 	// The commit-graph file is a supplemental data structure that accelerates
@@ -86,6 +86,7 @@ UPDATE gitserver_repos SET repo_size_bytes = 5 where repo_id = 3;
 		"test-gitserver",
 		connection.GitserverAddresses{Addresses: []string{"test-gitserver"}},
 		false,
+		nil,
 	)
 
 	// This may be different in practice, but the way we setup the tests
@@ -152,6 +153,7 @@ func TestCleanupInactive(t *testing.T) {
 		"test-gitserver",
 		connection.GitserverAddresses{Addresses: []string{"test-gitserver"}},
 		false,
+		nil,
 	)
 
 	if _, err := os.Stat(repoA); os.IsNotExist(err) {
@@ -196,6 +198,7 @@ func TestCleanupWrongShard(t *testing.T) {
 			"does-not-exist",
 			connection.GitserverAddresses{Addresses: []string{"gitserver-0", "gitserver-1"}},
 			false,
+			nil,
 		)
 
 		if _, err := os.Stat(repoA); err != nil {
@@ -238,6 +241,7 @@ func TestCleanupWrongShard(t *testing.T) {
 			"gitserver-0",
 			connection.GitserverAddresses{Addresses: []string{"gitserver-0.cluster.local:3178", "gitserver-1.cluster.local:3178"}},
 			false,
+			nil,
 		)
 
 		if _, err := os.Stat(repoA); err != nil {
@@ -280,6 +284,7 @@ func TestCleanupWrongShard(t *testing.T) {
 			"gitserver-0",
 			connection.GitserverAddresses{Addresses: []string{"gitserver-0", "gitserver-1"}},
 			true,
+			nil,
 		)
 
 		if _, err := os.Stat(repoA); os.IsNotExist(err) {
@@ -357,6 +362,7 @@ func TestGitGCAuto(t *testing.T) {
 		"test-gitserver",
 		connection.GitserverAddresses{Addresses: []string{"test-gitserver"}},
 		false,
+		nil,
 	)
 
 	// Verify that there are no more GC-able objects in the repository.
@@ -449,6 +455,7 @@ func TestCleanupBroken(t *testing.T) {
 		"test-gitserver",
 		connection.GitserverAddresses{Addresses: []string{"test-gitserver"}},
 		false,
+		nil,
 	)
 
 	require.Equal(t, []api.RepoName{"repo-corrupt", "repo-gc-old", "repo-non-bare"}, calledRemove)
@@ -505,6 +512,7 @@ func TestCleanup_RemoveNonExistentRepos(t *testing.T) {
 			"test-gitserver",
 			connection.GitserverAddresses{Addresses: []string{"test-gitserver"}},
 			false,
+			nil,
 		)
 
 		// nothing should happen if test env not declared to true
@@ -539,6 +547,7 @@ func TestCleanup_RemoveNonExistentRepos(t *testing.T) {
 			"test-gitserver",
 			connection.GitserverAddresses{Addresses: []string{"test-gitserver"}},
 			false,
+			nil,
 		)
 
 		if _, err := os.Stat(repoNotExists); err == nil {
@@ -696,6 +705,7 @@ func TestCleanupOldLocks(t *testing.T) {
 		"test-gitserver",
 		connection.GitserverAddresses{Addresses: []string{"gitserver-0"}},
 		false,
+		nil,
 	)
 
 	isRemoved := func(path string) bool {
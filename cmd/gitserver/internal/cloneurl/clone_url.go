@@ -16,10 +16,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	ghauth "github.com/sourcegraph/sourcegraph/internal/extsvc/github/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/mercurial"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/pagure"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/phabricator"
@@ -60,6 +62,10 @@ func cloneURL(ctx context.Context, db database.DB, kind string, parsed any, repo
 		if r, ok := repo.Metadata.(*gerrit.Project); ok {
 			return gerritCloneURL(r, t)
 		}
+	case *schema.GiteaConnection:
+		if r, ok := repo.Metadata.(*gitea.Repo); ok {
+			return giteaCloneURL(r, t)
+		}
 	case *schema.GitHubConnection:
 		if r, ok := repo.Metadata.(*github.Repository); ok {
 			return githubCloneURL(ctx, db, r, t)
@@ -84,6 +90,10 @@ func cloneURL(ctx context.Context, db database.DB, kind string, parsed any, repo
 		if r, ok := repo.Metadata.(*pagure.Project); ok {
 			return r.FullURL, nil
 		}
+	case *schema.MercurialConnection:
+		if r, ok := repo.Metadata.(*mercurial.Repo); ok {
+			return mercurialCloneURL(r, t)
+		}
 	case *schema.OtherExternalServiceConnection:
 		if r, ok := repo.Metadata.(*extsvc.OtherRepoMetadata); ok {
 			return otherCloneURL(repo, r), nil
@@ -199,6 +209,23 @@ func bitbucketCloudCloneURL(repo *bitbucketcloud.Repo, cfg *schema.BitbucketClou
 	return u.String(), nil
 }
 
+// giteaCloneURL returns the HTTP clone URL of the Gitea repository with the
+// access token inserted in the URL userinfo, or the SSH clone URL if
+// configured. Gitea accepts access tokens in place of passwords for any
+// username.
+func giteaCloneURL(repo *gitea.Repo, cfg *schema.GiteaConnection) (string, error) {
+	if cfg.GitURLType == "ssh" {
+		return repo.SSHURL, nil
+	}
+
+	u, err := url.Parse(repo.CloneURL)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse Gitea clone URL")
+	}
+	u.User = url.UserPassword("oauth2", cfg.Token)
+	return u.String(), nil
+}
+
 func githubCloneURL(ctx context.Context, db database.DB, repo *github.Repository, cfg *schema.GitHubConnection) (string, error) {
 	if cfg.GitURLType == "ssh" {
 		baseURL, err := url.Parse(cfg.Url)
@@ -348,6 +375,19 @@ func phabricatorCloneURL(repo *phabricator.Repo, _ *schema.PhabricatorConnection
 	return cloneURL, nil
 }
 
+// mercurialCloneURL returns the URL of the Mercurial repository with the
+// configured credentials inserted in the URL userinfo.
+func mercurialCloneURL(repo *mercurial.Repo, cfg *schema.MercurialConnection) (string, error) {
+	u, err := url.Parse(repo.URL)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse Mercurial repository URL")
+	}
+	if cfg.Username != "" || cfg.Password != "" {
+		u.User = url.UserPassword(cfg.Username, cfg.Password)
+	}
+	return u.String(), nil
+}
+
 func otherCloneURL(repo *types.Repo, m *extsvc.OtherRepoMetadata) string {
 	return repo.ExternalRepo.ServiceID + m.RelativePath
 }
@@ -0,0 +1,481 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/object"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// lastAccessFile is touched in the git directory of a repo whenever it is
+	// read from. Its mtime is the last time the repo was accessed.
+	lastAccessFile = "sg_last_access"
+	// lastAccessResolution limits how often we touch lastAccessFile, so that
+	// reads don't turn into writes.
+	lastAccessResolution = time.Hour
+)
+
+var (
+	reposOffloaded = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_repos_offloaded",
+		Help: "number of repos offloaded to cold storage because they haven't been accessed in a while",
+	})
+	reposRehydrated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_repos_rehydrated",
+		Help: "number of repos restored from cold storage on access",
+	}, []string{"success"})
+	rehydrationDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "src_gitserver_repo_rehydration_duration_seconds",
+		Help:    "Time it takes to restore a repo from cold storage",
+		Buckets: prometheus.ExponentialBucketsRange(0.1, 600, 12),
+	})
+)
+
+// ColdStorage offloads repos that haven't been accessed within a configurable
+// window to object storage as git bundles, and restores them transparently on
+// first access.
+//
+// Offloaded repos keep their row in gitserver_repos with the clone status
+// types.CloneStatusTiered. This tombstone tells gitserver to restore the repo
+// from its bundle instead of cloning it again, and keeps background fetches
+// from pulling the repo back onto disk.
+type ColdStorage struct {
+	logger        log.Logger
+	fs            gitserverfs.FS
+	db            database.DB
+	locker        RepositoryLocker
+	storage       object.Storage
+	shardID       string
+	inactiveAfter time.Duration
+
+	// rehydrations deduplicates concurrent restores of the same repo, so that
+	// all requests wait for the one restore in progress.
+	rehydrations singleflight.Group
+}
+
+// NewColdStorage returns a ColdStorage that offloads repos not accessed within
+// inactiveAfter to storage.
+func NewColdStorage(logger log.Logger, fs gitserverfs.FS, db database.DB, locker RepositoryLocker, storage object.Storage, shardID string, inactiveAfter time.Duration) *ColdStorage {
+	return &ColdStorage{
+		logger:        logger.Scoped("coldStorage"),
+		fs:            fs,
+		db:            db,
+		locker:        locker,
+		storage:       storage,
+		shardID:       shardID,
+		inactiveAfter: inactiveAfter,
+	}
+}
+
+// MarkAccessed records that the repo in dir has been read from.
+func (c *ColdStorage) MarkAccessed(dir common.GitDir) {
+	fi, err := os.Stat(dir.Path(lastAccessFile))
+	if err == nil && time.Since(fi.ModTime()) < lastAccessResolution {
+		return
+	}
+	if err := touchLastAccess(dir, time.Now()); err != nil {
+		c.logger.Warn("failed to record repo access", log.String("dir", string(dir)), log.Error(err))
+	}
+}
+
+// isUserRequest returns true if the request was made on behalf of a user,
+// signed in or anonymous, as opposed to a background process running as the
+// internal actor or without any actor. Only user requests count as access to a
+// repo and restore it from cold storage, otherwise background jobs that touch
+// every repo would keep all of them on disk.
+func isUserRequest(ctx context.Context) bool {
+	a := actor.FromContext(ctx)
+	if a.IsInternal() {
+		return false
+	}
+	return a.IsAuthenticated() || a.AnonymousUID != ""
+}
+
+// lastAccessed returns when the repo in dir has last been read from. Repos
+// that have never been marked as accessed start their inactivity window now.
+func lastAccessed(dir common.GitDir) (time.Time, error) {
+	fi, err := os.Stat(dir.Path(lastAccessFile))
+	if os.IsNotExist(err) {
+		now := time.Now()
+		return now, touchLastAccess(dir, now)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
+func touchLastAccess(dir common.GitDir, at time.Time) error {
+	path := dir.Path(lastAccessFile)
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		return err
+	}
+	return os.Chtimes(path, at, at)
+}
+
+// MaybeOffload offloads the repo in dir to cold storage and removes it from
+// disk if it hasn't been accessed within the inactivity window. It reports
+// whether the repo has been offloaded.
+//
+// The repo lock only excludes fetches and clones, not reads. The last access
+// time is checked again right before the repo is removed, so a read that
+// arrives while the bundle is uploaded keeps the repo on disk. Reads that are
+// still in flight when the repo is removed can fail, and the repo is restored
+// on the next access.
+func (c *ColdStorage) MaybeOffload(ctx context.Context, backend git.GitBackend, repo api.RepoName, dir common.GitDir) (bool, error) {
+	if inactive, err := c.inactive(dir); err != nil || !inactive {
+		return false, err
+	}
+
+	// Bundles only contain the git data. Perforce depots and Mercurial repos
+	// keep state outside of it that is expensive to recreate, so they stay on
+	// disk.
+	repoType, err := git.GetRepositoryType(ctx, backend.Config())
+	if err != nil {
+		return false, err
+	}
+	if repoType != "" {
+		return false, nil
+	}
+
+	// Bundles need every object, but partial clones miss blobs and don't keep
+	// the URL of their promisor remote to fetch them from. A restored bundle
+	// also wouldn't be a partial clone anymore, so they stay on disk.
+	if git.IsPartialClone(dir) {
+		return false, nil
+	}
+
+	lock, ok := c.locker.TryAcquire(repo, "offloading to cold storage")
+	if !ok {
+		// The repo is being fetched, we try again on the next run.
+		return false, nil
+	}
+	defer lock.Release()
+
+	// The repo might have been read from while we were waiting for the lock.
+	if inactive, err := c.inactive(dir); err != nil || !inactive {
+		return false, err
+	}
+
+	gr, err := c.db.GitserverRepos().GetByName(ctx, repo)
+	if err != nil {
+		return false, errors.Wrap(err, "getting gitserver repo")
+	}
+
+	if err := c.offload(ctx, gr.RepoID, dir); err != nil {
+		if errors.Is(err, errEmptyRepo) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Uploading the bundle can take a while, so we check one last time that
+	// nobody read from the repo in the meantime.
+	last, err := lastAccessed(dir)
+	if err != nil {
+		return false, errors.Wrap(err, "getting last access time")
+	}
+	if time.Since(last) < c.inactiveAfter {
+		// HEAD goes first, without it a leftover bundle is never restored.
+		for _, suffix := range []string{"HEAD", "bundle"} {
+			if err := c.storage.Delete(ctx, coldStorageKey(gr.RepoID, suffix)); err != nil {
+				c.logger.Warn("failed to delete bundle of accessed repo from cold storage", log.String("repo", string(repo)), log.Error(err))
+			}
+		}
+		return false, nil
+	}
+
+	// The tombstone has to be in place before the repo disappears from disk,
+	// otherwise the repo would be cloned again instead of being restored.
+	if err := c.db.GitserverRepos().SetCloneStatus(ctx, repo, types.CloneStatusTiered, c.shardID); err != nil {
+		return false, errors.Wrap(err, "failed to update clone status")
+	}
+	if err := c.fs.RemoveRepo(repo); err != nil {
+		return true, err
+	}
+
+	reposOffloaded.Inc()
+	c.logger.Info("offloaded repo to cold storage", log.String("repo", string(repo)), log.Time("lastAccessed", last))
+
+	return true, nil
+}
+
+// inactive returns true if the repo in dir hasn't been accessed within the
+// inactivity window.
+func (c *ColdStorage) inactive(dir common.GitDir) (bool, error) {
+	last, err := lastAccessed(dir)
+	if err != nil {
+		return false, errors.Wrap(err, "getting last access time")
+	}
+	return time.Since(last) >= c.inactiveAfter, nil
+}
+
+var errEmptyRepo = errors.New("repo has no refs")
+
+// offload uploads the bundle and HEAD of the repo in dir.
+func (c *ColdStorage) offload(ctx context.Context, id api.RepoID, dir common.GitDir) error {
+	refs, err := runGitOutput(ctx, dir, "for-each-ref", "--count=1", "--format=%(refname)")
+	if err != nil {
+		return err
+	}
+	// git refuses to create empty bundles, and there is nothing to store
+	// anyways.
+	if len(bytes.TrimSpace(refs)) == 0 {
+		return errEmptyRepo
+	}
+
+	head, err := os.ReadFile(dir.Path("HEAD"))
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := c.fs.TempDir("offload-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bundle := filepath.Join(tmpDir, "repo.bundle")
+	if err := runGit(ctx, dir, "bundle", "create", "--quiet", bundle, "--all"); err != nil {
+		return errors.Wrap(err, "creating bundle")
+	}
+
+	f, err := os.Open(bundle)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := c.storage.Upload(ctx, coldStorageKey(id, "bundle"), f); err != nil {
+		return errors.Wrap(err, "uploading bundle")
+	}
+	// HEAD goes last, it marks the upload as complete.
+	if _, err := c.storage.Upload(ctx, coldStorageKey(id, "HEAD"), bytes.NewReader(head)); err != nil {
+		return errors.Wrap(err, "uploading HEAD")
+	}
+	return nil
+}
+
+// Rehydrate restores repo from cold storage if it has been offloaded. It
+// reports whether the repo is on disk now. Concurrent calls for the same repo
+// wait for the same restore.
+func (c *ColdStorage) Rehydrate(ctx context.Context, repo api.RepoName) (bool, error) {
+	ch := c.rehydrations.DoChan(string(repo), func() (any, error) {
+		// The restore must not be aborted because the request that triggered
+		// it went away, other requests might be waiting for it.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), conf.GitLongCommandTimeout())
+		defer cancel()
+		return c.rehydrate(ctx, repo)
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return false, res.Err
+		}
+		return res.Val.(bool), nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+func (c *ColdStorage) rehydrate(ctx context.Context, repo api.RepoName) (_ bool, err error) {
+	if cloned, err := c.fs.RepoCloned(repo); err != nil || cloned {
+		return cloned, err
+	}
+
+	gr, tiered, err := c.tiered(ctx, repo)
+	if err != nil || !tiered {
+		return false, err
+	}
+
+	lock, ok := c.locker.TryAcquire(repo, "restoring from cold storage")
+	if !ok {
+		// Something else works on the repo, it shows up as cloning.
+		return false, nil
+	}
+	defer lock.Release()
+
+	// The repo might have been restored while we waited for the lock.
+	if cloned, err := c.fs.RepoCloned(repo); err != nil || cloned {
+		return cloned, err
+	}
+
+	start := time.Now()
+	defer func() {
+		reposRehydrated.WithLabelValues(fmt.Sprint(err == nil)).Inc()
+		rehydrationDuration.Observe(time.Since(start).Seconds())
+	}()
+
+	dir := c.fs.RepoDir(repo)
+	if err := c.restore(ctx, gr, dir); err != nil {
+		return false, errors.Wrapf(err, "restoring %s from cold storage", repo)
+	}
+
+	if err := c.db.GitserverRepos().SetCloneStatus(ctx, repo, types.CloneStatusCloned, c.shardID); err != nil {
+		return true, errors.Wrap(err, "failed to update clone status")
+	}
+
+	// The bundle is outdated as soon as the repo is fetched again, it is
+	// uploaded again when the repo is offloaded the next time.
+	for _, suffix := range []string{"HEAD", "bundle"} {
+		if err := c.storage.Delete(ctx, coldStorageKey(gr.RepoID, suffix)); err != nil {
+			c.logger.Warn("failed to delete restored repo from cold storage", log.String("repo", string(repo)), log.Error(err))
+		}
+	}
+
+	c.logger.Info("restored repo from cold storage", log.String("repo", string(repo)), log.Duration("duration", time.Since(start)))
+
+	return true, nil
+}
+
+// Delete removes repo from cold storage if it has been offloaded. It reports
+// whether the repo was tiered. The caller is responsible for updating the
+// clone status afterwards.
+func (c *ColdStorage) Delete(ctx context.Context, repo api.RepoName) (bool, error) {
+	gr, tiered, err := c.tiered(ctx, repo)
+	if err != nil || !tiered {
+		return false, err
+	}
+
+	lock, ok := c.locker.TryAcquire(repo, "deleting from cold storage")
+	if !ok {
+		return false, errors.Newf("repo %s is being restored from cold storage", repo)
+	}
+	defer lock.Release()
+
+	// HEAD goes first, without it a leftover bundle is never restored.
+	for _, suffix := range []string{"HEAD", "bundle"} {
+		key := coldStorageKey(gr.RepoID, suffix)
+		if err := c.storage.Delete(ctx, key); err != nil {
+			return false, errors.Wrapf(err, "deleting %s", key)
+		}
+	}
+
+	c.logger.Info("deleted repo from cold storage", log.String("repo", string(repo)))
+
+	return true, nil
+}
+
+// restore recreates the repo in dir from its bundle. Like clones, the repo is
+// created in a temporary directory first, so that dir never contains a
+// partial repo.
+func (c *ColdStorage) restore(ctx context.Context, gr *types.GitserverRepo, dir common.GitDir) error {
+	tmpDir, err := c.fs.TempDir("rehydrate-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var head bytes.Buffer
+	if err := c.download(ctx, coldStorageKey(gr.RepoID, "HEAD"), &head); err != nil {
+		return err
+	}
+
+	bundle := filepath.Join(tmpDir, "repo.bundle")
+	f, err := os.Create(bundle)
+	if err != nil {
+		return err
+	}
+	err = c.download(ctx, coldStorageKey(gr.RepoID, "bundle"), f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	tmp := common.GitDir(filepath.Join(tmpDir, ".git"))
+	if err := os.MkdirAll(tmp.Path(), os.ModePerm); err != nil {
+		return err
+	}
+	if err := runGit(ctx, tmp, "init", "--bare", "--quiet", tmp.Path()); err != nil {
+		return err
+	}
+	if err := runGit(ctx, tmp, "fetch", "--quiet", "--no-write-fetch-head", bundle, "+refs/*:refs/*"); err != nil {
+		return errors.Wrap(err, "fetching from bundle")
+	}
+	if err := os.WriteFile(tmp.Path("HEAD"), head.Bytes(), 0o644); err != nil {
+		return err
+	}
+	if err := git.SetGitAttributes(tmp); err != nil {
+		return err
+	}
+
+	// Without a FETCH_HEAD, the mtime of HEAD is the last fetch time of the
+	// repo, so we carry it over. Restoring is an access, too.
+	if !gr.LastFetched.IsZero() {
+		if err := os.Chtimes(tmp.Path("HEAD"), gr.LastFetched, gr.LastFetched); err != nil {
+			return err
+		}
+	}
+	if err := touchLastAccess(tmp, time.Now()); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir.Path()), os.ModePerm); err != nil {
+		return err
+	}
+	return fileutil.RenameAndSync(tmp.Path(), dir.Path())
+}
+
+// download copies the object at key to w.
+func (c *ColdStorage) download(ctx context.Context, key string, w io.Writer) error {
+	rc, err := c.storage.Get(ctx, key)
+	if err != nil {
+		return errors.Wrapf(err, "getting %s", key)
+	}
+	defer rc.Close()
+
+	if _, err := io.Copy(w, rc); err != nil {
+		return errors.Wrapf(err, "downloading %s", key)
+	}
+	return nil
+}
+
+// tiered returns the gitserver repo of repo and whether it has been offloaded
+// to cold storage.
+func (c *ColdStorage) tiered(ctx context.Context, repo api.RepoName) (*types.GitserverRepo, bool, error) {
+	gr, err := c.db.GitserverRepos().GetByName(ctx, repo)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return gr, gr.CloneStatus == types.CloneStatusTiered, nil
+}
+
+// TieredStats returns the number and size of the repos of this shard that
+// have been offloaded.
+func (c *ColdStorage) TieredStats(ctx context.Context) (count, sizeBytes int64, err error) {
+	return c.db.GitserverRepos().GetTieredStats(ctx, c.shardID)
+}
+
+// coldStorageKey returns the key of an object of the repo with the given ID.
+// We use the ID rather than the name, so that renames don't orphan objects.
+func coldStorageKey(id api.RepoID, suffix string) string {
+	return fmt.Sprintf("repos/%d.%s", id, suffix)
+}
@@ -0,0 +1,322 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/object"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestColdStorage(t *testing.T) {
+	ctx := context.Background()
+	const repo = api.RepoName("github.com/sourcegraph/cold")
+
+	remote := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	makeSingleCommitRepo(cmd)
+	cmd("git", "tag", "v1")
+
+	root := t.TempDir()
+	fs := gitserverfs.New(observation.TestContextTB(t), root)
+	require.NoError(t, fs.Initialize())
+	dir := fs.RepoDir(repo)
+	runCmd(t, root, "git", "clone", "--bare", remote, dir.Path())
+	refs := runCmd(t, root, "git", "--git-dir", dir.Path(), "for-each-ref")
+	head := runCmd(t, root, "git", "--git-dir", dir.Path(), "symbolic-ref", "HEAD")
+
+	lastFetched := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	cloneStatus := types.CloneStatusCloned
+	gitserverRepos := dbmocks.NewMockGitserverRepoStore()
+	gitserverRepos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.GitserverRepo, error) {
+		if name != repo {
+			return nil, &database.ErrGitserverRepoNotFound{}
+		}
+		return &types.GitserverRepo{RepoID: 42, CloneStatus: cloneStatus, LastFetched: lastFetched}, nil
+	})
+	gitserverRepos.SetCloneStatusFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, status types.CloneStatus, _ string) error {
+		cloneStatus = status
+		return nil
+	})
+	db := dbmocks.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+
+	storage, err := object.CreateLazyStorage(ctx, object.StorageConfig{
+		Backend:      "local",
+		Bucket:       "cold",
+		ManageBucket: true,
+		Local:        object.LocalConfig{Dir: t.TempDir()},
+	}, object.NewOperations(observation.TestContextTB(t), "test", "coldstorage"))
+	require.NoError(t, err)
+
+	c := NewColdStorage(logtest.Scoped(t), fs, db, NewRepositoryLocker(), storage, "test-gitserver", time.Hour)
+	backend := func() git.GitBackend {
+		b := git.NewMockGitBackend()
+		b.ConfigFunc.SetDefaultReturn(git.NewMockGitConfigBackend())
+		return b
+	}
+
+	// The inactivity window of repos without any recorded access starts now.
+	offloaded, err := c.MaybeOffload(ctx, backend(), repo, dir)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+
+	// Recently accessed repos stay on disk.
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+	c.MarkAccessed(dir)
+	offloaded, err = c.MaybeOffload(ctx, backend(), repo, dir)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+
+	// Repos that haven't been accessed within the window are offloaded.
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+	offloaded, err = c.MaybeOffload(ctx, backend(), repo, dir)
+	require.NoError(t, err)
+	require.True(t, offloaded)
+	require.Equal(t, types.CloneStatusTiered, cloneStatus)
+	cloned, err := fs.RepoCloned(repo)
+	require.NoError(t, err)
+	require.False(t, cloned)
+	require.Equal(t, []string{"repos/42.HEAD", "repos/42.bundle"}, listObjects(t, storage))
+
+	// Repos that haven't been offloaded are not restored.
+	rehydrated, err := c.Rehydrate(ctx, "github.com/sourcegraph/unknown")
+	require.NoError(t, err)
+	require.False(t, rehydrated)
+
+	// Background requests don't restore offloaded repos.
+	gs := &grpcServer{logger: logtest.Scoped(t), fs: fs, locker: NewRepositoryLocker(), coldStorage: c}
+	internalCtx := actor.WithInternalActor(ctx)
+	require.Equal(t, codes.NotFound, status.Code(gs.checkRepoExists(internalCtx, repo)))
+	cloned, err = fs.RepoCloned(repo)
+	require.NoError(t, err)
+	require.False(t, cloned)
+
+	// Offloaded repos are restored with all their refs on user requests.
+	userCtx := actor.WithActor(ctx, actor.FromUser(1))
+	require.NoError(t, gs.checkRepoExists(userCtx, repo))
+	rehydrated, err = c.Rehydrate(ctx, repo)
+	require.NoError(t, err)
+	require.True(t, rehydrated)
+	require.Equal(t, types.CloneStatusCloned, cloneStatus)
+	require.Equal(t, refs, runCmd(t, root, "git", "--git-dir", dir.Path(), "for-each-ref"))
+	require.Equal(t, head, runCmd(t, root, "git", "--git-dir", dir.Path(), "symbolic-ref", "HEAD"))
+	requireConnected(t, root, dir)
+	fetched, err := repoLastFetched(dir)
+	require.NoError(t, err)
+	require.Equal(t, lastFetched, fetched)
+	last, err := lastAccessed(dir)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), last, time.Minute)
+	require.Empty(t, listObjects(t, storage))
+
+	// Only user requests count as access.
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+	require.NoError(t, gs.checkRepoExists(internalCtx, repo))
+	last, err = lastAccessed(dir)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(-2*time.Hour), last, time.Minute)
+	require.NoError(t, gs.checkRepoExists(userCtx, repo))
+	last, err = lastAccessed(dir)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), last, time.Minute)
+
+	// Anonymous visitors count as users, too.
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+	anonymousCtx := actor.WithActor(ctx, actor.FromAnonymousUser("anonymous"))
+	require.NoError(t, gs.checkRepoExists(anonymousCtx, repo))
+	last, err = lastAccessed(dir)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), last, time.Minute)
+
+	// A read while the bundle is uploaded keeps the repo on disk, and the
+	// uploaded bundle is discarded.
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+	c.storage = &readDuringUploadStorage{Storage: storage, dir: dir}
+	offloaded, err = c.MaybeOffload(ctx, backend(), repo, dir)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+	require.Equal(t, types.CloneStatusCloned, cloneStatus)
+	cloned, err = fs.RepoCloned(repo)
+	require.NoError(t, err)
+	require.True(t, cloned)
+	require.Empty(t, listObjects(t, storage))
+	c.storage = storage
+
+	// Restoring a repo on disk is a no-op.
+	gitserverRepos.GetByNameFunc.SetDefaultHook(func(context.Context, api.RepoName) (*types.GitserverRepo, error) {
+		t.Fatal("unexpected lookup of cloned repo")
+		return nil, nil
+	})
+	rehydrated, err = c.Rehydrate(ctx, repo)
+	require.NoError(t, err)
+	require.True(t, rehydrated)
+}
+
+func TestIsUserRequest(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, isUserRequest(ctx))
+	require.False(t, isUserRequest(actor.WithInternalActor(ctx)))
+	require.True(t, isUserRequest(actor.WithActor(ctx, actor.FromUser(1))))
+	require.True(t, isUserRequest(actor.WithActor(ctx, actor.FromAnonymousUser("anonymous"))))
+}
+
+// readDuringUploadStorage simulates a read from the repo in dir while its
+// bundle is uploaded.
+type readDuringUploadStorage struct {
+	object.Storage
+	dir common.GitDir
+}
+
+func (s *readDuringUploadStorage) Upload(ctx context.Context, key string, r io.Reader) (int64, error) {
+	if err := touchLastAccess(s.dir, time.Now()); err != nil {
+		return 0, err
+	}
+	return s.Storage.Upload(ctx, key, r)
+}
+
+func TestColdStorage_EmptyRepo(t *testing.T) {
+	ctx := context.Background()
+	const repo = api.RepoName("github.com/sourcegraph/empty")
+
+	root := t.TempDir()
+	fs := gitserverfs.New(observation.TestContextTB(t), root)
+	require.NoError(t, fs.Initialize())
+	dir := fs.RepoDir(repo)
+	require.NoError(t, os.MkdirAll(dir.Path(), os.ModePerm))
+	runCmd(t, root, "git", "init", "--bare", dir.Path())
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+
+	gitserverRepos := dbmocks.NewMockGitserverRepoStore()
+	gitserverRepos.GetByNameFunc.SetDefaultReturn(&types.GitserverRepo{RepoID: 1, CloneStatus: types.CloneStatusCloned}, nil)
+	db := dbmocks.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+
+	b := git.NewMockGitBackend()
+	b.ConfigFunc.SetDefaultReturn(git.NewMockGitConfigBackend())
+
+	c := NewColdStorage(logtest.Scoped(t), fs, db, NewRepositoryLocker(), nil, "test-gitserver", time.Hour)
+	offloaded, err := c.MaybeOffload(ctx, b, repo, dir)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+	require.Empty(t, gitserverRepos.SetCloneStatusFunc.History())
+	_, err = os.Stat(dir.Path("HEAD"))
+	require.NoError(t, err)
+}
+
+func TestColdStorage_PartialClone(t *testing.T) {
+	ctx := context.Background()
+	const repo = api.RepoName("github.com/sourcegraph/partial")
+
+	remote := t.TempDir()
+	makeSingleCommitRepo(func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	})
+	runCmd(t, remote, "git", "config", "uploadpack.allowFilter", "true")
+
+	root := t.TempDir()
+	fs := gitserverfs.New(observation.TestContextTB(t), root)
+	require.NoError(t, fs.Initialize())
+	dir := fs.RepoDir(repo)
+	runCmd(t, root, "git", "clone", "--bare", "--filter=blob:none", "file://"+remote, dir.Path())
+	// Like gitserver, don't keep the URL of the promisor remote.
+	runCmd(t, root, "git", "--git-dir", dir.Path(), "config", "--unset", "remote.origin.url")
+	require.True(t, git.IsPartialClone(dir))
+	require.NoError(t, touchLastAccess(dir, time.Now().Add(-2*time.Hour)))
+
+	gitserverRepos := dbmocks.NewMockGitserverRepoStore()
+	gitserverRepos.GetByNameFunc.SetDefaultReturn(&types.GitserverRepo{RepoID: 1, CloneStatus: types.CloneStatusCloned}, nil)
+	db := dbmocks.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+
+	b := git.NewMockGitBackend()
+	b.ConfigFunc.SetDefaultReturn(git.NewMockGitConfigBackend())
+
+	c := NewColdStorage(logtest.Scoped(t), fs, db, NewRepositoryLocker(), nil, "test-gitserver", time.Hour)
+	offloaded, err := c.MaybeOffload(ctx, b, repo, dir)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+	require.Empty(t, gitserverRepos.SetCloneStatusFunc.History())
+	cloned, err := fs.RepoCloned(repo)
+	require.NoError(t, err)
+	require.True(t, cloned)
+}
+
+func TestColdStorage_Delete(t *testing.T) {
+	ctx := context.Background()
+	const repo = api.RepoName("github.com/sourcegraph/deleted")
+
+	storage, err := object.CreateLazyStorage(ctx, object.StorageConfig{
+		Backend:      "local",
+		Bucket:       "cold",
+		ManageBucket: true,
+		Local:        object.LocalConfig{Dir: t.TempDir()},
+	}, object.NewOperations(observation.TestContextTB(t), "test", "coldstorage"))
+	require.NoError(t, err)
+	for _, key := range []string{"repos/7.HEAD", "repos/7.bundle", "repos/8.HEAD", "repos/8.bundle"} {
+		_, err := storage.Upload(ctx, key, strings.NewReader(key))
+		require.NoError(t, err)
+	}
+
+	cloneStatus := types.CloneStatusCloned
+	gitserverRepos := dbmocks.NewMockGitserverRepoStore()
+	gitserverRepos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.GitserverRepo, error) {
+		if name != repo {
+			return nil, &database.ErrGitserverRepoNotFound{}
+		}
+		return &types.GitserverRepo{RepoID: 7, CloneStatus: cloneStatus}, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+
+	fs := gitserverfs.New(observation.TestContextTB(t), t.TempDir())
+	c := NewColdStorage(logtest.Scoped(t), fs, db, NewRepositoryLocker(), storage, "test-gitserver", time.Hour)
+
+	// Unknown repos and repos that haven't been offloaded are left alone.
+	deleted, err := c.Delete(ctx, "github.com/sourcegraph/unknown")
+	require.NoError(t, err)
+	require.False(t, deleted)
+	deleted, err = c.Delete(ctx, repo)
+	require.NoError(t, err)
+	require.False(t, deleted)
+
+	// Only the objects of the offloaded repo are deleted.
+	cloneStatus = types.CloneStatusTiered
+	deleted, err = c.Delete(ctx, repo)
+	require.NoError(t, err)
+	require.True(t, deleted)
+	require.Equal(t, []string{"repos/8.HEAD", "repos/8.bundle"}, listObjects(t, storage))
+}
+
+func listObjects(t *testing.T, storage object.Storage) []string {
+	t.Helper()
+	it, err := storage.List(context.Background(), "repos/")
+	require.NoError(t, err)
+	var keys []string
+	for it.Next() {
+		keys = append(keys, it.Current())
+	}
+	require.NoError(t, it.Err())
+	return keys
+}
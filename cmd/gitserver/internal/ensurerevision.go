@@ -32,6 +32,17 @@ func (s *Server) EnsureRevision(ctx context.Context, repo api.RepoName, rev stri
 		return false
 	}
 
+	// Offloaded repos are restored first, so that the fetch below updates
+	// them rather than being skipped. Background callers leave them offloaded,
+	// the fetch below skips them.
+	if s.coldStorage != nil && isUserRequest(ctx) {
+		if _, err := s.coldStorage.Rehydrate(ctx, repo); err != nil {
+			ensureRevisionCounter.WithLabelValues("rehydrate_failed").Inc()
+			s.logger.Warn("failed to restore repo from cold storage", log.Error(err), log.String("repo", string(repo)))
+			return false
+		}
+	}
+
 	// Revision not found, update before returning.
 	lastFetched, lastChanged, err := s.FetchRepository(ctx, repo)
 	if err != nil {
@@ -172,12 +172,16 @@ func configureRemoteGitCommand(cmd *exec.Cmd, remoteURL *vcs.URL, tlsConf *tlsCo
 	password, ok := remoteURL.User.Password()
 	if ok && executable == "git" && !remoteURL.IsSSH() {
 		// If the remote URL is one of the args, remove the user section from it.
+		// Remote URLs can also be addressed through a git remote helper, in
+		// which case they are of the form <transport>::<address>, for example
+		// hg::https://example.com/repo.
 		hasCreds := false
 		for i, arg := range cmd.Args {
-			if arg == remoteURL.String() {
+			transport, ok := strings.CutSuffix(arg, remoteURL.String())
+			if ok && isRemoteHelperPrefix(transport) {
 				ru := *remoteURL
 				ru.User = nil
-				cmd.Args[i] = ru.String()
+				cmd.Args[i] = transport + ru.String()
 				hasCreds = true
 			}
 		}
@@ -203,6 +207,16 @@ func configureRemoteGitCommand(cmd *exec.Cmd, remoteURL *vcs.URL, tlsConf *tlsCo
 	cmd.Args = append(cmd.Args[:1], append(extraArgs, cmd.Args[1:]...)...)
 }
 
+// isRemoteHelperPrefix returns true if prefix is empty or a remote helper
+// transport prefix such as "hg::".
+func isRemoteHelperPrefix(prefix string) bool {
+	if prefix == "" {
+		return true
+	}
+	transport, ok := strings.CutSuffix(prefix, "::")
+	return ok && transport != "" && !strings.ContainsAny(transport, ":/")
+}
+
 // removeUnsupportedP4Args removes all -c arguments as `p4-fusion` command doesn't
 // support -c argument and passing this causes warning logs.
 func removeUnsupportedP4Args(args []string) []string {
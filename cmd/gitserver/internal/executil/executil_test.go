@@ -88,6 +88,12 @@ func TestConfigureRemoteGitCommand(t *testing.T) {
 			expectedEnv:  append(expectedEnv, "GIT_SG_USERNAME=foo", "GIT_SG_PASSWORD=bar"),
 			expectedArgs: []string{"git", "-c", "credential.helper=", "-c", "credential.helper=!f() { echo \"username=$GIT_SG_USERNAME\npassword=$GIT_SG_PASSWORD\"; }; f", "-c", "protocol.version=2", "fetch", "https://example.com/foo.git"},
 		},
+		{
+			// Remote helper URLs
+			input:        exec.Command("git", "fetch", "hg::"+remoteURL.String()),
+			expectedEnv:  append(expectedEnv, "GIT_SG_USERNAME=foo", "GIT_SG_PASSWORD=bar"),
+			expectedArgs: []string{"git", "-c", "credential.helper=", "-c", "credential.helper=!f() { echo \"username=$GIT_SG_USERNAME\npassword=$GIT_SG_PASSWORD\"; }; f", "-c", "protocol.version=2", "fetch", "hg::https://example.com/foo.git"},
+		},
 		{
 			input:       exec.Command("git", "ls-remote", remoteURL.String()),
 			expectedEnv: append(expectedEnv, "GIT_SG_USERNAME=foo", "GIT_SG_PASSWORD=bar"),
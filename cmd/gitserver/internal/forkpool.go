@@ -0,0 +1,446 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/pagure"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Forks of the same upstream repository share most of their objects. With
+// fork pools enabled, gitserver fetches the refs of every fork into an object
+// pool for its upstream repository and makes the fork borrow objects from the
+// pool through git alternates, so that shared objects are only stored once.
+//
+// The pool keeps the refs of each member under refs/members/<id>/, which keeps
+// every object a member borrows reachable in the pool. Members are recorded in
+// the poolMembersDir of the pool, the janitor drops members that are gone and
+// removes pools without members.
+var enableForkPools, _ = strconv.ParseBool(env.Get("SRC_ENABLE_FORK_POOLS", "false", "Share objects between forks of the same upstream repository through object pools"))
+
+const (
+	// poolMembersDir is the directory in a pool which records its members. It
+	// contains a file per member, named after the member's ref namespace and
+	// containing the name of the member repository.
+	poolMembersDir = "sourcegraph-members"
+
+	// poolPruneExpiry is how long unreachable objects are kept in a pool. A
+	// member can reference an object that became unreachable in the pool
+	// before its refs are synced again, so pools must never be pruned with
+	// --expire now.
+	poolPruneExpiry = "2.weeks.ago"
+)
+
+var (
+	forkPoolsTotal = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "src_gitserver_fork_pools",
+		Help: "The number of object pools shared by forks on disk.",
+	})
+	forkPoolMembersTotal = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "src_gitserver_fork_pool_members",
+		Help: "The number of repositories that borrow objects from an object pool.",
+	})
+	forkPoolsRemoved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_fork_pools_removed",
+		Help: "The number of object pools removed because they had no members left.",
+	})
+)
+
+// forkUpstream returns the name of the repository repo was forked from, as
+// recorded in the code host metadata. The name is only used to group forks of
+// the same upstream, the upstream doesn't need to be a repository on
+// Sourcegraph.
+//
+// Private forks are never pooled, see linkForkPool.
+func forkUpstream(repo *types.Repo) (api.RepoName, bool) {
+	// 🚨 SECURITY: Every member of a pool can read all objects in the pool by
+	// their hash, regardless of which member they came from. Only public
+	// repositories can be pooled, as they are readable by everyone anyway.
+	if !repo.Fork || repo.Private {
+		return "", false
+	}
+
+	switch md := repo.Metadata.(type) {
+	case *github.Repository:
+		if md.Parent != nil {
+			return upstreamName(repo.ExternalRepo.ServiceID, md.Parent.NameWithOwner)
+		}
+	case *gitlab.Project:
+		if md.ForkedFromProject != nil {
+			return upstreamName(md.ForkedFromProject.WebURL, "")
+		}
+	case *bitbucketcloud.Repo:
+		if md.Parent != nil {
+			return upstreamName(repo.ExternalRepo.ServiceID, md.Parent.FullName)
+		}
+	case *pagure.Project:
+		if md.Parent != nil {
+			return upstreamName(md.Parent.FullURL, "")
+		}
+	}
+
+	return "", false
+}
+
+// upstreamName returns the host of baseURL joined with its path and name,
+// e.g. github.com/sourcegraph/sourcegraph.
+func upstreamName(baseURL, name string) (api.RepoName, bool) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	p := strings.Trim(u.Path+"/"+name, "/")
+	if p == "" {
+		return "", false
+	}
+	return api.RepoName(strings.ToLower(u.Host + "/" + p)), true
+}
+
+// linkForkPool adds repo to the object pool of the repository it was forked
+// from, if fork pools are enabled and repo is a fork. dir is the git directory
+// of repo, which doesn't need to be at its final location yet.
+func linkForkPool(ctx context.Context, logger log.Logger, fs gitserverfs.FS, db database.DB, repo api.RepoName, dir common.GitDir) error {
+	if !enableForkPools {
+		return nil
+	}
+
+	r, err := db.Repos().GetByName(ctx, repo)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	linked, err := linkedPool(dir)
+	if err != nil {
+		return err
+	}
+	upstream, ok := forkUpstream(r)
+	if !ok {
+		if linked != "" && r.Private {
+			// 🚨 SECURITY: repo was made private since it was linked. Its new
+			// objects must not end up in the pool, so it stops borrowing
+			// objects from it.
+			logger.Info("unlinking private repository from object pool", log.String("pool", string(linked)))
+			return unlinkFromPool(ctx, linked, repo, dir)
+		}
+		return nil
+	}
+
+	pool := fs.PoolDir(upstream)
+	if linked != "" && linked != pool {
+		// The upstream changed since repo was linked. Moving repo to another
+		// pool would require copying all borrowed objects, so we keep it in
+		// the old pool, where its refs are still synced.
+		logger.Debug("fork is linked to another pool", log.String("pool", string(linked)))
+		pool = linked
+	}
+
+	return linkToPool(ctx, pool, repo, dir)
+}
+
+// linkToPool syncs the refs of repo into pool and makes dir borrow objects
+// from pool, if it doesn't do so already.
+func linkToPool(ctx context.Context, pool common.GitDir, repo api.RepoName, dir common.GitDir) error {
+	if err := ensurePool(ctx, pool); err != nil {
+		return errors.Wrap(err, "creating object pool")
+	}
+	if err := syncPoolMember(ctx, pool, repo, dir); err != nil {
+		return errors.Wrap(err, "syncing refs into object pool")
+	}
+
+	linked, err := linkedPool(dir)
+	if err != nil || linked == pool {
+		return err
+	}
+
+	alternates := dir.Path("objects", "info", "alternates")
+	if err := os.MkdirAll(filepath.Dir(alternates), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(alternates, []byte(pool.Path("objects")+"\n"), 0o644); err != nil {
+		return err
+	}
+	// The janitor might have removed the pool while we were syncing. Don't
+	// leave dir pointing to a pool that doesn't exist.
+	if _, err := os.Stat(pool.Path("HEAD")); err != nil {
+		_ = os.Remove(alternates)
+		return errors.Wrap(err, "object pool removed while linking")
+	}
+
+	// Drop all objects from dir that are in the pool now. --local leaves out
+	// objects found in alternates. Bitmaps cannot be written for packs which
+	// don't contain all reachable objects.
+	return runGit(ctx, dir, "-c", "repack.writeBitmaps=false", "repack", "-a", "-d", "-l", "-q")
+}
+
+// unlinkFromPool copies the objects dir borrows from pool into dir, so that it
+// doesn't borrow objects anymore, and removes repo from the members of pool.
+// Objects repo added to the pool stay in it until they are pruned, they were
+// public when they were added.
+func unlinkFromPool(ctx context.Context, pool common.GitDir, repo api.RepoName, dir common.GitDir) error {
+	// Without --local, repack also packs the objects found in alternates.
+	if err := runGit(ctx, dir, "repack", "-a", "-d", "-q"); err != nil {
+		return errors.Wrap(err, "copying objects from object pool")
+	}
+	if err := os.Remove(dir.Path("objects", "info", "alternates")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return removePoolMember(ctx, pool, poolMemberID(repo))
+}
+
+// ensurePool creates the bare repository for pool if it doesn't exist yet.
+func ensurePool(ctx context.Context, pool common.GitDir) error {
+	if _, err := os.Stat(pool.Path("HEAD")); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(pool.Path(poolMembersDir), os.ModePerm); err != nil {
+		return err
+	}
+	// git init is safe to run concurrently and on existing repositories.
+	if err := runGit(ctx, pool, "init", "--bare", "--quiet", pool.Path()); err != nil {
+		return err
+	}
+	// The janitor maintains pools, git must never gc them on its own.
+	return runGit(ctx, pool, "config", "gc.auto", "0")
+}
+
+// syncPoolMember fetches all refs of repo from dir into its namespace in pool
+// and records repo as a member of pool.
+func syncPoolMember(ctx context.Context, pool common.GitDir, repo api.RepoName, dir common.GitDir) error {
+	id := poolMemberID(repo)
+	// Record the member first, so that the janitor never considers the
+	// objects we are about to fetch unused.
+	if err := os.MkdirAll(pool.Path(poolMembersDir), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(pool.Path(poolMembersDir, id), []byte(repo), 0o644); err != nil {
+		return err
+	}
+
+	return runGit(ctx, pool, "fetch", "--quiet", "--prune", "--no-tags", "--no-write-fetch-head", dir.Path(), "+refs/*:refs/members/"+id+"/*")
+}
+
+// removePoolMember deletes the refs of the member with the given id from
+// pool, which makes the objects only it referenced unreachable in the pool.
+func removePoolMember(ctx context.Context, pool common.GitDir, id string) error {
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--format=delete %(refname)", "refs/members/"+id+"/")
+	pool.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return executil.WrapCmdError(cmd, err)
+	}
+	if len(out) > 0 {
+		cmd = exec.CommandContext(ctx, "git", "update-ref", "--stdin")
+		pool.Set(cmd)
+		cmd.Stdin = bytes.NewReader(out)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return errors.Wrapf(executil.WrapCmdError(cmd, err), "output: %s", out)
+		}
+	}
+	return os.Remove(pool.Path(poolMembersDir, id))
+}
+
+// poolMemberID returns the namespace of the refs of repo in its pool.
+func poolMemberID(repo api.RepoName) string {
+	sum := sha256.Sum256([]byte(repo))
+	return hex.EncodeToString(sum[:16])
+}
+
+// linkedPool returns the pool dir borrows objects from, or "" if dir doesn't
+// borrow objects.
+func linkedPool(dir common.GitDir) (common.GitDir, error) {
+	b, err := os.ReadFile(dir.Path("objects", "info", "alternates"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	objects, _, _ := strings.Cut(strings.TrimSpace(string(b)), "\n")
+	if objects == "" {
+		return "", nil
+	}
+	return common.GitDir(filepath.Dir(objects)), nil
+}
+
+// hasAlternates returns true if dir borrows objects from another repository.
+func hasAlternates(dir common.GitDir) bool {
+	fi, err := os.Stat(dir.Path("objects", "info", "alternates"))
+	return err == nil && fi.Size() > 0
+}
+
+// cleanupPools maintains the object pools on disk. It drops members that no
+// longer borrow objects from a pool, removes pools without members and
+// repacks the others.
+//
+// Members are only dropped once they have not been synced for a while, so
+// that repositories which are being cloned into a temporary directory and
+// linked right now are not dropped.
+func cleanupPools(ctx context.Context, logger log.Logger, fs gitserverfs.FS) {
+	logger = logger.Scoped("pools")
+	grace := 2 * conf.GitLongCommandTimeout()
+
+	var pools, members int
+	err := fs.ForEachPool(func(pool common.GitDir) (done bool) {
+		if ctx.Err() != nil {
+			return true
+		}
+
+		n, err := cleanupPool(ctx, logger, fs, pool, grace)
+		if err != nil {
+			logger.Error("failed to clean up object pool", log.String("pool", string(pool)), log.Error(err))
+		}
+		if n > 0 {
+			pools++
+			members += n
+		}
+		return false
+	})
+	if err != nil {
+		logger.Error("error iterating over object pools", log.Error(err))
+	}
+
+	forkPoolsTotal.Set(float64(pools))
+	forkPoolMembersTotal.Set(float64(members))
+}
+
+// cleanupPool maintains a single pool and returns the number of its members.
+func cleanupPool(ctx context.Context, logger log.Logger, fs gitserverfs.FS, pool common.GitDir, grace time.Duration) (int, error) {
+	entries, err := os.ReadDir(pool.Path(poolMembersDir))
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	members := map[api.RepoName]common.GitDir{}
+	for _, e := range entries {
+		fi, err := e.Info()
+		if err != nil {
+			return 0, err
+		}
+		name, err := os.ReadFile(pool.Path(poolMembersDir, e.Name()))
+		if err != nil {
+			return 0, err
+		}
+		repo := api.RepoName(name)
+		dir := fs.RepoDir(repo)
+
+		if linked, err := linkedPool(dir); err == nil && linked == pool {
+			members[repo] = dir
+			continue
+		}
+
+		if time.Since(fi.ModTime()) < grace {
+			// The member might be in the middle of being linked.
+			members[repo] = ""
+			continue
+		}
+
+		logger.Info("removing object pool member", log.String("pool", string(pool)), log.String("repo", string(repo)))
+		if err := removePoolMember(ctx, pool, e.Name()); err != nil {
+			return len(members), errors.Wrapf(err, "removing member %s", repo)
+		}
+	}
+
+	if len(members) == 0 {
+		fi, err := os.Stat(pool.Path(poolMembersDir))
+		if err == nil && time.Since(fi.ModTime()) < grace {
+			return 0, nil
+		}
+		logger.Info("removing object pool without members", log.String("pool", string(pool)))
+		if err := os.RemoveAll(filepath.Dir(pool.Path())); err != nil {
+			return 0, err
+		}
+		forkPoolsRemoved.Inc()
+		return 0, nil
+	}
+
+	return len(members), maintainPool(ctx, logger, pool, members)
+}
+
+// maintainPool repacks pool if needed. Unlike sg maintenance, it keeps
+// unreachable objects for poolPruneExpiry.
+func maintainPool(ctx context.Context, logger log.Logger, pool common.GitDir, members map[api.RepoName]common.GitDir) error {
+	needed, _, err := needsMaintenance(pool)
+	if err != nil || !needed {
+		return err
+	}
+
+	err, unlock := lockRepoForGC(pool)
+	if err != nil {
+		logger.Debug("could not lock object pool for maintenance", log.String("pool", string(pool)), log.Error(err))
+		return nil
+	}
+	defer func() { _ = unlock() }()
+
+	// Bring the refs of all members up to date first, so that objects which
+	// members started to reference since their last sync are reachable.
+	for repo, dir := range members {
+		if dir == "" {
+			continue
+		}
+		if err := syncPoolMember(ctx, pool, repo, dir); err != nil {
+			return errors.Wrapf(err, "syncing member %s", repo)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"pack-refs", "--all", "--prune"},
+		{"repack", "-d", "-A", "--write-bitmap-index", "--window-memory", "100m", "--unpack-unreachable=" + poolPruneExpiry},
+		{"prune", "--expire", poolPruneExpiry},
+		{"commit-graph", "write", "--reachable", "--changed-paths"},
+	} {
+		if err := runGit(ctx, pool, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runGit(ctx context.Context, dir common.GitDir, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	dir.Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(executil.WrapCmdError(cmd, err), "output: %s", out)
+	}
+	return nil
+}
+
+// runGitOutput is like runGit, but returns the stdout of git.
+func runGitOutput(ctx context.Context, dir common.GitDir, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	dir.Set(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(executil.WrapCmdError(cmd, err), "output: %s", stderr.String())
+	}
+	return out, nil
+}
@@ -0,0 +1,272 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/pagure"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestForkUpstream(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		repo     *types.Repo
+		upstream api.RepoName
+	}{
+		{
+			name: "github",
+			repo: &types.Repo{
+				Fork:         true,
+				ExternalRepo: api.ExternalRepoSpec{ServiceID: "https://github.com/"},
+				Metadata:     &github.Repository{Parent: &github.ParentRepository{NameWithOwner: "Sourcegraph/Sourcegraph"}},
+			},
+			upstream: "github.com/sourcegraph/sourcegraph",
+		},
+		{
+			name: "github without parent",
+			repo: &types.Repo{
+				Fork:         true,
+				ExternalRepo: api.ExternalRepoSpec{ServiceID: "https://github.com/"},
+				Metadata:     &github.Repository{},
+			},
+		},
+		{
+			name: "gitlab",
+			repo: &types.Repo{
+				Fork:     true,
+				Metadata: &gitlab.Project{ForkedFromProject: &gitlab.ProjectCommon{WebURL: "https://gitlab.example.com/group/project"}},
+			},
+			upstream: "gitlab.example.com/group/project",
+		},
+		{
+			name: "bitbucket cloud",
+			repo: &types.Repo{
+				Fork:         true,
+				ExternalRepo: api.ExternalRepoSpec{ServiceID: "https://bitbucket.org/"},
+				Metadata:     &bitbucketcloud.Repo{Parent: &bitbucketcloud.Repo{FullName: "owner/repo"}},
+			},
+			upstream: "bitbucket.org/owner/repo",
+		},
+		{
+			name: "pagure",
+			repo: &types.Repo{
+				Fork:     true,
+				Metadata: &pagure.Project{Parent: &pagure.Project{FullURL: "https://pagure.io/project"}},
+			},
+			upstream: "pagure.io/project",
+		},
+		{
+			name: "private fork",
+			repo: &types.Repo{
+				Fork:         true,
+				Private:      true,
+				ExternalRepo: api.ExternalRepoSpec{ServiceID: "https://github.com/"},
+				Metadata:     &github.Repository{Parent: &github.ParentRepository{NameWithOwner: "sourcegraph/sourcegraph"}},
+			},
+		},
+		{
+			name: "not a fork",
+			repo: &types.Repo{
+				ExternalRepo: api.ExternalRepoSpec{ServiceID: "https://github.com/"},
+				Metadata:     &github.Repository{Parent: &github.ParentRepository{NameWithOwner: "sourcegraph/sourcegraph"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			upstream, ok := forkUpstream(tc.repo)
+			require.Equal(t, tc.upstream != "", ok)
+			require.Equal(t, tc.upstream, upstream)
+		})
+	}
+}
+
+func TestForkPools(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+
+	remote := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	makeSingleCommitRepo(cmd)
+
+	root := t.TempDir()
+	fs := gitserverfs.New(observation.TestContextTB(t), root)
+	require.NoError(t, fs.Initialize())
+
+	forkA, forkB := api.RepoName("github.com/a/fork"), api.RepoName("github.com/b/fork")
+	for _, fork := range []api.RepoName{forkA, forkB} {
+		runCmd(t, root, "git", "clone", "--bare", remote, fs.RepoDir(fork).Path())
+	}
+	// forkB has a commit of its own.
+	cmd("sh", "-c", "echo fork > hello.txt")
+	ownCommit := strings.TrimSpace(addCommitToRepo(cmd))
+	runCmd(t, root, "git", "--git-dir", fs.RepoDir(forkB).Path(), "fetch", remote, "+refs/heads/*:refs/heads/*")
+
+	pool := fs.PoolDir("github.com/upstream/repo")
+	for _, fork := range []api.RepoName{forkA, forkB} {
+		dir := fs.RepoDir(fork)
+		require.NoError(t, linkToPool(ctx, pool, fork, dir))
+		// Linking again is a no-op.
+		require.NoError(t, linkToPool(ctx, pool, fork, dir))
+
+		linked, err := linkedPool(dir)
+		require.NoError(t, err)
+		require.Equal(t, pool, linked)
+		requireConnected(t, root, dir)
+	}
+
+	// The objects of the forks have moved into the pool.
+	require.Equal(t, "0", countObjects(t, root, fs.RepoDir(forkA))["in-pack"])
+	require.Equal(t, "0", countObjects(t, root, fs.RepoDir(forkB))["in-pack"])
+	runCmd(t, root, "git", "--git-dir", fs.RepoDir(forkB).Path(), "cat-file", "-e", ownCommit)
+
+	// The pool is repacked, but keeps everything its members need.
+	n, err := cleanupPool(ctx, logger, fs, pool, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	hasBm, err := hasBitmap(pool)
+	require.NoError(t, err)
+	require.True(t, hasBm)
+	requireConnected(t, root, fs.RepoDir(forkA))
+	requireConnected(t, root, fs.RepoDir(forkB))
+
+	// sg maintenance never drops objects the forks borrow from the pool.
+	for _, fork := range []api.RepoName{forkA, forkB} {
+		require.NoError(t, sgMaintenance(logger, fs.RepoDir(fork)))
+		requireConnected(t, root, fs.RepoDir(fork))
+		needed, _, err := needsMaintenance(fs.RepoDir(fork))
+		require.NoError(t, err)
+		require.False(t, needed)
+	}
+
+	// Removed repositories are dropped from the pool.
+	require.NoError(t, fs.RemoveRepo(forkB))
+	n, err = cleanupPool(ctx, logger, fs, pool, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	refs := runCmd(t, root, "git", "--git-dir", pool.Path(), "for-each-ref", "--format=%(refname)")
+	require.NotContains(t, refs, "refs/members/"+poolMemberID(forkB)+"/")
+	require.Contains(t, refs, "refs/members/"+poolMemberID(forkA)+"/")
+	requireConnected(t, root, fs.RepoDir(forkA))
+
+	// Pools without members are removed.
+	require.NoError(t, fs.RemoveRepo(forkA))
+	n, err = cleanupPool(ctx, logger, fs, pool, 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	_, err = os.Stat(pool.Path())
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestLinkForkPool_PrivateFork(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+
+	old := enableForkPools
+	enableForkPools = true
+	t.Cleanup(func() { enableForkPools = old })
+
+	remote := t.TempDir()
+	makeSingleCommitRepo(func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	})
+
+	root := t.TempDir()
+	fs := gitserverfs.New(observation.TestContextTB(t), root)
+	require.NoError(t, fs.Initialize())
+
+	fork := api.RepoName("github.com/a/fork")
+	dir := fs.RepoDir(fork)
+	runCmd(t, root, "git", "clone", "--bare", remote, dir.Path())
+
+	repo := &types.Repo{
+		Name:         fork,
+		Fork:         true,
+		Private:      true,
+		ExternalRepo: api.ExternalRepoSpec{ServiceID: "https://github.com/"},
+		Metadata:     &github.Repository{Parent: &github.ParentRepository{NameWithOwner: "upstream/repo"}},
+	}
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetByNameFunc.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return repo, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+	pool := fs.PoolDir("github.com/upstream/repo")
+
+	// Private forks are not linked.
+	require.NoError(t, linkForkPool(ctx, logger, fs, db, fork, dir))
+	linked, err := linkedPool(dir)
+	require.NoError(t, err)
+	require.Empty(t, linked)
+	_, err = os.Stat(pool.Path())
+	require.True(t, os.IsNotExist(err))
+
+	// Public forks are linked.
+	repo.Private = false
+	require.NoError(t, linkForkPool(ctx, logger, fs, db, fork, dir))
+	linked, err = linkedPool(dir)
+	require.NoError(t, err)
+	require.Equal(t, pool, linked)
+
+	// Forks that are made private are unlinked, but keep all their objects.
+	repo.Private = true
+	require.NoError(t, linkForkPool(ctx, logger, fs, db, fork, dir))
+	linked, err = linkedPool(dir)
+	require.NoError(t, err)
+	require.Empty(t, linked)
+	requireConnected(t, root, dir)
+	refs := runCmd(t, root, "git", "--git-dir", pool.Path(), "for-each-ref", "--format=%(refname)")
+	require.NotContains(t, refs, "refs/members/"+poolMemberID(fork)+"/")
+}
+
+func TestForkPools_KeepsRecentMembers(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	fs := gitserverfs.New(observation.TestContextTB(t), root)
+
+	pool := fs.PoolDir("github.com/upstream/repo")
+	require.NoError(t, ensurePool(ctx, pool))
+	// A member that is being cloned into a temporary directory right now.
+	require.NoError(t, os.WriteFile(pool.Path(poolMembersDir, poolMemberID("github.com/a/fork")), []byte("github.com/a/fork"), 0o644))
+
+	n, err := cleanupPool(ctx, logtest.Scoped(t), fs, pool, conf.GitLongCommandTimeout())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	_, err = os.Stat(pool.Path(poolMembersDir, poolMemberID("github.com/a/fork")))
+	require.NoError(t, err)
+}
+
+func requireConnected(t *testing.T, root string, dir common.GitDir) {
+	t.Helper()
+	runCmd(t, root, "git", "--git-dir", dir.Path(), "fsck", "--connectivity-only", "--no-dangling")
+}
+
+func countObjects(t *testing.T, root string, dir common.GitDir) map[string]string {
+	t.Helper()
+	counts := map[string]string{}
+	for _, line := range strings.Split(runCmd(t, root, "git", "--git-dir", dir.Path(), "count-objects", "-v"), "\n") {
+		if k, v, ok := strings.Cut(line, ": "); ok {
+			counts[k] = v
+		}
+	}
+	return counts
+}
@@ -6,8 +6,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
@@ -19,6 +21,14 @@ func (g *gitCLIBackend) Blame(ctx context.Context, startCommit api.CommitID, pat
 	if err := checkSpecArgSafety(string(startCommit)); err != nil {
 		return nil, err
 	}
+	if err := checkSpecArgSafety(string(opt.OldestCommit)); err != nil {
+		return nil, err
+	}
+	for _, rev := range opt.IgnoreRevs {
+		if err := checkSpecArgSafety(rev); err != nil {
+			return nil, err
+		}
+	}
 
 	// Verify that the blob exists.
 	_, err := g.getBlobOID(ctx, startCommit, path)
@@ -26,6 +36,19 @@ func (g *gitCLIBackend) Blame(ctx context.Context, startCommit api.CommitID, pat
 		return nil, err
 	}
 
+	if opt.UseIgnoreRevsFile {
+		revs, err := g.readBlameIgnoreRevs(ctx, startCommit)
+		if err != nil {
+			return nil, err
+		}
+		opt.IgnoreRevs = append(opt.IgnoreRevs, revs...)
+	}
+
+	opt.IgnoreRevs, err = g.resolveIgnoreRevs(ctx, opt.IgnoreRevs)
+	if err != nil {
+		return nil, err
+	}
+
 	r, err := g.NewCommand(ctx, WithArguments(buildBlameArgs(startCommit, path, opt)...))
 	if err != nil {
 		return nil, err
@@ -39,13 +62,107 @@ func buildBlameArgs(startCommit api.CommitID, path string, opt git.BlameOptions)
 	if opt.IgnoreWhitespace {
 		args = append(args, "-w")
 	}
+	if opt.DetectMoves {
+		args = append(args, "-M")
+	}
+	if opt.DetectCopies {
+		args = append(args, "-C")
+	}
+	for _, rev := range opt.IgnoreRevs {
+		args = append(args, "--ignore-rev="+rev)
+	}
 	if opt.Range != nil {
 		args = append(args, fmt.Sprintf("-L%d,%d", opt.Range.StartLine, opt.Range.EndLine))
 	}
-	args = append(args, string(startCommit), "--", filepath.ToSlash(path))
+	if opt.OldestCommit != "" {
+		args = append(args, string(opt.OldestCommit)+".."+string(startCommit))
+	} else {
+		args = append(args, string(startCommit))
+	}
+	args = append(args, "--", filepath.ToSlash(path))
 	return args
 }
 
+// resolveIgnoreRevs resolves revs to commit IDs and drops those that don't
+// exist in the repository. git blame aborts on unknown revisions passed with
+// --ignore-rev, but .git-blame-ignore-revs files commonly list commits that
+// only exist in other branches or forks.
+func (g *gitCLIBackend) resolveIgnoreRevs(ctx context.Context, revs []string) ([]string, error) {
+	if len(revs) == 0 {
+		return nil, nil
+	}
+
+	var stdin strings.Builder
+	for _, rev := range revs {
+		if strings.ContainsAny(rev, "\r\n") {
+			continue
+		}
+		stdin.WriteString(rev + "^{commit}\n")
+	}
+
+	r, err := g.NewCommand(ctx,
+		WithArguments("cat-file", "--batch-check=%(objectname)"),
+		WithStdin(strings.NewReader(stdin.String())),
+		// Unknown commits must not be fetched from the promisor remote of
+		// partial clones.
+		WithEnv("GIT_NO_LAZY_FETCH=1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// Unknown revisions are printed as "<rev> missing", all others as the ID
+	// of the commit they resolve to.
+	var commits []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		if line := sc.Text(); gitdomain.IsAbsoluteRevision(line) {
+			commits = append(commits, line)
+		}
+	}
+	return commits, sc.Err()
+}
+
+// readBlameIgnoreRevs returns the revisions listed in the .git-blame-ignore-revs
+// file at the given commit. If the file doesn't exist, no revisions are
+// returned.
+func (g *gitCLIBackend) readBlameIgnoreRevs(ctx context.Context, commit api.CommitID) ([]string, error) {
+	blobOID, err := g.getBlobOID(ctx, commit, git.BlameIgnoreRevsFile)
+	if err != nil {
+		if os.IsNotExist(err) || err == errIsSubmodule {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("cat-file", "-p", string(blobOID)))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return parseBlameIgnoreRevs(r)
+}
+
+// parseBlameIgnoreRevs parses the contents of a .git-blame-ignore-revs file.
+// Empty lines and comments are skipped. Git only accepts unabbreviated object
+// names in this file, so anything else is skipped as well, rather than failing
+// the whole blame.
+func parseBlameIgnoreRevs(r io.Reader) ([]string, error) {
+	var revs []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		line = strings.TrimSpace(line)
+		if !gitdomain.IsAbsoluteRevision(line) {
+			continue
+		}
+		revs = append(revs, line)
+	}
+	return revs, sc.Err()
+}
+
 // blameHunkReader enables to read hunks from an io.Reader.
 type blameHunkReader struct {
 	rc io.ReadCloser
@@ -141,6 +141,98 @@ func TestGitCLIBackend_Blame(t *testing.T) {
 	})
 }
 
+func TestGitCLIBackend_Blame_Options(t *testing.T) {
+	// Prepare repo state:
+	backend := BackendWithRepoCommands(t,
+		"echo 'hello\nworld\n' > foo.txt",
+		"git add foo.txt",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		// Reformat the file in a second commit.
+		"echo 'Hello\nworld\n' > foo.txt",
+		"git add foo.txt",
+		"git commit -m reformat --author='Bar Author <bar@sourcegraph.com>'",
+		// And ignore that commit in blame.
+		"echo '# Reformatting' > .git-blame-ignore-revs",
+		"git rev-parse HEAD >> .git-blame-ignore-revs",
+		// Commits from other branches or forks are commonly listed as well.
+		"echo 1234567890123456789012345678901234567890 >> .git-blame-ignore-revs",
+		"git add .git-blame-ignore-revs",
+		"git commit -m ignore --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	ctx := context.Background()
+
+	commit, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	reformat, err := backend.ResolveRevision(ctx, "HEAD~1")
+	require.NoError(t, err)
+
+	// blameMessages returns the commit message each line is attributed to.
+	blameMessages := func(t *testing.T, opt git.BlameOptions) map[uint32]string {
+		hr, err := backend.Blame(ctx, commit, "foo.txt", opt)
+		require.NoError(t, err)
+		t.Cleanup(func() { hr.Close() })
+
+		messages := make(map[uint32]string)
+		for {
+			h, err := hr.Read()
+			if err == io.EOF {
+				return messages
+			}
+			require.NoError(t, err)
+			for line := h.StartLine; line < h.EndLine; line++ {
+				messages[line] = h.Message
+			}
+		}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		require.Equal(t, map[uint32]string{1: "reformat", 2: "foo", 3: "foo"}, blameMessages(t, git.BlameOptions{}))
+	})
+
+	t.Run("ignore revs", func(t *testing.T) {
+		got := blameMessages(t, git.BlameOptions{IgnoreRevs: []string{string(reformat)}})
+		require.Equal(t, map[uint32]string{1: "foo", 2: "foo", 3: "foo"}, got)
+	})
+
+	t.Run("unknown ignore revs", func(t *testing.T) {
+		got := blameMessages(t, git.BlameOptions{IgnoreRevs: []string{"1234567890123456789012345678901234567890", "notfound", string(reformat)}})
+		require.Equal(t, map[uint32]string{1: "foo", 2: "foo", 3: "foo"}, got)
+	})
+
+	t.Run("ignore revs file", func(t *testing.T) {
+		got := blameMessages(t, git.BlameOptions{UseIgnoreRevsFile: true})
+		require.Equal(t, map[uint32]string{1: "foo", 2: "foo", 3: "foo"}, got)
+	})
+
+	t.Run("oldest commit", func(t *testing.T) {
+		got := blameMessages(t, git.BlameOptions{OldestCommit: reformat})
+		require.Equal(t, map[uint32]string{1: "reformat", 2: "reformat", 3: "reformat"}, got)
+	})
+
+	t.Run("bad ignore rev", func(t *testing.T) {
+		_, err := backend.Blame(ctx, commit, "foo.txt", git.BlameOptions{IgnoreRevs: []string{"-very badarg"}})
+		require.Error(t, err)
+	})
+}
+
+func TestParseBlameIgnoreRevs(t *testing.T) {
+	input := `# Reformat the codebase
+e3889dff4263a2273459471739aafabc10269885
+
+53e63d6dd6e61a58369bbc637b0ead2ee58d993c # Upgrade linter
+53e63d6
+not a revision
+`
+	revs, err := parseBlameIgnoreRevs(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"e3889dff4263a2273459471739aafabc10269885",
+		"53e63d6dd6e61a58369bbc637b0ead2ee58d993c",
+	}, revs)
+}
+
 func TestBuildBlameArgs(t *testing.T) {
 	commit := "deadbeef"
 	path := "foo.txt"
@@ -171,6 +263,33 @@ func TestBuildBlameArgs(t *testing.T) {
 			t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, want)
 		}
 	})
+
+	t.Run("with move and copy detection", func(t *testing.T) {
+		want := []string{"blame", "--porcelain", "--incremental", "-M", "-C", commit, "--", "foo.txt"}
+		opt := git.BlameOptions{DetectMoves: true, DetectCopies: true}
+		got := buildBlameArgs(api.CommitID(commit), path, opt)
+		if !equalSlice(got, want) {
+			t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, want)
+		}
+	})
+
+	t.Run("with ignore revs", func(t *testing.T) {
+		want := []string{"blame", "--porcelain", "--incremental", "--ignore-rev=abc", "--ignore-rev=def", commit, "--", "foo.txt"}
+		opt := git.BlameOptions{IgnoreRevs: []string{"abc", "def"}}
+		got := buildBlameArgs(api.CommitID(commit), path, opt)
+		if !equalSlice(got, want) {
+			t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, want)
+		}
+	})
+
+	t.Run("with oldest commit", func(t *testing.T) {
+		want := []string{"blame", "--porcelain", "--incremental", "cafebabe.." + commit, "--", "foo.txt"}
+		opt := git.BlameOptions{OldestCommit: "cafebabe"}
+		got := buildBlameArgs(api.CommitID(commit), path, opt)
+		if !equalSlice(got, want) {
+			t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, want)
+		}
+	})
 }
 
 func equalSlice(a, b []string) bool {
@@ -54,6 +54,8 @@ type commandOpts struct {
 	arguments []string
 
 	stdin io.Reader
+
+	env []string
 }
 
 func optsFromFuncs(optFns ...CommandOptionFunc) commandOpts {
@@ -80,6 +82,14 @@ func WithStdin(stdin io.Reader) CommandOptionFunc {
 	}
 }
 
+// WithEnv appends the given environment variables to the environment of the
+// command.
+func WithEnv(env ...string) CommandOptionFunc {
+	return func(o *commandOpts) {
+		o.env = env
+	}
+}
+
 const gitCommandDefaultTimeout = time.Minute
 
 func (g *gitCLIBackend) NewCommand(ctx context.Context, optFns ...CommandOptionFunc) (_ io.ReadCloser, err error) {
@@ -123,6 +133,7 @@ func (g *gitCLIBackend) NewCommand(ctx context.Context, optFns ...CommandOptionF
 		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
 	}
 	g.dir.Set(cmd)
+	cmd.Env = append(cmd.Env, opts.env...)
 
 	if cmd.SysProcAttr == nil {
 		cmd.SysProcAttr = &syscall.SysProcAttr{}
@@ -0,0 +1,154 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) CommitGraph(ctx context.Context, opt git.CommitGraphOpts) (git.CommitGraphIterator, error) {
+	for _, r := range opt.Ranges {
+		if err := checkSpecArgSafety(r); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(opt.Ranges) > 0 && opt.AllRefs {
+		return nil, errors.New("cannot specify both a Range and AllRefs")
+	}
+	if len(opt.Ranges) == 0 && !opt.AllRefs {
+		return nil, errors.New("must specify a Range or AllRefs")
+	}
+
+	args, err := buildCommitGraphArgs(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+
+	return newCommitGraphIterator(g.repoName, strings.Join(opt.Ranges, " "), r), nil
+}
+
+func buildCommitGraphArgs(opt git.CommitGraphOpts) ([]string, error) {
+	// --timestamp prints the committer date as a unix timestamp in front of every
+	// line, --parents prints the parents after the commit ID:
+	// <timestamp> <commit> [<parent>...]
+	args := []string{"rev-list", "--parents", "--timestamp"}
+
+	if !opt.After.IsZero() {
+		args = append(args, "--after="+opt.After.Format(time.RFC3339))
+	}
+	switch opt.Order {
+	case git.CommitLogOrderCommitDate:
+		args = append(args, "--date-order")
+	case git.CommitLogOrderTopoDate:
+		args = append(args, "--topo-order")
+	case git.CommitLogOrderDefault:
+		// nothing to do
+	default:
+		return nil, errors.Newf("invalid ordering %d", opt.Order)
+	}
+
+	if opt.AllRefs {
+		args = append(args, "--all")
+	}
+
+	args = append(args, opt.Ranges...)
+
+	return append(args, "--"), nil
+}
+
+func newCommitGraphIterator(repoName api.RepoName, spec string, r io.ReadCloser) *commitGraphIterator {
+	return &commitGraphIterator{
+		Closer:   r,
+		repoName: repoName,
+		spec:     spec,
+		sc:       bufio.NewScanner(r),
+	}
+}
+
+type commitGraphIterator struct {
+	io.Closer
+	repoName api.RepoName
+	spec     string
+	sc       *bufio.Scanner
+}
+
+func (it *commitGraphIterator) Next() (*gitdomain.CommitGraphNode, error) {
+	if !it.sc.Scan() {
+		if err := it.sc.Err(); err != nil {
+			return nil, translateRevisionError(err, it.repoName, it.spec)
+		}
+		return nil, io.EOF
+	}
+
+	return parseCommitGraphLine(it.sc.Bytes())
+}
+
+func (it *commitGraphIterator) Close() error {
+	if err := it.Closer.Close(); err != nil {
+		if err := translateRevisionError(err, it.repoName, it.spec); err != io.EOF {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseCommitGraphLine parses a line of the form
+// <timestamp> <commit> [<parent>...]
+// as printed by git rev-list --parents --timestamp.
+func parseCommitGraphLine(line []byte) (*gitdomain.CommitGraphNode, error) {
+	fields := bytes.Fields(line)
+	if len(fields) < 2 {
+		return nil, errors.Errorf("invalid rev-list output line %q", line)
+	}
+
+	ts, err := strconv.ParseInt(string(fields[0]), 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid commit timestamp in rev-list output line %q", line)
+	}
+
+	id, err := parseOID(fields[1])
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make([]gitdomain.OID, len(fields)-2)
+	for i, field := range fields[2:] {
+		parents[i], err = parseOID(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &gitdomain.CommitGraphNode{
+		ID:            id,
+		Parents:       parents,
+		CommitterDate: time.Unix(ts, 0).UTC(),
+	}, nil
+}
+
+func parseOID(s []byte) (gitdomain.OID, error) {
+	var oid gitdomain.OID
+	if len(s) != hex.EncodedLen(len(oid)) {
+		return oid, errors.Errorf("invalid object ID %q", s)
+	}
+	if _, err := hex.Decode(oid[:], s); err != nil {
+		return oid, errors.Wrapf(err, "invalid object ID %q", s)
+	}
+	return oid, nil
+}
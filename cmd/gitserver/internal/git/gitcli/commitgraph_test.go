@@ -0,0 +1,178 @@
+package gitcli
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_CommitGraph(t *testing.T) {
+	ctx := context.Background()
+
+	// Prepare repo state:
+	// 	 * 8f0d2d6 (master) merge
+	// 	 |\
+	// 	 | * 6f6a8e7 (feature) feature
+	// 	 * | 2b22897 bar
+	// 	 |/
+	// 	 * 5fab3ad (tag: testbase) foo
+	backend := BackendWithRepoCommands(t,
+		"echo Hello > f",
+		"git add f",
+		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m foo --author='Foo Author <foo@sourcegraph.com>' --date 2006-01-02T15:04:05Z",
+		"git tag testbase",
+		"git checkout -b feature",
+		"echo Feature > f3",
+		"git add f3",
+		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:06Z git commit -m feature --author='Foo Author <foo@sourcegraph.com>' --date 2006-01-02T15:04:06Z",
+		"git checkout master",
+		"echo World > f2",
+		"git add f2",
+		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit -m bar --author='Bar Author <bar@sourcegraph.com>' --date 2006-01-02T15:04:06Z",
+		"GIT_AUTHOR_NAME='Bar Author' GIT_AUTHOR_EMAIL=bar@sourcegraph.com GIT_AUTHOR_DATE=2006-01-02T15:04:08Z GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:08Z git merge --no-ff -m merge feature",
+	)
+
+	oids := func(t *testing.T, ids ...string) []gitdomain.OID {
+		res := make([]gitdomain.OID, 0, len(ids))
+		for _, id := range ids {
+			b, err := hex.DecodeString(id)
+			require.NoError(t, err)
+			var oid gitdomain.OID
+			copy(oid[:], b)
+			res = append(res, oid)
+		}
+		return res
+	}
+
+	it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{Ranges: []string{"HEAD"}})
+	require.NoError(t, err)
+	headCommits := readCommitGraph(t, it)
+	require.NoError(t, it.Close())
+	require.Len(t, headCommits, 4)
+	merge, bar, feature, foo := headCommits[0], headCommits[1], headCommits[2], headCommits[3]
+
+	t.Run("parents and dates", func(t *testing.T) {
+		require.Equal(t, oids(t, "5fab3adc1e398e749749271d14ab843759b192cf")[0], foo.ID)
+		require.Equal(t, oids(t, "2b2289762392764ed127587b0d5fd88a2f16b7c1")[0], bar.ID)
+		require.Equal(t, []gitdomain.OID{}, foo.Parents)
+		require.Equal(t, []gitdomain.OID{foo.ID}, bar.Parents)
+		require.Equal(t, []gitdomain.OID{foo.ID}, feature.Parents)
+		require.Equal(t, []gitdomain.OID{bar.ID, feature.ID}, merge.Parents)
+
+		require.Equal(t, mustParseTime(time.RFC3339, "2006-01-02T15:04:05Z"), foo.CommitterDate)
+		require.Equal(t, mustParseTime(time.RFC3339, "2006-01-02T15:04:08Z"), merge.CommitterDate)
+	})
+
+	t.Run("commit range", func(t *testing.T) {
+		it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{Ranges: []string{"testbase..feature"}})
+		require.NoError(t, err)
+		got := readCommitGraph(t, it)
+		require.NoError(t, it.Close())
+		require.Equal(t, []*gitdomain.CommitGraphNode{feature}, got)
+	})
+
+	t.Run("all refs", func(t *testing.T) {
+		it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{AllRefs: true})
+		require.NoError(t, err)
+		got := readCommitGraph(t, it)
+		require.NoError(t, it.Close())
+		require.ElementsMatch(t, headCommits, got)
+	})
+
+	t.Run("topo order", func(t *testing.T) {
+		it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{
+			Ranges: []string{"HEAD"},
+			Order:  git.CommitLogOrderTopoDate,
+		})
+		require.NoError(t, err)
+		got := readCommitGraph(t, it)
+		require.NoError(t, it.Close())
+		// With topo order, the commits of a line of history are not intermixed.
+		require.Equal(t, []*gitdomain.CommitGraphNode{merge, feature, bar, foo}, got)
+	})
+
+	t.Run("after", func(t *testing.T) {
+		it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{
+			Ranges: []string{"HEAD"},
+			After:  mustParseTime(time.RFC3339, "2006-01-02T15:04:07Z"),
+		})
+		require.NoError(t, err)
+		got := readCommitGraph(t, it)
+		require.NoError(t, it.Close())
+		require.Equal(t, []*gitdomain.CommitGraphNode{merge, bar}, got)
+	})
+
+	t.Run("empty repo", func(t *testing.T) {
+		backend := BackendWithRepoCommands(t)
+		it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{
+			Ranges: []string{"HEAD"},
+		})
+		require.NoError(t, err)
+		_, err = it.Next()
+		require.True(t, errors.Is(err, io.EOF))
+		require.NoError(t, it.Close())
+	})
+
+	t.Run("commit doesn't exist", func(t *testing.T) {
+		it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{
+			Ranges: []string{"deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"},
+		})
+		require.NoError(t, err)
+		_, err = it.Next()
+		require.True(t, errors.HasType[*gitdomain.RevisionNotFoundError](err))
+		_ = it.Close()
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := backend.CommitGraph(ctx, git.CommitGraphOpts{})
+		require.Error(t, err)
+		_, err = backend.CommitGraph(ctx, git.CommitGraphOpts{Ranges: []string{"HEAD"}, AllRefs: true})
+		require.Error(t, err)
+		_, err = backend.CommitGraph(ctx, git.CommitGraphOpts{Ranges: []string{"--all"}})
+		require.Error(t, err)
+	})
+}
+
+func readCommitGraph(t *testing.T, it git.CommitGraphIterator) []*gitdomain.CommitGraphNode {
+	t.Helper()
+	nodes := []*gitdomain.CommitGraphNode{}
+	for {
+		n, err := it.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func TestParseCommitGraphLine(t *testing.T) {
+	n, err := parseCommitGraphLine([]byte("1136214245 2b2289762392764ed127587b0d5fd88a2f16b7c1 5fab3adc1e398e749749271d14ab843759b192cf"))
+	require.NoError(t, err)
+	require.Equal(t, "2b2289762392764ed127587b0d5fd88a2f16b7c1", n.ID.String())
+	require.Len(t, n.Parents, 1)
+	require.Equal(t, "5fab3adc1e398e749749271d14ab843759b192cf", n.Parents[0].String())
+	require.Equal(t, time.Unix(1136214245, 0).UTC(), n.CommitterDate)
+
+	for _, line := range []string{
+		"",
+		"1136214245",
+		"notatimestamp 2b2289762392764ed127587b0d5fd88a2f16b7c1",
+		"1136214245 2b22897",
+		"1136214245 2b2289762392764ed127587b0d5fd88a2f16b7c1 zzzz89762392764ed127587b0d5fd88a2f16b7c1",
+	} {
+		_, err := parseCommitGraphLine([]byte(line))
+		require.Error(t, err, "line %q", line)
+	}
+}
@@ -29,7 +29,7 @@ func (g *gitCLIBackend) CommitLog(ctx context.Context, opt git.CommitLogOpts) (g
 		return nil, errors.New("must specify a Range or AllRefs")
 	}
 
-	args, err := buildCommitLogArgs(opt)
+	args, err := buildCommitLogArgs(opt, git.IsPartialClone(g.dir))
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (g *gitCLIBackend) CommitLog(ctx context.Context, opt git.CommitLogOpts) (g
 	return newCommitLogIterator(g.repoName, strings.Join(opt.Ranges, " "), r), nil
 }
 
-func buildCommitLogArgs(opt git.CommitLogOpts) ([]string, error) {
+func buildCommitLogArgs(opt git.CommitLogOpts, partialClone bool) ([]string, error) {
 	args := []string{"log", logFormatWithoutRefs}
 
 	if opt.MaxCommits != 0 {
@@ -87,6 +87,9 @@ func buildCommitLogArgs(opt git.CommitLogOpts) ([]string, error) {
 
 	if opt.IncludeModifiedFiles {
 		args = append(args, "--name-only")
+		if partialClone && !opt.FollowPathRenames {
+			args = append(args, noRenamesInPartialClones)
+		}
 	}
 	if opt.FollowPathRenames {
 		args = append(args, "--follow")
@@ -128,37 +131,7 @@ type commitLogIterator struct {
 func (it *commitLogIterator) Next() (*git.GitCommitWithFiles, error) {
 	if !it.sc.Scan() {
 		if err := it.sc.Err(); err != nil {
-			// If exit code is 128 and `fatal: bad object` is part of stderr, most likely we
-			// are referencing a commit that does not exist.
-			// We want to return a gitdomain.RevisionNotFoundError in that case.
-			var e *commandFailedError
-			if errors.As(err, &e) && e.ExitStatus == 128 {
-				if (bytes.Contains(e.Stderr, []byte("fatal: your current branch")) && bytes.Contains(e.Stderr, []byte("does not have any commits yet"))) || bytes.Contains(e.Stderr, []byte("fatal: bad revision 'HEAD'")) {
-					return nil, io.EOF
-				}
-
-				// range with bad commit or bad ref on RHS: fatal: bad revision
-				// range with bad commit or bad ref on LHS: fatal: Invalid revision range
-				// 40 character commit sha: fatal: bad object
-				// unknown ref name: fatal: ambiguous argument && unknown revision or path not in the working tree.
-
-				var errMessages = []string{
-					"not a tree object",
-					"fatal: bad object",
-					"fatal: Invalid revision range",
-					"fatal: bad revision",
-				}
-				for _, message := range errMessages {
-					if bytes.Contains(e.Stderr, []byte(message)) {
-						return nil, &gitdomain.RevisionNotFoundError{Repo: it.repoName, Spec: it.spec}
-					}
-				}
-
-				if bytes.Contains(e.Stderr, []byte("fatal: ambiguous argument")) && bytes.Contains(e.Stderr, []byte("unknown revision or path not in the working tree.")) {
-					return nil, &gitdomain.RevisionNotFoundError{Repo: it.repoName, Spec: it.spec}
-				}
-			}
-			return nil, err
+			return nil, translateRevisionError(err, it.repoName, it.spec)
 		}
 		return nil, io.EOF
 	}
@@ -172,30 +145,47 @@ func (it *commitLogIterator) Next() (*git.GitCommitWithFiles, error) {
 }
 
 func (it *commitLogIterator) Close() error {
-	err := it.Closer.Close()
-	if err != nil {
-		var e *commandFailedError
-		if errors.As(err, &e) && e.ExitStatus == 128 {
-			if (bytes.Contains(e.Stderr, []byte("fatal: your current branch")) && bytes.Contains(e.Stderr, []byte("does not have any commits yet"))) || bytes.Contains(e.Stderr, []byte("fatal: bad revision 'HEAD'")) {
-				return nil
-			}
+	if err := it.Closer.Close(); err != nil {
+		if err := translateRevisionError(err, it.repoName, it.spec); err != io.EOF {
+			return err
+		}
+	}
+	return nil
+}
 
-			var errMessages = []string{
-				"not a tree object",
-				"fatal: bad object",
-				"fatal: Invalid revision range",
-				"fatal: bad revision",
-			}
-			for _, message := range errMessages {
-				if bytes.Contains(e.Stderr, []byte(message)) {
-					return &gitdomain.RevisionNotFoundError{Repo: it.repoName, Spec: it.spec}
-				}
-			}
+// translateRevisionError maps the error of a failed git log or git rev-list
+// command to a gitdomain.RevisionNotFoundError if one of the given revisions
+// doesn't exist. io.EOF is returned if the repository has no commits yet.
+func translateRevisionError(err error, repoName api.RepoName, spec string) error {
+	// If exit code is 128 and `fatal: bad object` is part of stderr, most likely we
+	// are referencing a commit that does not exist.
+	// We want to return a gitdomain.RevisionNotFoundError in that case.
+	var e *commandFailedError
+	if errors.As(err, &e) && e.ExitStatus == 128 {
+		if (bytes.Contains(e.Stderr, []byte("fatal: your current branch")) && bytes.Contains(e.Stderr, []byte("does not have any commits yet"))) || bytes.Contains(e.Stderr, []byte("fatal: bad revision 'HEAD'")) {
+			return io.EOF
+		}
+
+		// range with bad commit or bad ref on RHS: fatal: bad revision
+		// range with bad commit or bad ref on LHS: fatal: Invalid revision range
+		// 40 character commit sha: fatal: bad object
+		// unknown ref name: fatal: ambiguous argument && unknown revision or path not in the working tree.
 
-			if bytes.Contains(e.Stderr, []byte("fatal: ambiguous argument")) && bytes.Contains(e.Stderr, []byte("unknown revision or path not in the working tree.")) {
-				return &gitdomain.RevisionNotFoundError{Repo: it.repoName, Spec: it.spec}
+		var errMessages = []string{
+			"not a tree object",
+			"fatal: bad object",
+			"fatal: Invalid revision range",
+			"fatal: bad revision",
+		}
+		for _, message := range errMessages {
+			if bytes.Contains(e.Stderr, []byte(message)) {
+				return &gitdomain.RevisionNotFoundError{Repo: repoName, Spec: spec}
 			}
 		}
+
+		if bytes.Contains(e.Stderr, []byte("fatal: ambiguous argument")) && bytes.Contains(e.Stderr, []byte("unknown revision or path not in the working tree.")) {
+			return &gitdomain.RevisionNotFoundError{Repo: repoName, Spec: spec}
+		}
 	}
 	return err
 }
@@ -15,11 +15,11 @@ var (
 		"show":      append([]string{}, gitCommonAllowlist...),
 		"remote":    {"-v"},
 		"diff-tree": append([]string{"--root"}, gitCommonAllowlist...),
-		"blame":     {"--root", "--incremental", "-w", "-p", "--porcelain", "--"},
+		"blame":     {"--root", "--incremental", "-w", "-p", "--porcelain", "-M", "-C", "--ignore-rev", "--"},
 		"branch":    {"-r", "-a", "--contains", "--merged", "--format"},
 
 		"rev-parse":    {"--abbrev-ref", "--symbolic-full-name", "--glob", "--exclude"},
-		"rev-list":     {"--first-parent", "--max-parents", "--reverse", "--max-count", "--count", "--after", "--before", "--", "-n", "--date-order", "--skip", "--left-right", "--timestamp", "--all"},
+		"rev-list":     {"--first-parent", "--max-parents", "--reverse", "--max-count", "--count", "--after", "--before", "--", "-n", "--date-order", "--topo-order", "--parents", "--skip", "--left-right", "--timestamp", "--all", "--objects", "--missing", "--no-walk", "--glob", "--exclude"},
 		"ls-remote":    {"--get-url"},
 		"symbolic-ref": {"--short"},
 		"archive":      {"--worktree-attributes", "--format", "-0", "HEAD", "--"},
@@ -30,7 +30,7 @@ var (
 		"merge-base":   {"--octopus", "--"},
 		"show-ref":     {"--heads"},
 		"shortlog":     {"--summary", "--numbered", "--email", "--no-merges", "--after", "--before"},
-		"cat-file":     {"-p", "-t"},
+		"cat-file":     {"-p", "-t", "--batch-check"},
 		"lfs":          {},
 
 		// Commands used by GitConfigStore:
@@ -0,0 +1,166 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/byteutils"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) MissingObjects(ctx context.Context, opt git.MissingObjectsOpts) ([]string, error) {
+	// Only partial clones can ever miss objects, so we can skip the expensive
+	// object walk for all other repositories.
+	if !git.IsPartialClone(g.dir) {
+		return nil, nil
+	}
+
+	if len(opt.Revisions) == 0 {
+		return nil, errors.New("at least one revision must be given")
+	}
+
+	// Reading a single file is by far the most common case. Looking up that one
+	// object is much cheaper than walking the tree, and only if it is missing
+	// do we need to find out which objects to fetch.
+	if !opt.Walk && len(opt.Revisions) == 1 && len(opt.Paths) == 1 {
+		if g.blobPresent(ctx, opt.Revisions[0]+":"+opt.Paths[0]) {
+			return nil, nil
+		}
+	}
+
+	args := []string{"rev-list", "--objects", "--missing=print"}
+	if opt.Walk {
+		args = append(args, opt.Revisions...)
+		args = append(args, "--")
+		args = append(args, opt.Paths...)
+		return g.revListMissing(ctx, args, opt.Revisions, nil)
+	}
+
+	// Without walking, we can't pass the paths to rev-list: it simplifies
+	// history by path, so it prints nothing for paths that the revision itself
+	// didn't modify. Instead, we list all objects of the trees and only keep
+	// the blobs that ls-tree finds in the given paths.
+	var want map[string]struct{}
+	if len(opt.Paths) > 0 {
+		var err error
+		want, err = g.treeBlobs(ctx, opt.Revisions, opt.Paths)
+		if err != nil {
+			return nil, err
+		}
+		if len(want) == 0 {
+			return nil, nil
+		}
+	}
+	args = append(args, "--no-walk")
+	args = append(args, opt.Revisions...)
+	return g.revListMissing(ctx, args, opt.Revisions, want)
+}
+
+// revListMissing runs the given rev-list command and returns the IDs of the
+// objects it reports as missing. If want is non-nil, only the objects in want
+// are returned.
+func (g *gitCLIBackend) revListMissing(ctx context.Context, args []string, revisions []string, want map[string]struct{}) ([]string, error) {
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var missing []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		// Missing objects are printed as "?<oid>", all other objects as
+		// "<oid> <path>".
+		line := sc.Text()
+		oid, ok := strings.CutPrefix(line, "?")
+		if !ok {
+			continue
+		}
+		if want != nil {
+			if _, ok := want[oid]; !ok {
+				continue
+			}
+		}
+		missing = append(missing, oid)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, g.missingObjectsError(err, revisions)
+	}
+
+	return missing, nil
+}
+
+// treeBlobs returns the IDs of all blobs in the given paths of the trees of the
+// given revisions.
+func (g *gitCLIBackend) treeBlobs(ctx context.Context, revisions, paths []string) (map[string]struct{}, error) {
+	blobs := make(map[string]struct{})
+	for _, rev := range revisions {
+		if err := checkSpecArgSafety(rev); err != nil {
+			return nil, err
+		}
+
+		args := []string{"ls-tree", "-r", "-z", rev, "--"}
+		args = append(args, paths...)
+
+		r, err := g.NewCommand(ctx, WithArguments(args...))
+		if err != nil {
+			return nil, err
+		}
+
+		sc := bufio.NewScanner(r)
+		sc.Split(byteutils.ScanNullLines)
+		for sc.Scan() {
+			// format: <mode> SP <type> SP <oid> TAB <path>
+			info, _, ok := strings.Cut(sc.Text(), "\t")
+			if !ok {
+				continue
+			}
+			// Submodules are listed as commits, which we never fetch.
+			if fields := strings.Fields(info); len(fields) == 3 && fields[1] == "blob" {
+				blobs[fields[2]] = struct{}{}
+			}
+		}
+		err = sc.Err()
+		r.Close()
+		if err != nil {
+			return nil, g.missingObjectsError(err, revisions)
+		}
+	}
+	return blobs, nil
+}
+
+func (g *gitCLIBackend) missingObjectsError(err error, revisions []string) error {
+	var e *commandFailedError
+	if errors.As(err, &e) && e.ExitStatus == 128 && (bytes.Contains(e.Stderr, []byte("fatal: bad revision")) ||
+		bytes.Contains(e.Stderr, []byte("fatal: bad object")) ||
+		bytes.Contains(e.Stderr, []byte("fatal: Not a valid object name")) ||
+		bytes.Contains(e.Stderr, []byte("unknown revision or path not in the working tree"))) {
+		return &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: strings.Join(revisions, " ")}
+	}
+	return err
+}
+
+// blobPresent returns true if objectName refers to a blob that is present in
+// the local object database. For trees, it returns false, as their entries
+// may still be missing.
+func (g *gitCLIBackend) blobPresent(ctx context.Context, objectName string) bool {
+	if checkSpecArgSafety(objectName) != nil {
+		return false
+	}
+
+	// Without GIT_NO_LAZY_FETCH, git would try to fetch a missing blob from
+	// the promisor remote.
+	r, err := g.NewCommand(ctx, WithArguments("cat-file", "-t", objectName), WithEnv("GIT_NO_LAZY_FETCH=1"))
+	if err != nil {
+		return false
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(r)
+	return err == nil && bytes.Equal(bytes.TrimSpace(out), []byte("blob"))
+}
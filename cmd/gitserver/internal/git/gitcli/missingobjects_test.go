@@ -0,0 +1,326 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_MissingObjects(t *testing.T) {
+	ctx := context.Background()
+
+	src := RepoWithCommands(t,
+		"mkdir dir",
+		"echo a > a.txt",
+		"echo b > dir/b.txt",
+		"git add .",
+		"git commit -m first --author='Foo Author <foo@sourcegraph.com>'",
+		"echo c > dir/c.txt",
+		"git add .",
+		"git commit -m second --author='Foo Author <foo@sourcegraph.com>'",
+		"git config uploadpack.allowFilter true",
+		"git config uploadpack.allowAnySHA1InWant true",
+	)
+
+	t.Run("full clone", func(t *testing.T) {
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), src, api.RepoName(t.Name()))
+
+		missing, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD"}, Walk: true})
+		require.NoError(t, err)
+		require.Empty(t, missing)
+	})
+
+	dir := common.GitDir(filepath.Join(t.TempDir(), "partial.git"))
+	out, err := exec.Command("git", "clone", "--bare", "--filter=blob:none", "file://"+src.Path(), dir.Path()).CombinedOutput()
+	require.NoError(t, err, string(out))
+	require.True(t, git.IsPartialClone(dir))
+
+	backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+	t.Run("all objects", func(t *testing.T) {
+		missing, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD"}, Walk: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{
+			"78981922613b2afb6025042ff6bd878ac1994e85", // a.txt
+			"61780798228d17af2d34fce4cfbdf35556832472", // dir/b.txt
+			"f2ad6c76f0115a6ba5b00456a849810e7ec0af20", // dir/c.txt
+		}, missing)
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		missing, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD~1"}, Paths: []string{"dir"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"61780798228d17af2d34fce4cfbdf35556832472"}, missing)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		// Like gitserver, don't persist the remote URL so that the blob can't
+		// be fetched lazily.
+		dir := common.GitDir(filepath.Join(t.TempDir(), "partial.git"))
+		out, err := exec.Command("git", "clone", "--bare", "--filter=blob:none", "file://"+src.Path(), dir.Path()).CombinedOutput()
+		require.NoError(t, err, string(out))
+		out, err = exec.Command("git", "--git-dir", dir.Path(), "config", "--unset", "remote.origin.url").CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+		missing, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD"}, Paths: []string{"dir/c.txt"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"f2ad6c76f0115a6ba5b00456a849810e7ec0af20"}, missing)
+	})
+
+	t.Run("file not modified by revision", func(t *testing.T) {
+		// HEAD didn't touch a.txt, so its blob is only reachable through the
+		// tree of HEAD, not through the history of the path.
+		missing, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD"}, Paths: []string{"dir"}})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{
+			"61780798228d17af2d34fce4cfbdf35556832472", // dir/b.txt
+			"f2ad6c76f0115a6ba5b00456a849810e7ec0af20", // dir/c.txt
+		}, missing)
+
+		missing, err = backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD"}, Paths: []string{"a.txt", "dir/b.txt"}})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{
+			"78981922613b2afb6025042ff6bd878ac1994e85", // a.txt
+			"61780798228d17af2d34fce4cfbdf35556832472", // dir/b.txt
+		}, missing)
+	})
+
+	t.Run("read file not modified by revision", func(t *testing.T) {
+		// Like gitserver, don't persist the remote URL so that only explicit
+		// hydration can fetch the blob.
+		dir := common.GitDir(filepath.Join(t.TempDir(), "partial.git"))
+		out, err := exec.Command("git", "clone", "--bare", "--filter=blob:none", "file://"+src.Path(), dir.Path()).CombinedOutput()
+		require.NoError(t, err, string(out))
+		out, err = exec.Command("git", "--git-dir", dir.Path(), "config", "--unset", "remote.origin.url").CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		backend := git.NewHydratingBackend(
+			NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name())),
+			&fetchHydrator{remote: "file://" + src.Path()},
+			dir,
+			api.RepoName(t.Name()),
+		)
+		head, err := exec.Command("git", "--git-dir", dir.Path(), "rev-parse", "HEAD").Output()
+		require.NoError(t, err)
+		r, err := backend.ReadFile(ctx, api.CommitID(strings.TrimSpace(string(head))), "a.txt")
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "a\n", string(content))
+	})
+
+	t.Run("hydrated objects are not reported", func(t *testing.T) {
+		// The clone still knows its remote, so git lazily fetches the blob.
+		out, err := exec.Command("git", "--git-dir", dir.Path(), "cat-file", "-p", "HEAD:a.txt").CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		missing, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"HEAD"}, Paths: []string{"a.txt"}})
+		require.NoError(t, err)
+		require.Empty(t, missing)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.MissingObjects(ctx, git.MissingObjectsOpts{Revisions: []string{"notfound"}})
+		require.Error(t, err)
+		require.True(t, errors.HasType[*gitdomain.RevisionNotFoundError](err))
+	})
+}
+
+func TestHydratingBackend_PartialClone(t *testing.T) {
+	ctx := context.Background()
+
+	src := RepoWithCommands(t,
+		"mkdir dir",
+		"echo a > a.txt",
+		"echo b > dir/b.txt",
+		"seq 1 50 > old.txt",
+		"echo '# no revisions' > .git-blame-ignore-revs",
+		`printf '[submodule "sub"]\n\tpath = sub\n\turl = https://example.com/sub\n' > .gitmodules`,
+		"git add .",
+		"git update-index --add --cacheinfo 160000,0123456789012345678901234567890123456789,sub",
+		"git commit -m first --author='Foo Author <foo@sourcegraph.com>'",
+		// An inexact rename, which git can only detect by comparing contents.
+		"git mv old.txt new.txt",
+		"echo 51 >> new.txt",
+		"git add new.txt",
+		"git commit -m second --author='Bar Author <bar@sourcegraph.com>'",
+		"git config uploadpack.allowFilter true",
+		"git config uploadpack.allowAnySHA1InWant true",
+	)
+
+	// newBackend returns a backend for a new partial clone of src. Like
+	// gitserver, the clone doesn't persist the remote URL, so that git can't
+	// fetch missing blobs lazily.
+	newBackend := func(t *testing.T) (git.GitBackend, api.CommitID, *fetchHydrator) {
+		dir := common.GitDir(filepath.Join(t.TempDir(), "partial.git"))
+		out, err := exec.Command("git", "clone", "--bare", "--filter=blob:none", "file://"+src.Path(), dir.Path()).CombinedOutput()
+		require.NoError(t, err, string(out))
+		out, err = exec.Command("git", "--git-dir", dir.Path(), "config", "--unset", "remote.origin.url").CombinedOutput()
+		require.NoError(t, err, string(out))
+		head, err := exec.Command("git", "--git-dir", dir.Path(), "rev-parse", "HEAD").Output()
+		require.NoError(t, err)
+
+		hydrator := &fetchHydrator{remote: "file://" + src.Path()}
+		backend := git.NewHydratingBackend(
+			NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name())),
+			hydrator,
+			dir,
+			api.RepoName(t.Name()),
+		)
+		return backend, api.CommitID(strings.TrimSpace(string(head))), hydrator
+	}
+
+	// Listings don't fetch the blobs of the listed files, so their sizes are
+	// unknown and reported as 0. Only .gitmodules is fetched to describe
+	// submodules.
+	const gitModulesOID = "dd93db290ebc081fbbe5d726f6d565205cc91432"
+
+	t.Run("Stat", func(t *testing.T) {
+		backend, head, hydrator := newBackend(t)
+
+		fi, err := backend.Stat(ctx, head, "dir/b.txt")
+		require.NoError(t, err)
+		require.Equal(t, int64(0), fi.Size())
+
+		fi, err = backend.Stat(ctx, head, "dir")
+		require.NoError(t, err)
+		require.True(t, fi.IsDir())
+
+		fi, err = backend.Stat(ctx, head, "sub")
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/sub", fi.Sys().(gitdomain.Submodule).URL)
+
+		require.Equal(t, []string{gitModulesOID}, hydrator.fetched)
+	})
+
+	t.Run("ReadDir", func(t *testing.T) {
+		backend, head, hydrator := newBackend(t)
+
+		for _, tc := range []struct {
+			path      string
+			recursive bool
+			want      []string
+		}{
+			{path: "", want: []string{".git-blame-ignore-revs", ".gitmodules", "a.txt", "dir", "new.txt", "sub"}},
+			{path: "dir", want: []string{"dir/b.txt"}},
+			{path: "", recursive: true, want: []string{".git-blame-ignore-revs", ".gitmodules", "a.txt", "dir", "dir/b.txt", "new.txt", "sub"}},
+		} {
+			it, err := backend.ReadDir(ctx, head, tc.path, tc.recursive)
+			require.NoError(t, err)
+			var got []string
+			for {
+				fi, err := it.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				require.Zero(t, fi.Size(), fi.Name())
+				got = append(got, fi.Name())
+			}
+			require.NoError(t, it.Close())
+			require.Equal(t, tc.want, got, "path %q, recursive %v", tc.path, tc.recursive)
+		}
+
+		require.Equal(t, []string{gitModulesOID}, hydrator.fetched)
+	})
+
+	t.Run("Blame", func(t *testing.T) {
+		backend, head, _ := newBackend(t)
+
+		r, err := backend.Blame(ctx, head, "new.txt", git.BlameOptions{UseIgnoreRevsFile: true})
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		// The lines from before the rename are attributed to the first commit.
+		authors := make(map[string]string)
+		for {
+			h, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			authors[h.Filename] = h.Author.Name
+		}
+		require.Equal(t, map[string]string{"old.txt": "Foo Author", "new.txt": "Bar Author"}, authors)
+	})
+
+	t.Run("RawDiff", func(t *testing.T) {
+		backend, head, _ := newBackend(t)
+
+		r, err := backend.RawDiff(ctx, string(head)+"~1", string(head), git.GitDiffComparisonTypeOnlyInHead, git.RawDiffOpts{})
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+		diff, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Contains(t, string(diff), "rename from old.txt")
+		require.Contains(t, string(diff), "+51")
+	})
+
+	t.Run("CommitLog following renames", func(t *testing.T) {
+		backend, head, _ := newBackend(t)
+
+		it, err := backend.CommitLog(ctx, git.CommitLogOpts{Ranges: []string{string(head)}, Path: "new.txt", FollowPathRenames: true})
+		require.NoError(t, err)
+		var messages []string
+		for {
+			c, err := it.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			messages = append(messages, strings.TrimSpace(string(c.Message)))
+		}
+		require.NoError(t, it.Close())
+		require.Equal(t, []string{"second", "first"}, messages)
+	})
+
+	t.Run("GetCommit with modified files", func(t *testing.T) {
+		backend, head, _ := newBackend(t)
+
+		c, err := backend.GetCommit(ctx, head, true)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"new.txt", "old.txt"}, c.ModifiedFiles)
+	})
+
+	t.Run("ContributorCounts", func(t *testing.T) {
+		backend, head, _ := newBackend(t)
+
+		// Counting contributors of a path only compares trees.
+		counts, err := backend.ContributorCounts(ctx, git.ContributorCountsOpts{Range: string(head), Path: "new.txt"})
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		require.Equal(t, "Bar Author", counts[0].Name)
+	})
+}
+
+// fetchHydrator fetches missing objects from a fixed remote and records their
+// IDs.
+type fetchHydrator struct {
+	remote  string
+	fetched []string
+}
+
+func (h *fetchHydrator) HydrateObjects(ctx context.Context, _ api.RepoName, dir common.GitDir, oids []string) error {
+	h.fetched = append(h.fetched, oids...)
+	args := append([]string{"--git-dir", dir.Path(), "fetch", "--no-tags", "--filter=blob:none", h.remote}, oids...)
+	out, err := exec.CommandContext(ctx, "git", args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrap(err, string(out))
+	}
+	return nil
+}
@@ -37,7 +37,7 @@ func (g *gitCLIBackend) GetCommit(ctx context.Context, commit api.CommitID, incl
 		return nil, err
 	}
 
-	args := buildGetCommitArgs(commitID, includeModifiedFiles)
+	args := buildGetCommitArgs(commitID, includeModifiedFiles, git.IsPartialClone(g.dir))
 
 	r, err := g.NewCommand(ctx, WithArguments(args...))
 	if err != nil {
@@ -65,15 +65,24 @@ func (g *gitCLIBackend) GetCommit(ctx context.Context, commit api.CommitID, incl
 	return c, nil
 }
 
-func buildGetCommitArgs(commit api.CommitID, includeModifiedFiles bool) []string {
+func buildGetCommitArgs(commit api.CommitID, includeModifiedFiles, partialClone bool) []string {
 	args := []string{"log", logFormatWithoutRefs, "-n", "1"}
 	if includeModifiedFiles {
 		args = append(args, "--name-only")
+		if partialClone {
+			args = append(args, noRenamesInPartialClones)
+		}
 	}
 	args = append(args, string(commit))
 	return args
 }
 
+// noRenamesInPartialClones disables rename detection when listing modified
+// files in partial clones. Detecting inexact renames compares the contents of
+// the added and deleted files, which partial clones may be missing. Without
+// it, a renamed file is listed under both its old and its new name.
+const noRenamesInPartialClones = "--no-renames"
+
 const (
 	partsPerCommit = 10 // number of \x00-separated fields per commit
 
@@ -352,13 +361,19 @@ func (g *gitCLIBackend) lsTree(ctx context.Context, commit api.CommitID, path st
 	// Note: We don't call filepath.Clean(path) because ReadDir needs to pass
 	// path with a trailing slash.
 
-	args := []string{
-		"ls-tree",
-		"--long", // show size
+	// Sizes are read from the blobs, which partial clones may not have. We
+	// don't fetch them just to list a tree, so their sizes are reported as 0.
+	long := !git.IsPartialClone(g.dir)
+
+	args := []string{"ls-tree"}
+	if long {
+		args = append(args, "--long") // show size
+	}
+	args = append(args,
 		"--full-name",
 		"-z",
 		string(commit),
-	}
+	)
 	if recurse {
 		args = append(args, "-r", "-t") // -t: Show tree entries even when going to recurse them.
 	}
@@ -383,6 +398,7 @@ func (g *gitCLIBackend) lsTree(ctx context.Context, commit api.CommitID, path st
 		repoName: g.repoName,
 		commit:   commit,
 		path:     path,
+		long:     long,
 		r:        r,
 	}, nil
 }
@@ -394,8 +410,10 @@ type readDirIterator struct {
 	repoName api.RepoName
 	commit   api.CommitID
 	path     string
-	fdsSeen  int
-	r        io.ReadCloser
+	// long is set if the output of ls-tree includes the size of entries.
+	long    bool
+	fdsSeen int
+	r       io.ReadCloser
 }
 
 func (it *readDirIterator) Next() (fs.FileInfo, error) {
@@ -409,9 +427,13 @@ func (it *readDirIterator) Next() (fs.FileInfo, error) {
 		if tabPos == -1 {
 			return nil, errors.Errorf("invalid `git ls-tree` output: %q", line)
 		}
-		info := bytes.SplitN(line[:tabPos], []byte(" "), 4)
+		fields := 3
+		if it.long {
+			fields = 4
+		}
+		info := bytes.SplitN(line[:tabPos], []byte(" "), fields)
 
-		if len(info) != 4 {
+		if len(info) != fields {
 			return nil, errors.Errorf("invalid `git ls-tree` output: %q", line)
 		}
 
@@ -426,13 +448,15 @@ func (it *readDirIterator) Next() (fs.FileInfo, error) {
 			return nil, err
 		}
 
-		sizeStr := string(bytes.TrimSpace(info[3]))
 		var size int64
-		if sizeStr != "-" {
-			// Size of "-" indicates a dir or submodule.
-			size, err = strconv.ParseInt(sizeStr, 10, 64)
-			if err != nil || size < 0 {
-				return nil, errors.Errorf("invalid `git ls-tree` size output: %q (error: %s)", sizeStr, err)
+		if it.long {
+			sizeStr := string(bytes.TrimSpace(info[3]))
+			if sizeStr != "-" {
+				// Size of "-" indicates a dir or submodule.
+				size, err = strconv.ParseInt(sizeStr, 10, 64)
+				if err != nil || size < 0 {
+					return nil, errors.Errorf("invalid `git ls-tree` size output: %q (error: %s)", sizeStr, err)
+				}
 			}
 		}
 
@@ -0,0 +1,394 @@
+package git
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	hydrationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "src_gitserver_partial_clone_hydration_duration_seconds",
+		Help:    "Time spent fetching objects missing from partial clones, in seconds.",
+		Buckets: prometheus.ExponentialBucketsRange(0.05, 300, 12),
+	}, []string{"op", "error"})
+	hydratedObjects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_partial_clone_hydrated_objects_total",
+		Help: "Total number of objects fetched on demand for partial clones.",
+	}, []string{"op"})
+)
+
+// IsPartialClone returns true if the repository at dir is a partial clone, ie.
+// if it contains packfiles received from a promisor remote that may reference
+// objects which are not present locally.
+func IsPartialClone(dir common.GitDir) bool {
+	matches, err := filepath.Glob(dir.Path("objects", "pack", "*.promisor"))
+	return err == nil && len(matches) > 0
+}
+
+// ObjectHydrator fetches objects that are missing from the local object
+// database of a partial clone from the remote of the repository.
+type ObjectHydrator interface {
+	// HydrateObjects fetches the objects with the given IDs into the repository
+	// at dir.
+	HydrateObjects(ctx context.Context, repo api.RepoName, dir common.GitDir, oids []string) error
+}
+
+// HydrateObjects makes sure that all objects selected by opt are present in the
+// repository at dir, fetching missing ones via the given hydrator. For
+// repositories that are not partial clones, this is a no-op.
+// op is used to label the recorded metrics.
+func HydrateObjects(ctx context.Context, backend GitBackend, hydrator ObjectHydrator, repo api.RepoName, dir common.GitDir, op string, opt MissingObjectsOpts) (err error) {
+	missing, err := backend.MissingObjects(ctx, opt)
+	if err != nil {
+		return errors.Wrap(err, "finding missing objects")
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		hydrationDuration.WithLabelValues(op, strconv.FormatBool(err != nil)).Observe(time.Since(start).Seconds())
+	}()
+
+	if err := hydrator.HydrateObjects(ctx, repo, dir, missing); err != nil {
+		return errors.Wrapf(err, "fetching %d missing objects", len(missing))
+	}
+
+	hydratedObjects.WithLabelValues(op).Add(float64(len(missing)))
+
+	return nil
+}
+
+// NewHydratingBackend returns a GitBackend that transparently fetches objects
+// missing from partial clones before the given backend reads blobs. Partial
+// clones never persist the URL of their remote, so git itself can't fetch
+// missing objects lazily: every method that reads the content of blobs must be
+// wrapped here. Listings like Stat and ReadDir don't read the blobs of the
+// listed files in partial clones, so only .gitmodules is fetched for them. For repositories that are not partial clones,
+// all calls are passed through unchanged.
+func NewHydratingBackend(backend GitBackend, hydrator ObjectHydrator, dir common.GitDir, repoName api.RepoName) GitBackend {
+	return &hydratingBackend{
+		GitBackend: backend,
+		hydrator:   hydrator,
+		dir:        dir,
+		repoName:   repoName,
+	}
+}
+
+type hydratingBackend struct {
+	GitBackend
+	hydrator ObjectHydrator
+	dir      common.GitDir
+	repoName api.RepoName
+}
+
+func (b *hydratingBackend) ReadFile(ctx context.Context, commit api.CommitID, path string) (io.ReadCloser, error) {
+	err := HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, "ReadFile", MissingObjectsOpts{
+		Revisions: []string{string(commit)},
+		Paths:     []string{path},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.GitBackend.ReadFile(ctx, commit, path)
+}
+
+func (b *hydratingBackend) ArchiveReader(ctx context.Context, format ArchiveFormat, treeish string, paths []string) (io.ReadCloser, error) {
+	err := HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, "ArchiveReader", MissingObjectsOpts{
+		Revisions: []string{treeish},
+		Paths:     paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.GitBackend.ArchiveReader(ctx, format, treeish, paths)
+}
+
+func (b *hydratingBackend) Stat(ctx context.Context, commit api.CommitID, path string) (fs.FileInfo, error) {
+	if err := b.hydrateGitModules(ctx, commit, "Stat"); err != nil {
+		return nil, err
+	}
+
+	return b.GitBackend.Stat(ctx, commit, path)
+}
+
+func (b *hydratingBackend) ReadDir(ctx context.Context, commit api.CommitID, path string, recursive bool) (ReadDirIterator, error) {
+	if err := b.hydrateGitModules(ctx, commit, "ReadDir"); err != nil {
+		return nil, err
+	}
+
+	return b.GitBackend.ReadDir(ctx, commit, path, recursive)
+}
+
+// hydrateGitModules fetches the .gitmodules file at commit, which listings read
+// to describe submodules. The blobs of the listed files are not fetched.
+func (b *hydratingBackend) hydrateGitModules(ctx context.Context, commit api.CommitID, op string) error {
+	return HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, op, MissingObjectsOpts{
+		Revisions: []string{string(commit)},
+		Paths:     []string{".gitmodules"},
+	})
+}
+
+func (b *hydratingBackend) Blame(ctx context.Context, startCommit api.CommitID, path string, opt BlameOptions) (BlameHunkReader, error) {
+	if IsPartialClone(b.dir) {
+		if err := b.hydrateBlame(ctx, startCommit, path, opt); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.GitBackend.Blame(ctx, startCommit, path, opt)
+}
+
+func (b *hydratingBackend) hydrateBlame(ctx context.Context, startCommit api.CommitID, path string, opt BlameOptions) error {
+	if opt.UseIgnoreRevsFile {
+		err := HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, "Blame", MissingObjectsOpts{
+			Revisions: []string{string(startCommit)},
+			Paths:     []string{BlameIgnoreRevsFile},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if opt.DetectCopies {
+		// Copies are detected by comparing lines to all files modified in the
+		// same commit, so we need every blob in the history.
+		return HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, "Blame", MissingObjectsOpts{
+			Revisions: []string{string(startCommit)},
+			Walk:      true,
+		})
+	}
+
+	return b.hydratePathHistory(ctx, "Blame", []string{string(startCommit)}, cleanPath(path))
+}
+
+func (b *hydratingBackend) RawDiff(ctx context.Context, base string, head string, typ GitDiffComparisonType, opts RawDiffOpts, paths ...string) (io.ReadCloser, error) {
+	if IsPartialClone(b.dir) {
+		if err := b.hydrateRawDiff(ctx, base, head, typ, paths); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.GitBackend.RawDiff(ctx, base, head, typ, opts, paths...)
+}
+
+// hydrateRawDiff fetches the blobs of all files that differ between base and
+// head, restricted to the given paths. Listing the changed files only compares
+// trees, so it works without the blobs.
+func (b *hydratingBackend) hydrateRawDiff(ctx context.Context, base, head string, typ GitDiffComparisonType, paths []string) error {
+	if typ == GitDiffComparisonTypeIntersection {
+		mergeBase, err := b.GitBackend.MergeBase(ctx, base, head)
+		if err != nil {
+			return err
+		}
+		base = string(mergeBase)
+	}
+
+	changed, err := b.changedFiles(ctx, base, head)
+	if err != nil {
+		return err
+	}
+
+	var changedPaths []string
+	for _, f := range changed {
+		if pathsContain(paths, f.Path) {
+			changedPaths = append(changedPaths, f.Path)
+		}
+	}
+	if len(changedPaths) == 0 {
+		return nil
+	}
+
+	return HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, "RawDiff", MissingObjectsOpts{
+		Revisions: []string{base, head},
+		Paths:     changedPaths,
+	})
+}
+
+func (b *hydratingBackend) CommitLog(ctx context.Context, opt CommitLogOpts) (CommitLogIterator, error) {
+	// Only following renames reads blobs, to find the file a path was renamed
+	// from. Modified files are listed without rename detection in partial
+	// clones.
+	if opt.FollowPathRenames && opt.Path != "" && IsPartialClone(b.dir) {
+		revisions := opt.Ranges
+		if opt.AllRefs {
+			revisions = []string{"--all"}
+		}
+		if err := b.hydratePathHistory(ctx, "CommitLog", revisions, cleanPath(opt.Path)); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.GitBackend.CommitLog(ctx, opt)
+}
+
+// maxFollowedRenames is the maximum number of renames of a path that
+// hydratePathHistory follows.
+const maxFollowedRenames = 10
+
+// hydratePathHistory fetches the blobs of path in the history of the given
+// revisions. Git follows renames of path by comparing it to the files deleted
+// in the commit that added it, so we fetch those as well and continue with the
+// history of the file it was renamed from.
+func (b *hydratingBackend) hydratePathHistory(ctx context.Context, op string, revisions []string, path string) error {
+	for range maxFollowedRenames {
+		err := HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, op, MissingObjectsOpts{
+			Revisions: revisions,
+			Walk:      true,
+			Paths:     []string{path},
+		})
+		if err != nil {
+			return err
+		}
+
+		added, err := b.oldestCommit(ctx, revisions, path)
+		if err != nil || added == nil || len(added.Parents) == 0 {
+			return err
+		}
+		parent := string(added.Parents[0])
+
+		changed, err := b.changedFiles(ctx, parent, string(added.ID))
+		if err != nil {
+			return err
+		}
+		var deleted []string
+		for _, f := range changed {
+			if f.Status == gitdomain.StatusDeleted {
+				deleted = append(deleted, f.Path)
+			}
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+		err = HydrateObjects(ctx, b.GitBackend, b.hydrator, b.repoName, b.dir, op, MissingObjectsOpts{
+			Revisions: []string{parent},
+			Paths:     deleted,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Now that git can detect the rename, the commit before the one that
+		// added path lists the name path had before.
+		it, err := b.GitBackend.CommitLog(ctx, CommitLogOpts{
+			Ranges:               []string{string(added.ID)},
+			Path:                 path,
+			FollowPathRenames:    true,
+			IncludeModifiedFiles: true,
+			MaxCommits:           2,
+		})
+		if err != nil {
+			return err
+		}
+		commits, err := collectCommits(it)
+		if err != nil {
+			return err
+		}
+		if len(commits) < 2 || len(commits[1].ModifiedFiles) != 1 {
+			return nil
+		}
+
+		revisions = []string{parent}
+		path = commits[1].ModifiedFiles[0]
+	}
+
+	return nil
+}
+
+// oldestCommit returns the oldest commit in the history of the given revisions
+// that modified path, or nil if there is none.
+func (b *hydratingBackend) oldestCommit(ctx context.Context, revisions []string, path string) (*gitdomain.Commit, error) {
+	opt := CommitLogOpts{Ranges: revisions, Path: path}
+	if len(revisions) == 1 && revisions[0] == "--all" {
+		opt = CommitLogOpts{AllRefs: true, Path: path}
+	}
+	it, err := b.GitBackend.CommitLog(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := collectCommits(it)
+	if err != nil || len(commits) == 0 {
+		return nil, err
+	}
+	return commits[len(commits)-1].Commit, nil
+}
+
+func (b *hydratingBackend) changedFiles(ctx context.Context, base, head string) (_ []gitdomain.PathStatus, err error) {
+	it, err := b.GitBackend.ChangedFiles(ctx, base, head)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Append(err, it.Close())
+	}()
+
+	var files []gitdomain.PathStatus
+	for {
+		f, err := it.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+}
+
+func collectCommits(it CommitLogIterator) (_ []*GitCommitWithFiles, err error) {
+	defer func() {
+		err = errors.Append(err, it.Close())
+	}()
+
+	var commits []*GitCommitWithFiles
+	for {
+		c, err := it.Next()
+		if err == io.EOF {
+			return commits, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, c)
+	}
+}
+
+// cleanPath returns path relative to the root of the repository, or an empty
+// string for the root itself.
+func cleanPath(path string) string {
+	path = filepath.Clean(strings.TrimPrefix(path, "/"))
+	if path == "." {
+		return ""
+	}
+	return path
+}
+
+// pathsContain returns true if path is one of paths or inside of one of them.
+// All paths are contained in an empty list of paths.
+func pathsContain(paths []string, path string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		if p = cleanPath(p); p == "" || p == path || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package git
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+type hydratorFunc func(ctx context.Context, repo api.RepoName, dir common.GitDir, oids []string) error
+
+func (f hydratorFunc) HydrateObjects(ctx context.Context, repo api.RepoName, dir common.GitDir, oids []string) error {
+	return f(ctx, repo, dir, oids)
+}
+
+func TestHydratingBackend(t *testing.T) {
+	ctx := context.Background()
+
+	var hydrated []string
+	hydrator := hydratorFunc(func(_ context.Context, repo api.RepoName, _ common.GitDir, oids []string) error {
+		require.Equal(t, api.RepoName("repo"), repo)
+		hydrated = append(hydrated, oids...)
+		return nil
+	})
+
+	t.Run("ReadFile", func(t *testing.T) {
+		hydrated = nil
+
+		b := NewMockGitBackend()
+		b.MissingObjectsFunc.SetDefaultHook(func(_ context.Context, opt MissingObjectsOpts) ([]string, error) {
+			require.Equal(t, MissingObjectsOpts{Revisions: []string{"deadbeef"}, Paths: []string{"a.txt"}}, opt)
+			return []string{"oid1"}, nil
+		})
+		b.ReadFileFunc.SetDefaultReturn(io.NopCloser(strings.NewReader("content")), nil)
+
+		r, err := NewHydratingBackend(b, hydrator, "dir", "repo").ReadFile(ctx, "deadbeef", "a.txt")
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, []string{"oid1"}, hydrated)
+	})
+
+	t.Run("ArchiveReader without missing objects", func(t *testing.T) {
+		hydrated = nil
+
+		b := NewMockGitBackend()
+		b.ArchiveReaderFunc.SetDefaultReturn(io.NopCloser(strings.NewReader("archive")), nil)
+
+		r, err := NewHydratingBackend(b, hydrator, "dir", "repo").ArchiveReader(ctx, ArchiveFormatTar, "HEAD", nil)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Empty(t, hydrated)
+		require.Len(t, b.MissingObjectsFunc.History(), 1)
+	})
+}
@@ -117,6 +117,14 @@ type GitBackend interface {
 	// Empty branches return an iterator that emits zero commits, not an error.
 	CommitLog(ctx context.Context, opt CommitLogOpts) (CommitLogIterator, error)
 
+	// CommitGraph returns the commit graph of all commits in the given boundaries
+	// specified by opt. For every commit, only its parents and committer date are
+	// returned, which makes this a lot cheaper than CommitLog for large ranges.
+	// If the range does not exist, a RevisionNotFoundError is returned from the
+	// iterator.
+	// Empty branches return an iterator that emits zero commits, not an error.
+	CommitGraph(ctx context.Context, opt CommitGraphOpts) (CommitGraphIterator, error)
+
 	// FirstEverCommit returns the first commit ever made to the repository.
 	//
 	// If the repository is empty, a RevisionNotFoundError is returned (as the
@@ -181,6 +189,14 @@ type GitBackend interface {
 	//
 	// If one of the given revspecs does not exist, a RevisionNotFoundError is returned.
 	MergeBaseOctopus(ctx context.Context, revspecs ...string) (api.CommitID, error)
+
+	// MissingObjects returns the IDs of all objects selected by opt that are not
+	// present in the local object database. Objects can only be missing in partial
+	// clones, for all other repositories the result is always empty.
+	//
+	// If one of the given revisions does not exist, a RevisionNotFoundError is
+	// returned.
+	MissingObjects(ctx context.Context, opt MissingObjectsOpts) ([]string, error)
 }
 
 // CommitLogOrder is the order of the commits returned by CommitLog.
@@ -242,6 +258,30 @@ type CommitLogOpts struct {
 	FollowPathRenames bool
 }
 
+// CommitGraphOpts defines the options for the CommitGraph method.
+type CommitGraphOpts struct {
+	// Ranges to include in the graph (revspec, "A..B", "A...B", etc.).
+	// At least one range, or AllRefs must be specified.
+	Ranges []string
+	// If true, the commits reachable from all refs are returned.
+	// Must not be true when ranges are given.
+	AllRefs bool
+	// After is an optional parameter to specify the earliest commit to consider.
+	After time.Time
+	Order CommitLogOrder
+}
+
+// CommitGraphIterator iterates over the nodes of a commit graph. The iterator
+// ends with Next returning io.EOF.
+// Callers must make sure to Close() the iterator.
+type CommitGraphIterator interface {
+	// Next returns the next commit in the graph.
+	// If a given revision was not found, a RevisionNotFoundError is returned.
+	Next() (*gitdomain.CommitGraphNode, error)
+	// Close releases resources associated with the iterator.
+	Close() error
+}
+
 // CommitLogIterator iterates over commits. The iterator ends with Next returning
 // io.EOF.
 // Callers must make sure to Close() the iterator.
@@ -276,10 +316,28 @@ type GitConfigBackend interface {
 	Unset(ctx context.Context, key string) error
 }
 
+// BlameIgnoreRevsFile is the conventional name of the file listing revisions
+// that blame should ignore, such as bulk reformatting commits.
+const BlameIgnoreRevsFile = ".git-blame-ignore-revs"
+
 // BlameOptions are options for git blame.
 type BlameOptions struct {
 	IgnoreWhitespace bool
 	Range            *BlameRange
+	// OldestCommit, if set, is the lower bound of the blame. Lines that have
+	// not changed since OldestCommit are attributed to it.
+	OldestCommit api.CommitID
+	// IgnoreRevs are revisions whose changes are ignored when attributing
+	// lines.
+	IgnoreRevs []string
+	// UseIgnoreRevsFile additionally ignores the revisions listed in the
+	// .git-blame-ignore-revs file of the blamed commit, if it exists.
+	UseIgnoreRevsFile bool
+	// DetectMoves detects lines moved or copied within the same file.
+	DetectMoves bool
+	// DetectCopies detects lines moved or copied from other files that were
+	// modified in the same commit.
+	DetectCopies bool
 }
 
 type BlameRange struct {
@@ -388,3 +446,17 @@ type RawDiffOpts struct {
 	// be fused together with other hunks if they meet the threshold.
 	ContextLines int
 }
+
+// MissingObjectsOpts are options for the MissingObjects method.
+type MissingObjectsOpts struct {
+	// Revisions are the revisions from which objects are considered. These can
+	// be revspecs or any other argument understood by git rev-list, like --glob.
+	Revisions []string
+	// If true, the history of the given revisions is walked and all objects
+	// reachable from any ancestor are considered. Otherwise, only the trees of
+	// the given revisions themselves are considered.
+	Walk bool
+	// If set, only objects in the given paths are considered. Can be pathspecs
+	// (e.g., "foo/bar/").
+	Paths []string
+}
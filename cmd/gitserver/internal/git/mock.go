@@ -280,6 +280,270 @@ func (c BlameHunkReaderReadFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// MockCommitGraphIterator is a mock implementation of the
+// CommitGraphIterator interface (from the package
+// github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git) used for
+// unit testing.
+type MockCommitGraphIterator struct {
+	// CloseFunc is an instance of a mock function object controlling the
+	// behavior of the method Close.
+	CloseFunc *CommitGraphIteratorCloseFunc
+	// NextFunc is an instance of a mock function object controlling the
+	// behavior of the method Next.
+	NextFunc *CommitGraphIteratorNextFunc
+}
+
+// NewMockCommitGraphIterator creates a new mock of the CommitGraphIterator
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockCommitGraphIterator() *MockCommitGraphIterator {
+	return &MockCommitGraphIterator{
+		CloseFunc: &CommitGraphIteratorCloseFunc{
+			defaultHook: func() (r0 error) {
+				return
+			},
+		},
+		NextFunc: &CommitGraphIteratorNextFunc{
+			defaultHook: func() (r0 *gitdomain.CommitGraphNode, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockCommitGraphIterator creates a new mock of the
+// CommitGraphIterator interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockCommitGraphIterator() *MockCommitGraphIterator {
+	return &MockCommitGraphIterator{
+		CloseFunc: &CommitGraphIteratorCloseFunc{
+			defaultHook: func() error {
+				panic("unexpected invocation of MockCommitGraphIterator.Close")
+			},
+		},
+		NextFunc: &CommitGraphIteratorNextFunc{
+			defaultHook: func() (*gitdomain.CommitGraphNode, error) {
+				panic("unexpected invocation of MockCommitGraphIterator.Next")
+			},
+		},
+	}
+}
+
+// NewMockCommitGraphIteratorFrom creates a new mock of the
+// MockCommitGraphIterator interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockCommitGraphIteratorFrom(i CommitGraphIterator) *MockCommitGraphIterator {
+	return &MockCommitGraphIterator{
+		CloseFunc: &CommitGraphIteratorCloseFunc{
+			defaultHook: i.Close,
+		},
+		NextFunc: &CommitGraphIteratorNextFunc{
+			defaultHook: i.Next,
+		},
+	}
+}
+
+// CommitGraphIteratorCloseFunc describes the behavior when the Close method
+// of the parent MockCommitGraphIterator instance is invoked.
+type CommitGraphIteratorCloseFunc struct {
+	defaultHook func() error
+	hooks       []func() error
+	history     []CommitGraphIteratorCloseFuncCall
+	mutex       sync.Mutex
+}
+
+// Close delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCommitGraphIterator) Close() error {
+	r0 := m.CloseFunc.nextHook()()
+	m.CloseFunc.appendCall(CommitGraphIteratorCloseFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Close method of the
+// parent MockCommitGraphIterator instance is invoked and the hook queue is
+// empty.
+func (f *CommitGraphIteratorCloseFunc) SetDefaultHook(hook func() error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Close method of the parent MockCommitGraphIterator instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CommitGraphIteratorCloseFunc) PushHook(hook func() error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CommitGraphIteratorCloseFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func() error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CommitGraphIteratorCloseFunc) PushReturn(r0 error) {
+	f.PushHook(func() error {
+		return r0
+	})
+}
+
+func (f *CommitGraphIteratorCloseFunc) nextHook() func() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CommitGraphIteratorCloseFunc) appendCall(r0 CommitGraphIteratorCloseFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CommitGraphIteratorCloseFuncCall objects
+// describing the invocations of this function.
+func (f *CommitGraphIteratorCloseFunc) History() []CommitGraphIteratorCloseFuncCall {
+	f.mutex.Lock()
+	history := make([]CommitGraphIteratorCloseFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CommitGraphIteratorCloseFuncCall is an object that describes an
+// invocation of method Close on an instance of MockCommitGraphIterator.
+type CommitGraphIteratorCloseFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CommitGraphIteratorCloseFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CommitGraphIteratorCloseFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CommitGraphIteratorNextFunc describes the behavior when the Next method
+// of the parent MockCommitGraphIterator instance is invoked.
+type CommitGraphIteratorNextFunc struct {
+	defaultHook func() (*gitdomain.CommitGraphNode, error)
+	hooks       []func() (*gitdomain.CommitGraphNode, error)
+	history     []CommitGraphIteratorNextFuncCall
+	mutex       sync.Mutex
+}
+
+// Next delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCommitGraphIterator) Next() (*gitdomain.CommitGraphNode, error) {
+	r0, r1 := m.NextFunc.nextHook()()
+	m.NextFunc.appendCall(CommitGraphIteratorNextFuncCall{r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Next method of the
+// parent MockCommitGraphIterator instance is invoked and the hook queue is
+// empty.
+func (f *CommitGraphIteratorNextFunc) SetDefaultHook(hook func() (*gitdomain.CommitGraphNode, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Next method of the parent MockCommitGraphIterator instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CommitGraphIteratorNextFunc) PushHook(hook func() (*gitdomain.CommitGraphNode, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CommitGraphIteratorNextFunc) SetDefaultReturn(r0 *gitdomain.CommitGraphNode, r1 error) {
+	f.SetDefaultHook(func() (*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CommitGraphIteratorNextFunc) PushReturn(r0 *gitdomain.CommitGraphNode, r1 error) {
+	f.PushHook(func() (*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+func (f *CommitGraphIteratorNextFunc) nextHook() func() (*gitdomain.CommitGraphNode, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CommitGraphIteratorNextFunc) appendCall(r0 CommitGraphIteratorNextFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CommitGraphIteratorNextFuncCall objects
+// describing the invocations of this function.
+func (f *CommitGraphIteratorNextFunc) History() []CommitGraphIteratorNextFuncCall {
+	f.mutex.Lock()
+	history := make([]CommitGraphIteratorNextFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CommitGraphIteratorNextFuncCall is an object that describes an invocation
+// of method Next on an instance of MockCommitGraphIterator.
+type CommitGraphIteratorNextFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.CommitGraphNode
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CommitGraphIteratorNextFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CommitGraphIteratorNextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockCommitLogIterator is a mock implementation of the CommitLogIterator
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git) used for
@@ -561,6 +825,9 @@ type MockGitBackend struct {
 	// ChangedFilesFunc is an instance of a mock function object controlling
 	// the behavior of the method ChangedFiles.
 	ChangedFilesFunc *GitBackendChangedFilesFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *GitBackendCommitGraphFunc
 	// CommitLogFunc is an instance of a mock function object controlling
 	// the behavior of the method CommitLog.
 	CommitLogFunc *GitBackendCommitLogFunc
@@ -591,6 +858,9 @@ type MockGitBackend struct {
 	// MergeBaseOctopusFunc is an instance of a mock function object
 	// controlling the behavior of the method MergeBaseOctopus.
 	MergeBaseOctopusFunc *GitBackendMergeBaseOctopusFunc
+	// MissingObjectsFunc is an instance of a mock function object
+	// controlling the behavior of the method MissingObjects.
+	MissingObjectsFunc *GitBackendMissingObjectsFunc
 	// RawDiffFunc is an instance of a mock function object controlling the
 	// behavior of the method RawDiff.
 	RawDiffFunc *GitBackendRawDiffFunc
@@ -644,6 +914,11 @@ func NewMockGitBackend() *MockGitBackend {
 				return
 			},
 		},
+		CommitGraphFunc: &GitBackendCommitGraphFunc{
+			defaultHook: func(context.Context, CommitGraphOpts) (r0 CommitGraphIterator, r1 error) {
+				return
+			},
+		},
 		CommitLogFunc: &GitBackendCommitLogFunc{
 			defaultHook: func(context.Context, CommitLogOpts) (r0 CommitLogIterator, r1 error) {
 				return
@@ -694,6 +969,11 @@ func NewMockGitBackend() *MockGitBackend {
 				return
 			},
 		},
+		MissingObjectsFunc: &GitBackendMissingObjectsFunc{
+			defaultHook: func(context.Context, MissingObjectsOpts) (r0 []string, r1 error) {
+				return
+			},
+		},
 		RawDiffFunc: &GitBackendRawDiffFunc{
 			defaultHook: func(context.Context, string, string, GitDiffComparisonType, RawDiffOpts, ...string) (r0 io.ReadCloser, r1 error) {
 				return
@@ -766,6 +1046,11 @@ func NewStrictMockGitBackend() *MockGitBackend {
 				panic("unexpected invocation of MockGitBackend.ChangedFiles")
 			},
 		},
+		CommitGraphFunc: &GitBackendCommitGraphFunc{
+			defaultHook: func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+				panic("unexpected invocation of MockGitBackend.CommitGraph")
+			},
+		},
 		CommitLogFunc: &GitBackendCommitLogFunc{
 			defaultHook: func(context.Context, CommitLogOpts) (CommitLogIterator, error) {
 				panic("unexpected invocation of MockGitBackend.CommitLog")
@@ -816,6 +1101,11 @@ func NewStrictMockGitBackend() *MockGitBackend {
 				panic("unexpected invocation of MockGitBackend.MergeBaseOctopus")
 			},
 		},
+		MissingObjectsFunc: &GitBackendMissingObjectsFunc{
+			defaultHook: func(context.Context, MissingObjectsOpts) ([]string, error) {
+				panic("unexpected invocation of MockGitBackend.MissingObjects")
+			},
+		},
 		RawDiffFunc: &GitBackendRawDiffFunc{
 			defaultHook: func(context.Context, string, string, GitDiffComparisonType, RawDiffOpts, ...string) (io.ReadCloser, error) {
 				panic("unexpected invocation of MockGitBackend.RawDiff")
@@ -880,6 +1170,9 @@ func NewMockGitBackendFrom(i GitBackend) *MockGitBackend {
 		ChangedFilesFunc: &GitBackendChangedFilesFunc{
 			defaultHook: i.ChangedFiles,
 		},
+		CommitGraphFunc: &GitBackendCommitGraphFunc{
+			defaultHook: i.CommitGraph,
+		},
 		CommitLogFunc: &GitBackendCommitLogFunc{
 			defaultHook: i.CommitLog,
 		},
@@ -910,6 +1203,9 @@ func NewMockGitBackendFrom(i GitBackend) *MockGitBackend {
 		MergeBaseOctopusFunc: &GitBackendMergeBaseOctopusFunc{
 			defaultHook: i.MergeBaseOctopus,
 		},
+		MissingObjectsFunc: &GitBackendMissingObjectsFunc{
+			defaultHook: i.MissingObjects,
+		},
 		RawDiffFunc: &GitBackendRawDiffFunc{
 			defaultHook: i.RawDiff,
 		},
@@ -1389,6 +1685,114 @@ func (c GitBackendChangedFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitBackendCommitGraphFunc describes the behavior when the CommitGraph
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendCommitGraphFunc struct {
+	defaultHook func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)
+	hooks       []func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)
+	history     []GitBackendCommitGraphFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) CommitGraph(v0 context.Context, v1 CommitGraphOpts) (CommitGraphIterator, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1)
+	m.CommitGraphFunc.appendCall(GitBackendCommitGraphFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCommitGraphFunc) SetDefaultHook(hook func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCommitGraphFunc) PushHook(hook func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCommitGraphFunc) SetDefaultReturn(r0 CommitGraphIterator, r1 error) {
+	f.SetDefaultHook(func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCommitGraphFunc) PushReturn(r0 CommitGraphIterator, r1 error) {
+	f.PushHook(func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCommitGraphFunc) nextHook() func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCommitGraphFunc) appendCall(r0 GitBackendCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCommitGraphFunc) History() []GitBackendCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCommitGraphFuncCall is an object that describes an invocation
+// of method CommitGraph on an instance of MockGitBackend.
+type GitBackendCommitGraphFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 CommitGraphOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 CommitGraphIterator
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitBackendCommitLogFunc describes the behavior when the CommitLog method
 // of the parent MockGitBackend instance is invoked.
 type GitBackendCommitLogFunc struct {
@@ -2470,6 +2874,114 @@ func (c GitBackendMergeBaseOctopusFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitBackendMissingObjectsFunc describes the behavior when the
+// MissingObjects method of the parent MockGitBackend instance is invoked.
+type GitBackendMissingObjectsFunc struct {
+	defaultHook func(context.Context, MissingObjectsOpts) ([]string, error)
+	hooks       []func(context.Context, MissingObjectsOpts) ([]string, error)
+	history     []GitBackendMissingObjectsFuncCall
+	mutex       sync.Mutex
+}
+
+// MissingObjects delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitBackend) MissingObjects(v0 context.Context, v1 MissingObjectsOpts) ([]string, error) {
+	r0, r1 := m.MissingObjectsFunc.nextHook()(v0, v1)
+	m.MissingObjectsFunc.appendCall(GitBackendMissingObjectsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MissingObjects
+// method of the parent MockGitBackend instance is invoked and the hook
+// queue is empty.
+func (f *GitBackendMissingObjectsFunc) SetDefaultHook(hook func(context.Context, MissingObjectsOpts) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MissingObjects method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendMissingObjectsFunc) PushHook(hook func(context.Context, MissingObjectsOpts) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendMissingObjectsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, MissingObjectsOpts) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendMissingObjectsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, MissingObjectsOpts) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendMissingObjectsFunc) nextHook() func(context.Context, MissingObjectsOpts) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendMissingObjectsFunc) appendCall(r0 GitBackendMissingObjectsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendMissingObjectsFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendMissingObjectsFunc) History() []GitBackendMissingObjectsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendMissingObjectsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendMissingObjectsFuncCall is an object that describes an
+// invocation of method MissingObjects on an instance of MockGitBackend.
+type GitBackendMissingObjectsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 MissingObjectsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendMissingObjectsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendMissingObjectsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitBackendRawDiffFunc describes the behavior when the RawDiff method of
 // the parent MockGitBackend instance is invoked.
 type GitBackendRawDiffFunc struct {
@@ -521,6 +521,68 @@ func (hr *observableCommitLogIterator) Close() error {
 	return err
 }
 
+func (b *observableBackend) CommitGraph(ctx context.Context, opt CommitGraphOpts) (_ CommitGraphIterator, err error) {
+	ctx, errCollector, endObservation := b.operations.commitGraph.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.StringSlice("ranges", opt.Ranges),
+			attribute.Bool("allRefs", opt.AllRefs),
+			attribute.Stringer("after", opt.After),
+			attribute.Int("order", int(opt.Order)),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CommitGraph").Inc()
+
+	it, err := b.backend.CommitGraph(ctx, opt)
+	if err != nil {
+		concurrentOps.WithLabelValues("CommitGraph").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableCommitGraphIterator{
+		inner: it,
+		onClose: func(err error) {
+			concurrentOps.WithLabelValues("CommitGraph").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
+type observableCommitGraphIterator struct {
+	inner   CommitGraphIterator
+	onClose func(err error)
+}
+
+func (hr *observableCommitGraphIterator) Next() (*gitdomain.CommitGraphNode, error) {
+	return hr.inner.Next()
+}
+
+func (hr *observableCommitGraphIterator) Close() error {
+	err := hr.inner.Close()
+	hr.onClose(err)
+	return err
+}
+
+func (b *observableBackend) MissingObjects(ctx context.Context, opt MissingObjectsOpts) (_ []string, err error) {
+	ctx, _, endObservation := b.operations.missingObjects.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.StringSlice("revisions", opt.Revisions),
+			attribute.Bool("walk", opt.Walk),
+			attribute.StringSlice("paths", opt.Paths),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("MissingObjects").Inc()
+	defer concurrentOps.WithLabelValues("MissingObjects").Dec()
+
+	return b.backend.MissingObjects(ctx, opt)
+}
+
 type operations struct {
 	configGet             *observation.Operation
 	configSet             *observation.Operation
@@ -546,7 +608,9 @@ type operations struct {
 	latestCommitTimestamp *observation.Operation
 	refHash               *observation.Operation
 	commitLog             *observation.Operation
+	commitGraph           *observation.Operation
 	mergeBaseOctopus      *observation.Operation
+	missingObjects        *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -599,7 +663,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		latestCommitTimestamp: op("latest-commit-timestamp"),
 		refHash:               op("ref-hash"),
 		commitLog:             op("commit-log"),
+		commitGraph:           op("commit-graph"),
 		mergeBaseOctopus:      op("merge-base-octopus"),
+		missingObjects:        op("missing-objects"),
 	}
 }
 
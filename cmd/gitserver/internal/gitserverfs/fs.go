@@ -34,6 +34,11 @@ type FS interface {
 	RepoCloned(api.RepoName) (bool, error)
 	RemoveRepo(api.RepoName) error
 	ForEachRepo(func(api.RepoName, common.GitDir) (done bool)) error
+	// PoolDir returns the directory of the object pool shared by the forks of
+	// the given upstream repository.
+	PoolDir(upstream api.RepoName) common.GitDir
+	// ForEachPool calls visit for each object pool on disk.
+	ForEachPool(visit func(common.GitDir) (done bool)) error
 	DiskUsage() (diskusage.DiskUsage, error)
 	CanonicalPath(common.GitDir) string
 }
@@ -130,6 +135,35 @@ func (r *realGitserverFS) ForEachRepo(visit func(api.RepoName, common.GitDir) bo
 	})
 }
 
+func (r *realGitserverFS) PoolDir(upstream api.RepoName) common.GitDir {
+	poolsDir := filepath.Join(r.reposDir, poolsDirName)
+	dir := repoDirFromName(poolsDir, upstream)
+	// dir is expected to be cleaned, ie. it doesn't allow `..`.
+	if !strings.HasPrefix(dir.Path(), poolsDir) {
+		panic("dir is outside of pools dir")
+	}
+	return dir
+}
+
+func (r *realGitserverFS) ForEachPool(visit func(common.GitDir) bool) error {
+	poolsDir := filepath.Join(r.reposDir, poolsDirName)
+	if _, err := os.Stat(poolsDir); os.IsNotExist(err) {
+		return nil
+	}
+
+	return BestEffortWalk(poolsDir, func(dir string, fi fs.DirEntry) error {
+		if !fi.IsDir() || fi.Name() != ".git" {
+			return nil
+		}
+
+		if done := visit(common.GitDir(dir)); done {
+			return filepath.SkipAll
+		}
+
+		return filepath.SkipDir
+	})
+}
+
 func (r *realGitserverFS) DiskUsage() (diskusage.DiskUsage, error) {
 	return du.New(r.reposDir)
 }
@@ -201,6 +235,10 @@ const tempDirName = ".tmp"
 // and where it will store cache data.
 const p4HomeName = ".p4home"
 
+// poolsDirName is the name used for the directory that holds the object pools
+// shared by forks.
+const poolsDirName = ".pools"
+
 func repoDirFromName(reposDir string, name api.RepoName) common.GitDir {
 	p := string(protocol.NormalizeRepo(name))
 	return common.GitDir(filepath.Join(reposDir, filepath.FromSlash(p), ".git"))
@@ -232,12 +270,12 @@ func tempDir(reposDir, prefix string) (name string, err error) {
 }
 
 func ignorePath(reposDir string, path string) bool {
-	// We ignore any path which starts with .tmp or .p4home in ReposDir
+	// We ignore any path which starts with .tmp, .p4home or .pools in ReposDir
 	if filepath.Dir(path) != reposDir {
 		return false
 	}
 	base := filepath.Base(path)
-	return strings.HasPrefix(base, tempDirName) || strings.HasPrefix(base, p4HomeName)
+	return strings.HasPrefix(base, tempDirName) || strings.HasPrefix(base, p4HomeName) || strings.HasPrefix(base, poolsDirName)
 }
 
 // removeRepoDirectory atomically removes a directory from reposDir.
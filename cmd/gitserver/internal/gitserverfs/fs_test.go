@@ -48,6 +48,44 @@ func TestGitserverFS_RepoDir(t *testing.T) {
 	}
 }
 
+func TestGitserverFS_ForEachPool(t *testing.T) {
+	root := t.TempDir()
+	fs := New(observation.TestContextTB(t), root)
+
+	mkFiles(t, root,
+		"github.com/foo/bar/.git/HEAD",
+		".pools/github.com/foo/bar/.git/HEAD",
+		".pools/gitlab.com/group/project/.git/HEAD",
+	)
+
+	assert.Equal(t, filepath.Join(root, ".pools/github.com/foo/bar/.git"), string(fs.PoolDir("github.com/foo/bar")))
+
+	var pools []string
+	err := fs.ForEachPool(func(dir common.GitDir) bool {
+		rel, err := filepath.Rel(root, string(dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pools = append(pools, filepath.ToSlash(rel))
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{".pools/github.com/foo/bar/.git", ".pools/gitlab.com/group/project/.git"}, pools)
+
+	// Pools are not repositories.
+	var repos []api.RepoName
+	err = fs.ForEachRepo(func(name api.RepoName, _ common.GitDir) bool {
+		repos = append(repos, name)
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []api.RepoName{"github.com/foo/bar"}, repos)
+}
+
 func TestIgnorePath(t *testing.T) {
 	reposDir := "/data/repos"
 
@@ -57,6 +95,7 @@ func TestIgnorePath(t *testing.T) {
 	}{
 		{path: filepath.Join(reposDir, tempDirName), shouldIgnore: true},
 		{path: filepath.Join(reposDir, p4HomeName), shouldIgnore: true},
+		{path: filepath.Join(reposDir, poolsDirName), shouldIgnore: true},
 		// Double check handling of trailing space
 		{path: filepath.Join(reposDir, p4HomeName+"   "), shouldIgnore: true},
 		{path: filepath.Join(reposDir, "sourcegraph/sourcegraph"), shouldIgnore: false},
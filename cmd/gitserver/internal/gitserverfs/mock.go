@@ -27,6 +27,9 @@ type MockFS struct {
 	// DiskUsageFunc is an instance of a mock function object controlling
 	// the behavior of the method DiskUsage.
 	DiskUsageFunc *FSDiskUsageFunc
+	// ForEachPoolFunc is an instance of a mock function object controlling
+	// the behavior of the method ForEachPool.
+	ForEachPoolFunc *FSForEachPoolFunc
 	// ForEachRepoFunc is an instance of a mock function object controlling
 	// the behavior of the method ForEachRepo.
 	ForEachRepoFunc *FSForEachRepoFunc
@@ -39,6 +42,9 @@ type MockFS struct {
 	// P4HomeDirFunc is an instance of a mock function object controlling
 	// the behavior of the method P4HomeDir.
 	P4HomeDirFunc *FSP4HomeDirFunc
+	// PoolDirFunc is an instance of a mock function object controlling the
+	// behavior of the method PoolDir.
+	PoolDirFunc *FSPoolDirFunc
 	// RemoveRepoFunc is an instance of a mock function object controlling
 	// the behavior of the method RemoveRepo.
 	RemoveRepoFunc *FSRemoveRepoFunc
@@ -75,6 +81,11 @@ func NewMockFS() *MockFS {
 				return
 			},
 		},
+		ForEachPoolFunc: &FSForEachPoolFunc{
+			defaultHook: func(func(common.GitDir) bool) (r0 error) {
+				return
+			},
+		},
 		ForEachRepoFunc: &FSForEachRepoFunc{
 			defaultHook: func(func(api.RepoName, common.GitDir) bool) (r0 error) {
 				return
@@ -95,6 +106,11 @@ func NewMockFS() *MockFS {
 				return
 			},
 		},
+		PoolDirFunc: &FSPoolDirFunc{
+			defaultHook: func(api.RepoName) (r0 common.GitDir) {
+				return
+			},
+		},
 		RemoveRepoFunc: &FSRemoveRepoFunc{
 			defaultHook: func(api.RepoName) (r0 error) {
 				return
@@ -142,6 +158,11 @@ func NewStrictMockFS() *MockFS {
 				panic("unexpected invocation of MockFS.DiskUsage")
 			},
 		},
+		ForEachPoolFunc: &FSForEachPoolFunc{
+			defaultHook: func(func(common.GitDir) bool) error {
+				panic("unexpected invocation of MockFS.ForEachPool")
+			},
+		},
 		ForEachRepoFunc: &FSForEachRepoFunc{
 			defaultHook: func(func(api.RepoName, common.GitDir) bool) error {
 				panic("unexpected invocation of MockFS.ForEachRepo")
@@ -162,6 +183,11 @@ func NewStrictMockFS() *MockFS {
 				panic("unexpected invocation of MockFS.P4HomeDir")
 			},
 		},
+		PoolDirFunc: &FSPoolDirFunc{
+			defaultHook: func(api.RepoName) common.GitDir {
+				panic("unexpected invocation of MockFS.PoolDir")
+			},
+		},
 		RemoveRepoFunc: &FSRemoveRepoFunc{
 			defaultHook: func(api.RepoName) error {
 				panic("unexpected invocation of MockFS.RemoveRepo")
@@ -203,6 +229,9 @@ func NewMockFSFrom(i FS) *MockFS {
 		DiskUsageFunc: &FSDiskUsageFunc{
 			defaultHook: i.DiskUsage,
 		},
+		ForEachPoolFunc: &FSForEachPoolFunc{
+			defaultHook: i.ForEachPool,
+		},
 		ForEachRepoFunc: &FSForEachRepoFunc{
 			defaultHook: i.ForEachRepo,
 		},
@@ -215,6 +244,9 @@ func NewMockFSFrom(i FS) *MockFS {
 		P4HomeDirFunc: &FSP4HomeDirFunc{
 			defaultHook: i.P4HomeDir,
 		},
+		PoolDirFunc: &FSPoolDirFunc{
+			defaultHook: i.PoolDir,
+		},
 		RemoveRepoFunc: &FSRemoveRepoFunc{
 			defaultHook: i.RemoveRepo,
 		},
@@ -539,6 +571,107 @@ func (c FSDiskUsageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// FSForEachPoolFunc describes the behavior when the ForEachPool method of
+// the parent MockFS instance is invoked.
+type FSForEachPoolFunc struct {
+	defaultHook func(func(common.GitDir) bool) error
+	hooks       []func(func(common.GitDir) bool) error
+	history     []FSForEachPoolFuncCall
+	mutex       sync.Mutex
+}
+
+// ForEachPool delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockFS) ForEachPool(v0 func(common.GitDir) bool) error {
+	r0 := m.ForEachPoolFunc.nextHook()(v0)
+	m.ForEachPoolFunc.appendCall(FSForEachPoolFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ForEachPool method
+// of the parent MockFS instance is invoked and the hook queue is empty.
+func (f *FSForEachPoolFunc) SetDefaultHook(hook func(func(common.GitDir) bool) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ForEachPool method of the parent MockFS instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *FSForEachPoolFunc) PushHook(hook func(func(common.GitDir) bool) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FSForEachPoolFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(func(common.GitDir) bool) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FSForEachPoolFunc) PushReturn(r0 error) {
+	f.PushHook(func(func(common.GitDir) bool) error {
+		return r0
+	})
+}
+
+func (f *FSForEachPoolFunc) nextHook() func(func(common.GitDir) bool) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FSForEachPoolFunc) appendCall(r0 FSForEachPoolFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FSForEachPoolFuncCall objects describing
+// the invocations of this function.
+func (f *FSForEachPoolFunc) History() []FSForEachPoolFuncCall {
+	f.mutex.Lock()
+	history := make([]FSForEachPoolFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FSForEachPoolFuncCall is an object that describes an invocation of method
+// ForEachPool on an instance of MockFS.
+type FSForEachPoolFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 func(common.GitDir) bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FSForEachPoolFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FSForEachPoolFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // FSForEachRepoFunc describes the behavior when the ForEachRepo method of
 // the parent MockFS instance is invoked.
 type FSForEachRepoFunc struct {
@@ -940,6 +1073,107 @@ func (c FSP4HomeDirFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// FSPoolDirFunc describes the behavior when the PoolDir method of the
+// parent MockFS instance is invoked.
+type FSPoolDirFunc struct {
+	defaultHook func(api.RepoName) common.GitDir
+	hooks       []func(api.RepoName) common.GitDir
+	history     []FSPoolDirFuncCall
+	mutex       sync.Mutex
+}
+
+// PoolDir delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockFS) PoolDir(v0 api.RepoName) common.GitDir {
+	r0 := m.PoolDirFunc.nextHook()(v0)
+	m.PoolDirFunc.appendCall(FSPoolDirFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the PoolDir method of
+// the parent MockFS instance is invoked and the hook queue is empty.
+func (f *FSPoolDirFunc) SetDefaultHook(hook func(api.RepoName) common.GitDir) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PoolDir method of the parent MockFS instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *FSPoolDirFunc) PushHook(hook func(api.RepoName) common.GitDir) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FSPoolDirFunc) SetDefaultReturn(r0 common.GitDir) {
+	f.SetDefaultHook(func(api.RepoName) common.GitDir {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FSPoolDirFunc) PushReturn(r0 common.GitDir) {
+	f.PushHook(func(api.RepoName) common.GitDir {
+		return r0
+	})
+}
+
+func (f *FSPoolDirFunc) nextHook() func(api.RepoName) common.GitDir {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FSPoolDirFunc) appendCall(r0 FSPoolDirFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FSPoolDirFuncCall objects describing the
+// invocations of this function.
+func (f *FSPoolDirFunc) History() []FSPoolDirFuncCall {
+	f.mutex.Lock()
+	history := make([]FSPoolDirFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FSPoolDirFuncCall is an object that describes an invocation of method
+// PoolDir on an instance of MockFS.
+type FSPoolDirFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 common.GitDir
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FSPoolDirFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FSPoolDirFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // FSRemoveRepoFunc describes the behavior when the RemoveRepo method of the
 // parent MockFS instance is invoked.
 type FSRemoveRepoFunc struct {
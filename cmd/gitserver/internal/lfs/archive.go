@@ -0,0 +1,165 @@
+package lfs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ResolveArchive returns a reader for the archive read from rc, in which all
+// pointer files are replaced by the content of the objects they point to. As
+// with ResolveFile, pointers to objects that cannot be fetched are left as is.
+// rc is closed once it has been consumed.
+func (r *Resolver) ResolveArchive(ctx context.Context, repo api.RepoName, dir common.GitDir, format git.ArchiveFormat, rc io.ReadCloser) io.ReadCloser {
+	rewrite := r.rewriteTar
+	if format == git.ArchiveFormatZip {
+		rewrite = r.rewriteZip
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer rc.Close()
+		_ = pw.CloseWithError(rewrite(ctx, repo, dir, rc, pw))
+	}()
+
+	return pr
+}
+
+func (r *Resolver) rewriteTar(ctx context.Context, repo api.RepoName, dir common.GitDir, in io.Reader, out io.Writer) error {
+	tr := tar.NewReader(in)
+	tw := tar.NewWriter(out)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		content := io.ReadCloser(io.NopCloser(tr))
+		if hdr.Typeflag == tar.TypeReg && hdr.Size < MaxPointerSize {
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			content = io.NopCloser(bytes.NewReader(data))
+
+			if p, ok := ParsePointer(data); ok {
+				if obj, err := r.open(ctx, repo, dir, p); err == nil {
+					content = obj
+					hdr.Size = p.Size
+					// Let the writer pick a format that can represent the size
+					// of the object.
+					hdr.Format = tar.FormatUnknown
+				}
+			}
+		}
+
+		err = tw.WriteHeader(hdr)
+		if err == nil {
+			_, err = io.Copy(tw, content)
+		}
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+func (r *Resolver) rewriteZip(ctx context.Context, repo api.RepoName, dir common.GitDir, in io.Reader, out io.Writer) error {
+	// Reading a zip archive requires random access, so we spool it to disk
+	// first. This is fine, as only raw downloads resolve LFS pointers.
+	tmpDir := dir.Path("lfs", "tmp")
+	if err := os.MkdirAll(tmpDir, 0o750); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(tmpDir, "archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := io.Copy(f, in)
+	if err != nil {
+		return errors.Wrap(err, "spooling zip archive")
+	}
+
+	zr, err := zip.NewReader(f, size)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(out)
+	if err := zw.SetComment(zr.Comment); err != nil {
+		return err
+	}
+
+	for _, file := range zr.File {
+		obj, err := r.openZipPointer(ctx, repo, dir, file)
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			if err := zw.Copy(file); err != nil {
+				return err
+			}
+			continue
+		}
+
+		hdr := file.FileHeader
+		hdr.Method = zip.Deflate
+		hdr.CRC32 = 0
+		hdr.CompressedSize64 = 0
+		hdr.UncompressedSize64 = 0
+		w, err := zw.CreateHeader(&hdr)
+		if err == nil {
+			_, err = io.Copy(w, obj)
+		}
+		obj.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// openZipPointer returns a reader for the object file points to, or nil if
+// file is not a pointer or the object cannot be fetched.
+func (r *Resolver) openZipPointer(ctx context.Context, repo api.RepoName, dir common.GitDir, file *zip.File) (io.ReadCloser, error) {
+	if !file.Mode().IsRegular() || file.UncompressedSize64 >= MaxPointerSize {
+		return nil, nil
+	}
+
+	fr, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(fr)
+	fr.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := ParsePointer(data)
+	if !ok {
+		return nil, nil
+	}
+	obj, err := r.open(ctx, repo, dir, p)
+	if err != nil {
+		return nil, nil
+	}
+	return obj, nil
+}
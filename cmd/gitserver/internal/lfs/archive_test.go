@@ -0,0 +1,93 @@
+package lfs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+)
+
+func TestResolver_ResolveArchive(t *testing.T) {
+	ctx := context.Background()
+	content := "the actual content"
+	server := newTestLFSServer(t, content)
+
+	files := map[string]string{
+		"README.md":   "# Hello\n",
+		"image.png":   pointerFor(content),
+		"missing.bin": pointerFor("not on the server"),
+	}
+	want := map[string]string{
+		"README.md":   "# Hello\n",
+		"image.png":   content,
+		"missing.bin": pointerFor("not on the server"),
+	}
+
+	t.Run("tar", func(t *testing.T) {
+		r, dir := newTestResolver(t, server, 1024)
+
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, name := range []string{"README.md", "image.png", "missing.bin"} {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}))
+			_, err := io.WriteString(tw, files[name])
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+
+		rc := r.ResolveArchive(ctx, "repo", dir, git.ArchiveFormatTar, io.NopCloser(&buf))
+		defer rc.Close()
+
+		got := map[string]string{}
+		tr := tar.NewReader(rc)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			data, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			got[hdr.Name] = string(data)
+		}
+		require.Equal(t, want, got)
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		r, dir := newTestResolver(t, server, 1024)
+
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for _, name := range []string{"README.md", "image.png", "missing.bin"} {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = io.WriteString(w, files[name])
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+
+		rc := r.ResolveArchive(ctx, "repo", dir, git.ArchiveFormatZip, io.NopCloser(&buf))
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		got := map[string]string{}
+		for _, f := range zr.File {
+			fr, err := f.Open()
+			require.NoError(t, err)
+			b, err := io.ReadAll(fr)
+			fr.Close()
+			require.NoError(t, err)
+			got[f.Name] = string(b)
+		}
+		require.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,167 @@
+package lfs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const mediaType = "application/vnd.git-lfs+json"
+
+// client talks to the LFS batch API, see
+// https://github.com/git-lfs/git-lfs/blob/main/docs/api/batch.md.
+type client struct {
+	doer httpcli.Doer
+}
+
+type batchRequest struct {
+	Operation string        `json:"operation"`
+	Transfers []string      `json:"transfers"`
+	Objects   []batchObject `json:"objects"`
+}
+
+type batchObject struct {
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+type batchResponse struct {
+	Objects []struct {
+		OID     string `json:"oid"`
+		Actions struct {
+			Download *batchAction `json:"download"`
+		} `json:"actions"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"objects"`
+}
+
+type batchAction struct {
+	Href   string            `json:"href"`
+	Header map[string]string `json:"header"`
+}
+
+// endpoint returns the LFS server URL for the given remote, following the
+// defaults of git-lfs. Only HTTP remotes are supported, as LFS over SSH needs
+// the git-lfs-authenticate command on the remote.
+func endpoint(remoteURL *vcs.URL) (*url.URL, error) {
+	if remoteURL.Scheme != "http" && remoteURL.Scheme != "https" {
+		return nil, errors.Errorf("unsupported scheme %q for LFS", remoteURL.Scheme)
+	}
+
+	u := remoteURL.URL
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(u.Path, ".git") {
+		u.Path += ".git"
+	}
+	u.Path += "/info/lfs"
+	u.RawPath = ""
+	return &u, nil
+}
+
+// download returns a reader for the object p points to, fetched from the LFS
+// server of the given remote. The caller must close it.
+func (c *client) download(ctx context.Context, remoteURL *vcs.URL, p Pointer) (io.ReadCloser, error) {
+	action, err := c.batch(ctx, remoteURL, p)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, action.Href, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return nil, errors.Errorf("unsupported scheme %q for LFS download", req.URL.Scheme)
+	}
+	// 🚨 SECURITY: The LFS server decides where the object is downloaded from,
+	// which is often a different host like a storage bucket. The headers of the
+	// action can contain credentials for the remote, so we only send them to
+	// the remote's host. Objects on other hosts are expected to be downloadable
+	// with the URL alone. We never send the credentials of the remote itself.
+	if strings.EqualFold(req.URL.Host, remoteURL.Host) {
+		for k, v := range action.Header {
+			req.Header.Set(k, v)
+		}
+	}
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "downloading LFS object")
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Errorf("downloading LFS object: unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+func (c *client) batch(ctx context.Context, remoteURL *vcs.URL, p Pointer) (*batchAction, error) {
+	u, err := endpoint(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+	user := u.User
+	u.User = nil
+
+	body, err := json.Marshal(batchRequest{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   []batchObject{{OID: p.OID, Size: p.Size}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String()+"/objects/batch", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaType)
+	req.Header.Set("Content-Type", mediaType)
+	if user != nil {
+		password, _ := user.Password()
+		req.SetBasicAuth(user.Username(), password)
+	}
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "requesting LFS batch API")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("requesting LFS batch API: unexpected status %d", resp.StatusCode)
+	}
+
+	var br batchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&br); err != nil {
+		return nil, errors.Wrap(err, "decoding LFS batch API response")
+	}
+
+	for _, o := range br.Objects {
+		if o.OID != p.OID {
+			continue
+		}
+		if o.Error != nil {
+			return nil, errors.Errorf("LFS object %s: %s (%d)", p.OID, o.Error.Message, o.Error.Code)
+		}
+		if o.Actions.Download == nil {
+			return nil, errors.Errorf("LFS object %s: no download action", p.OID)
+		}
+		return o.Actions.Download, nil
+	}
+
+	return nil, errors.Errorf("LFS object %s missing from batch API response", p.OID)
+}
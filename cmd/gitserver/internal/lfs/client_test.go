@@ -0,0 +1,59 @@
+package lfs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+func TestClientDownloadOtherHost(t *testing.T) {
+	content := "the actual content"
+
+	// The object is stored on another host, which must not receive the headers
+	// of the download action.
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = io.WriteString(w, content)
+	}))
+	t.Cleanup(storage.Close)
+
+	lfsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", mediaType)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"objects": []map[string]any{{
+				"oid": oidOf(content),
+				"actions": map[string]any{
+					"download": map[string]any{
+						"href":   storage.URL + "/bucket/object",
+						"header": map[string]string{"Authorization": "Bearer remote-token"},
+					},
+				},
+			}},
+		})
+	}))
+	t.Cleanup(lfsServer.Close)
+
+	remoteURL, err := vcs.ParseURL(lfsServer.URL + "/repo")
+	require.NoError(t, err)
+
+	c := &client{doer: httpcli.TestExternalDoer}
+	p := Pointer{OID: oidOf(content), Size: int64(len(content))}
+	rc, err := c.download(context.Background(), remoteURL, p)
+	require.NoError(t, err)
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.Equal(t, content, string(got))
+}
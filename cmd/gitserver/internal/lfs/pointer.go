@@ -0,0 +1,85 @@
+// Package lfs resolves Git LFS pointer files to the content of the objects they
+// point to. Objects are fetched on demand from the LFS server of the remote of
+// a repository and cached in the repository's git directory.
+package lfs
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// MaxPointerSize is the maximum size of a pointer file. Larger blobs are never
+// parsed as pointers, this is the same limit git-lfs uses.
+const MaxPointerSize = 1024
+
+// pointerVersions are the spec URLs accepted in the version line of a pointer.
+// The hawser one is from before git-lfs was renamed and still shows up in old
+// repositories.
+var pointerVersions = []string{
+	"https://git-lfs.github.com/spec/v1",
+	"https://hawser.github.com/spec/v1",
+}
+
+// Pointer is a parsed Git LFS pointer file.
+type Pointer struct {
+	// OID is the hex encoded SHA-256 hash of the object.
+	OID string
+	// Size is the size of the object in bytes.
+	Size int64
+}
+
+// ParsePointer parses data as a pointer file, see
+// https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md. It returns false
+// if data is not a valid pointer.
+func ParsePointer(data []byte) (Pointer, bool) {
+	if len(data) >= MaxPointerSize || !bytes.HasSuffix(data, []byte("\n")) {
+		return Pointer{}, false
+	}
+
+	var (
+		p                           Pointer
+		hasVersion, hasOID, hasSize bool
+	)
+	for i, line := range strings.Split(string(data[:len(data)-1]), "\n") {
+		key, value, ok := strings.Cut(line, " ")
+		if !ok {
+			return Pointer{}, false
+		}
+
+		switch key {
+		case "version":
+			// The version must be the first line.
+			if i != 0 {
+				return Pointer{}, false
+			}
+			for _, v := range pointerVersions {
+				hasVersion = hasVersion || value == v
+			}
+		case "oid":
+			oid, ok := strings.CutPrefix(value, "sha256:")
+			if !ok || len(oid) != 64 || strings.ToLower(oid) != oid {
+				return Pointer{}, false
+			}
+			if _, err := hex.DecodeString(oid); err != nil {
+				return Pointer{}, false
+			}
+			p.OID, hasOID = oid, true
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || size < 0 {
+				return Pointer{}, false
+			}
+			p.Size, hasSize = size, true
+		default:
+			// Other keys are extensions, which don't change where the object
+			// is stored.
+		}
+	}
+
+	if !hasVersion || !hasOID || !hasSize {
+		return Pointer{}, false
+	}
+	return p, true
+}
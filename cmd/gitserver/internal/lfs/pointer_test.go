@@ -0,0 +1,80 @@
+package lfs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePointer(t *testing.T) {
+	const oid = "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393"
+
+	for _, tc := range []struct {
+		name   string
+		data   string
+		want   Pointer
+		wantOK bool
+	}{
+		{
+			name:   "valid",
+			data:   "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 12345\n",
+			want:   Pointer{OID: oid, Size: 12345},
+			wantOK: true,
+		},
+		{
+			name:   "legacy version",
+			data:   "version https://hawser.github.com/spec/v1\noid sha256:" + oid + "\nsize 1\n",
+			want:   Pointer{OID: oid, Size: 1},
+			wantOK: true,
+		},
+		{
+			name:   "extensions",
+			data:   "version https://git-lfs.github.com/spec/v1\next-0-foo sha256:" + oid + "\noid sha256:" + oid + "\nsize 2\n",
+			want:   Pointer{OID: oid, Size: 2},
+			wantOK: true,
+		},
+		{
+			name: "missing trailing newline",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 12345",
+		},
+		{
+			name: "version not first",
+			data: "oid sha256:" + oid + "\nversion https://git-lfs.github.com/spec/v1\nsize 12345\n",
+		},
+		{
+			name: "unknown version",
+			data: "version https://git-lfs.github.com/spec/v2\noid sha256:" + oid + "\nsize 12345\n",
+		},
+		{
+			name: "unknown hash",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha1:" + oid[:40] + "\nsize 12345\n",
+		},
+		{
+			name: "invalid oid",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + strings.ToUpper(oid) + "\nsize 12345\n",
+		},
+		{
+			name: "missing size",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\n",
+		},
+		{
+			name: "negative size",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize -1\n",
+		},
+		{
+			name: "too large",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 1\n" + "x-pad " + strings.Repeat("x", MaxPointerSize) + "\n",
+		},
+		{
+			name: "regular file",
+			data: "package main\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, ok := ParsePointer([]byte(tc.data))
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, p)
+		})
+	}
+}
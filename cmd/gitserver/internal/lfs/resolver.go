@@ -0,0 +1,239 @@
+package lfs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// defaultMaxBytesPerRepo is the default budget for the cached LFS objects of a
+// single repository.
+const defaultMaxBytesPerRepo = 1 << 30
+
+var (
+	lfsFetchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "src_gitserver_lfs_fetch_duration_seconds",
+		Help:    "Time spent fetching LFS objects from the LFS server of a repository, in seconds.",
+		Buckets: prometheus.ExponentialBucketsRange(0.05, 300, 12),
+	}, []string{"error"})
+	lfsResolvedPointers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_lfs_resolved_pointers_total",
+		Help: "Total number of LFS pointers gitserver tried to resolve, by result.",
+	}, []string{"result"})
+)
+
+// errSkipped is returned for objects that are not resolved on purpose, e.g.
+// because they don't fit into the budget.
+var errSkipped = errors.New("LFS object skipped")
+
+// Resolver replaces Git LFS pointers with the content of the objects they point
+// to.
+type Resolver struct {
+	logger             log.Logger
+	getRemoteURLSource func(ctx context.Context, name api.RepoName) (vcssyncer.RemoteURLSource, error)
+	client             *client
+	config             func() *schema.GitserverLfs
+
+	// fetches coalesces concurrent fetches of the same object.
+	fetches singleflight.Group
+	// mu protects reserved and serializes evictions, so that concurrent
+	// fetches don't evict more objects than necessary.
+	mu sync.Mutex
+	// reserved is the number of bytes reserved for objects that are being
+	// fetched, by repository. They count against the budget before they are
+	// stored, so that concurrent fetches can't exceed it together.
+	reserved map[common.GitDir]int64
+}
+
+// NewResolver returns a Resolver that fetches LFS objects from the LFS server
+// of the remote of a repository, as returned by getRemoteURLSource. It is
+// configured by the gitserver.lfs site configuration.
+func NewResolver(
+	logger log.Logger,
+	getRemoteURLSource func(ctx context.Context, name api.RepoName) (vcssyncer.RemoteURLSource, error),
+	doer httpcli.Doer,
+) *Resolver {
+	return &Resolver{
+		logger:             logger.Scoped("lfs"),
+		getRemoteURLSource: getRemoteURLSource,
+		client:             &client{doer: doer},
+		config: func() *schema.GitserverLfs {
+			return conf.Get().GitserverLfs
+		},
+	}
+}
+
+// Enabled returns true if LFS objects should be resolved at all.
+func (r *Resolver) Enabled() bool {
+	c := r.config()
+	return c != nil && c.Enabled
+}
+
+func (r *Resolver) maxBytesPerRepo() int64 {
+	if c := r.config(); c != nil && c.MaxBytesPerRepo > 0 {
+		return int64(c.MaxBytesPerRepo)
+	}
+	return defaultMaxBytesPerRepo
+}
+
+// ResolveFile returns a reader for the content of the object if rc reads a
+// pointer file, and a reader for the content of rc otherwise. rc is closed
+// when the returned reader is closed.
+//
+// Objects that cannot be fetched are logged and the pointer is returned
+// instead, so that LFS problems don't make files unreadable.
+func (r *Resolver) ResolveFile(ctx context.Context, repo api.RepoName, dir common.GitDir, rc io.ReadCloser) (io.ReadCloser, error) {
+	head := make([]byte, MaxPointerSize)
+	n, err := io.ReadFull(rc, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		rc.Close()
+		return nil, err
+	}
+	head = head[:n]
+
+	// Files that fill the buffer are too large to be pointers.
+	if err == nil {
+		return &readCloser{Reader: io.MultiReader(bytes.NewReader(head), rc), close: rc.Close}, nil
+	}
+	rc.Close()
+
+	if p, ok := ParsePointer(head); ok {
+		if obj, err := r.open(ctx, repo, dir, p); err == nil {
+			return obj, nil
+		}
+	}
+
+	return io.NopCloser(bytes.NewReader(head)), nil
+}
+
+// open returns a reader for the object p points to, fetching it if it isn't
+// cached yet. Failures are recorded and logged here, so callers only need to
+// fall back to the pointer.
+func (r *Resolver) open(ctx context.Context, repo api.RepoName, dir common.GitDir, p Pointer) (_ io.ReadCloser, err error) {
+	result := "cached"
+	defer func() {
+		if err != nil {
+			result = "error"
+			if errors.Is(err, errSkipped) {
+				result = "skipped"
+			} else {
+				r.logger.Warn("failed to resolve LFS pointer",
+					log.String("repo", string(repo)),
+					log.String("oid", p.OID),
+					log.Error(err))
+			}
+		}
+		lfsResolvedPointers.WithLabelValues(result).Inc()
+	}()
+
+	s := store{dir: dir}
+	if f, err := s.open(p.OID); err == nil {
+		return f, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	budget := r.maxBytesPerRepo()
+	if p.Size > budget {
+		return nil, errSkipped
+	}
+
+	result = "fetched"
+	_, err, _ = r.fetches.Do(string(dir)+":"+p.OID, func() (any, error) {
+		return nil, r.fetch(ctx, repo, s, p, budget)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.open(p.OID)
+}
+
+func (r *Resolver) fetch(ctx context.Context, repo api.RepoName, s store, p Pointer, budget int64) (err error) {
+	start := time.Now()
+	defer func() {
+		lfsFetchDuration.WithLabelValues(strconv.FormatBool(err != nil)).Observe(time.Since(start).Seconds())
+	}()
+
+	source, err := r.getRemoteURLSource(ctx, repo)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get remote URL source for %s", repo)
+	}
+	remoteURL, err := source.RemoteURL(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get remote URL for %s", repo)
+	}
+
+	if err := r.reserve(s, p.Size, budget); err != nil {
+		return err
+	}
+	defer r.release(s, p.Size)
+
+	body, err := r.client.download(ctx, remoteURL, p)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return errors.Wrap(s.put(p, body), "storing LFS object")
+}
+
+// reserve makes room for an object of the given size in the store and reserves
+// it until release is called. It returns errSkipped if the objects being
+// fetched concurrently leave no room for it.
+func (r *Resolver) reserve(s store, size, budget int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reserved := r.reserved[s.dir]
+	if reserved+size > budget {
+		return errSkipped
+	}
+	if err := s.makeRoom(reserved+size, budget); err != nil {
+		return errors.Wrap(err, "evicting LFS objects")
+	}
+
+	if r.reserved == nil {
+		r.reserved = make(map[common.GitDir]int64)
+	}
+	r.reserved[s.dir] = reserved + size
+	return nil
+}
+
+// release releases the room reserved for an object by reserve, once it is
+// stored or failed to be fetched.
+func (r *Resolver) release(s store, size int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reserved[s.dir] -= size
+	if r.reserved[s.dir] <= 0 {
+		delete(r.reserved, s.dir)
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (rc *readCloser) Close() error {
+	return rc.close()
+}
@@ -0,0 +1,199 @@
+package lfs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestResolver_ResolveFile(t *testing.T) {
+	ctx := context.Background()
+	content := "the actual content"
+	other := strings.Repeat("a", 110)
+	server := newTestLFSServer(t, content, other)
+
+	readFile := func(t *testing.T, r *Resolver, dir common.GitDir, data string) string {
+		t.Helper()
+		rc, err := r.ResolveFile(ctx, "repo", dir, io.NopCloser(strings.NewReader(data)))
+		require.NoError(t, err)
+		defer rc.Close()
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	t.Run("pointer", func(t *testing.T) {
+		r, dir := newTestResolver(t, server, 1024)
+
+		require.Equal(t, content, readFile(t, r, dir, pointerFor(content)))
+		require.Equal(t, int64(1), server.downloads.Load())
+
+		// The object is cached now.
+		require.Equal(t, content, readFile(t, r, dir, pointerFor(content)))
+		require.Equal(t, int64(1), server.downloads.Load())
+	})
+
+	t.Run("regular files", func(t *testing.T) {
+		r, dir := newTestResolver(t, server, 1024)
+
+		require.Equal(t, "package main\n", readFile(t, r, dir, "package main\n"))
+		large := strings.Repeat("x", 3*MaxPointerSize)
+		require.Equal(t, large, readFile(t, r, dir, large))
+		require.Equal(t, "", readFile(t, r, dir, ""))
+	})
+
+	t.Run("unknown object", func(t *testing.T) {
+		r, dir := newTestResolver(t, server, 1024)
+
+		pointer := pointerFor("not on the server")
+		require.Equal(t, pointer, readFile(t, r, dir, pointer))
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		r, dir := newTestResolver(t, server, 120)
+
+		// Too large for the budget on its own.
+		pointer := pointerFor(strings.Repeat("b", 121))
+		require.Equal(t, pointer, readFile(t, r, dir, pointer))
+
+		// Both objects don't fit into the budget, so the first one is evicted.
+		require.Equal(t, content, readFile(t, r, dir, pointerFor(content)))
+		require.Equal(t, other, readFile(t, r, dir, pointerFor(other)))
+
+		objects, err := store{dir: dir}.objects()
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, int64(len(other)), objects[0].size)
+	})
+}
+
+func TestResolver_Reserve(t *testing.T) {
+	r := &Resolver{}
+	s := store{dir: common.GitDir(t.TempDir())}
+
+	// An object being fetched counts against the budget.
+	require.NoError(t, r.reserve(s, 80, 100))
+	require.ErrorIs(t, r.reserve(s, 30, 100), errSkipped)
+
+	// Other repositories have their own budget.
+	require.NoError(t, r.reserve(store{dir: common.GitDir(t.TempDir())}, 30, 100))
+
+	r.release(s, 80)
+	require.NoError(t, r.reserve(s, 30, 100))
+	r.release(s, 30)
+	require.Empty(t, r.reserved[s.dir])
+}
+
+type testLFSServer struct {
+	*httptest.Server
+	downloads atomic.Int64
+}
+
+// newTestLFSServer returns an LFS server for a repository at /repo that serves
+// the given objects.
+func newTestLFSServer(t *testing.T, objects ...string) *testLFSServer {
+	byOID := map[string]string{}
+	for _, o := range objects {
+		byOID[oidOf(o)] = o
+	}
+
+	s := &testLFSServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repo.git/info/lfs/objects/batch", func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var req batchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		type object struct {
+			OID     string         `json:"oid"`
+			Actions map[string]any `json:"actions,omitempty"`
+			Error   map[string]any `json:"error,omitempty"`
+		}
+		var resp struct {
+			Objects []object `json:"objects"`
+		}
+		for _, o := range req.Objects {
+			if _, ok := byOID[o.OID]; !ok {
+				resp.Objects = append(resp.Objects, object{OID: o.OID, Error: map[string]any{"code": 404, "message": "not found"}})
+				continue
+			}
+			resp.Objects = append(resp.Objects, object{OID: o.OID, Actions: map[string]any{
+				"download": map[string]any{
+					"href":   s.URL + "/objects/" + o.OID,
+					"header": map[string]string{"Authorization": "Bearer download-token"},
+				},
+			}})
+		}
+
+		w.Header().Set("Content-Type", mediaType)
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("GET /objects/{oid}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer download-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.downloads.Add(1)
+		_, _ = io.WriteString(w, byOID[r.PathValue("oid")])
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestResolver(t *testing.T, server *testLFSServer, maxBytesPerRepo int) (*Resolver, common.GitDir) {
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	u.User = url.UserPassword("user", "secret")
+	u.Path = "/repo"
+
+	r := NewResolver(logtest.Scoped(t), func(ctx context.Context, name api.RepoName) (vcssyncer.RemoteURLSource, error) {
+		return vcssyncer.RemoteURLSourceFunc(func(ctx context.Context) (*vcs.URL, error) {
+			return vcs.ParseURL(u.String())
+		}), nil
+	}, httpcli.TestExternalDoer)
+	r.config = func() *schema.GitserverLfs {
+		return &schema.GitserverLfs{Enabled: true, MaxBytesPerRepo: maxBytesPerRepo}
+	}
+
+	dir := common.GitDir(t.TempDir())
+	require.NoError(t, os.MkdirAll(string(dir), 0o750))
+	return r, dir
+}
+
+func oidOf(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func pointerFor(content string) string {
+	return fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n", oidOf(content), len(content))
+}
@@ -0,0 +1,152 @@
+package lfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// store is the cache of LFS objects of a single repository. It uses the same
+// layout as git-lfs, so objects are stored at lfs/objects/<oid[0:2]>/<oid[2:4]>/<oid>
+// below the git directory.
+type store struct {
+	dir common.GitDir
+}
+
+func (s store) objectsDir() string {
+	return s.dir.Path("lfs", "objects")
+}
+
+func (s store) path(oid string) string {
+	return filepath.Join(s.objectsDir(), oid[0:2], oid[2:4], oid)
+}
+
+// open opens the cached object with the given OID. The modification time of
+// the object is bumped, so that recently read objects are evicted last.
+func (s store) open(oid string) (*os.File, error) {
+	path := s.path(oid)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return f, nil
+}
+
+// put stores the object p points to, read from r. The content is verified
+// against the pointer before it is made visible.
+func (s store) put(p Pointer, r io.Reader) (err error) {
+	tmpDir := s.dir.Path("lfs", "tmp")
+	if err := os.MkdirAll(tmpDir, 0o750); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(tmpDir, p.OID+"-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	// The download location is chosen by the LFS server, so we never trust it
+	// to send only as many bytes as the pointer announces. Reading one byte
+	// more than expected is enough to detect an oversized object without
+	// writing it to disk.
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(r, p.Size+1))
+	if err != nil {
+		return err
+	}
+	if n > p.Size {
+		return errors.Errorf("object %s is larger than expected size %d", p.OID, p.Size)
+	}
+	if n != p.Size {
+		return errors.Errorf("object %s has size %d, expected %d", p.OID, n, p.Size)
+	}
+	if oid := hex.EncodeToString(h.Sum(nil)); oid != p.OID {
+		return errors.Errorf("object %s has hash %s", p.OID, oid)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	path := s.path(p.OID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+type storedObject struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// objects returns all cached objects.
+func (s store) objects() ([]storedObject, error) {
+	var objects []storedObject
+	err := filepath.WalkDir(s.objectsDir(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Evicted concurrently
+				return nil
+			}
+			return err
+		}
+		objects = append(objects, storedObject{path: path, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return objects, err
+}
+
+// makeRoom evicts the least recently read objects until an object of the given
+// size fits into the budget.
+func (s store) makeRoom(size, budget int64) error {
+	objects, err := s.objects()
+	if err != nil {
+		return err
+	}
+
+	var used int64
+	for _, o := range objects {
+		used += o.size
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].modTime.Before(objects[j].modTime)
+	})
+	for _, o := range objects {
+		if used+size <= budget {
+			break
+		}
+		if err := os.Remove(o.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		used -= o.size
+	}
+
+	return nil
+}
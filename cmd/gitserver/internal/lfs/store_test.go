@@ -0,0 +1,66 @@
+package lfs
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+)
+
+func TestStore_Put(t *testing.T) {
+	s := store{dir: common.GitDir(t.TempDir())}
+	content := "the actual content"
+	p := Pointer{OID: oidOf(content), Size: int64(len(content))}
+
+	t.Run("oversized", func(t *testing.T) {
+		// The body never ends, so put must stop reading on its own.
+		r := &countingReader{r: endlessReader{}}
+		require.Error(t, s.put(p, r))
+		require.Equal(t, p.Size+1, r.n)
+
+		_, err := os.Stat(s.path(p.OID))
+		require.True(t, os.IsNotExist(err))
+		tmp, err := os.ReadDir(s.dir.Path("lfs", "tmp"))
+		require.NoError(t, err)
+		require.Empty(t, tmp)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		require.Error(t, s.put(p, strings.NewReader(content[:5])))
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		require.NoError(t, s.put(p, strings.NewReader(content)))
+
+		f, err := s.open(p.OID)
+		require.NoError(t, err)
+		defer f.Close()
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, content, string(b))
+	})
+}
+
+type endlessReader struct{}
+
+func (endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'x'
+	}
+	return len(p), nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -53,6 +53,12 @@ func postRepoFetchActions(
 		errs = errors.Append(errs, errors.Wrap(err, "failed to update last changed time"))
 	}
 
+	// Share objects with other forks of the same upstream. We do this before
+	// calculating the size, as it usually shrinks the repo considerably.
+	if err := linkForkPool(ctx, logger, fs, db, repo, dir); err != nil {
+		errs = errors.Append(errs, errors.Wrap(err, "failed to link fork to object pool"))
+	}
+
 	// Successfully updated, best-effort calculation of the repo size.
 	repoSizeBytes, err := fs.DirSize(dir.Path())
 	if err != nil {
@@ -24,11 +24,12 @@ type GRPCRepositoryServiceConfig struct {
 
 func NewRepositoryServiceServer(server *Server, config *GRPCRepositoryServiceConfig) proto.GitserverRepositoryServiceServer {
 	var srv proto.GitserverRepositoryServiceServer = &repositoryServiceServer{
-		logger:   server.logger,
-		db:       server.db,
-		hostname: server.hostname,
-		svc:      server,
-		fs:       server.fs,
+		logger:      server.logger,
+		db:          server.db,
+		hostname:    server.hostname,
+		svc:         server,
+		fs:          server.fs,
+		coldStorage: server.coldStorage,
 	}
 
 	if config.ExhaustiveRequestLoggingEnabled {
@@ -49,6 +50,8 @@ type repositoryServiceServer struct {
 	hostname string
 	fs       gitserverfs.FS
 	svc      service
+	// coldStorage is nil if repos are never offloaded.
+	coldStorage *ColdStorage
 
 	proto.UnimplementedGitserverRepositoryServiceServer
 }
@@ -67,15 +70,26 @@ func (s *repositoryServiceServer) DeleteRepository(ctx context.Context, req *pro
 		return nil, status.New(codes.Internal, "failed to determine clone status").Err()
 	}
 
-	if !cloned {
-		return nil, newRepoNotFoundError(repoName, false, "")
-	}
-
-	err = s.fs.RemoveRepo(repoName)
-	if err != nil {
-		err = errors.Wrap(err, "removing repo directory")
-		s.logger.Error("failed to delete repository", log.String("repo", string(repoName)), log.Error(err))
-		return &proto.DeleteRepositoryResponse{}, status.Errorf(codes.Internal, "failed to delete repository %s: %s", repoName, err)
+	if cloned {
+		err = s.fs.RemoveRepo(repoName)
+		if err != nil {
+			err = errors.Wrap(err, "removing repo directory")
+			s.logger.Error("failed to delete repository", log.String("repo", string(repoName)), log.Error(err))
+			return &proto.DeleteRepositoryResponse{}, status.Errorf(codes.Internal, "failed to delete repository %s: %s", repoName, err)
+		}
+	} else {
+		// Repos that have been offloaded only exist in cold storage.
+		tiered := false
+		if s.coldStorage != nil {
+			tiered, err = s.coldStorage.Delete(ctx, repoName)
+			if err != nil {
+				s.logger.Error("failed to delete repository", log.String("repo", string(repoName)), log.Error(err))
+				return &proto.DeleteRepositoryResponse{}, status.Errorf(codes.Internal, "failed to delete repository %s: %s", repoName, err)
+			}
+		}
+		if !tiered {
+			return nil, newRepoNotFoundError(repoName, false, "")
+		}
 	}
 
 	err = s.db.GitserverRepos().SetCloneStatus(ctx, repoName, types.CloneStatusNotCloned, s.hostname)
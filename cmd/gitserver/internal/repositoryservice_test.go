@@ -21,6 +21,8 @@ import (
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	objectmocks "github.com/sourcegraph/sourcegraph/internal/object/mocks"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func TestRepositoryServiceServer_DeleteRepository(t *testing.T) {
@@ -45,6 +47,29 @@ func TestRepositoryServiceServer_DeleteRepository(t *testing.T) {
 		mockassert.Called(t, fs.RepoClonedFunc)
 	})
 
+	t.Run("deletes tiered repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		gitserverRepos := dbmocks.NewMockGitserverRepoStore()
+		gitserverRepos.GetByNameFunc.SetDefaultReturn(&types.GitserverRepo{RepoID: 1, CloneStatus: types.CloneStatusTiered}, nil)
+		db := dbmocks.NewMockDB()
+		db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+		storage := objectmocks.NewMockStorage()
+		rs := &repositoryServiceServer{
+			svc:         NewMockService(),
+			fs:          fs,
+			db:          db,
+			logger:      logtest.NoOp(t),
+			coldStorage: NewColdStorage(logtest.NoOp(t), fs, db, NewRepositoryLocker(), storage, "test-gitserver", time.Hour),
+		}
+
+		_, err := rs.DeleteRepository(ctx, &proto.DeleteRepositoryRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		mockassert.NotCalled(t, fs.RemoveRepoFunc)
+		mockassert.CalledN(t, storage.DeleteFunc, 2)
+		mockassert.CalledOnceWith(t, gitserverRepos.SetCloneStatusFunc, mockassert.Values(mockassert.Skip, api.RepoName("therepo"), types.CloneStatusNotCloned))
+	})
+
 	t.Run("e2e", func(t *testing.T) {
 		fs := gitserverfs.NewMockFS()
 		// Repo is cloned, proceed!
@@ -49,6 +49,8 @@ func (d *DiffFetcher) start() (err error) {
 			"-p",               // Output in patch format
 			"--format=format:", // Output only the patch, not any other commit metadata
 			"--root",           // Treat the root commit as a big creation event (otherwise the diff would be empty)
+			"--find-renames",   // Report renamed files as renames rather than as a deletion and an addition
+			"--find-copies",    // Report copied files as copies of the file they were copied from
 		)
 		d.cmd.Dir = d.dir
 
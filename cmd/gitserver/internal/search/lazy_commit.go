@@ -21,6 +21,10 @@ type LazyCommit struct {
 	diff        []*godiff.FileDiff
 	diffFetcher *DiffFetcher
 
+	// hydrate is called once before the diff is fetched, see
+	// CommitSearcher.HydrateDiff.
+	hydrate func() error
+
 	// LowerBuf is a re-usable buffer for doing case-transformations on the fields of LazyCommit
 	LowerBuf []byte
 }
@@ -45,6 +49,12 @@ func (l *LazyCommit) CommitterDate() (time.Time, error) {
 
 // RawDiff returns the diff exactly as returned by git diff-tree
 func (l *LazyCommit) RawDiff() ([]byte, error) {
+	if l.hydrate != nil {
+		if err := l.hydrate(); err != nil {
+			return nil, err
+		}
+		l.hydrate = nil
+	}
 	return l.diffFetcher.Fetch(l.Hash)
 }
 
@@ -101,12 +111,12 @@ func (l *LazyCommit) ModifiedFiles() []string {
 			return files
 		}
 		switch l.RawCommit.ModifiedFiles[i][0] {
-		case 'R':
+		case 'R', 'C':
 			// SAFETY: don't assume that we have the right number of things
 			if i+2 >= len(l.RawCommit.ModifiedFiles) {
 				return files
 			}
-			// A rename entry will be followed by two file names
+			// A rename or copy entry will be followed by two file names
 			files = append(files, utf8String(l.RawCommit.ModifiedFiles[i+1]))
 			files = append(files, utf8String(l.RawCommit.ModifiedFiles[i+2]))
 			i += 3
@@ -89,6 +89,13 @@ type CommitSearcher struct {
 	IncludeDiff          bool
 	IncludeModifiedFiles bool
 	RepoName             api.RepoName
+
+	// HydrateDiff is called before the diff of a commit is computed with the
+	// commit and its parents as revisions and the paths changed in the commit.
+	// Partial clones use it to fetch the blobs the diff reads on demand. If
+	// set, rename detection is disabled for modified files, as it would read
+	// the contents of all files changed in all searched commits.
+	HydrateDiff func(ctx context.Context, revisions, paths []string) error
 }
 
 // Search runs a search for commits matching the given predicate across the revisions passed in as revisionArgs.
@@ -135,15 +142,23 @@ func (cs *CommitSearcher) Search(ctx context.Context, onMatch func(*protocol.Com
 }
 
 func (cs *CommitSearcher) gitArgs() []string {
-	revArgs := revsToGitArgs(cs.Revisions)
+	revArgs := RevsToGitArgs(cs.Revisions)
 	args := append(logArgs, revArgs...)
-	if cs.IncludeModifiedFiles {
-		args = append(args, "--name-status")
+	if cs.IncludeModifiedFiles && cs.HydrateDiff != nil {
+		// Without rename detection, a renamed file is reported as a deletion
+		// and an addition, so both names are still included.
+		args = append(args, "--name-status", "--no-renames")
+	} else if cs.IncludeModifiedFiles {
+		// Detect renames and copies the same way the diff fetcher does, so
+		// that both the old and the new name of a renamed file are reported.
+		args = append(args, "--name-status", "--find-renames", "--find-copies")
 	}
 	return args
 }
 
-func revsToGitArgs(revs []string) []string {
+// RevsToGitArgs converts the revisions of a search request to the arguments
+// passed to git. An empty revision is interpreted as HEAD.
+func RevsToGitArgs(revs []string) []string {
 	revArgs := make([]string, 0, len(revs))
 	for _, rev := range revs {
 		if rev != "" {
@@ -253,6 +268,11 @@ func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 				diffFetcher: diffFetcher,
 				LowerBuf:    startBuf,
 			}
+			if cs.HydrateDiff != nil {
+				lc.hydrate = func() error {
+					return cs.hydrateDiff(ctx, cv)
+				}
+			}
 			mergedResult, highlights, err := cs.Query.Match(lc)
 			if err != nil {
 				return err
@@ -275,6 +295,36 @@ func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 	return errs
 }
 
+// hydrateDiff calls cs.HydrateDiff with the revisions and paths read when
+// computing the diff of commit.
+func (cs *CommitSearcher) hydrateDiff(ctx context.Context, commit *RawCommit) error {
+	// Like the diff fetcher, we only diff non-merge commits against their
+	// parent, so merge commits don't change any paths here.
+	cmd := exec.CommandContext(ctx, "git", "diff-tree", "--no-commit-id", "-r", "--root", "--no-renames", "--name-only", "-z", string(commit.Hash))
+	cmd.Dir = cs.RepoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return errors.Wrap(err, "listing changed paths")
+	}
+
+	var paths []string
+	for _, path := range bytes.Split(out, sep) {
+		if len(path) > 0 {
+			paths = append(paths, ":(literal)"+string(path))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	revisions := []string{string(commit.Hash)}
+	if len(commit.ParentHashes) > 0 {
+		revisions = append(revisions, strings.Split(string(commit.ParentHashes), " ")...)
+	}
+
+	return cs.HydrateDiff(ctx, revisions, paths)
+}
+
 // RawCommit is a shallow parse of the output of git log
 type RawCommit struct {
 	Hash           []byte
@@ -400,6 +450,7 @@ func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff bool, filte
 	}
 
 	diff := result.MatchedString{}
+	var copiedFiles []string
 	if includeDiff {
 		rawDiff, err := lc.Diff()
 		if err != nil {
@@ -407,6 +458,7 @@ func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff bool, filte
 		}
 		rawDiff = filterRawDiff(rawDiff, filterFunc)
 		diff.Content, diff.MatchedRanges = FormatDiff(rawDiff, hc.Diff)
+		copiedFiles = copiedFileNames(rawDiff)
 	}
 
 	commitID, err := api.NewCommitID(string(lc.Hash))
@@ -440,9 +492,26 @@ func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff bool, filte
 		},
 		Diff:          diff,
 		ModifiedFiles: lc.ModifiedFiles(),
+		CopiedFiles:   copiedFiles,
 	}, nil
 }
 
+// copiedFileNames returns the new names of the files in rawDiff that git
+// detected as copies, which is the "C" status of --name-status. The formatted
+// diff only lists their old and new names, like for renames.
+func copiedFileNames(rawDiff []*godiff.FileDiff) []string {
+	var names []string
+	for _, fileDiff := range rawDiff {
+		for _, line := range fileDiff.Extended {
+			if strings.HasPrefix(line, "copy to ") {
+				names = append(names, fileDiff.NewName)
+				break
+			}
+		}
+	}
+	return names
+}
+
 func utf8String(b []byte) string {
 	return string(bytes.ToValidUTF8(b, []byte("�")))
 }
@@ -454,7 +523,7 @@ func filterRawDiff(rawDiff []*godiff.FileDiff, filterFunc func(string) (bool, er
 	}
 	filtered := make([]*godiff.FileDiff, 0, len(rawDiff))
 	for _, fileDiff := range rawDiff {
-		if isAllowed, err := filterFunc(fileDiff.NewName); err != nil {
+		if isAllowed, err := isFileDiffAllowed(fileDiff, filterFunc); err != nil {
 			logger.Error("error filtering files in raw diff", log.Error(err))
 			continue
 		} else if !isAllowed {
@@ -464,3 +533,19 @@ func filterRawDiff(rawDiff []*godiff.FileDiff, filterFunc func(string) (bool, er
 	}
 	return filtered
 }
+
+// isFileDiffAllowed returns whether the file diff passes filterFunc. Renamed
+// and copied files only pass if both the old and the new path pass, so that a
+// rename doesn't leak the contents of a file that is not visible on either
+// side of it.
+func isFileDiffAllowed(fileDiff *godiff.FileDiff, filterFunc func(string) (bool, error)) (bool, error) {
+	for _, name := range []string{fileDiff.OrigName, fileDiff.NewName} {
+		if name == "/dev/null" {
+			continue
+		}
+		if isAllowed, err := filterFunc(name); err != nil || !isAllowed {
+			return false, err
+		}
+	}
+	return true, nil
+}
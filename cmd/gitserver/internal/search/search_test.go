@@ -96,6 +96,36 @@ func TestSearch(t *testing.T) {
 		require.Empty(t, matches[0].ModifiedFiles)
 	})
 
+	t.Run("hydrates diffs of matched commits only", func(t *testing.T) {
+		query := &protocol.MessageMatches{Expr: "commit2"}
+		tree, err := ToMatchTree(query)
+		require.NoError(t, err)
+
+		var hydrated [][]string
+		searcher := &CommitSearcher{
+			RepoDir:     dir,
+			Query:       tree,
+			IncludeDiff: true,
+			HydrateDiff: func(_ context.Context, revisions, paths []string) error {
+				hydrated = append(hydrated, revisions, paths)
+				return nil
+			},
+		}
+		var matches []*protocol.CommitMatch
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			matches = append(matches, match)
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+
+		out, err := gitCommand(dir, "git", "rev-parse", "HEAD~1", "HEAD~2").Output()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			strings.Fields(string(out)),
+			{":(literal)file2", ":(literal)file3"},
+		}, hydrated)
+	})
+
 	t.Run("match both, in order", func(t *testing.T) {
 		query := &protocol.MessageMatches{Expr: "c"}
 		tree, err := ToMatchTree(query)
@@ -144,9 +174,9 @@ func TestSearch(t *testing.T) {
 			matches = append(matches, match)
 		})
 		require.NoError(t, err)
-		require.Len(t, matches, 2)
-		require.Equal(t, matches[0].Author.Name, "camden3")
-		require.Equal(t, matches[1].Author.Name, "camden1")
+		// The rename of file1 in commit3 doesn't change its content.
+		require.Len(t, matches, 1)
+		require.Equal(t, matches[0].Author.Name, "camden1")
 	})
 
 	t.Run("author matches", func(t *testing.T) {
@@ -202,10 +232,32 @@ func TestSearch(t *testing.T) {
 			matches = append(matches, match)
 		})
 		require.NoError(t, err)
-		require.Len(t, matches, 2)
-		require.Equal(t, matches[0].Author.Name, "camden3")
-		require.Equal(t, matches[1].Author.Name, "camden1")
-		require.Len(t, strings.Split(matches[1].Diff.Content, "\n"), 4)
+		require.Len(t, matches, 1)
+		require.Equal(t, matches[0].Author.Name, "camden1")
+		require.Len(t, strings.Split(matches[0].Diff.Content, "\n"), 4)
+	})
+
+	t.Run("file matches either side of a rename", func(t *testing.T) {
+		for _, name := range []string{"file1$", "file1a"} {
+			query := &protocol.DiffModifiesFile{Expr: name}
+			tree, err := ToMatchTree(query)
+			require.NoError(t, err)
+			searcher := &CommitSearcher{
+				RepoDir:              dir,
+				Query:                tree,
+				IncludeDiff:          true,
+				IncludeModifiedFiles: true,
+			}
+			var matches []*protocol.CommitMatch
+			err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+				matches = append(matches, match)
+			})
+			require.NoError(t, err)
+			require.NotEmpty(t, matches)
+			require.Equal(t, "camden3", matches[0].Author.Name)
+			// The rename is reported as a single file diff without hunks.
+			require.Equal(t, "file1 file1a\n", matches[0].Diff.Content)
+		}
 	})
 
 	t.Run("match all, in order with modified files", func(t *testing.T) {
@@ -578,6 +630,56 @@ index 0000000000..7e54670557
 	require.Equal(t, expectedRanges, ranges)
 }
 
+func TestFilterRawDiff_Renames(t *testing.T) {
+	rawDiff := []*diff.FileDiff{
+		{OrigName: "foo.go", NewName: "bar.go"},
+		{OrigName: "foo_test.go", NewName: "bar.go"},
+		{OrigName: "foo.go", NewName: "bar_test.go"},
+		{OrigName: "baz.go", NewName: "/dev/null"},
+	}
+
+	filtered := filterRawDiff(rawDiff, setupSubRepoFilterFunc())
+	require.Equal(t, []*diff.FileDiff{rawDiff[0], rawDiff[3]}, filtered)
+}
+
+func TestSearch_Copies(t *testing.T) {
+	dir := initGitRepository(t,
+		"printf 'line1\\nline2\\nline3\\nline4\\nline5\\n' > original",
+		"git add -A",
+		"git -c user.name=a -c user.email=a@example.com commit -m original",
+		// Without --find-copies-harder, copies are only detected if the
+		// original file is modified in the same commit.
+		"cp original copy",
+		"echo line6 >> original",
+		"git add -A",
+		"git -c user.name=a -c user.email=a@example.com commit -m copy",
+	)
+
+	tree, err := ToMatchTree(&protocol.DiffModifiesFile{Expr: "copy"})
+	require.NoError(t, err)
+	searcher := &CommitSearcher{
+		RepoDir:              dir,
+		Query:                tree,
+		IncludeDiff:          true,
+		IncludeModifiedFiles: true,
+	}
+	var matches []*protocol.CommitMatch
+	err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+		matches = append(matches, match)
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, []string{"copy"}, matches[0].CopiedFiles)
+
+	diffFiles, err := result.ParseDiffString(matches[0].Diff.Content)
+	require.NoError(t, err)
+	result.MarkCopiedFiles(diffFiles, matches[0].CopiedFiles)
+	require.Len(t, diffFiles, 1)
+	require.Equal(t, "original", diffFiles[0].OrigName)
+	require.Equal(t, "copy", diffFiles[0].NewName)
+	require.Equal(t, result.Copied, (&result.CommitDiffMatch{DiffFile: &diffFiles[0]}).PathStatus())
+}
+
 func setupSubRepoFilterFunc() func(string) (bool, error) {
 	checker := authz.NewMockSubRepoPermissionChecker()
 	ctx := context.Background()
@@ -752,7 +854,7 @@ func (authorNameGenerator) Generate(rand *rand.Rand, size int) reflect.Value {
 	return reflect.ValueOf(buf)
 }
 
-func Test_revsToGitArgs(t *testing.T) {
+func TestRevsToGitArgs(t *testing.T) {
 	cases := []struct {
 		name     string
 		revSpecs []string
@@ -771,7 +873,7 @@ func Test_revsToGitArgs(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		got := revsToGitArgs(tc.revSpecs)
+		got := RevsToGitArgs(tc.revSpecs)
 		require.Equal(t, tc.expected, got)
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/lfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -35,6 +36,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -90,6 +92,19 @@ type ServerOpts struct {
 	// The factory creates recordable commands with a set predicate, which is used to determine whether a
 	// particular command should be recorded or not.
 	RecordingCommandFactory *wrexec.RecordingCommandFactory
+
+	// ObjectHydrator is used to fetch objects missing from partial clones
+	// before operations that bypass the git backend read them. If nil, missing
+	// objects are not fetched.
+	ObjectHydrator git.ObjectHydrator
+
+	// LFSResolver is used to replace Git LFS pointers with the content of the
+	// objects they point to. If nil, pointers are returned as is.
+	LFSResolver *lfs.Resolver
+
+	// ColdStorage is used to restore repos that have been offloaded because
+	// they haven't been accessed in a while. If nil, repos are never offloaded.
+	ColdStorage *ColdStorage
 }
 
 func NewServer(opt *ServerOpts) *Server {
@@ -122,6 +137,9 @@ func NewServer(opt *ServerOpts) *Server {
 		rpsLimiter:              opt.RPSLimiter,
 		recordingCommandFactory: opt.RecordingCommandFactory,
 		fs:                      opt.FS,
+		objectHydrator:          opt.ObjectHydrator,
+		lfsResolver:             opt.LFSResolver,
+		coldStorage:             opt.ColdStorage,
 
 		cloneLimiter: cloneLimiter,
 		ctx:          ctx,
@@ -186,6 +204,18 @@ type Server struct {
 	// The factory creates recordable commands with a set predicate, which is used to determine whether a
 	// particular command should be recorded or not.
 	recordingCommandFactory *wrexec.RecordingCommandFactory
+
+	// objectHydrator is used to fetch objects missing from partial clones
+	// before operations that bypass the git backend read them.
+	objectHydrator git.ObjectHydrator
+
+	// lfsResolver is used to replace Git LFS pointers with the content of the
+	// objects they point to.
+	lfsResolver *lfs.Resolver
+
+	// coldStorage is used to restore repos that have been offloaded because
+	// they haven't been accessed in a while.
+	coldStorage *ColdStorage
 }
 
 // Stop cancels the running background jobs and returns when done.
@@ -271,6 +301,17 @@ func (s *Server) IsRepoCloneable(ctx context.Context, repo api.RepoName) (protoc
 // Canceling the context will not cancel the update, but it will let the caller
 // escape the function early.
 func (s *Server) FetchRepository(ctx context.Context, repoName api.RepoName) (lastFetched, lastChanged time.Time, err error) {
+	// Offloaded repos are only restored when they are accessed. Scheduled
+	// fetches would otherwise bring every repo back onto disk, so they are a
+	// no-op until then.
+	if s.coldStorage != nil {
+		if gr, tiered, err := s.tieredRepo(ctx, repoName); err != nil {
+			return lastFetched, lastChanged, err
+		} else if tiered {
+			return gr.LastFetched, gr.LastChanged, nil
+		}
+	}
+
 	err = s.repoUpdateOrClone(ctx, repoName)
 	if err != nil {
 		return lastFetched, lastChanged, err
@@ -291,6 +332,16 @@ func (s *Server) FetchRepository(ctx context.Context, repoName api.RepoName) (la
 	return lastFetched, lastChanged, nil
 }
 
+// tieredRepo returns the gitserver repo of repoName and whether it has been
+// offloaded to cold storage and is not on disk.
+func (s *Server) tieredRepo(ctx context.Context, repoName api.RepoName) (*types.GitserverRepo, bool, error) {
+	cloned, err := s.fs.RepoCloned(repoName)
+	if err != nil || cloned {
+		return nil, false, err
+	}
+	return s.coldStorage.tiered(ctx, repoName)
+}
+
 func (s *Server) repoUpdateOrClone(ctx context.Context, repoName api.RepoName) error {
 	logger := s.logger.Scoped("repoUpdateOrClone")
 
@@ -346,10 +397,14 @@ func (s *Server) repoUpdateOrClone(ctx context.Context, repoName api.RepoName) e
 				if err := s.cloneRepo(ctx, repoName, lock); err != nil {
 					repoCloneFailedCounter.Inc()
 					logger.Error("error cloning repo", log.String("repo", string(repoName)), log.Error(err))
+					if s.isNewCloneFailure(ctx, repoName, err) {
+						s.enqueueCloneWebhook(ctx, repoName, err)
+					}
 					return errors.Wrapf(err, "failed to clone %s", repoName)
 				}
 				repoClonedCounter.Inc()
 				logger.Info("cloned repo", log.String("repo", string(repoName)))
+				s.enqueueCloneWebhook(ctx, repoName, nil)
 			} else {
 				if err := s.doRepoUpdate(ctx, repoName, lock); err != nil {
 					// The repo update might have failed due to the repo being corrupt
@@ -382,6 +437,41 @@ func (s *Server) LogIfCorrupt(ctx context.Context, repo api.RepoName, err error)
 	}
 }
 
+// enqueueCloneWebhook enqueues an outbound webhook for the outcome of cloning
+// the given repository. cloneErr is nil if the clone succeeded.
+func (s *Server) enqueueCloneWebhook(ctx context.Context, repoName api.RepoName, cloneErr error) {
+	repo, err := s.db.Repos().GetByName(ctx, repoName)
+	if err != nil {
+		s.logger.Warn("failed to get repo for outbound webhook", log.String("repo", string(repoName)), log.Error(err))
+		return
+	}
+
+	eventType := events.RepositoryClone
+	if cloneErr != nil {
+		eventType = events.RepositoryCloneError
+	}
+	events.EnqueueRepository(ctx, s.logger, s.db, eventType, repo, cloneErr)
+}
+
+// isNewCloneFailure returns true if cloneErr should be reported as a failed
+// clone. Failed clones are retried by the update scheduler until they succeed,
+// so a failure is only reported if the previous attempt didn't fail as well.
+// Failures caused by gitserver shutting down are never reported.
+func (s *Server) isNewCloneFailure(ctx context.Context, repoName api.RepoName, cloneErr error) bool {
+	if errors.IsContextCanceled(cloneErr) || ctx.Err() != nil {
+		return false
+	}
+
+	// This runs before the error of this attempt is stored, so LastError holds
+	// the error of the previous attempt.
+	repo, err := s.db.GitserverRepos().GetByName(ctx, repoName)
+	if err != nil {
+		s.logger.Warn("failed to get gitserver repo for outbound webhook", log.String("repo", string(repoName)), log.Error(err))
+		return false
+	}
+	return repo.LastError == ""
+}
+
 var ErrFetchInProgress = errors.New("fetch for this repo already in progress")
 
 // cloneRepo performs a clone operation for the given repository.
@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/accesslog"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/lfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -25,6 +26,7 @@ import (
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/chunk"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -50,6 +52,9 @@ func NewGRPCServer(server *Server, config *GRPCServerConfig) proto.GitserverServ
 		gitBackendSource: server.gitBackendSource,
 		svc:              server,
 		fs:               server.fs,
+		objectHydrator:   server.objectHydrator,
+		lfsResolver:      server.lfsResolver,
+		coldStorage:      server.coldStorage,
 	}
 
 	if config.ExhaustiveRequestLoggingEnabled {
@@ -72,6 +77,9 @@ type grpcServer struct {
 	gitBackendSource git.GitBackendSource
 	fs               gitserverfs.FS
 	svc              service
+	objectHydrator   git.ObjectHydrator
+	lfsResolver      *lfs.Resolver
+	coldStorage      *ColdStorage
 
 	proto.UnimplementedGitserverServiceServer
 }
@@ -98,7 +106,7 @@ func (gs *grpcServer) CreateCommitFromPatchBinary(s proto.GitserverService_Creat
 	}
 
 	repoName := api.RepoName(metadata.GetRepo())
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(s.Context(), repoName); err != nil {
 		return err
 	}
 
@@ -127,17 +135,28 @@ func (gs *grpcServer) CreateCommitFromPatchBinary(s proto.GitserverService_Creat
 	return s.SendAndClose(res)
 }
 
-func (gs *grpcServer) DiskInfo(_ context.Context, _ *proto.DiskInfoRequest) (*proto.DiskInfoResponse, error) {
+func (gs *grpcServer) DiskInfo(ctx context.Context, _ *proto.DiskInfoRequest) (*proto.DiskInfoResponse, error) {
 	usage, err := gs.fs.DiskUsage()
 	if err != nil {
 		return nil, err
 	}
 
-	return &proto.DiskInfoResponse{
+	resp := &proto.DiskInfoResponse{
 		TotalSpace:  usage.Size(),
 		FreeSpace:   usage.Free(),
 		PercentUsed: usage.PercentUsed(),
-	}, nil
+	}
+
+	if gs.coldStorage != nil {
+		count, size, err := gs.coldStorage.TieredStats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp.TieredRepos = uint64(count)
+		resp.TieredSizeBytes = uint64(size)
+	}
+
+	return resp, nil
 }
 
 func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverService_ArchiveServer) error {
@@ -170,7 +189,7 @@ func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 	repoName := api.RepoName(req.GetRepo())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
@@ -201,6 +220,9 @@ func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 		gs.svc.LogIfCorrupt(ctx, repoName, err)
 		return err
 	}
+	if !req.GetLfsPointer() && gs.lfsResolver != nil && gs.lfsResolver.Enabled() {
+		r = gs.lfsResolver.ResolveArchive(ctx, repoName, repoDir, format, r)
+	}
 	defer r.Close()
 
 	w := streamio.NewWriter(func(p []byte) error {
@@ -230,7 +252,7 @@ func (gs *grpcServer) GetObject(ctx context.Context, req *proto.GetObjectRequest
 	repoName := api.RepoName(req.GetRepo())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -293,7 +315,7 @@ func (gs *grpcServer) Search(req *proto.SearchRequest, ss proto.GitserverService
 
 	repoName := api.RepoName(req.GetRepo())
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ss.Context(), repoName); err != nil {
 		return err
 	}
 
@@ -306,7 +328,24 @@ func (gs *grpcServer) Search(req *proto.SearchRequest, ss proto.GitserverService
 	tr, ctx := trace.New(ss.Context(), "search")
 	defer tr.End()
 
-	limitHit, err := searchWithObservability(ctx, gs.logger, gs.fs.RepoDir(args.Repo), tr, args, onMatch)
+	repoDir := gs.fs.RepoDir(repoName)
+
+	// Search reads the git object database directly, so partial clones need to
+	// fetch the blobs of a commit before its diff is computed. We only do this
+	// for the commits we actually diff, as hydrating all searched revisions
+	// would undo the partial clone.
+	var hydrateDiff func(context.Context, []string, []string) error
+	if gs.objectHydrator != nil && git.IsPartialClone(repoDir) {
+		backend := gs.gitBackendSource(repoDir, repoName)
+		hydrateDiff = func(ctx context.Context, revisions, paths []string) error {
+			return git.HydrateObjects(ctx, backend, gs.objectHydrator, repoName, repoDir, "Search", git.MissingObjectsOpts{
+				Revisions: revisions,
+				Paths:     paths,
+			})
+		}
+	}
+
+	limitHit, err := searchWithObservability(ctx, gs.logger, repoDir, tr, args, hydrateDiff, onMatch)
 	if err != nil {
 		return err
 	}
@@ -658,7 +697,7 @@ func (gs *grpcServer) MergeBase(ctx context.Context, req *proto.MergeBaseRequest
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -706,7 +745,7 @@ func (gs *grpcServer) MergeBaseOctopus(ctx context.Context, req *proto.MergeBase
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -753,7 +792,7 @@ func (gs *grpcServer) GetCommit(ctx context.Context, req *proto.GetCommitRequest
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -812,14 +851,19 @@ func (gs *grpcServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_B
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
 	backend := gs.gitBackendSource(repoDir, repoName)
 
 	opts := git.BlameOptions{
-		IgnoreWhitespace: req.GetIgnoreWhitespace(),
+		IgnoreWhitespace:  req.GetIgnoreWhitespace(),
+		OldestCommit:      api.CommitID(req.GetOldestCommit()),
+		IgnoreRevs:        req.GetIgnoreRevs(),
+		UseIgnoreRevsFile: req.GetUseIgnoreRevsFile(),
+		DetectMoves:       req.GetDetectMoves(),
+		DetectCopies:      req.GetDetectCopies(),
 	}
 
 	if r := req.GetRange(); r != nil {
@@ -890,7 +934,7 @@ func (gs *grpcServer) DefaultBranch(ctx context.Context, req *proto.DefaultBranc
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -950,7 +994,7 @@ func (gs *grpcServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverSer
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
@@ -983,6 +1027,12 @@ func (gs *grpcServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverSer
 		gs.svc.LogIfCorrupt(ctx, repoName, err)
 		return err
 	}
+	if !req.GetLfsPointer() && gs.lfsResolver != nil && gs.lfsResolver.Enabled() {
+		r, err = gs.lfsResolver.ResolveFile(ctx, repoName, repoDir, r)
+		if err != nil {
+			return err
+		}
+	}
 	defer r.Close()
 
 	w := streamio.NewWriter(func(p []byte) error {
@@ -1007,7 +1057,7 @@ func (gs *grpcServer) ResolveRevision(ctx context.Context, req *proto.ResolveRev
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -1064,7 +1114,7 @@ func (gs *grpcServer) RevAtTime(ctx context.Context, req *proto.RevAtTimeRequest
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -1105,7 +1155,7 @@ func (gs *grpcServer) ListRefs(req *proto.ListRefsRequest, ss proto.GitserverSer
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ss.Context(), repoName); err != nil {
 		return err
 	}
 
@@ -1204,7 +1254,7 @@ func (gs *grpcServer) RawDiff(req *proto.RawDiffRequest, ss proto.GitserverServi
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
@@ -1277,7 +1327,7 @@ func (gs *grpcServer) ContributorCounts(ctx context.Context, req *proto.Contribu
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -1326,7 +1376,7 @@ func (gs *grpcServer) FirstEverCommit(ctx context.Context, request *proto.FirstE
 	repoName := api.RepoName(request.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -1388,7 +1438,7 @@ func (gs *grpcServer) BehindAhead(ctx context.Context, req *proto.BehindAheadReq
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -1435,7 +1485,7 @@ func (gs *grpcServer) ChangedFiles(req *proto.ChangedFilesRequest, ss proto.Gits
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
@@ -1516,7 +1566,7 @@ func (gs *grpcServer) Stat(ctx context.Context, req *proto.StatRequest) (*proto.
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return nil, err
 	}
 
@@ -1557,8 +1607,11 @@ func (gs *grpcServer) Stat(ctx context.Context, req *proto.StatRequest) (*proto.
 		return nil, err
 	}
 
+	p := gitdomain.FSFileInfoToProto(fi)
+	gs.resolveSubmodule(ctx, repoName, p, map[string]string{})
+
 	return &proto.StatResponse{
-		FileInfo: gitdomain.FSFileInfoToProto(fi),
+		FileInfo: p,
 	}, nil
 }
 
@@ -1583,7 +1636,7 @@ func (gs *grpcServer) ReadDir(req *proto.ReadDirRequest, ss proto.GitserverServi
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
@@ -1619,6 +1672,10 @@ func (gs *grpcServer) ReadDir(req *proto.ReadDirRequest, ss proto.GitserverServi
 		return ss.Send(&proto.ReadDirResponse{FileInfo: fis})
 	})
 
+	// Trees usually reference the same few submodule URLs, so only resolve
+	// each of them once.
+	submoduleNames := map[string]string{}
+
 	for {
 		fi, err := it.Next()
 		if err != nil {
@@ -1649,7 +1706,9 @@ func (gs *grpcServer) ReadDir(req *proto.ReadDirRequest, ss proto.GitserverServi
 			}
 			return err
 		}
-		err = chunker.Send(gitdomain.FSFileInfoToProto(fi))
+		p := gitdomain.FSFileInfoToProto(fi)
+		gs.resolveSubmodule(ctx, repoName, p, submoduleNames)
+		err = chunker.Send(p)
 		if err != nil {
 			return errors.Wrap(err, "failed to send file chunk")
 		}
@@ -1663,6 +1722,28 @@ func (gs *grpcServer) ReadDir(req *proto.ReadDirRequest, ss proto.GitserverServi
 	return nil
 }
 
+// resolveSubmodule sets the name of the repository that fi points to if fi is
+// a submodule of repo. names caches the resolved names by submodule URL.
+func (gs *grpcServer) resolveSubmodule(ctx context.Context, repo api.RepoName, fi *proto.FileInfo, names map[string]string) {
+	sm := fi.GetSubmodule()
+	if sm == nil {
+		return
+	}
+
+	name, ok := names[sm.GetUrl()]
+	if !ok {
+		n, err := repos.SubmoduleRepoName(ctx, gs.db, repo, sm.GetUrl())
+		if err != nil {
+			// Listing the tree is more important than linking the submodule, so
+			// leave the name empty like for URLs no code host matches.
+			gs.logger.Warn("failed to resolve submodule repo name", log.String("repo", string(repo)), log.String("url", sm.GetUrl()), log.Error(err))
+		}
+		name = string(n)
+		names[sm.GetUrl()] = name
+	}
+	sm.RepoName = name
+}
+
 func (gs *grpcServer) CommitLog(req *proto.CommitLogRequest, ss proto.GitserverService_CommitLogServer) (err error) {
 	ctx := ss.Context()
 
@@ -1700,7 +1781,7 @@ func (gs *grpcServer) CommitLog(req *proto.CommitLogRequest, ss proto.GitserverS
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
-	if err := gs.checkRepoExists(repoName); err != nil {
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
 		return err
 	}
 
@@ -1792,21 +1873,150 @@ func (gs *grpcServer) CommitLog(req *proto.CommitLogRequest, ss proto.GitserverS
 	return nil
 }
 
+func (gs *grpcServer) CommitGraph(req *proto.CommitGraphRequest, ss proto.GitserverService_CommitGraphServer) (err error) {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.Strings("ranges", byteSlicesToStrings(req.GetRanges())),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(req.GetRanges()) == 0 && !req.GetAllRefs() {
+		return status.New(codes.InvalidArgument, "must specify ranges or all_refs").Err()
+	}
+
+	if len(req.GetRanges()) > 0 && req.GetAllRefs() {
+		return status.New(codes.InvalidArgument, "cannot specify both ranges and all_refs").Err()
+	}
+
+	var order git.CommitLogOrder
+	switch req.GetOrder() {
+	case proto.CommitGraphRequest_COMMIT_GRAPH_ORDER_COMMIT_DATE:
+		order = git.CommitLogOrderCommitDate
+	case proto.CommitGraphRequest_COMMIT_GRAPH_ORDER_TOPO_DATE:
+		order = git.CommitLogOrderTopoDate
+	case proto.CommitGraphRequest_COMMIT_GRAPH_ORDER_UNSPECIFIED:
+		order = git.CommitLogOrderDefault
+	default:
+		return status.New(codes.InvalidArgument, "unknown order").Err()
+	}
+
+	var after time.Time
+	if req.GetAfter() != nil {
+		after = req.GetAfter().AsTime()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	backend := gs.gitBackendSource(repoDir, repoName)
+
+	it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{
+		Ranges:  byteSlicesToStrings(req.GetRanges()),
+		AllRefs: req.GetAllRefs(),
+		After:   after,
+		Order:   order,
+	})
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+
+	defer func() {
+		closeErr := it.Close()
+		if closeErr == nil {
+			return
+		}
+
+		if err == nil {
+			err = closeErr
+			return
+		}
+	}()
+
+	tr, _ := trace.New(ctx, "chunkedsender")
+	defer tr.EndWithErr(&err)
+
+	// Graph nodes are small, so the chunker packs many thousands of them into a
+	// single message.
+	chunker := chunk.New(func(ns []*proto.CommitGraphNode) error {
+		tr.AddEvent("sending chunk", attribute.Int("count", len(ns)))
+		return ss.Send(&proto.CommitGraphResponse{Commits: ns})
+	})
+
+	for {
+		node, err := it.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			var e *gitdomain.RevisionNotFoundError
+			if errors.As(err, &e) {
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+					Repo: req.GetRepoName(),
+					Spec: e.Spec,
+				})
+				if err != nil {
+					return err
+				}
+				return s.Err()
+			}
+			return err
+		}
+
+		if err := chunker.Send(node.ToProto()); err != nil {
+			return errors.Wrap(err, "failed to send commit graph chunk")
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return errors.Wrap(err, "failed to flush commit graph")
+	}
+
+	return nil
+}
+
 // checkRepoExists checks if a given repository is cloned on disk, and returns an
 // error otherwise.
 // On Sourcegraph.com, not all repos are managed by the scheduler. We thus
 // need to enqueue a manual update of a repo that is visited but not cloned to
 // ensure it is cloned and managed.
-func (gs *grpcServer) checkRepoExists(repo api.RepoName) error {
+// Repos that have been offloaded to cold storage are restored before returning.
+func (gs *grpcServer) checkRepoExists(ctx context.Context, repo api.RepoName) error {
 	cloned, err := gs.fs.RepoCloned(repo)
 	if err != nil {
 		return status.New(codes.Internal, errors.Wrap(err, "failed to check if repo is cloned").Error()).Err()
 	}
 
+	// Background callers, like indexing, read from every repo regularly. Only
+	// requests of users keep repos out of cold storage or restore them.
+	userRequest := isUserRequest(ctx)
+
 	if cloned {
+		if gs.coldStorage != nil && userRequest {
+			gs.coldStorage.MarkAccessed(gs.fs.RepoDir(repo))
+		}
 		return nil
 	}
 
+	if gs.coldStorage != nil && userRequest {
+		rehydrated, err := gs.coldStorage.Rehydrate(ctx, repo)
+		if err != nil {
+			gs.logger.Error("failed to restore repo from cold storage", log.String("repo", string(repo)), log.Error(err))
+		} else if rehydrated {
+			return nil
+		}
+	}
+
 	cloneProgress, locked := gs.locker.Status(repo)
 
 	// We checked above that the repo is not cloned. So if the repo is currently
@@ -376,6 +376,7 @@ func archiveRequestToLogFields(req *proto.ArchiveRequest) []log.Field {
 		log.String("treeish", req.GetTreeish()),
 		log.String("format", req.GetFormat().String()),
 		log.Strings("paths", byteSlicesToStrings(req.GetPaths())),
+		log.Bool("lfsPointer", req.GetLfsPointer()),
 	}
 }
 
@@ -682,6 +683,11 @@ func blameRequestToLogFields(req *proto.BlameRequest) []log.Field {
 		log.String("path", string(req.GetPath())),
 		log.Bool("ignoreWhitespace", req.GetIgnoreWhitespace()),
 		log.Object("range", blameRangeToLogFields(req.GetRange())...),
+		log.String("oldestCommit", req.GetOldestCommit()),
+		log.Strings("ignoreRevs", req.GetIgnoreRevs()),
+		log.Bool("useIgnoreRevsFile", req.GetUseIgnoreRevsFile()),
+		log.Bool("detectMoves", req.GetDetectMoves()),
+		log.Bool("detectCopies", req.GetDetectCopies()),
 	}
 }
 
@@ -745,6 +751,7 @@ func readFileRequestToLogFields(req *proto.ReadFileRequest) []log.Field {
 		log.String("repoName", req.GetRepoName()),
 		log.String("commit", req.GetCommit()),
 		log.String("path", string(req.GetPath())),
+		log.Bool("lfsPointer", req.GetLfsPointer()),
 	}
 }
 
@@ -1096,6 +1103,36 @@ func commitLogRequestToLogFields(req *proto.CommitLogRequest) []log.Field {
 	}
 }
 
+func (l *loggingGRPCServer) CommitGraph(request *proto.CommitGraphRequest, server proto.GitserverService_CommitGraphServer) error {
+	start := time.Now()
+
+	defer func() {
+		elapsed := time.Since(start)
+
+		doLog(
+			l.logger,
+			proto.GitserverService_CommitGraph_FullMethodName,
+			status.Code(server.Context().Err()),
+			trace.Context(server.Context()).TraceID,
+			elapsed,
+
+			commitGraphRequestToLogFields(request)...,
+		)
+	}()
+
+	return l.base.CommitGraph(request, server)
+}
+
+func commitGraphRequestToLogFields(req *proto.CommitGraphRequest) []log.Field {
+	return []log.Field{
+		log.String("repoName", req.GetRepoName()),
+		log.Strings("ranges", byteSlicesToStrings(req.GetRanges())),
+		log.Bool("allRefs", req.GetAllRefs()),
+		log.Time("after", req.GetAfter().AsTime()),
+		log.Int("order", int(req.GetOrder())),
+	}
+}
+
 func (l *loggingGRPCServer) MergeBaseOctopus(ctx context.Context, request *proto.MergeBaseOctopusRequest) (response *proto.MergeBaseOctopusResponse, err error) {
 	start := time.Now()
 
@@ -29,6 +29,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -1447,6 +1448,58 @@ func TestGRPCServer_ReadDir(t *testing.T) {
 		assertGRPCStatusCode(t, err, codes.NotFound)
 		assertHasGRPCErrorDetailOfType(t, err, &proto.FileNotFoundPayload{})
 	})
+	t.Run("resolves submodules", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		repos := dbmocks.NewMockRepoStore()
+		repos.GetFirstRepoNameByCloneURLFunc.SetDefaultHook(func(_ context.Context, cloneURL string) (api.RepoName, error) {
+			if cloneURL == "github.com/sourcegraph/zoekt" {
+				return "github.com/sourcegraph/zoekt", nil
+			}
+			return "", nil
+		})
+		db := dbmocks.NewMockDB()
+		db.ReposFunc.SetDefaultReturn(repos)
+		db.ExternalServicesFunc.SetDefaultReturn(dbmocks.NewMockExternalServiceStore())
+		b := git.NewMockGitBackend()
+		it := git.NewMockReadDirIterator()
+		it.NextFunc.PushReturn(&fileutil.FileInfo{Name_: "zoekt", Mode_: gitdomain.ModeSubmodule, Sys_: gitdomain.Submodule{URL: "../zoekt", Path: "zoekt", CommitID: "deadbeef"}}, nil)
+		it.NextFunc.PushReturn(&fileutil.FileInfo{Name_: "vendor/zoekt", Mode_: gitdomain.ModeSubmodule, Sys_: gitdomain.Submodule{URL: "../zoekt", Path: "vendor/zoekt", CommitID: "deadbeef"}}, nil)
+		it.NextFunc.PushReturn(&fileutil.FileInfo{Name_: "unknown", Mode_: gitdomain.ModeSubmodule, Sys_: gitdomain.Submodule{URL: "https://example.com/unknown.git", Path: "unknown", CommitID: "deadbeef"}}, nil)
+		it.NextFunc.PushReturn(nil, io.EOF)
+		b.ReadDirFunc.SetDefaultReturn(it, nil)
+		gs := &grpcServer{
+			logger: logtest.Scoped(t),
+			db:     db,
+			svc:    NewMockService(),
+			fs:     fs,
+			gitBackendSource: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		cc, err := cli.ReadDir(ctx, &v1.ReadDirRequest{
+			RepoName:  "github.com/sourcegraph/sourcegraph",
+			CommitSha: "HEAD",
+		})
+		require.NoError(t, err)
+		var names []string
+		for {
+			resp, err := cc.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			for _, fi := range resp.GetFileInfo() {
+				names = append(names, fi.GetSubmodule().GetRepoName())
+			}
+		}
+		require.Equal(t, []string{"github.com/sourcegraph/zoekt", "github.com/sourcegraph/zoekt", ""}, names)
+		// Submodules with the same URL are only resolved once.
+		mockassert.CalledN(t, repos.GetFirstRepoNameByCloneURLFunc, 2)
+	})
 }
 
 func TestGRPCServer_CommitLog(t *testing.T) {
@@ -1570,6 +1623,120 @@ func TestGRPCServer_CommitLog(t *testing.T) {
 	})
 }
 
+func TestGRPCServer_CommitGraph(t *testing.T) {
+	ctx := context.Background()
+	mockSS := gitserver.NewMockGitserverService_CommitGraphServer()
+	mockSS.ContextFunc.SetDefaultReturn(ctx)
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: ""}, mockSS)
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "repo"}, mockSS)
+		require.ErrorContains(t, err, "must specify ranges or all_refs")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "repo", Ranges: [][]byte{[]byte("range")}, AllRefs: true}, mockSS)
+		require.ErrorContains(t, err, "cannot specify both ranges and all_refs")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "repo", AllRefs: true, Order: 42}, mockSS)
+		require.ErrorContains(t, err, "unknown order")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "therepo", AllRefs: true}, mockSS)
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		child := gitdomain.OID{0x2b, 0x22}
+		root := gitdomain.OID{0x5f, 0xab}
+		it := git.NewMockCommitGraphIterator()
+		it.NextFunc.PushReturn(&gitdomain.CommitGraphNode{
+			ID:            child,
+			Parents:       []gitdomain.OID{root},
+			CommitterDate: mustParseTime(time.RFC3339, "2006-01-02T15:04:07Z"),
+		}, nil)
+		it.NextFunc.PushReturn(&gitdomain.CommitGraphNode{
+			ID:            root,
+			Parents:       []gitdomain.OID{},
+			CommitterDate: mustParseTime(time.RFC3339, "2006-01-02T15:04:05Z"),
+		}, nil)
+		it.NextFunc.PushReturn(nil, io.EOF)
+		b.CommitGraphFunc.SetDefaultReturn(it, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			gitBackendSource: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		cc, err := cli.CommitGraph(ctx, &v1.CommitGraphRequest{
+			RepoName: "therepo",
+			Ranges:   [][]byte{[]byte("HEAD")},
+			Order:    v1.CommitGraphRequest_COMMIT_GRAPH_ORDER_TOPO_DATE,
+		})
+		require.NoError(t, err)
+		res := []*v1.CommitGraphNode{}
+		for {
+			resp, err := cc.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			res = append(res, resp.GetCommits()...)
+		}
+		if diff := cmp.Diff([]*v1.CommitGraphNode{
+			{
+				Oid:           child[:],
+				Parents:       [][]byte{root[:]},
+				CommitterTime: mustParseTime(time.RFC3339, "2006-01-02T15:04:07Z").Unix(),
+			},
+			{
+				Oid:           root[:],
+				Parents:       nil,
+				CommitterTime: mustParseTime(time.RFC3339, "2006-01-02T15:04:05Z").Unix(),
+			},
+		}, res, cmpopts.IgnoreUnexported(v1.CommitGraphNode{})); diff != "" {
+			t.Fatalf("unexpected response (-want +got):\n%s", diff)
+		}
+		mockrequire.CalledOnceWith(t, b.CommitGraphFunc, mockrequire.Values(mockrequire.Skip, git.CommitGraphOpts{
+			Ranges: []string{"HEAD"},
+			Order:  git.CommitLogOrderTopoDate,
+		}))
+
+		it = git.NewMockCommitGraphIterator()
+		it.NextFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{})
+		it.CloseFunc.SetDefaultReturn(&gitdomain.RevisionNotFoundError{})
+		b.CommitGraphFunc.SetDefaultReturn(it, nil)
+		cc, err = cli.CommitGraph(context.Background(), &v1.CommitGraphRequest{
+			RepoName: "therepo",
+			AllRefs:  true,
+		})
+		require.NoError(t, err)
+		_, err = cc.Recv()
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+}
+
 func TestGRPCServer_MergeBaseOctopus(t *testing.T) {
 	ctx := context.Background()
 	t.Run("argument validation", func(t *testing.T) {
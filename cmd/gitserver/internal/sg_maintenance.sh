@@ -54,7 +54,16 @@ git reflog expire --all
 # instances. Restricting the memory consumption by setting pack.windowMemory,
 # pack.deltaCacheSize and pack.threads in addition to --geometric=2 seemed to
 # have no effect.
-git repack -d -l -A --write-bitmap-index --window-memory 100m --unpack-unreachable=now
+#
+# Forks which borrow objects from an object pool through objects/info/alternates
+# cannot have bitmaps, because -l leaves out the objects found in the pool. The
+# objects only the fork references are never in the pool, so pruning them here
+# is safe. The pool itself is maintained by gitserver's janitor.
+if [ -s objects/info/alternates ]; then
+  git -c repack.writeBitmaps=false repack -d -l -A --window-memory 100m --unpack-unreachable=now
+else
+  git repack -d -l -A --write-bitmap-index --window-memory 100m --unpack-unreachable=now
+fi
 
 # With the --changed-paths option, compute and write information about the
 # paths changed between a commit and its first parent. This operation can take
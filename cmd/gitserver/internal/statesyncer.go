@@ -209,6 +209,10 @@ func syncRepoState(
 				shouldUpdate = true
 			}
 			cloneStatus := cloneStatus(cloned, locked)
+			// Offloaded repos are not on disk, but they are not lost either.
+			if cloneStatus == types.CloneStatusNotCloned && repo.CloneStatus == types.CloneStatusTiered {
+				cloneStatus = types.CloneStatusTiered
+			}
 			if repo.CloneStatus != cloneStatus {
 				repo.CloneStatus = cloneStatus
 				// Since the repo has been recloned or is being cloned
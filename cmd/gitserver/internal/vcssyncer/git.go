@@ -26,6 +26,9 @@ type gitRepoSyncer struct {
 	logger                  log.Logger
 	recordingCommandFactory *wrexec.RecordingCommandFactory
 	getRemoteURLSource      func(ctx context.Context, name api.RepoName) (RemoteURLSource, error)
+	// partialClone indicates that repositories should be cloned as blobless
+	// partial clones.
+	partialClone bool
 }
 
 func NewGitRepoSyncer(
@@ -38,6 +41,18 @@ func NewGitRepoSyncer(
 		getRemoteURLSource:      getRemoteURLSource}
 }
 
+// NewPartialCloneGitRepoSyncer returns a syncer for Git repositories that clones
+// and fetches repositories as blobless partial clones. Blobs missing locally
+// need to be fetched on demand, see NewPartialCloneHydrator.
+func NewPartialCloneGitRepoSyncer(
+	logger log.Logger,
+	r *wrexec.RecordingCommandFactory,
+	getRemoteURLSource func(ctx context.Context, name api.RepoName) (RemoteURLSource, error)) *gitRepoSyncer {
+	s := NewGitRepoSyncer(logger, r, getRemoteURLSource)
+	s.partialClone = true
+	return s
+}
+
 func (s *gitRepoSyncer) Type() string {
 	return "git"
 }
@@ -115,6 +130,14 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, _ common.G
 		tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", string(dir))
 	}
 
+	if s.partialClone {
+		tryWrite(s.logger, progressWriter, "Configuring partial clone\n")
+
+		if err := configurePartialClone(ctx, dir); err != nil {
+			return err
+		}
+	}
+
 	source, err := s.getRemoteURLSource(ctx, repo)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get remote URL source for %q", repo)
@@ -159,6 +182,15 @@ func (s *gitRepoSyncer) Fetch(ctx context.Context, repoName api.RepoName, dir co
 		return errors.Wrapf(err, "failed to get remote URL source for %s", repoName)
 	}
 
+	// Repositories that were fully cloned before partial clones were enabled
+	// for their code host are converted to partial clones, so that subsequent
+	// fetches skip blobs as well.
+	if s.partialClone && !git.IsPartialClone(dir) {
+		if err := configurePartialClone(ctx, dir); err != nil {
+			return err
+		}
+	}
+
 	// Fetch the remote contents.
 	{
 		tryWrite(s.logger, progressWriter, "Fetching remote contents\n")
@@ -205,21 +237,17 @@ func (s *gitRepoSyncer) runFetchCommand(ctx context.Context, repoName api.RepoNa
 		configRemoteOpts = false
 	} else if useRefspecOverrides() {
 		cmd = refspecOverridesFetchCmd(ctx, remoteURL)
+	} else if s.partialClone {
+		// In partial clones, we fetch from the promisor remote instead of the
+		// URL directly, because git only allows filtered fetches from it.
+		cmd = exec.CommandContext(ctx, "git", append([]string{"fetch",
+			"--progress", "--prune", "--filter=" + partialCloneFilter, partialCloneRemote},
+			defaultRefspecs...)...)
+		cmd.Env = append(os.Environ(), partialCloneRemoteEnv(remoteURL)...)
 	} else {
-		cmd = exec.CommandContext(ctx, "git", "fetch",
-			"--progress", "--prune", remoteURL.String(),
-			// Normal git refs
-			"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
-			// GitHub pull requests
-			"+refs/pull/*:refs/pull/*",
-			// GitLab merge requests
-			"+refs/merge-requests/*:refs/merge-requests/*",
-			// Bitbucket pull requests
-			"+refs/pull-requests/*:refs/pull-requests/*",
-			// Gerrit changesets
-			"+refs/changes/*:refs/changes/*",
-			// Possibly deprecated refs for sourcegraph zap experiment?
-			"+refs/sourcegraph/*:refs/sourcegraph/*")
+		cmd = exec.CommandContext(ctx, "git", append([]string{"fetch",
+			"--progress", "--prune", remoteURL.String()},
+			defaultRefspecs...)...)
 	}
 
 	if cmd.Env == nil {
@@ -247,6 +275,22 @@ func (s *gitRepoSyncer) runFetchCommand(ctx context.Context, repoName api.RepoNa
 	return executil.RunCommandWriteOutput(ctx, wrCmd, progressWriter, redactor.Redact)
 }
 
+// defaultRefspecs are the refspecs fetched from the remote.
+var defaultRefspecs = []string{
+	// Normal git refs
+	"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
+	// GitHub pull requests
+	"+refs/pull/*:refs/pull/*",
+	// GitLab merge requests
+	"+refs/merge-requests/*:refs/merge-requests/*",
+	// Bitbucket pull requests
+	"+refs/pull-requests/*:refs/pull-requests/*",
+	// Gerrit changesets
+	"+refs/changes/*:refs/changes/*",
+	// Possibly deprecated refs for sourcegraph zap experiment?
+	"+refs/sourcegraph/*:refs/sourcegraph/*",
+}
+
 var headBranchPattern = lazyregexp.New(`HEAD branch: (.+?)\n`)
 
 // setHEAD configures git repo defaults (such as what HEAD is) which are
@@ -0,0 +1,227 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// mercurialRemoteHelperPrefix makes git talk to a remote through the hg remote
+// helper, which is provided by git-cinnabar.
+const mercurialRemoteHelperPrefix = "hg::"
+
+// mercurialDefaultBranch is the ref git-cinnabar maps the tip of the default
+// branch of a Mercurial repository to. This is what hg checks out by default,
+// so we use it as HEAD.
+const mercurialDefaultBranch = "refs/heads/branches/default/tip"
+
+// mercurialRefspecs are the refspecs fetched from Mercurial remotes. Branch
+// heads are exposed under refs/heads/branches/, bookmarks under
+// refs/heads/bookmarks/ and tags under refs/tags/.
+var mercurialRefspecs = []string{"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"}
+
+// mercurialRepoSyncer is a syncer for Mercurial repositories. Repositories are
+// converted to git by git-cinnabar, which is used as a git remote helper.
+//
+// git-cinnabar stores the metadata it needs to convert changesets, including
+// the mapping between Mercurial changeset hashes and git commit hashes, in the
+// converted repository under refs/cinnabar/. Because of this, fetches only
+// convert changesets that have not been converted before, and the git commit
+// of a changeset never changes. The mapping can be queried with
+// `git cinnabar hg2git` and `git cinnabar git2hg`.
+type mercurialRepoSyncer struct {
+	logger                  log.Logger
+	recordingCommandFactory *wrexec.RecordingCommandFactory
+	getRemoteURLSource      func(ctx context.Context, name api.RepoName) (RemoteURLSource, error)
+}
+
+func NewMercurialRepoSyncer(
+	logger log.Logger,
+	r *wrexec.RecordingCommandFactory,
+	getRemoteURLSource func(ctx context.Context, name api.RepoName) (RemoteURLSource, error),
+) VCSSyncer {
+	return &mercurialRepoSyncer{
+		logger:                  logger.Scoped("MercurialRepoSyncer"),
+		recordingCommandFactory: r,
+		getRemoteURLSource:      getRemoteURLSource,
+	}
+}
+
+func (s *mercurialRepoSyncer) Type() string {
+	return "mercurial"
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *mercurialRepoSyncer) IsCloneable(ctx context.Context, repoName api.RepoName) error {
+	remoteURL, err := s.remoteURL(ctx, repoName)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// git-cinnabar keeps its metadata in the repository it runs in, so the
+	// remote helper needs one even for ls-remote. We don't have one yet, so we
+	// use a scratch repository that is thrown away afterwards.
+	scratch, err := os.MkdirTemp("", "hg-ls-remote-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(scratch)
+	if out, err := exec.CommandContext(ctx, "git", "init", "--bare", "--quiet", scratch).CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to create scratch repository: %s", out)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "ls-remote", mercurialRemoteHelperPrefix+remoteURL.String(), "HEAD")
+	cmd.Dir = scratch
+
+	// Configure the command to be able to talk to a remote.
+	executil.ConfigureRemoteGitCommand(cmd, remoteURL)
+
+	r := urlredactor.New(remoteURL)
+	out, err := s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact).CombinedOutput()
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = errors.Wrap(err, "failed to check remote access: "+r.Redact(string(out)))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Clone converts a Mercurial repository into a git repository at tmpPath,
+// reporting redacted progress logs via the progressWriter.
+func (s *mercurialRepoSyncer) Clone(ctx context.Context, repo api.RepoName, _ common.GitDir, tmpPath string, progressWriter io.Writer) error {
+	dir := common.GitDir(tmpPath)
+
+	// First, make sure the tmpPath exists.
+	if err := os.MkdirAll(string(dir), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	tryWrite(s.logger, progressWriter, "Creating bare repo\n")
+	if err := git.MakeBareRepo(ctx, string(dir)); err != nil {
+		return err
+	}
+	tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", string(dir))
+
+	tryWrite(s.logger, progressWriter, "Converting Mercurial repository using git-cinnabar\n")
+	if err := s.fetch(ctx, repo, dir, progressWriter); err != nil {
+		return err
+	}
+	tryWrite(s.logger, progressWriter, "Converted Mercurial repository\n")
+
+	tryWrite(s.logger, progressWriter, "Setting local HEAD to the default branch\n")
+	if err := s.setHEAD(ctx, dir); err != nil {
+		return errors.Wrap(err, "failed to set local HEAD")
+	}
+	tryWrite(s.logger, progressWriter, "Finished setting local HEAD to the default branch\n")
+
+	return nil
+}
+
+// Fetch converts changesets of a Mercurial repository that were added since the
+// last fetch.
+func (s *mercurialRepoSyncer) Fetch(ctx context.Context, repoName api.RepoName, dir common.GitDir, progressWriter io.Writer) error {
+	tryWrite(s.logger, progressWriter, "Converting new changesets using git-cinnabar\n")
+	if err := s.fetch(ctx, repoName, dir, progressWriter); err != nil {
+		return err
+	}
+	tryWrite(s.logger, progressWriter, "Converted new changesets\n")
+
+	if err := s.setHEAD(ctx, dir); err != nil {
+		return errors.Wrap(err, "failed to set local HEAD")
+	}
+
+	return nil
+}
+
+func (s *mercurialRepoSyncer) fetch(ctx context.Context, repoName api.RepoName, dir common.GitDir, progressWriter io.Writer) error {
+	remoteURL, err := s.remoteURL(ctx, repoName)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", append([]string{"fetch",
+		"--progress", "--prune", mercurialRemoteHelperPrefix + remoteURL.String()},
+		mercurialRefspecs...)...)
+	dir.Set(cmd)
+
+	// Configure the command to be able to talk to a remote.
+	executil.ConfigureRemoteGitCommand(cmd, remoteURL)
+
+	redactor := urlredactor.New(remoteURL)
+	wrCmd := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repoName, cmd).WithRedactorFunc(redactor.Redact)
+
+	// Note: Using RunCommandWriteOutput here does NOT store the output of the
+	// command as the command output of the wrexec command, because the pipes are
+	// already used.
+	exitCode, err := executil.RunCommandWriteOutput(ctx, wrCmd, progressWriter, redactor.Redact)
+	if err != nil {
+		return errors.Wrapf(err, "failed to run hg->git conversion: exit code %d", exitCode)
+	}
+
+	return nil
+}
+
+// setHEAD points HEAD at the tip of the default branch of the Mercurial
+// repository. If the repository has no default branch, HEAD points at the
+// first branch instead.
+func (s *mercurialRepoSyncer) setHEAD(ctx context.Context, dir common.GitDir) error {
+	head := mercurialDefaultBranch
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", head)
+	dir.Set(cmd)
+	if err := cmd.Run(); err != nil {
+		cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--count=1", "--format=%(refname)", "refs/heads/")
+		dir.Set(cmd)
+		out, err := cmd.Output()
+		if err != nil {
+			return errors.Wrap(err, "failed to list branches")
+		}
+		if ref := strings.TrimSpace(string(out)); ref != "" {
+			head = ref
+		}
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "symbolic-ref", "HEAD", head)
+	dir.Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to set HEAD: %s", string(out))
+	}
+
+	return nil
+}
+
+func (s *mercurialRepoSyncer) remoteURL(ctx context.Context, repoName api.RepoName) (*vcs.URL, error) {
+	source, err := s.getRemoteURLSource(ctx, repoName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get remote URL source for %s", repoName)
+	}
+
+	remoteURL, err := source.RemoteURL(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get remote URL for %s", repoName)
+	}
+
+	return remoteURL, nil
+}
@@ -0,0 +1,196 @@
+package vcssyncer
+
+import (
+	"context"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// partialCloneRemote is the name of the promisor remote we configure in
+// partial clones. We never persist the URL of this remote in the repository
+// config, as it can contain credentials. Instead, it is passed to every git
+// command that talks to the remote through the environment, see
+// partialCloneRemoteEnv.
+const partialCloneRemote = "origin"
+
+// partialCloneFilter is the object filter used for partial clones. We only omit
+// blobs, as trees are required for almost every operation.
+const partialCloneFilter = "blob:none"
+
+// partialCloneConfig is the subset of a code host connection config relevant to
+// partial clones. All code host connections that support partial clones share
+// this field.
+type partialCloneConfig struct {
+	PartialClone bool `json:"partialClone"`
+}
+
+// partialCloneEnabledCache caches whether partial clones are enabled per
+// external service ID. Without it, we'd have to fetch and decrypt the code host
+// connection config on every clone and fetch of every git repository.
+var partialCloneEnabledCache = expirable.NewLRU[int64, bool](1000, nil, time.Minute)
+
+// partialCloneEnabled returns whether the code host connections that r is
+// synced from have partial clones enabled. If r is synced from more than one
+// connection, all of them need to enable partial clones, so that the result
+// doesn't depend on which connection we look at.
+func partialCloneEnabled(ctx context.Context, store database.ExternalServiceStore, r *types.Repo) (bool, error) {
+	if len(r.Sources) == 0 {
+		return false, nil
+	}
+
+	ids := make([]int64, 0, len(r.Sources))
+	for _, info := range r.Sources {
+		ids = append(ids, info.ExternalServiceID())
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		enabled, err := externalServicePartialCloneEnabled(ctx, store, id)
+		if err != nil || !enabled {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// externalServicePartialCloneEnabled returns whether the code host connection
+// with the given ID has partial clones enabled.
+func externalServicePartialCloneEnabled(ctx context.Context, store database.ExternalServiceStore, id int64) (bool, error) {
+	if enabled, ok := partialCloneEnabledCache.Get(id); ok {
+		return enabled, nil
+	}
+
+	extSvc, err := store.GetByID(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(err, "get external service")
+	}
+	rawConfig, err := extSvc.Config.Decrypt(ctx)
+	if err != nil {
+		return false, err
+	}
+	normalized, err := jsonc.Parse(rawConfig)
+	if err != nil {
+		return false, errors.Wrap(err, "normalize JSON")
+	}
+	var c partialCloneConfig
+	if err := jsoniter.Unmarshal(normalized, &c); err != nil {
+		return false, errors.Wrap(err, "unmarshal JSON")
+	}
+
+	partialCloneEnabledCache.Add(id, c.PartialClone)
+	return c.PartialClone, nil
+}
+
+// configurePartialClone marks the repository at dir as a partial clone, so that
+// git accepts objects missing from the local object database.
+func configurePartialClone(ctx context.Context, dir common.GitDir) error {
+	for _, kv := range [][2]string{
+		// Extensions are only honored in repository format version 1.
+		{"core.repositoryformatversion", "1"},
+		{"extensions.partialClone", partialCloneRemote},
+		{"remote." + partialCloneRemote + ".promisor", "true"},
+		{"remote." + partialCloneRemote + ".partialclonefilter", partialCloneFilter},
+	} {
+		cmd := exec.CommandContext(ctx, "git", "config", kv[0], kv[1])
+		dir.Set(cmd)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return errors.Wrapf(err, "failed to set %s: %s", kv[0], string(out))
+		}
+	}
+	return nil
+}
+
+// partialCloneRemoteEnv returns the environment variables that configure the
+// URL of the promisor remote for a single git command. We use the environment
+// over -c arguments so that credentials in the URL never show up in the
+// process list.
+func partialCloneRemoteEnv(remoteURL *vcs.URL) []string {
+	return []string{
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=remote." + partialCloneRemote + ".url",
+		"GIT_CONFIG_VALUE_0=" + remoteURL.String(),
+	}
+}
+
+// NewPartialCloneHydrator returns a git.ObjectHydrator that fetches objects
+// missing from partial clones from the remote of the repository.
+func NewPartialCloneHydrator(
+	logger log.Logger,
+	r *wrexec.RecordingCommandFactory,
+	getRemoteURLSource func(ctx context.Context, name api.RepoName) (RemoteURLSource, error),
+) git.ObjectHydrator {
+	return &partialCloneHydrator{
+		logger:                  logger.Scoped("PartialCloneHydrator"),
+		recordingCommandFactory: r,
+		getRemoteURLSource:      getRemoteURLSource,
+	}
+}
+
+type partialCloneHydrator struct {
+	logger                  log.Logger
+	recordingCommandFactory *wrexec.RecordingCommandFactory
+	getRemoteURLSource      func(ctx context.Context, name api.RepoName) (RemoteURLSource, error)
+}
+
+func (h *partialCloneHydrator) HydrateObjects(ctx context.Context, repo api.RepoName, dir common.GitDir, oids []string) error {
+	source, err := h.getRemoteURLSource(ctx, repo)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get remote URL source for %s", repo)
+	}
+
+	remoteURL, err := source.RemoteURL(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get remote URL for %s", repo)
+	}
+
+	// This mirrors the fetch git itself runs when it lazily fetches missing
+	// objects from a promisor remote.
+	cmd := exec.CommandContext(ctx, "git",
+		"-c", "fetch.negotiationAlgorithm=noop",
+		"fetch",
+		"--no-tags",
+		"--no-write-fetch-head",
+		"--recurse-submodules=no",
+		"--filter="+partialCloneFilter,
+		"--stdin",
+		partialCloneRemote,
+	)
+	cmd.Stdin = strings.NewReader(strings.Join(oids, "\n") + "\n")
+	dir.Set(cmd)
+
+	// Configure the command to be able to talk to a remote.
+	executil.ConfigureRemoteGitCommand(cmd, remoteURL)
+	cmd.Env = append(cmd.Env, partialCloneRemoteEnv(remoteURL)...)
+
+	r := urlredactor.New(remoteURL)
+	out, err := h.recordingCommandFactory.WrapWithRepoName(ctx, h.logger, repo, cmd).WithRedactorFunc(r.Redact).CombinedOutput()
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = errors.Wrap(err, "failed to fetch missing objects: "+r.Redact(string(out)))
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+)
+
+func TestPartialCloneGitRepoSyncer(t *testing.T) {
+	ctx := context.Background()
+
+	// Prepare a remote that serves filtered fetches.
+	remoteDir := t.TempDir()
+	for _, cmd := range []string{
+		"git init --initial-branch=main .",
+		"echo a > a.txt",
+		"echo b > b.txt",
+		"git add .",
+		"git -c user.name=a -c user.email=a@example.com commit -m first",
+		"git config uploadpack.allowFilter true",
+		"git config uploadpack.allowAnySHA1InWant true",
+	} {
+		c := exec.Command("bash", "-c", cmd)
+		c.Dir = remoteDir
+		out, err := c.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	remoteURL, err := vcs.ParseURL("file://" + remoteDir)
+	require.NoError(t, err)
+	getRemoteURLSource := func(context.Context, api.RepoName) (RemoteURLSource, error) {
+		return RemoteURLSourceFunc(func(context.Context) (*vcs.URL, error) {
+			return remoteURL, nil
+		}), nil
+	}
+
+	rcf := wrexec.NewNoOpRecordingCommandFactory()
+	syncer := NewPartialCloneGitRepoSyncer(logtest.Scoped(t), rcf, getRemoteURLSource)
+
+	tmpPath := filepath.Join(t.TempDir(), ".git")
+	require.NoError(t, syncer.Clone(ctx, "repo", common.GitDir(tmpPath), tmpPath, io.Discard))
+
+	dir := common.GitDir(tmpPath)
+	require.True(t, git.IsPartialClone(dir))
+
+	// The remote URL must never be persisted.
+	config, err := os.ReadFile(dir.Path("config"))
+	require.NoError(t, err)
+	require.NotContains(t, string(config), remoteDir)
+
+	missing := func() []string {
+		c := exec.Command("git", "rev-list", "--objects", "--missing=print", "HEAD")
+		dir.Set(c)
+		out, err := c.Output()
+		require.NoError(t, err)
+		var oids []string
+		for _, line := range strings.Split(string(out), "\n") {
+			if oid, ok := strings.CutPrefix(line, "?"); ok {
+				oids = append(oids, oid)
+			}
+		}
+		return oids
+	}
+
+	oids := missing()
+	require.Len(t, oids, 2)
+
+	hydrator := NewPartialCloneHydrator(logtest.Scoped(t), rcf, getRemoteURLSource)
+	require.NoError(t, hydrator.HydrateObjects(ctx, "repo", dir, oids[:1]))
+	require.Equal(t, oids[1:], missing())
+
+	// Fetching again keeps the repository a partial clone.
+	require.NoError(t, syncer.Fetch(ctx, "repo", dir, io.Discard))
+	require.Equal(t, oids[1:], missing())
+}
@@ -177,6 +177,17 @@ func NewVCSSyncer(ctx context.Context, opts *NewVCSSyncerOpts) (VCSSyncer, error
 				return nil, err
 			}
 			return NewRubyPackagesSyncer(&c, opts.DepsSvc, cli, opts.FS, opts.GetRemoteURLSource), nil
+		case extsvc.TypeMercurial:
+			return NewMercurialRepoSyncer(opts.Logger, opts.RecordingCommandFactory, opts.GetRemoteURLSource), nil
+		}
+
+		partialClone, err := partialCloneEnabled(ctx, opts.ExternalServiceStore, r)
+		if err != nil {
+			// Partial clones are an optimization, failing to read the code host
+			// connection shouldn't prevent us from syncing the repository.
+			opts.Logger.Warn("failed to determine whether partial clones are enabled, using a full clone", log.String("repo", string(opts.Repo)), log.Error(err))
+		} else if partialClone {
+			return NewPartialCloneGitRepoSyncer(opts.Logger, opts.RecordingCommandFactory, opts.GetRemoteURLSource), nil
 		}
 
 		return NewGitRepoSyncer(opts.Logger, opts.RecordingCommandFactory, opts.GetRemoteURLSource), nil
@@ -2,6 +2,7 @@ package vcssyncer
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func TestGetVCSSyncer(t *testing.T) {
@@ -72,3 +74,86 @@ func TestGetVCSSyncer(t *testing.T) {
 
 	require.Equal(t, "perforce", s.Type())
 }
+
+func TestGetVCSSyncer_PartialClone(t *testing.T) {
+	t.Cleanup(partialCloneEnabledCache.Purge)
+
+	repoStore := dbmocks.NewMockRepoStore()
+	repoStore.GetByNameFunc.SetDefaultHook(func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{
+			Name: name,
+			ExternalRepo: api.ExternalRepoSpec{
+				ServiceType: extsvc.TypeGitHub,
+			},
+			Sources: map[string]*types.SourceInfo{
+				"extsvc:github:1": {
+					ID:       "extsvc:github:1",
+					CloneURL: "https://github.com/foo/bar",
+				},
+			},
+		}, nil
+	})
+
+	newSyncer := func(t *testing.T, extsvcStore *dbmocks.MockExternalServiceStore) *gitRepoSyncer {
+		t.Helper()
+		s, err := NewVCSSyncer(context.Background(), &NewVCSSyncerOpts{
+			ExternalServiceStore: extsvcStore,
+			RepoStore:            repoStore,
+			Repo:                 "github.com/foo/bar",
+			Logger:               logtest.Scoped(t),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "git", s.Type())
+		return s.(*instrumentedSyncer).base.(*gitRepoSyncer)
+	}
+
+	t.Run("config lookup fails", func(t *testing.T) {
+		partialCloneEnabledCache.Purge()
+
+		extsvcStore := dbmocks.NewMockExternalServiceStore()
+		extsvcStore.GetByIDFunc.SetDefaultReturn(nil, errors.New("boom"))
+
+		// The sync must not fail because of the lookup, we fall back to a
+		// full clone.
+		require.False(t, newSyncer(t, extsvcStore).partialClone)
+	})
+
+	t.Run("config is cached", func(t *testing.T) {
+		partialCloneEnabledCache.Purge()
+
+		extsvcStore := dbmocks.NewMockExternalServiceStore()
+		extsvcStore.GetByIDFunc.SetDefaultReturn(&types.ExternalService{
+			ID:     1,
+			Kind:   extsvc.KindGitHub,
+			Config: extsvc.NewUnencryptedConfig(`{"partialClone": true}`),
+		}, nil)
+
+		require.True(t, newSyncer(t, extsvcStore).partialClone)
+		require.True(t, newSyncer(t, extsvcStore).partialClone)
+		require.Len(t, extsvcStore.GetByIDFunc.History(), 1)
+	})
+	t.Run("all sources must enable partial clones", func(t *testing.T) {
+		partialCloneEnabledCache.Purge()
+
+		extsvcStore := dbmocks.NewMockExternalServiceStore()
+		extsvcStore.GetByIDFunc.SetDefaultHook(func(_ context.Context, id int64) (*types.ExternalService, error) {
+			return &types.ExternalService{
+				ID:     id,
+				Kind:   extsvc.KindGitHub,
+				Config: extsvc.NewUnencryptedConfig(fmt.Sprintf(`{"partialClone": %t}`, id == 1)),
+			}, nil
+		})
+
+		repo := &types.Repo{
+			Sources: map[string]*types.SourceInfo{
+				"extsvc:github:1": {ID: "extsvc:github:1"},
+				"extsvc:github:2": {ID: "extsvc:github:2"},
+			},
+		}
+		for range 10 {
+			enabled, err := partialCloneEnabled(context.Background(), extsvcStore, repo)
+			require.NoError(t, err)
+			require.False(t, enabled)
+		}
+	})
+}
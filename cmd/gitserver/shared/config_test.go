@@ -36,6 +36,44 @@ func TestConfigDefaults(t *testing.T) {
 	if have, want := config.JanitorDisableDeleteReposOnWrongShard, false; have != want {
 		t.Errorf("invalid value for JanitorDisableDeleteReposOnWrongShard: have=%t want=%t", have, want)
 	}
+	if have, want := config.ColdStorageEnabled, false; have != want {
+		t.Errorf("invalid value for ColdStorageEnabled: have=%t want=%t", have, want)
+	}
+}
+
+func TestConfigColdStorage(t *testing.T) {
+	config := Config{}
+	config.SetMockGetter(mapGetter(map[string]string{
+		"SRC_REPOS_COLD_STORAGE_ENABLED":   "true",
+		"SRC_REPOS_COLD_STORAGE_BACKEND":   "Local",
+		"SRC_REPOS_COLD_STORAGE_LOCAL_DIR": "/data/cold",
+	}))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if have, want := config.ColdStorageInactiveAfter, 90*24*time.Hour; have != want {
+		t.Errorf("invalid value for ColdStorageInactiveAfter: have=%s want=%s", have, want)
+	}
+	if have, want := config.ColdStorage.Backend, "local"; have != want {
+		t.Errorf("invalid value for ColdStorage.Backend: have=%s want=%s", have, want)
+	}
+	if have, want := config.ColdStorage.Local.Dir, "/data/cold"; have != want {
+		t.Errorf("invalid value for ColdStorage.Local.Dir: have=%s want=%s", have, want)
+	}
+
+	config = Config{}
+	config.SetMockGetter(mapGetter(map[string]string{
+		"SRC_REPOS_COLD_STORAGE_ENABLED": "true",
+		"SRC_REPOS_COLD_STORAGE_BACKEND": "ftp",
+	}))
+	config.Load()
+
+	if err := config.Validate(); err == nil {
+		t.Fatal("expected validation error for invalid backend")
+	}
 }
 
 func mapGetter(env map[string]string) func(name, defaultValue, description string) string {
@@ -31,6 +31,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/lfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
@@ -47,6 +48,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine/recorder"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/object"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/service"
@@ -91,8 +94,21 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 	recordingCommandFactory := wrexec.NewRecordingCommandFactory(nil, 0)
 	locker := server.NewRepositoryLocker()
 	hostname := config.ExternalAddress
+	remoteURLFunc := func(ctx context.Context, repo api.RepoName) (string, error) {
+		return getRemoteURLFunc(ctx, db, repo)
+	}
+	hydrator := vcssyncer.NewPartialCloneHydrator(logger, recordingCommandFactory, remoteURLSourceFunc(remoteURLFunc))
+	lfsResolver := lfs.NewResolver(logger, remoteURLSourceFunc(remoteURLFunc), httpcli.UncachedExternalDoer)
 	backendSource := func(dir common.GitDir, repoName api.RepoName) git.GitBackend {
-		return git.NewObservableBackend(gitcli.NewBackend(logger, recordingCommandFactory, dir, repoName))
+		return git.NewObservableBackend(git.NewHydratingBackend(gitcli.NewBackend(logger, recordingCommandFactory, dir, repoName), hydrator, dir, repoName))
+	}
+	var coldStorage *server.ColdStorage
+	if config.ColdStorageEnabled {
+		storage, err := object.CreateLazyStorage(ctx, config.ColdStorage, object.NewOperations(observationCtx, "gitserver", "coldstorage"))
+		if err != nil {
+			return errors.Wrap(err, "initializing cold storage")
+		}
+		coldStorage = server.NewColdStorage(logger, fs, db, locker, storage, hostname, config.ColdStorageInactiveAfter)
 	}
 	gitserver := makeServer(
 		observationCtx,
@@ -100,12 +116,13 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		db,
 		recordingCommandFactory,
 		backendSource,
+		hydrator,
+		lfsResolver,
+		coldStorage,
 		hostname,
 		config.CoursierCacheDir,
 		locker,
-		func(ctx context.Context, repo api.RepoName) (string, error) {
-			return getRemoteURLFunc(ctx, db, repo)
-		},
+		remoteURLFunc,
 	)
 
 	// Make sure we watch for config updates that affect the recordingCommandFactory.
@@ -144,6 +161,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 				ShardID:                        hostname,
 				JanitorInterval:                config.JanitorInterval,
 				DisableDeleteReposOnWrongShard: config.JanitorDisableDeleteReposOnWrongShard,
+				ColdStorage:                    coldStorage,
 			},
 			db,
 			fs,
@@ -198,6 +216,9 @@ func makeServer(
 	db database.DB,
 	recordingCommandFactory *wrexec.RecordingCommandFactory,
 	backendSource func(dir common.GitDir, repoName api.RepoName) git.GitBackend,
+	objectHydrator git.ObjectHydrator,
+	lfsResolver *lfs.Resolver,
+	coldStorage *internal.ColdStorage,
 	hostname string,
 	coursierCacheDir string,
 	locker internal.RepositoryLocker,
@@ -217,25 +238,7 @@ func makeServer(
 				RecordingCommandFactory: recordingCommandFactory,
 				Logger:                  observationCtx.Logger,
 				FS:                      fs,
-				GetRemoteURLSource: func(ctx context.Context, repo api.RepoName) (vcssyncer.RemoteURLSource, error) {
-					return vcssyncer.RemoteURLSourceFunc(func(ctx context.Context) (*vcs.URL, error) {
-						rawURL, err := getRemoteURLFunc(ctx, repo)
-						if err != nil {
-							return nil, errors.Wrapf(err, "getting remote URL for %q", repo)
-
-						}
-
-						u, err := vcs.ParseURL(rawURL)
-						if err != nil {
-							// TODO@ggilmore: Note that we can't redact the URL here because we can't
-							// parse it to know where the sensitive information is.
-							return nil, errors.Wrapf(err, "parsing remote URL %q", rawURL)
-						}
-
-						return u, nil
-
-					}), nil
-				},
+				GetRemoteURLSource:      remoteURLSourceFunc(getRemoteURLFunc),
 			})
 		},
 		FS:                      fs,
@@ -247,9 +250,36 @@ func makeServer(
 			ratelimit.GitRPSLimiterBucketName,
 			ratelimit.NewGlobalRateLimiter(observationCtx.Logger, ratelimit.GitRPSLimiterBucketName),
 		),
+		ObjectHydrator: objectHydrator,
+		LFSResolver:    lfsResolver,
+		ColdStorage:    coldStorage,
 	})
 }
 
+// remoteURLSourceFunc returns a function that returns a vcssyncer.RemoteURLSource
+// for a repository, backed by the given getRemoteURLFunc.
+func remoteURLSourceFunc(getRemoteURLFunc func(ctx context.Context, repo api.RepoName) (string, error)) func(ctx context.Context, repo api.RepoName) (vcssyncer.RemoteURLSource, error) {
+	return func(ctx context.Context, repo api.RepoName) (vcssyncer.RemoteURLSource, error) {
+		return vcssyncer.RemoteURLSourceFunc(func(ctx context.Context) (*vcs.URL, error) {
+			rawURL, err := getRemoteURLFunc(ctx, repo)
+			if err != nil {
+				return nil, errors.Wrapf(err, "getting remote URL for %q", repo)
+
+			}
+
+			u, err := vcs.ParseURL(rawURL)
+			if err != nil {
+				// TODO@ggilmore: Note that we can't redact the URL here because we can't
+				// parse it to know where the sensitive information is.
+				return nil, errors.Wrapf(err, "parsing remote URL %q", rawURL)
+			}
+
+			return u, nil
+
+		}), nil
+	}
+}
+
 // makeHTTPServer creates a new *http.Server for the gitserver endpoints and registers
 // it with methods on the given server. It multiplexes HTTP requests and gRPC requests
 // from a single port.
@@ -44,6 +44,7 @@ import (
 	proto "github.com/sourcegraph/sourcegraph/internal/repoupdater/v1"
 	"github.com/sourcegraph/sourcegraph/internal/service"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -117,7 +118,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		server.ChangesetSyncRegistry = syncRegistry
 	}
 
-	go watchSyncer(ctx, logger, syncer, updateScheduler, server.ChangesetSyncRegistry)
+	go watchSyncer(ctx, logger, db, syncer, updateScheduler, server.ChangesetSyncRegistry)
 
 	routines := []goroutine.BackgroundRoutine{
 		makeGRPCServer(logger, server),
@@ -288,6 +289,7 @@ func repoUpdaterStatsHandler(debugDumpers map[string]debugserver.Dumper) http.Ha
 func watchSyncer(
 	ctx context.Context,
 	logger log.Logger,
+	db database.DB,
 	syncer *repos.Syncer,
 	sched *scheduler.UpdateScheduler,
 	changesetSyncer syncer.UnarchivedChangesetSyncRegistry,
@@ -312,10 +314,39 @@ func watchSyncer(
 					}
 				}
 			}
+
+			enqueueRepositoryWebhooks(ctx, logger, db, diff)
 		}
 	}
 }
 
+// enqueueRepositoryWebhooks enqueues outbound webhooks for the repositories
+// that were added or deleted in the given diff.
+func enqueueRepositoryWebhooks(ctx context.Context, logger log.Logger, db database.DB, diff types.RepoSyncDiff) {
+	for _, repo := range diff.Added {
+		events.EnqueueRepository(ctx, logger, db, events.RepositoryAdd, repo, nil)
+	}
+
+	if len(diff.Deleted) == 0 {
+		return
+	}
+
+	// Diffs of deleted repositories only carry their IDs, so we look the
+	// repositories up to include their names in the payloads.
+	deleted, err := db.Repos().List(ctx, database.ReposListOptions{
+		IDs:            diff.Deleted.IDs(),
+		IncludeDeleted: true,
+		IncludeBlocked: true,
+	})
+	if err != nil {
+		logger.Warn("error listing deleted repos for outbound webhooks", log.Error(err))
+		return
+	}
+	for _, repo := range deleted {
+		events.EnqueueRepository(ctx, logger, db, events.RepositoryDelete, repo, nil)
+	}
+}
+
 // newUnclonedReposManager creates a background routine that will periodically list
 // the uncloned repositories on gitserver and update the scheduler with the list.
 func newUnclonedReposManager(ctx context.Context, logger log.Logger, sched *scheduler.UpdateScheduler, store repos.Store) goroutine.BackgroundRoutine {
@@ -73,17 +73,20 @@ func Start(ctx context.Context, observationCtx *observation.Context, ready servi
 	// Explicitly don't scope Store logger under the parent logger
 	storeObservationCtx := observation.NewContext(log.Scoped("Store"))
 	store := &search.Store{
+		// We search Git LFS pointer files, not the binaries they point to.
 		FetchTar: func(ctx context.Context, repo api.RepoName, commit api.CommitID) (io.ReadCloser, error) {
 			return git.ArchiveReader(ctx, repo, gitserver.ArchiveOptions{
-				Treeish: string(commit),
-				Format:  gitserver.ArchiveFormatTar,
+				Treeish:     string(commit),
+				Format:      gitserver.ArchiveFormatTar,
+				LFSPointers: true,
 			})
 		},
 		FetchTarPaths: func(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) (io.ReadCloser, error) {
 			return git.ArchiveReader(ctx, repo, gitserver.ArchiveOptions{
-				Treeish: string(commit),
-				Format:  gitserver.ArchiveFormatTar,
-				Paths:   paths,
+				Treeish:     string(commit),
+				Format:      gitserver.ArchiveFormatTar,
+				Paths:       paths,
+				LFSPointers: true,
 			})
 		},
 		FilterTar:         search.NewFilterFactory(git),
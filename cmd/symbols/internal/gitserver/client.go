@@ -63,6 +63,9 @@ func (c *gitserverClient) FetchTar(ctx context.Context, repo api.RepoName, commi
 		Treeish: string(commit),
 		Format:  gitserver.ArchiveFormatTar,
 		Paths:   paths,
+		// Symbols are parsed from Git LFS pointer files, not the binaries they
+		// point to.
+		LFSPointers: true,
 	}
 
 	// Note: the sub-repo perms checker is nil here because we do the sub-repo filtering at a higher level
@@ -81,7 +84,7 @@ func (c *gitserverClient) ChangedFiles(ctx context.Context, repo api.RepoName, c
 }
 
 func (c *gitserverClient) NewFileReader(ctx context.Context, repoCommitPath types.RepoCommitPath) (io.ReadCloser, error) {
-	return c.innerClient.NewFileReader(ctx, api.RepoName(repoCommitPath.Repo), api.CommitID(repoCommitPath.Commit), repoCommitPath.Path)
+	return c.innerClient.NewLFSPointerReader(ctx, api.RepoName(repoCommitPath.Repo), api.CommitID(repoCommitPath.Commit), repoCommitPath.Path)
 }
 
 const revListPageSize = 100
@@ -0,0 +1,327 @@
+package squirrel
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func (s *SquirrelService) getDefC(ctx context.Context, node Node) (ret *Node, err error) {
+	defer s.onCall(node, String(node.Type()), lazyNodeStringer(&ret))()
+
+	switch node.Type() {
+	case "identifier", "type_identifier":
+		ident := node.Content(node.Contents)
+
+		cur := node.Node
+
+		for {
+			prev := cur
+			cur = cur.Parent()
+			if cur == nil {
+				s.breadcrumb(node, "getDefC: ran out of parents")
+				return nil, nil
+			}
+
+			switch cur.Type() {
+
+			case "translation_unit":
+				found := findTopLevelC(swapNode(node, cur), ident)
+				if found != nil {
+					return found, nil
+				}
+				return s.getDefInIncludesC(ctx, swapNode(node, cur), ident, map[string]struct{}{})
+
+			// Declarations are only in scope after they're declared.
+			case "compound_statement":
+				for stmt := prev; stmt != nil; stmt = stmt.PrevNamedSibling() {
+					for _, binding := range declarationBindingsC(stmt) {
+						if binding.Content(node.Contents) == ident && binding.StartByte() <= node.StartByte() {
+							return swapNodePtr(node, binding), nil
+						}
+					}
+				}
+				continue
+
+			case "function_definition":
+				declarator := cur.ChildByFieldName("declarator")
+				for declarator != nil && declarator.Type() != "function_declarator" {
+					declarator = declarator.ChildByFieldName("declarator")
+				}
+				if declarator == nil {
+					continue
+				}
+				parameters := declarator.ChildByFieldName("parameters")
+				if parameters == nil {
+					continue
+				}
+				for _, param := range children(parameters) {
+					binding := declaratorIdentC(param.ChildByFieldName("declarator"))
+					if binding != nil && binding.Content(node.Contents) == ident {
+						return swapNodePtr(node, binding), nil
+					}
+				}
+				continue
+
+			case "for_statement":
+				initializer := cur.ChildByFieldName("initializer")
+				if initializer == nil {
+					continue
+				}
+				for _, binding := range declarationBindingsC(initializer) {
+					if binding.Content(node.Contents) == ident {
+						return swapNodePtr(node, binding), nil
+					}
+				}
+				continue
+
+			// Skip all other nodes
+			default:
+				continue
+			}
+		}
+
+	case "field_identifier":
+		parent := node.Parent()
+		if parent == nil || parent.Type() != "field_expression" {
+			return nil, nil
+		}
+		argument := parent.ChildByFieldName("argument")
+		if argument == nil {
+			return nil, nil
+		}
+		return s.getFieldC(ctx, swapNode(node, argument), node.Content(node.Contents))
+
+	// No other nodes have a definition
+	default:
+		return nil, nil
+	}
+}
+
+// declaratorIdentC returns the identifier declared by a possibly nested declarator such as *x[3].
+func declaratorIdentC(declarator *sitter.Node) *sitter.Node {
+	for declarator != nil {
+		switch declarator.Type() {
+		case "identifier", "type_identifier", "field_identifier":
+			return declarator
+		case "parenthesized_declarator":
+			declarator = declarator.NamedChild(0)
+		case "init_declarator", "pointer_declarator", "array_declarator", "function_declarator", "attributed_declarator":
+			declarator = declarator.ChildByFieldName("declarator")
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// declarationBindingsC returns the identifiers declared by a declaration or typedef.
+func declarationBindingsC(decl *sitter.Node) []*sitter.Node {
+	switch decl.Type() {
+	case "declaration", "type_definition", "field_declaration":
+		var bindings []*sitter.Node
+		ty := decl.ChildByFieldName("type")
+		for _, child := range children(decl) {
+			if ty != nil && nodeId(child) == nodeId(ty) {
+				continue
+			}
+			if binding := declaratorIdentC(child); binding != nil {
+				bindings = append(bindings, binding)
+			}
+		}
+		if ty != nil {
+			bindings = append(bindings, specifierBindingsC(ty)...)
+		}
+		return bindings
+	case "struct_specifier", "union_specifier", "enum_specifier":
+		return specifierBindingsC(decl)
+	default:
+		return nil
+	}
+}
+
+// specifierBindingsC returns the tag and enumerators declared by a struct, union or enum definition.
+func specifierBindingsC(specifier *sitter.Node) []*sitter.Node {
+	switch specifier.Type() {
+	case "struct_specifier", "union_specifier", "enum_specifier":
+	default:
+		return nil
+	}
+	body := specifier.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var bindings []*sitter.Node
+	if name := specifier.ChildByFieldName("name"); name != nil {
+		bindings = append(bindings, name)
+	}
+	if specifier.Type() == "enum_specifier" {
+		for _, enumerator := range children(body) {
+			if name := enumerator.ChildByFieldName("name"); name != nil {
+				bindings = append(bindings, name)
+			}
+		}
+	}
+	return bindings
+}
+
+// findTopLevelC looks for a top-level declaration or macro named ident. Definitions are preferred over
+// declarations such as function prototypes.
+func findTopLevelC(unit Node, ident string) *Node {
+	var declared *Node
+	var find func(parent *sitter.Node) *Node
+	find = func(parent *sitter.Node) *Node {
+		for _, child := range children(parent) {
+			switch child.Type() {
+			case "function_definition":
+				name := declaratorIdentC(child.ChildByFieldName("declarator"))
+				if name != nil && name.Content(unit.Contents) == ident {
+					return swapNodePtr(unit, name)
+				}
+			case "preproc_def", "preproc_function_def":
+				name := child.ChildByFieldName("name")
+				if name != nil && name.Content(unit.Contents) == ident {
+					return swapNodePtr(unit, name)
+				}
+			case "preproc_ifdef", "preproc_if", "preproc_else", "preproc_elif":
+				// Include guards and conditional compilation.
+				if found := find(child); found != nil {
+					return found
+				}
+			default:
+				for _, binding := range declarationBindingsC(child) {
+					if binding.Content(unit.Contents) != ident {
+						continue
+					}
+					if binding.Parent() != nil && binding.Parent().Type() == "function_declarator" {
+						if declared == nil {
+							declared = swapNodePtr(unit, binding)
+						}
+						continue
+					}
+					return swapNodePtr(unit, binding)
+				}
+			}
+		}
+		return nil
+	}
+	if found := find(unit.Node); found != nil {
+		return found
+	}
+	return declared
+}
+
+// getDefInIncludesC looks for a top-level declaration in the files included with #include "...",
+// which are resolved relative to the including file.
+func (s *SquirrelService) getDefInIncludesC(ctx context.Context, unit Node, ident string, visited map[string]struct{}) (ret *Node, err error) {
+	defer s.onCall(unit, &Tuple{String(unit.RepoCommitPath.Path), String(ident)}, lazyNodeStringer(&ret))()
+
+	visited[unit.RepoCommitPath.Path] = struct{}{}
+
+	query := `(preproc_include path: (string_literal) @path)`
+	for _, path := range allCaptures(query, unit) {
+		includePath := filepath.Join(filepath.Dir(unit.RepoCommitPath.Path), strings.Trim(path.Content(path.Contents), `"`))
+		if _, ok := visited[includePath]; ok {
+			continue
+		}
+		included, err := s.parse(ctx, types.RepoCommitPath{
+			Repo:   unit.RepoCommitPath.Repo,
+			Commit: unit.RepoCommitPath.Commit,
+			Path:   includePath,
+		})
+		if err != nil {
+			s.breadcrumb(path, fmt.Sprintf("getDefInIncludesC: could not parse %s: %s", includePath, err))
+			visited[includePath] = struct{}{}
+			continue
+		}
+		if found := findTopLevelC(*included, ident); found != nil {
+			return found, nil
+		}
+		found, err := s.getDefInIncludesC(ctx, *included, ident, visited)
+		if err != nil || found != nil {
+			return found, err
+		}
+	}
+	return nil, nil
+}
+
+func (s *SquirrelService) getFieldC(ctx context.Context, object Node, field string) (ret *Node, err error) {
+	defer s.onCall(object, &Tuple{String(object.Type()), String(field)}, lazyNodeStringer(&ret))()
+
+	if object.Type() != "identifier" {
+		s.breadcrumb(object, fmt.Sprintf("getFieldC: unsupported object type %q", object.Type()))
+		return nil, nil
+	}
+	def, err := s.getDefC(ctx, object)
+	if err != nil || def == nil {
+		return nil, err
+	}
+
+	// Find the type of the declaration.
+	var ty *sitter.Node
+	for cur := def.Parent(); cur != nil; cur = cur.Parent() {
+		if cur.Type() == "declaration" || cur.Type() == "parameter_declaration" || cur.Type() == "field_declaration" {
+			ty = cur.ChildByFieldName("type")
+			break
+		}
+	}
+	if ty == nil {
+		return nil, nil
+	}
+	body, err := s.structBodyC(ctx, swapNode(*def, ty))
+	if err != nil || body == nil {
+		return nil, err
+	}
+	for _, decl := range children(body.Node) {
+		for _, binding := range declarationBindingsC(decl) {
+			if binding.Content(body.Contents) == field {
+				return swapNodePtr(*body, binding), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// structBodyC returns the field list of the struct or union that the given type refers to, following
+// typedefs.
+func (s *SquirrelService) structBodyC(ctx context.Context, ty Node) (ret *Node, err error) {
+	defer s.onCall(ty, String(ty.Type()), lazyNodeStringer(&ret))()
+
+	switch ty.Type() {
+	case "struct_specifier", "union_specifier":
+		if body := ty.ChildByFieldName("body"); body != nil {
+			return swapNodePtr(ty, body), nil
+		}
+		name := ty.ChildByFieldName("name")
+		if name == nil {
+			return nil, nil
+		}
+		def, err := s.getDefC(ctx, swapNode(ty, name))
+		if err != nil || def == nil || def.Parent() == nil || nodeId(def.Node) == nodeId(name) {
+			return nil, err
+		}
+		return s.structBodyC(ctx, swapNode(*def, def.Parent()))
+	case "type_identifier":
+		def, err := s.getDefC(ctx, ty)
+		if err != nil || def == nil {
+			return nil, err
+		}
+		for cur := def.Parent(); cur != nil; cur = cur.Parent() {
+			if cur.Type() == "type_definition" {
+				typedefType := cur.ChildByFieldName("type")
+				if typedefType == nil {
+					return nil, nil
+				}
+				return s.structBodyC(ctx, swapNode(*def, typedefType))
+			}
+		}
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
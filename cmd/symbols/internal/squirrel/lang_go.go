@@ -0,0 +1,439 @@
+package squirrel
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func (s *SquirrelService) getDefGo(ctx context.Context, node Node) (ret *Node, err error) {
+	defer s.onCall(node, String(node.Type()), lazyNodeStringer(&ret))()
+
+	switch node.Type() {
+	case "identifier", "type_identifier", "package_identifier":
+		ident := node.Content(node.Contents)
+
+		cur := node.Node
+
+		for {
+			prev := cur
+			cur = cur.Parent()
+			if cur == nil {
+				s.breadcrumb(node, "getDefGo: ran out of parents")
+				return nil, nil
+			}
+
+			switch cur.Type() {
+
+			case "source_file":
+				return s.getDefInPackageGo(ctx, swapNode(node, cur), ident)
+
+			case "qualified_type":
+				// pkg.Type
+				pkg := cur.ChildByFieldName("package")
+				if pkg == nil || nodeId(pkg) == nodeId(prev) {
+					continue
+				}
+				return s.getFieldGo(ctx, swapNode(node, pkg), ident)
+
+			// Statements are only in scope after they're declared.
+			case "block", "expression_case", "type_case", "default_case", "communication_case":
+				for stmt := prev; stmt != nil; stmt = stmt.PrevNamedSibling() {
+					if found := findBindingGo(swapNode(node, stmt), ident); found != nil && found.StartByte() <= node.StartByte() {
+						return found, nil
+					}
+				}
+				continue
+
+			case "function_declaration", "method_declaration", "func_literal":
+				for _, field := range []string{"receiver", "type_parameters", "parameters", "result"} {
+					child := cur.ChildByFieldName(field)
+					if child == nil {
+						continue
+					}
+					if found := findBindingGo(swapNode(node, child), ident); found != nil {
+						return found, nil
+					}
+				}
+				continue
+
+			case "if_statement", "expression_switch_statement", "type_switch_statement":
+				for _, field := range []string{"initializer", "alias"} {
+					child := cur.ChildByFieldName(field)
+					if child == nil {
+						continue
+					}
+					if field == "alias" {
+						// switch x := y.(type) { ... }
+						for _, alias := range children(child) {
+							if alias.Type() == "identifier" && alias.Content(node.Contents) == ident {
+								return swapNodePtr(node, alias), nil
+							}
+						}
+						continue
+					}
+					if found := findBindingGo(swapNode(node, child), ident); found != nil {
+						return found, nil
+					}
+				}
+				continue
+
+			case "for_statement":
+				for _, child := range children(cur) {
+					if found := findBindingGo(swapNode(node, child), ident); found != nil {
+						return found, nil
+					}
+				}
+				continue
+
+			// Skip all other nodes
+			default:
+				continue
+			}
+		}
+
+	case "field_identifier":
+		parent := node.Parent()
+		if parent == nil || parent.Type() != "selector_expression" {
+			return nil, nil
+		}
+		operand := parent.ChildByFieldName("operand")
+		if operand == nil {
+			return nil, nil
+		}
+		return s.getFieldGo(ctx, swapNode(node, operand), node.Content(node.Contents))
+
+	// No other nodes have a definition
+	default:
+		return nil, nil
+	}
+}
+
+// findBindingGo returns the identifier named ident that the given declaration, parameter list or
+// clause binds, if any.
+func findBindingGo(decl Node, ident string) *Node {
+	for _, binding := range bindingsGo(decl.Node) {
+		if binding.Content(decl.Contents) == ident {
+			return swapNodePtr(decl, binding)
+		}
+	}
+	return nil
+}
+
+func bindingsGo(node *sitter.Node) []*sitter.Node {
+	identifiers := func(node *sitter.Node, ty string) []*sitter.Node {
+		var idents []*sitter.Node
+		for _, child := range children(node) {
+			if child.Type() == ty {
+				idents = append(idents, child)
+			}
+		}
+		return idents
+	}
+
+	switch node.Type() {
+	case "short_var_declaration", "range_clause", "receive_statement":
+		// x, y := ...
+		left := node.ChildByFieldName("left")
+		if left == nil {
+			return nil
+		}
+		return identifiers(left, "identifier")
+	case "for_clause":
+		// for i := 0; ...
+		initializer := node.ChildByFieldName("initializer")
+		if initializer == nil {
+			return nil
+		}
+		return bindingsGo(initializer)
+	case "var_declaration", "const_declaration", "type_declaration", "var_spec_list",
+		"parameter_list", "type_parameter_list":
+		var bindings []*sitter.Node
+		for _, child := range children(node) {
+			bindings = append(bindings, bindingsGo(child)...)
+		}
+		return bindings
+	case "var_spec", "const_spec", "parameter_declaration", "variadic_parameter_declaration":
+		return identifiers(node, "identifier")
+	case "type_spec", "type_alias":
+		name := node.ChildByFieldName("name")
+		if name == nil {
+			return nil
+		}
+		return []*sitter.Node{name}
+	default:
+		return nil
+	}
+}
+
+// getDefInPackageGo looks for a top-level declaration or import named ident in the given file, then
+// in the other files of the same package.
+func (s *SquirrelService) getDefInPackageGo(ctx context.Context, sourceFile Node, ident string) (ret *Node, err error) {
+	defer s.onCall(sourceFile, &Tuple{String(sourceFile.Type()), String(ident)}, lazyNodeStringer(&ret))()
+
+	for _, child := range children(sourceFile.Node) {
+		switch child.Type() {
+		case "function_declaration":
+			name := child.ChildByFieldName("name")
+			if name != nil && name.Content(sourceFile.Contents) == ident {
+				return swapNodePtr(sourceFile, name), nil
+			}
+		case "var_declaration", "const_declaration", "type_declaration":
+			if found := findBindingGo(swapNode(sourceFile, child), ident); found != nil {
+				return found, nil
+			}
+		case "import_declaration":
+			query := `(import_spec) @spec`
+			for _, spec := range allCaptures(query, swapNode(sourceFile, child)) {
+				path := spec.ChildByFieldName("path")
+				if path == nil {
+					continue
+				}
+				importPath, err := strconv.Unquote(path.Content(sourceFile.Contents))
+				if err != nil {
+					continue
+				}
+				name := filepath.Base(importPath)
+				if alias := spec.ChildByFieldName("name"); alias != nil {
+					name = alias.Content(sourceFile.Contents)
+				}
+				if name == ident {
+					return s.resolveImportGo(ctx, sourceFile, importPath)
+				}
+			}
+		}
+	}
+
+	dir := filepath.Dir(sourceFile.RepoCommitPath.Path)
+	include := "^[^/]*\\.go$"
+	if dir != "." {
+		include = fmt.Sprintf("^%s/[^/]*\\.go$", dir)
+	}
+	return s.symbolSearchOne(ctx, sourceFile.RepoCommitPath.Repo, sourceFile.RepoCommitPath.Commit, []string{include}, ident)
+}
+
+// resolveImportGo returns the directory of the imported package if it belongs to a Go module in the
+// same repository. The module is found by looking for the nearest go.mod file.
+func (s *SquirrelService) resolveImportGo(ctx context.Context, node Node, importPath string) (*Node, error) {
+	for dir := filepath.Dir(node.RepoCommitPath.Path); ; dir = filepath.Dir(dir) {
+		goMod := types.RepoCommitPath{
+			Repo:   node.RepoCommitPath.Repo,
+			Commit: node.RepoCommitPath.Commit,
+			Path:   filepath.Join(dir, "go.mod"),
+		}
+		contents, err := s.readFile(ctx, goMod)
+		if err == nil {
+			module := goModulePath(contents)
+			if module == "" || !strings.HasPrefix(importPath+"/", module+"/") {
+				s.breadcrumb(node, fmt.Sprintf("resolveImportGo: %s is not in module %s", importPath, module))
+				return nil, nil
+			}
+			return &Node{
+				RepoCommitPath: types.RepoCommitPath{
+					Repo:   node.RepoCommitPath.Repo,
+					Commit: node.RepoCommitPath.Commit,
+					Path:   filepath.Join(dir, strings.TrimPrefix(importPath, module)),
+				},
+				Node:     nil,
+				Contents: node.Contents,
+				LangSpec: node.LangSpec,
+			}, nil
+		}
+		if dir == "." || dir == "/" {
+			return nil, nil
+		}
+	}
+}
+
+// goModulePath returns the module path declared in the given go.mod file.
+func goModulePath(goMod []byte) string {
+	for _, line := range strings.Split(string(goMod), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
+func (s *SquirrelService) getFieldGo(ctx context.Context, object Node, field string) (ret *Node, err error) {
+	defer s.onCall(object, &Tuple{String(object.Type()), String(field)}, lazyNodeStringer(&ret))()
+
+	switch object.Type() {
+	case "identifier", "package_identifier":
+		def, err := s.getDefGo(ctx, object)
+		if err != nil {
+			return nil, err
+		}
+		if def == nil {
+			return nil, nil
+		}
+
+		// The object is an imported package.
+		if def.Node == nil {
+			return s.symbolSearchOne(
+				ctx,
+				def.RepoCommitPath.Repo,
+				def.RepoCommitPath.Commit,
+				[]string{fmt.Sprintf("^%s/[^/]*\\.go$", def.RepoCommitPath.Path)},
+				field,
+			)
+		}
+
+		ty, err := s.getTypeDefGo(ctx, *def)
+		if err != nil {
+			return nil, err
+		}
+		if ty == nil {
+			return nil, nil
+		}
+		return lookupFieldGo(*ty, field), nil
+	default:
+		s.breadcrumb(object, fmt.Sprintf("getFieldGo: unrecognized object type %q", object.Type()))
+		return nil, nil
+	}
+}
+
+// getTypeDefGo returns the type_spec of the named type of the given definition, if it can be
+// determined.
+func (s *SquirrelService) getTypeDefGo(ctx context.Context, def Node) (ret *Node, err error) {
+	defer s.onCall(def, String(def.Type()), lazyNodeStringer(&ret))()
+
+	parent := def.Parent()
+	if parent == nil {
+		return nil, nil
+	}
+
+	switch parent.Type() {
+	case "type_spec":
+		return swapNodePtr(def, parent), nil
+	case "parameter_declaration", "var_spec":
+		if ty := parent.ChildByFieldName("type"); ty != nil {
+			return s.typeToTypeDefGo(ctx, swapNode(def, ty))
+		}
+		value := parent.ChildByFieldName("value")
+		if value == nil {
+			return nil, nil
+		}
+		return s.exprToTypeDefGo(ctx, swapNode(def, value), indexOfIdentifierGo(parent, def.Node))
+	case "expression_list":
+		decl := parent.Parent()
+		if decl == nil || decl.Type() != "short_var_declaration" {
+			return nil, nil
+		}
+		right := decl.ChildByFieldName("right")
+		if right == nil {
+			return nil, nil
+		}
+		return s.exprToTypeDefGo(ctx, swapNode(def, right), indexOfIdentifierGo(parent, def.Node))
+	default:
+		s.breadcrumb(swapNode(def, parent), fmt.Sprintf("getTypeDefGo: unrecognized def parent %q", parent.Type()))
+		return nil, nil
+	}
+}
+
+func indexOfIdentifierGo(parent *sitter.Node, ident *sitter.Node) int {
+	i := 0
+	for _, child := range children(parent) {
+		if nodeId(child) == nodeId(ident) {
+			return i
+		}
+		if child.Type() == "identifier" {
+			i++
+		}
+	}
+	return -1
+}
+
+// exprToTypeDefGo determines the type of the i-th expression of an expression list.
+func (s *SquirrelService) exprToTypeDefGo(ctx context.Context, exprs Node, i int) (*Node, error) {
+	if i < 0 || i >= int(exprs.NamedChildCount()) {
+		return nil, nil
+	}
+	expr := exprs.NamedChild(i)
+	for {
+		switch expr.Type() {
+		case "unary_expression":
+			// &T{...}
+			expr = expr.ChildByFieldName("operand")
+			if expr == nil {
+				return nil, nil
+			}
+			continue
+		case "composite_literal":
+			// T{...}
+			ty := expr.ChildByFieldName("type")
+			if ty == nil {
+				return nil, nil
+			}
+			return s.typeToTypeDefGo(ctx, swapNode(exprs, ty))
+		default:
+			s.breadcrumb(swapNode(exprs, expr), fmt.Sprintf("exprToTypeDefGo: unrecognized expression %q", expr.Type()))
+			return nil, nil
+		}
+	}
+}
+
+func (s *SquirrelService) typeToTypeDefGo(ctx context.Context, ty Node) (*Node, error) {
+	switch ty.Type() {
+	case "pointer_type":
+		if ty.NamedChildCount() == 0 {
+			return nil, nil
+		}
+		return s.typeToTypeDefGo(ctx, swapNode(ty, ty.NamedChild(0)))
+	case "type_identifier":
+		found, err := s.getDefGo(ctx, ty)
+		if err != nil || found == nil || found.Node == nil {
+			return nil, err
+		}
+		return s.getTypeDefGo(ctx, *found)
+	case "qualified_type":
+		name := ty.ChildByFieldName("name")
+		if name == nil {
+			return nil, nil
+		}
+		return s.typeToTypeDefGo(ctx, swapNode(ty, name))
+	default:
+		return nil, nil
+	}
+}
+
+// lookupFieldGo finds a field or method of the type declared by the given type_spec. Methods are only
+// looked up in the file that declares the type.
+func lookupFieldGo(typeSpec Node, field string) *Node {
+	ty := typeSpec.ChildByFieldName("type")
+	if ty != nil && ty.Type() == "struct_type" {
+		query := `(struct_type (field_declaration_list (field_declaration name: (field_identifier) @ident)))`
+		for _, capture := range allCaptures(query, swapNode(typeSpec, ty)) {
+			if capture.Content(capture.Contents) == field {
+				return &capture
+			}
+		}
+	}
+
+	name := typeSpec.ChildByFieldName("name")
+	if name == nil {
+		return nil
+	}
+	typeName := name.Content(typeSpec.Contents)
+	query := `(method_declaration receiver: (parameter_list (parameter_declaration type: [(type_identifier) @type (pointer_type (type_identifier) @type)])) name: (field_identifier) @name)`
+	var found *Node
+	forEachCapture(query, swapNode(typeSpec, getRoot(typeSpec.Node)), func(nameToNode map[string]Node) {
+		receiverType, ok := nameToNode["type"]
+		if !ok || receiverType.Content(receiverType.Contents) != typeName {
+			return
+		}
+		method, ok := nameToNode["name"]
+		if !ok || method.Content(method.Contents) != field {
+			return
+		}
+		found = &method
+	})
+	return found
+}
@@ -0,0 +1,558 @@
+package squirrel
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func (s *SquirrelService) getDefRust(ctx context.Context, node Node) (ret *Node, err error) {
+	defer s.onCall(node, String(node.Type()), lazyNodeStringer(&ret))()
+
+	switch node.Type() {
+	case "identifier", "type_identifier":
+		ident := node.Content(node.Contents)
+
+		cur := node.Node
+
+		for {
+			prev := cur
+			cur = cur.Parent()
+			if cur == nil {
+				s.breadcrumb(node, "getDefRust: ran out of parents")
+				return nil, nil
+			}
+
+			switch cur.Type() {
+
+			case "source_file", "declaration_list":
+				found := findItemRust(swapNode(node, cur), ident)
+				if found == nil {
+					return nil, nil
+				}
+				return s.followUseRust(ctx, *found)
+
+			case "scoped_identifier", "scoped_type_identifier":
+				// module::name
+				name := cur.ChildByFieldName("name")
+				if name == nil || nodeId(name) != nodeId(prev) {
+					continue
+				}
+				path := cur.ChildByFieldName("path")
+				if path == nil {
+					continue
+				}
+				container, err := s.resolvePathRust(ctx, swapNode(node, path))
+				if err != nil || container == nil {
+					return nil, err
+				}
+				found := s.findInContainerRust(*container, ident)
+				if found == nil {
+					return nil, nil
+				}
+				return s.followUseRust(ctx, *found)
+
+			case "use_as_clause":
+				// use a::{b as c}; resolve b the same way as c.
+				target := cur.ChildByFieldName("path")
+				alias := cur.ChildByFieldName("alias")
+				if target == nil || alias == nil || nodeId(target) != nodeId(prev) {
+					continue
+				}
+				return s.followUseRust(ctx, swapNode(node, alias))
+
+			case "block":
+				// Items are visible in the whole block, let bindings only after they're declared.
+				if found := findItemRust(swapNode(node, cur), ident); found != nil {
+					return s.followUseRust(ctx, *found)
+				}
+				for stmt := prev; stmt != nil; stmt = stmt.PrevNamedSibling() {
+					if stmt.Type() != "let_declaration" {
+						continue
+					}
+					found := findBindingRust(swapNode(node, stmt.ChildByFieldName("pattern")), ident)
+					// The value of a let declaration can't refer to its own bindings.
+					if found != nil && (nodeId(stmt) != nodeId(prev) || nodeId(found.Node) == nodeId(node.Node)) {
+						return found, nil
+					}
+				}
+				continue
+
+			case "function_item", "closure_expression":
+				if found := findBindingRust(swapNode(node, cur.ChildByFieldName("parameters")), ident); found != nil {
+					return found, nil
+				}
+				if found := findTypeParameterRust(swapNode(node, cur), ident); found != nil {
+					return found, nil
+				}
+				continue
+
+			case "impl_item", "trait_item", "struct_item", "enum_item", "type_item":
+				if found := findTypeParameterRust(swapNode(node, cur), ident); found != nil {
+					return found, nil
+				}
+				continue
+
+			case "for_expression", "match_arm", "let_condition":
+				// The pattern of a let condition is in scope in the condition's block.
+				if found := findBindingRust(swapNode(node, cur.ChildByFieldName("pattern")), ident); found != nil {
+					return found, nil
+				}
+				continue
+
+			case "if_expression", "while_expression":
+				condition := cur.ChildByFieldName("condition")
+				if condition == nil || condition.Type() != "let_condition" {
+					continue
+				}
+				if found := findBindingRust(swapNode(node, condition.ChildByFieldName("pattern")), ident); found != nil {
+					return found, nil
+				}
+				continue
+
+			// Skip all other nodes
+			default:
+				continue
+			}
+		}
+
+	// No other nodes have a definition
+	default:
+		return nil, nil
+	}
+}
+
+// findBindingRust looks for the binding named ident in the given pattern or parameter list.
+func findBindingRust(pattern Node, ident string) *Node {
+	if pattern.Node == nil {
+		return nil
+	}
+	for _, binding := range patternBindingsRust(pattern.Node) {
+		if binding.Content(pattern.Contents) == ident {
+			return swapNodePtr(pattern, binding)
+		}
+	}
+	return nil
+}
+
+// patternBindingsRust returns the identifiers bound by a pattern.
+func patternBindingsRust(pattern *sitter.Node) []*sitter.Node {
+	switch pattern.Type() {
+	case "identifier", "shorthand_field_identifier":
+		return []*sitter.Node{pattern}
+	case "parameter":
+		// x: i32
+		if p := pattern.ChildByFieldName("pattern"); p != nil {
+			return patternBindingsRust(p)
+		}
+		return nil
+	case "field_pattern":
+		// Point { x, y: b }
+		if p := pattern.ChildByFieldName("pattern"); p != nil {
+			return patternBindingsRust(p)
+		}
+		if name := pattern.ChildByFieldName("name"); name != nil {
+			return patternBindingsRust(name)
+		}
+		return nil
+	case "tuple_struct_pattern", "struct_pattern", "match_pattern":
+		// Some(x), Point { x }, x if x > 0
+		var bindings []*sitter.Node
+		ty := pattern.ChildByFieldName("type")
+		condition := pattern.ChildByFieldName("condition")
+		for _, child := range children(pattern) {
+			if (ty != nil && nodeId(child) == nodeId(ty)) || (condition != nil && nodeId(child) == nodeId(condition)) {
+				continue
+			}
+			bindings = append(bindings, patternBindingsRust(child)...)
+		}
+		return bindings
+	case "parameters", "closure_parameters", "tuple_pattern", "slice_pattern", "or_pattern",
+		"ref_pattern", "mut_pattern", "reference_pattern", "captured_pattern":
+		var bindings []*sitter.Node
+		for _, child := range children(pattern) {
+			bindings = append(bindings, patternBindingsRust(child)...)
+		}
+		return bindings
+	default:
+		return nil
+	}
+}
+
+func findTypeParameterRust(item Node, ident string) *Node {
+	typeParameters := item.ChildByFieldName("type_parameters")
+	if typeParameters == nil {
+		return nil
+	}
+	for _, child := range children(typeParameters) {
+		param := child
+		if child.Type() == "constrained_type_parameter" {
+			param = child.ChildByFieldName("left")
+		}
+		if param != nil && param.Type() == "type_identifier" && param.Content(item.Contents) == ident {
+			return swapNodePtr(item, param)
+		}
+	}
+	return nil
+}
+
+// findItemRust looks for an item (including use declarations) named ident in the given module or
+// block.
+func findItemRust(scope Node, ident string) *Node {
+	for _, child := range children(scope.Node) {
+		switch child.Type() {
+		case "function_item", "function_signature_item", "struct_item", "enum_item", "union_item", "trait_item",
+			"type_item", "const_item", "static_item", "mod_item", "macro_definition":
+			name := child.ChildByFieldName("name")
+			if name != nil && name.Content(scope.Contents) == ident {
+				return swapNodePtr(scope, name)
+			}
+		case "use_declaration":
+			argument := child.ChildByFieldName("argument")
+			if argument == nil {
+				continue
+			}
+			for _, binding := range useBindingsRust(argument) {
+				if binding.Content(scope.Contents) == ident {
+					return swapNodePtr(scope, binding)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// useBindingsRust returns the identifiers bound by the argument of a use declaration.
+func useBindingsRust(argument *sitter.Node) []*sitter.Node {
+	switch argument.Type() {
+	case "identifier":
+		return []*sitter.Node{argument}
+	case "scoped_identifier":
+		// use a::b;
+		if name := argument.ChildByFieldName("name"); name != nil {
+			return []*sitter.Node{name}
+		}
+		return nil
+	case "use_as_clause":
+		// use a::b as c;
+		if alias := argument.ChildByFieldName("alias"); alias != nil {
+			return []*sitter.Node{alias}
+		}
+		return nil
+	case "scoped_use_list":
+		// use a::{b, c};
+		if list := argument.ChildByFieldName("list"); list != nil {
+			return useBindingsRust(list)
+		}
+		return nil
+	case "use_list":
+		var bindings []*sitter.Node
+		for _, child := range children(argument) {
+			bindings = append(bindings, useBindingsRust(child)...)
+		}
+		return bindings
+	default:
+		return nil
+	}
+}
+
+// followUseRust resolves a binding introduced by a use declaration to the item it refers to. Other
+// definitions are returned as-is.
+func (s *SquirrelService) followUseRust(ctx context.Context, def Node) (ret *Node, err error) {
+	defer s.onCall(def, String(def.Type()), lazyNodeStringer(&ret))()
+
+	parent := def.Parent()
+	if parent == nil {
+		return &def, nil
+	}
+
+	var path *sitter.Node
+	name := def.Node
+	switch parent.Type() {
+	case "scoped_identifier":
+		// use a::b;
+		if !isInUseDeclarationRust(parent) {
+			return &def, nil
+		}
+		path = parent.ChildByFieldName("path")
+	case "use_as_clause":
+		// use a::b as c;
+		target := parent.ChildByFieldName("path")
+		if target == nil {
+			return nil, nil
+		}
+		if target.Type() == "scoped_identifier" {
+			path = target.ChildByFieldName("path")
+			name = target.ChildByFieldName("name")
+		} else {
+			path = useListPathRust(parent)
+			name = target
+		}
+	case "use_list":
+		// use a::{b};
+		path = useListPathRust(parent)
+	default:
+		return &def, nil
+	}
+	if path == nil || name == nil {
+		return nil, nil
+	}
+
+	container, err := s.resolvePathRust(ctx, swapNode(def, path))
+	if err != nil || container == nil {
+		return nil, err
+	}
+	found := s.findInContainerRust(*container, name.Content(def.Contents))
+	if found == nil {
+		return nil, nil
+	}
+	// The item might be re-exported.
+	return s.followUseRust(ctx, *found)
+}
+
+func isInUseDeclarationRust(node *sitter.Node) bool {
+	for cur := node; cur != nil; cur = cur.Parent() {
+		switch cur.Type() {
+		case "use_declaration":
+			return true
+		case "scoped_identifier", "use_list", "scoped_use_list", "use_as_clause":
+			continue
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// useListPathRust returns the path that prefixes the items of the use list containing the given node.
+func useListPathRust(node *sitter.Node) *sitter.Node {
+	for prev, cur := node, node.Parent(); cur != nil; prev, cur = cur, cur.Parent() {
+		if cur.Type() != "scoped_use_list" {
+			continue
+		}
+		list := cur.ChildByFieldName("list")
+		if list != nil && nodeId(list) == nodeId(prev) {
+			return cur.ChildByFieldName("path")
+		}
+	}
+	return nil
+}
+
+// resolvePathRust resolves a module path such as crate::a::b to the module, enum or type it refers to.
+// Modules are returned as their source_file or declaration_list node.
+func (s *SquirrelService) resolvePathRust(ctx context.Context, path Node) (ret *Node, err error) {
+	defer s.onCall(path, String(path.Content(path.Contents)), lazyNodeStringer(&ret))()
+
+	switch path.Type() {
+	case "crate":
+		return s.crateRootRust(ctx, path)
+	case "self":
+		if listPath := useListPathRust(path.Node); listPath != nil {
+			return s.resolvePathRust(ctx, swapNode(path, listPath))
+		}
+		return swapNodePtr(path, getRoot(path.Node)), nil
+	case "super":
+		return s.parentModuleRust(ctx, path)
+	case "identifier":
+		if isInUseDeclarationRust(path.Node) {
+			// Paths in use lists are relative to the list's path.
+			if listPath := useListPathRust(path.Node); listPath != nil {
+				container, err := s.resolvePathRust(ctx, swapNode(path, listPath))
+				if err != nil || container == nil {
+					return nil, err
+				}
+				found := s.findInContainerRust(*container, path.Content(path.Contents))
+				if found == nil {
+					return nil, nil
+				}
+				return s.defToContainerRust(ctx, *found)
+			}
+			// Otherwise they're relative to the current module.
+			found := findItemRust(swapNode(path, getRoot(path.Node)), path.Content(path.Contents))
+			if found == nil || nodeId(found.Node) == nodeId(path.Node) {
+				return nil, nil
+			}
+			return s.defToContainerRust(ctx, *found)
+		}
+		found, err := s.getDefRust(ctx, path)
+		if err != nil || found == nil {
+			return nil, err
+		}
+		return s.defToContainerRust(ctx, *found)
+	case "scoped_identifier":
+		prefix := path.ChildByFieldName("path")
+		name := path.ChildByFieldName("name")
+		if name == nil {
+			return nil, nil
+		}
+		if prefix == nil {
+			// ::a
+			return nil, nil
+		}
+		container, err := s.resolvePathRust(ctx, swapNode(path, prefix))
+		if err != nil || container == nil {
+			return nil, err
+		}
+		found := s.findInContainerRust(*container, name.Content(path.Contents))
+		if found == nil {
+			return nil, nil
+		}
+		found, err = s.followUseRust(ctx, *found)
+		if err != nil || found == nil {
+			return nil, err
+		}
+		return s.defToContainerRust(ctx, *found)
+	default:
+		s.breadcrumb(path, fmt.Sprintf("resolvePathRust: unrecognized path node %q", path.Type()))
+		return nil, nil
+	}
+}
+
+// defToContainerRust returns the module, enum or type that the given item name identifies.
+func (s *SquirrelService) defToContainerRust(ctx context.Context, def Node) (*Node, error) {
+	parent := def.Parent()
+	if parent == nil {
+		return nil, nil
+	}
+	switch parent.Type() {
+	case "mod_item":
+		if body := parent.ChildByFieldName("body"); body != nil {
+			return swapNodePtr(def, body), nil
+		}
+		return s.moduleFileRust(ctx, def, def.Content(def.Contents))
+	case "enum_item", "struct_item", "union_item", "trait_item":
+		return swapNodePtr(def, parent), nil
+	default:
+		found, err := s.followUseRust(ctx, def)
+		if err != nil || found == nil || nodeId(found.Node) == nodeId(def.Node) {
+			return nil, err
+		}
+		return s.defToContainerRust(ctx, *found)
+	}
+}
+
+// findInContainerRust looks up a name in a module, or a variant or associated item of an enum or type.
+func (s *SquirrelService) findInContainerRust(container Node, ident string) *Node {
+	switch container.Type() {
+	case "source_file", "declaration_list":
+		return findItemRust(container, ident)
+	case "enum_item", "struct_item", "union_item", "trait_item":
+		if container.Type() == "enum_item" {
+			query := `(enum_variant name: (identifier) @name)`
+			for _, variant := range allCaptures(query, container) {
+				if variant.Content(variant.Contents) == ident {
+					return &variant
+				}
+			}
+		}
+		if container.Type() == "trait_item" {
+			if body := container.ChildByFieldName("body"); body != nil {
+				if found := findItemRust(swapNode(container, body), ident); found != nil {
+					return found
+				}
+			}
+		}
+
+		// Look for associated items in impl blocks in the same file.
+		name := container.ChildByFieldName("name")
+		if name == nil {
+			return nil
+		}
+		for _, impl := range children(container.Parent()) {
+			if impl.Type() != "impl_item" {
+				continue
+			}
+			ty := impl.ChildByFieldName("type")
+			if ty == nil || ty.Content(container.Contents) != name.Content(container.Contents) {
+				continue
+			}
+			body := impl.ChildByFieldName("body")
+			if body == nil {
+				continue
+			}
+			if found := findItemRust(swapNode(container, body), ident); found != nil {
+				return found
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// crateRootRust parses the root module of the crate containing the given node, which is assumed to
+// be in a src directory.
+func (s *SquirrelService) crateRootRust(ctx context.Context, node Node) (*Node, error) {
+	components := strings.Split(node.RepoCommitPath.Path, "/")
+	dir := filepath.Dir(node.RepoCommitPath.Path)
+	for i := len(components) - 2; i >= 0; i-- {
+		if components[i] == "src" {
+			dir = filepath.Join(components[:i+1]...)
+			break
+		}
+	}
+	return s.parseFirstRust(ctx, node, filepath.Join(dir, "lib.rs"), filepath.Join(dir, "main.rs"))
+}
+
+// parentModuleRust parses the parent module of the module defined by the file containing the given
+// node.
+func (s *SquirrelService) parentModuleRust(ctx context.Context, node Node) (*Node, error) {
+	dir := filepath.Dir(node.RepoCommitPath.Path)
+	if isModuleRootRust(node.RepoCommitPath.Path) {
+		dir = filepath.Dir(dir)
+	}
+	return s.parseFirstRust(
+		ctx,
+		node,
+		filepath.Join(dir, "mod.rs"),
+		dir+".rs",
+		filepath.Join(dir, "lib.rs"),
+		filepath.Join(dir, "main.rs"),
+	)
+}
+
+// moduleFileRust parses the file of a module declared with `mod name;` in the file containing the
+// given node.
+func (s *SquirrelService) moduleFileRust(ctx context.Context, node Node, name string) (*Node, error) {
+	dir := filepath.Dir(node.RepoCommitPath.Path)
+	if !isModuleRootRust(node.RepoCommitPath.Path) {
+		dir = filepath.Join(dir, strings.TrimSuffix(filepath.Base(node.RepoCommitPath.Path), ".rs"))
+	}
+	return s.parseFirstRust(ctx, node, filepath.Join(dir, name+".rs"), filepath.Join(dir, name, "mod.rs"))
+}
+
+// isModuleRootRust returns true for files whose submodules live in the same directory.
+func isModuleRootRust(path string) bool {
+	switch filepath.Base(path) {
+	case "mod.rs", "lib.rs", "main.rs":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseFirstRust parses the first of the given paths that exists.
+func (s *SquirrelService) parseFirstRust(ctx context.Context, node Node, paths ...string) (*Node, error) {
+	for _, path := range paths {
+		if path == node.RepoCommitPath.Path {
+			return swapNodePtr(node, getRoot(node.Node)), nil
+		}
+		root, err := s.parse(ctx, types.RepoCommitPath{
+			Repo:   node.RepoCommitPath.Repo,
+			Commit: node.RepoCommitPath.Commit,
+			Path:   path,
+		})
+		if err != nil {
+			// The file doesn't exist, try the next one.
+			continue
+		}
+		return root, nil
+	}
+	s.breadcrumb(node, fmt.Sprintf("parseFirstRust: none of %v exist", paths))
+	return nil, nil
+}
@@ -0,0 +1,433 @@
+package squirrel
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// getDefTypeScript finds definitions in TypeScript and JavaScript files, which share most of their
+// tree-sitter grammar.
+func (s *SquirrelService) getDefTypeScript(ctx context.Context, node Node) (ret *Node, err error) {
+	defer s.onCall(node, String(node.Type()), lazyNodeStringer(&ret))()
+
+	switch node.Type() {
+	case "identifier", "type_identifier", "shorthand_property_identifier":
+		ident := node.Content(node.Contents)
+
+		cur := node.Node
+
+		for {
+			prev := cur
+			cur = cur.Parent()
+			if cur == nil {
+				s.breadcrumb(node, "getDefTypeScript: ran out of parents")
+				return nil, nil
+			}
+
+			switch cur.Type() {
+
+			case "nested_type_identifier":
+				// ns.Type
+				module := cur.ChildByFieldName("module")
+				if module == nil || nodeId(module) == nodeId(prev) || module.Type() != "identifier" {
+					continue
+				}
+				return s.getNamespaceMemberTypeScript(ctx, swapNode(node, module), ident)
+
+			case "import_specifier":
+				// import { x as y } from "..."
+				name := cur.ChildByFieldName("name")
+				if name != nil && nodeId(name) == nodeId(prev) {
+					return s.followImportTypeScript(ctx, node)
+				}
+				continue
+
+			case "program":
+				found := findInScopeTypeScript(swapNode(node, cur), ident)
+				if found == nil {
+					return nil, nil
+				}
+				return s.followImportTypeScript(ctx, *found)
+
+			// Declarations are hoisted, so look at all the statements in the block.
+			case "statement_block":
+				found := findInScopeTypeScript(swapNode(node, cur), ident)
+				if found != nil {
+					return found, nil
+				}
+				continue
+
+			case "function", "function_declaration", "generator_function", "generator_function_declaration",
+				"arrow_function", "method_definition":
+				for _, field := range []string{"parameters", "parameter"} {
+					params := cur.ChildByFieldName(field)
+					if params == nil {
+						continue
+					}
+					for _, binding := range patternBindingsTypeScript(params) {
+						if binding.Content(node.Contents) == ident {
+							return swapNodePtr(node, binding), nil
+						}
+					}
+				}
+				// Named function expressions can refer to themselves.
+				if cur.Type() == "function" || cur.Type() == "generator_function" {
+					name := cur.ChildByFieldName("name")
+					if name != nil && name.Content(node.Contents) == ident {
+						return swapNodePtr(node, name), nil
+					}
+				}
+				continue
+
+			case "class_declaration", "class":
+				name := cur.ChildByFieldName("name")
+				if name != nil && name.Content(node.Contents) == ident {
+					return swapNodePtr(node, name), nil
+				}
+				continue
+
+			case "for_statement":
+				initializer := cur.ChildByFieldName("initializer")
+				if initializer == nil {
+					continue
+				}
+				for _, binding := range declarationBindingsTypeScript(initializer) {
+					if binding.Content(node.Contents) == ident {
+						return swapNodePtr(node, binding), nil
+					}
+				}
+				continue
+
+			case "for_in_statement":
+				left := cur.ChildByFieldName("left")
+				if left == nil {
+					continue
+				}
+				for _, binding := range patternBindingsTypeScript(left) {
+					if binding.Content(node.Contents) == ident {
+						return swapNodePtr(node, binding), nil
+					}
+				}
+				continue
+
+			case "catch_clause":
+				parameter := cur.ChildByFieldName("parameter")
+				if parameter == nil {
+					continue
+				}
+				for _, binding := range patternBindingsTypeScript(parameter) {
+					if binding.Content(node.Contents) == ident {
+						return swapNodePtr(node, binding), nil
+					}
+				}
+				continue
+
+			// Skip all other nodes
+			default:
+				continue
+			}
+		}
+
+	case "property_identifier":
+		parent := node.Parent()
+		if parent == nil || parent.Type() != "member_expression" {
+			return nil, nil
+		}
+		object := parent.ChildByFieldName("object")
+		if object == nil || object.Type() != "identifier" {
+			return nil, nil
+		}
+		return s.getNamespaceMemberTypeScript(ctx, swapNode(node, object), node.Content(node.Contents))
+
+	// No other nodes have a definition
+	default:
+		return nil, nil
+	}
+}
+
+// getNamespaceMemberTypeScript finds a member of a namespace import, e.g. `import * as ns from "./ns"`
+// then `ns.foo`. Members of other objects are not supported.
+func (s *SquirrelService) getNamespaceMemberTypeScript(ctx context.Context, object Node, member string) (ret *Node, err error) {
+	defer s.onCall(object, &Tuple{String(object.Type()), String(member)}, lazyNodeStringer(&ret))()
+
+	def, err := s.getDefTypeScript(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+	if def == nil || def.Node.Type() != "program" {
+		return nil, nil
+	}
+	found := findExportTypeScript(*def, member)
+	if found == nil {
+		return nil, nil
+	}
+	return s.followImportTypeScript(ctx, *found)
+}
+
+// findInScopeTypeScript looks for a declaration or import named ident among the statements of the
+// given program or block.
+func findInScopeTypeScript(scope Node, ident string) *Node {
+	for _, stmt := range children(scope.Node) {
+		if stmt.Type() == "export_statement" {
+			declaration := stmt.ChildByFieldName("declaration")
+			if declaration == nil {
+				continue
+			}
+			stmt = declaration
+		}
+		for _, binding := range declarationBindingsTypeScript(stmt) {
+			if binding.Content(scope.Contents) == ident {
+				return swapNodePtr(scope, binding)
+			}
+		}
+	}
+	return nil
+}
+
+// declarationBindingsTypeScript returns the identifiers bound by a statement.
+func declarationBindingsTypeScript(stmt *sitter.Node) []*sitter.Node {
+	switch stmt.Type() {
+	case "lexical_declaration", "variable_declaration":
+		// const x = ..., { y } = ...
+		var bindings []*sitter.Node
+		for _, declarator := range children(stmt) {
+			if declarator.Type() != "variable_declarator" {
+				continue
+			}
+			name := declarator.ChildByFieldName("name")
+			if name == nil {
+				continue
+			}
+			bindings = append(bindings, patternBindingsTypeScript(name)...)
+		}
+		return bindings
+	case "function_declaration", "generator_function_declaration", "class_declaration", "abstract_class_declaration",
+		"interface_declaration", "type_alias_declaration", "enum_declaration":
+		name := stmt.ChildByFieldName("name")
+		if name == nil {
+			return nil
+		}
+		return []*sitter.Node{name}
+	case "import_statement":
+		// import x, { y, z as w } from "..."
+		// import * as ns from "..."
+		var bindings []*sitter.Node
+		for _, clause := range children(stmt) {
+			if clause.Type() != "import_clause" {
+				continue
+			}
+			for _, child := range children(clause) {
+				switch child.Type() {
+				case "identifier":
+					bindings = append(bindings, child)
+				case "namespace_import":
+					bindings = append(bindings, children(child)...)
+				case "named_imports":
+					for _, specifier := range children(child) {
+						if specifier.Type() != "import_specifier" {
+							continue
+						}
+						if alias := specifier.ChildByFieldName("alias"); alias != nil {
+							bindings = append(bindings, alias)
+						} else if name := specifier.ChildByFieldName("name"); name != nil {
+							bindings = append(bindings, name)
+						}
+					}
+				}
+			}
+		}
+		return bindings
+	default:
+		return nil
+	}
+}
+
+// patternBindingsTypeScript returns the identifiers bound by a (possibly destructuring) pattern or
+// parameter list.
+func patternBindingsTypeScript(pattern *sitter.Node) []*sitter.Node {
+	if pattern == nil {
+		return nil
+	}
+	switch pattern.Type() {
+	case "identifier", "shorthand_property_identifier_pattern":
+		return []*sitter.Node{pattern}
+	case "formal_parameters", "object_pattern", "array_pattern", "rest_pattern":
+		var bindings []*sitter.Node
+		for _, child := range children(pattern) {
+			bindings = append(bindings, patternBindingsTypeScript(child)...)
+		}
+		return bindings
+	case "pair_pattern":
+		// { key: value }
+		return patternBindingsTypeScript(pattern.ChildByFieldName("value"))
+	case "assignment_pattern", "object_assignment_pattern":
+		// x = 5
+		return patternBindingsTypeScript(pattern.ChildByFieldName("left"))
+	case "required_parameter", "optional_parameter":
+		// x: number, x?: number
+		return patternBindingsTypeScript(pattern.ChildByFieldName("pattern"))
+	default:
+		return nil
+	}
+}
+
+// followImportTypeScript resolves an import binding to the definition in the imported module. Other
+// definitions are returned as-is.
+func (s *SquirrelService) followImportTypeScript(ctx context.Context, def Node) (ret *Node, err error) {
+	defer s.onCall(def, String(def.Type()), lazyNodeStringer(&ret))()
+
+	var stmt *sitter.Node
+	for cur := def.Parent(); cur != nil; cur = cur.Parent() {
+		if cur.Type() == "import_statement" {
+			stmt = cur
+			break
+		}
+	}
+	if stmt == nil {
+		return &def, nil
+	}
+
+	source := stmt.ChildByFieldName("source")
+	if source == nil {
+		return nil, nil
+	}
+	module, err := s.resolveModuleTypeScript(ctx, def, strings.Trim(source.Content(def.Contents), "\"'`"))
+	if err != nil || module == nil {
+		return nil, err
+	}
+
+	parent := def.Parent()
+	switch parent.Type() {
+	case "namespace_import":
+		return module, nil
+	case "import_clause":
+		return findDefaultExportTypeScript(*module), nil
+	case "import_specifier":
+		name := parent.ChildByFieldName("name")
+		if name == nil {
+			return nil, nil
+		}
+		found := findExportTypeScript(*module, name.Content(def.Contents))
+		if found == nil {
+			return nil, nil
+		}
+		// The module might re-export an import.
+		return s.followImportTypeScript(ctx, *found)
+	default:
+		return nil, nil
+	}
+}
+
+// tsModuleExtensions are tried in order when resolving relative imports without an extension.
+var tsModuleExtensions = []string{".ts", ".tsx", ".d.ts", ".js", ".jsx", "/index.ts", "/index.tsx", "/index.js", "/index.jsx"}
+
+// resolveModuleTypeScript parses the module imported from the given file. Only relative imports can be
+// resolved.
+func (s *SquirrelService) resolveModuleTypeScript(ctx context.Context, from Node, source string) (*Node, error) {
+	if !strings.HasPrefix(source, "./") && !strings.HasPrefix(source, "../") {
+		s.breadcrumb(from, fmt.Sprintf("resolveModuleTypeScript: unsupported non-relative import %q", source))
+		return nil, nil
+	}
+
+	path := filepath.Join(filepath.Dir(from.RepoCommitPath.Path), source)
+	candidates := []string{path}
+	for _, ext := range tsModuleExtensions {
+		candidates = append(candidates, path+ext)
+	}
+	for _, candidate := range candidates {
+		module, err := s.parse(ctx, types.RepoCommitPath{
+			Repo:   from.RepoCommitPath.Repo,
+			Commit: from.RepoCommitPath.Commit,
+			Path:   candidate,
+		})
+		if errors.Is(err, UnrecognizedFileExtensionError) || errors.Is(err, UnsupportedLanguageError) {
+			continue
+		}
+		if err != nil {
+			// The file doesn't exist, try the next candidate.
+			continue
+		}
+		return module, nil
+	}
+	return nil, nil
+}
+
+// findExportTypeScript finds the exported declaration named ident in the given module.
+func findExportTypeScript(module Node, ident string) *Node {
+	for _, stmt := range children(module.Node) {
+		switch stmt.Type() {
+		case "export_statement":
+			declaration := stmt.ChildByFieldName("declaration")
+			if declaration != nil {
+				for _, binding := range declarationBindingsTypeScript(declaration) {
+					if binding.Content(module.Contents) == ident {
+						return swapNodePtr(module, binding)
+					}
+				}
+				continue
+			}
+			// export { x, y as z }
+			for _, clause := range children(stmt) {
+				if clause.Type() != "export_clause" {
+					continue
+				}
+				for _, specifier := range children(clause) {
+					name := specifier.ChildByFieldName("name")
+					if name == nil {
+						continue
+					}
+					exported := name
+					if alias := specifier.ChildByFieldName("alias"); alias != nil {
+						exported = alias
+					}
+					if exported.Content(module.Contents) == ident {
+						return findInScopeTypeScript(module, name.Content(module.Contents))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findDefaultExportTypeScript finds the default export of the given module.
+func findDefaultExportTypeScript(module Node) *Node {
+	for _, stmt := range children(module.Node) {
+		if stmt.Type() != "export_statement" {
+			continue
+		}
+		isDefault := false
+		for i := range int(stmt.ChildCount()) {
+			if stmt.Child(i).Type() == "default" {
+				isDefault = true
+			}
+		}
+		if !isDefault {
+			continue
+		}
+		// export default class C { ... }
+		if declaration := stmt.ChildByFieldName("declaration"); declaration != nil {
+			if bindings := declarationBindingsTypeScript(declaration); len(bindings) > 0 {
+				return swapNodePtr(module, bindings[0])
+			}
+			return swapNodePtr(module, declaration)
+		}
+		// export default x
+		if value := stmt.ChildByFieldName("value"); value != nil {
+			if value.Type() == "identifier" {
+				if found := findInScopeTypeScript(module, value.Content(module.Contents)); found != nil {
+					return found
+				}
+			}
+			return swapNodePtr(module, value)
+		}
+	}
+	return nil
+}
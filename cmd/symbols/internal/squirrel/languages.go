@@ -6,6 +6,7 @@ import (
 
 	"github.com/grafana/regexp"
 	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/c"
 	"github.com/smacker/go-tree-sitter/cpp"
 	"github.com/smacker/go-tree-sitter/csharp"
 	"github.com/smacker/go-tree-sitter/golang"
@@ -13,6 +14,7 @@ import (
 	"github.com/smacker/go-tree-sitter/javascript"
 	"github.com/smacker/go-tree-sitter/python"
 	"github.com/smacker/go-tree-sitter/ruby"
+	"github.com/smacker/go-tree-sitter/rust"
 	"github.com/smacker/go-tree-sitter/typescript/tsx"
 
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
@@ -125,6 +127,12 @@ var langToLangSpec = map[string]LangSpec{
 (short_var_declaration left: (expression_list (identifier) @definition)) ; x, y := ...
 (range_clause          left: (expression_list (identifier) @definition)) ; for i := range ... { ... }
 (receive_statement     left: (expression_list (identifier) @definition)) ; case x := <-ch: ...
+`,
+		topLevelSymbolsQuery: `
+(source_file (function_declaration name: (identifier) @symbol))
+(source_file (type_declaration (type_spec name: (type_identifier) @symbol)))
+(source_file (var_declaration (var_spec name: (identifier) @symbol)))
+(source_file (const_declaration (const_spec name: (identifier) @symbol)))
 `,
 	},
 	"csharp": {
@@ -194,6 +202,12 @@ var langToLangSpec = map[string]LangSpec{
 (arrow_function parameter: (identifier) @definition)                                   ; x => ...
 (for_in_statement left: (identifier) @definition)                                      ; for (const x of xs) ...
 (catch_clause parameter: (identifier) @definition)                                     ; catch (e) ...
+`,
+		topLevelSymbolsQuery: `
+(program (function_declaration name: (identifier) @symbol))
+(program (class_declaration name: (identifier) @symbol))
+(program (export_statement declaration: (function_declaration name: (identifier) @symbol)))
+(program (export_statement declaration: (class_declaration name: (identifier) @symbol)))
 `,
 	},
 	"typescript": {
@@ -228,6 +242,14 @@ var langToLangSpec = map[string]LangSpec{
 (arrow_function parameter: (identifier) @definition)            ; x => ...
 (for_in_statement left: (identifier) @definition)               ; for (const x of xs) ...
 (catch_clause parameter: (identifier) @definition)              ; catch (e) ...
+`,
+		topLevelSymbolsQuery: `
+(program (function_declaration name: (identifier) @symbol))
+(program (class_declaration name: (type_identifier) @symbol))
+(program (interface_declaration name: (type_identifier) @symbol))
+(program (export_statement declaration: (function_declaration name: (identifier) @symbol)))
+(program (export_statement declaration: (class_declaration name: (type_identifier) @symbol)))
+(program (export_statement declaration: (interface_declaration name: (type_identifier) @symbol)))
 `,
 	},
 	"cpp": {
@@ -254,6 +276,69 @@ var langToLangSpec = map[string]LangSpec{
 (parameter_declaration          declarator: (pointer_declarator   (identifier) @definition)) ; [](int* x) { ... }
 (optional_parameter_declaration declarator: (identifier) @definition)                        ; [](auto x = 5) { ... }
 (for_range_loop declarator: (identifier) @definition)									     ; for (int x : xs) ...
+`,
+	},
+	"c": {
+		name:     "c",
+		language: c.GetLanguage(),
+		commentStyle: CommentStyle{
+			nodeTypes:     []string{"comment"},
+			stripRegex:    javaStyleStripRegex,
+			ignoreRegex:   javaStyleIgnoreRegex,
+			codeFenceName: "c",
+		},
+		localsQuery: `
+(compound_statement)  @scope ; { ... }
+(for_statement)       @scope ; for (int i = 0; ...) ...
+(function_definition) @scope ; void f() { ... }
+
+(declaration           declarator: (identifier) @definition)                      ; int x;
+(declaration           declarator: (pointer_declarator (identifier) @definition)) ; int *x;
+(declaration           declarator: (array_declarator   (identifier) @definition)) ; int x[3];
+(init_declarator       declarator: (identifier) @definition)                      ; int x = 5;
+(init_declarator       declarator: (pointer_declarator (identifier) @definition)) ; int *x = &y;
+(parameter_declaration declarator: (identifier) @definition)                      ; void f(int x) { ... }
+(parameter_declaration declarator: (pointer_declarator (identifier) @definition)) ; void f(int *x) { ... }
+(parameter_declaration declarator: (array_declarator   (identifier) @definition)) ; void f(int x[]) { ... }
+`,
+		topLevelSymbolsQuery: `
+(translation_unit (function_definition declarator: (function_declarator declarator: (identifier) @symbol)))
+(translation_unit (type_definition declarator: (type_identifier) @symbol))
+(translation_unit (struct_specifier name: (type_identifier) @symbol body: (field_declaration_list)))
+`,
+	},
+	"rust": {
+		name:     "rust",
+		language: rust.GetLanguage(),
+		commentStyle: CommentStyle{
+			nodeTypes:     []string{"line_comment", "block_comment"},
+			stripRegex:    regexp.MustCompile(`^//[/!]?|^\s*\*/?|^/\*[*!]?|\*/$`),
+			ignoreRegex:   javaStyleIgnoreRegex,
+			codeFenceName: "rust",
+			skipNodeTypes: []string{"attribute_item"},
+		},
+		localsQuery: `
+(block)              @scope ; { ... }
+(function_item)      @scope ; fn f() { ... }
+(closure_expression) @scope ; |x| ...
+(for_expression)     @scope ; for x in xs { ... }
+(if_expression)      @scope ; if let Some(x) = y { ... }
+(while_expression)   @scope ; while let Some(x) = y { ... }
+(match_arm)          @scope ; Some(x) => ...
+
+(let_declaration   pattern: (identifier) @definition)                                 ; let x = ...;
+(let_declaration   pattern: (tuple_pattern (identifier) @definition))                 ; let (x, y) = ...;
+(parameter         pattern: (identifier) @definition)                                 ; fn f(x: i32) { ... }
+(closure_parameters         (identifier) @definition)                                 ; |x| ...
+(for_expression    pattern: (identifier) @definition)                                 ; for x in xs { ... }
+(let_condition     pattern: (tuple_struct_pattern type: (_) (identifier) @definition)) ; if let Some(x) = y { ... }
+(match_pattern              (tuple_struct_pattern type: (_) (identifier) @definition)) ; Some(x) => ...
+`,
+		topLevelSymbolsQuery: `
+(source_file (function_item name: (identifier) @symbol))
+(source_file (struct_item name: (type_identifier) @symbol))
+(source_file (enum_item name: (type_identifier) @symbol))
+(source_file (trait_item name: (type_identifier) @symbol))
 `,
 	},
 	"ruby": {
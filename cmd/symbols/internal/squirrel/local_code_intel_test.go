@@ -317,6 +317,75 @@ void f(int p1, int p2 = 3, int& p3, int* p4)
 	//                                   v f.e ref
     try { } catch (const std::exception& e) { }
 }
+`,
+		}, {
+			path: "test.c",
+			contents: `
+//         vv f.p1 def
+//         vv f.p1 ref
+//                  vv f.p2 def
+//                  vv f.p2 ref
+void f(int p1, int *p2)
+{
+	//  v f.x def
+	//  v f.x ref
+	int x;
+
+	//  v f.y def
+	//  v f.y ref
+	//      vv f.p1 ref
+	int y = p1;
+
+	//       v f.i def
+	//       v f.i ref
+	for (int i = 0; ; ) { }
+
+	{
+		//  v f.x2 def
+		//  v f.x2 ref
+		int x;
+	}
+}
+`,
+		}, {
+			path: "test.rs",
+			contents: `
+//   vv f.p1 def
+//   vv f.p1 ref
+fn f(p1: i32) {
+	//  v f.x def
+	//  v f.x ref
+	//      vv f.p1 ref
+	let x = p1;
+
+	//   v f.a def
+	//   v f.a ref
+	//      v f.b def
+	//      v f.b ref
+	let (a, b) = (1, 2);
+
+	//  v f.i def
+	//  v f.i ref
+	for i in 0..3 { }
+
+	//  v f.g def
+	//  v f.g ref
+	//       v f.c def
+	//       v f.c ref
+	let g = |c| 5;
+
+	//          v f.v def
+	//          v f.v ref
+	if let Some(v) = None { }
+
+	//    v f.x ref
+	match x {
+		//   v f.k def
+		//   v f.k ref
+		Some(k) => 5,
+		_ => 6,
+	}
+}
 `,
 		}, {
 			path: "test.rb",
@@ -144,11 +144,15 @@ func (s *SquirrelService) getDef(ctx context.Context, node Node) (*Node, error)
 		return s.getDefStarlark(ctx, node)
 	case "python":
 		return s.getDefPython(ctx, node)
-	// case "go":
+	case "go":
+		return s.getDefGo(ctx, node)
+	case "javascript", "typescript":
+		return s.getDefTypeScript(ctx, node)
+	case "rust":
+		return s.getDefRust(ctx, node)
+	case "c":
+		return s.getDefC(ctx, node)
 	// case "csharp":
-	// case "python":
-	// case "javascript":
-	// case "typescript":
 	// case "cpp":
 	// case "ruby":
 	default:
@@ -0,0 +1,33 @@
+#include "util.h"
+
+//             vvvvv c.point ref
+//                    v c.sum.p def
+//                           vvvvv c.sum.count def
+int sum(struct point *p, int count) {
+    //  vvvvv c.sum.total def
+    int total = 0;
+    //       v c.sum.i def
+    //              v c.sum.i ref
+    //                  vvvvv c.sum.count ref
+    for (int i = 0; i < count; i++) {
+        //       v c.sum.p ref
+        //          v c.Point.x ref
+        total += p->x;
+    }
+    //     vvvvv c.sum.total ref
+    return total;
+}
+
+int main(void) {
+    //    v c.main.p def
+    Point p = {1, 2}; // < "Point" c.Point ref
+    //   vvvvv c.Color ref
+    //         v c.main.c def
+    //             vvv c.RED ref
+    enum Color c = RED;
+    //     vvvvvv c.square ref
+    //            v c.main.p ref
+    //              v c.Point.x ref
+    //                   vvvvvvvvv c.MAX_ITEMS ref
+    return square(p.x) + MAX_ITEMS + c;
+}
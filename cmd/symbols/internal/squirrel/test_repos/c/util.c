@@ -0,0 +1,7 @@
+#include "util.h"
+
+//             v c.square.n def
+int square(int n) {
+    //     v c.square.n ref
+    return n * n;
+}
@@ -0,0 +1,22 @@
+#ifndef UTIL_H
+#define UTIL_H
+
+#define MAX_ITEMS 8 // < "MAX_ITEMS" c.MAX_ITEMS def
+
+//             vvvvv c.point def
+typedef struct point {
+    int x; // < "x" c.Point.x def
+    int y;
+} Point; // < "Point" c.Point def
+
+//   vvvvv c.Color def
+enum Color {
+    RED, // < "RED" c.RED def
+    GREEN,
+};
+
+// Squares a number.
+//  vvvvvv c.square def
+int square(int n);
+
+#endif
@@ -0,0 +1,6 @@
+package main
+
+//   vvvvvv go.helper def
+func helper() int {
+	return 0
+}
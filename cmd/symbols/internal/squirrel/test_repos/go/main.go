@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	u "example.com/app/util" // < "u" util path
+)
+
+//   vvvvv go.Point def
+type Point struct {
+	X int // < "X" go.Point.X def
+}
+
+//              vvvv go.Point.Norm def
+func (p *Point) Norm() int {
+	//       v go.Point.X ref
+	return p.X * p.X
+}
+
+//  vvvvvv go.origin def
+var origin = Point{}
+
+func main() {
+	p := &Point{} // < "p" go.main.p def
+
+	//          v go.main.p ref
+	//            vvvv go.Point.Norm ref
+	fmt.Println(p.Norm())
+
+	//    vvvvv go.Point ref
+	var q Point
+	//    v go.Point.X ref
+	_ = q.X
+
+	//  v go.main.i def
+	for i := 0; i < 3; i++ {
+		//          v go.main.i ref
+		fmt.Println(i)
+	}
+
+	//          v util path
+	//            vvvvvv go.util.Square ref
+	fmt.Println(u.Square(2))
+
+	//      vvvvv go.util.Shape ref
+	var s u.Shape
+	_ = s
+
+	//          vvvvvv go.origin ref
+	//                  vvvvvv go.helper ref
+	fmt.Println(origin, helper())
+
+	// v go.main.v def
+	//             v go.Point.X ref
+	if v := origin.X; v > 0 {
+		//  v go.main.v ref
+		_ = v
+	}
+
+	//     v go.main.t def
+	switch t := any(p).(type) {
+	case *Point:
+		//  v go.main.t ref
+		_ = t
+	}
+
+	//        v go.main.f.n def
+	f := func(n int) int {
+		//     v go.main.f.n ref
+		return n
+	}
+	_ = f
+}
@@ -0,0 +1,13 @@
+package util
+
+// Shape is a shape.
+//
+//   vvvvv go.util.Shape def
+type Shape struct{}
+
+// Square squares a number.
+//
+//   vvvvvv go.util.Square def
+func Square(n int) int {
+	return n * n
+}
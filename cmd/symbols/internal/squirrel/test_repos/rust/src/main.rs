@@ -0,0 +1,69 @@
+mod util; // < "util" rs.util def
+mod shapes; // < "shapes" rs.shapes def
+
+//         vvvv rs.util ref
+//               vvvvvv rs.util.square ref
+use crate::util::square;
+//                  vvvvvv rs.shapes.Circle ref
+//                          vvvv rs.shapes.area ref
+use crate::shapes::{Circle, area as circle_area};
+
+//     vvvvv rs.Point def
+struct Point {
+    x: i32,
+}
+
+//   vvvvv rs.Point ref
+impl Point {
+    //     v rs.Point.new.x def
+    // vvv rs.Point.new def
+    fn new(x: i32) -> Point {
+        //         v rs.Point.new.x ref
+        Point { x: x }
+    }
+}
+
+fn main() {
+    //  v rs.main.p def
+    //             vvv rs.Point.new ref
+    let p = Point::new(1);
+    //            v rs.main.p ref
+    //                 vvvv rs.util ref
+    //                       vvvvvv rs.util.square ref
+    let (a, b) = (p.x, util::square(2));
+
+    //  v rs.main.i def
+    for i in 0..3 {
+        //     v rs.main.i ref
+        square(i);
+    }
+
+    //          v rs.main.v def
+    if let Some(v) = Some(a) {
+        //     v rs.main.v ref
+        square(v);
+    }
+
+    match Some(b) {
+        //   v rs.main.k def
+        //                v rs.main.k ref
+        Some(k) => square(k),
+        None => 0,
+    };
+
+    //       v rs.main.f.n def
+    //          v rs.main.f.n ref
+    let f = |n| n + 1;
+    f(a + b);
+
+    //      vvvvvvvvvvv rs.shapes.area ref
+    //                  vvvvvv rs.shapes.Circle ref
+    //                          vvv rs.shapes.Circle.new ref
+    let _ = circle_area(Circle::new(1.0));
+    //      vvvvvv rs.shapes ref
+    //                      vvv rs.shapes.Circle.new ref
+    let _ = shapes::Circle::new(2.0);
+    //              vvvvv rs.shapes.Color ref
+    //                     vvv rs.shapes.Color.Red ref
+    let _ = shapes::Color::Red;
+}
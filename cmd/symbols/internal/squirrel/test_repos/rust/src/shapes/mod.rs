@@ -0,0 +1,26 @@
+//         vvvvvv rs.util.square ref
+use super::square;
+
+//         vvvvvv rs.shapes.Circle def
+pub struct Circle {
+    radius: f64,
+}
+
+impl Circle {
+    //     vvv rs.shapes.Circle.new def
+    pub fn new(radius: f64) -> Circle {
+        Circle { radius }
+    }
+}
+
+//       vvvvv rs.shapes.Color def
+pub enum Color {
+    Red, // < "Red" rs.shapes.Color.Red def
+}
+
+//     vvvv rs.shapes.area def
+pub fn area(c: Circle) -> f64 {
+    //            vvvvvv rs.util.square ref
+    let _ = 3.0 * square(2);
+    c.radius
+}
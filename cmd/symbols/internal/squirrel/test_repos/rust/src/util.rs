@@ -0,0 +1,5 @@
+/// Squares a number.
+//     vvvvvv rs.util.square def
+pub fn square(n: i32) -> i32 {
+    n * n
+}
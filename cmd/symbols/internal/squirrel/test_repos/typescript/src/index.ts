@@ -0,0 +1,35 @@
+//     vvvvvvv ts.util.Counter ref
+//                vvvvvv ts.util.square ref
+//                        vv ts.util.PI ref
+import Counter, { square, PI as pi } from './util'
+import * as shapes from './shapes'
+
+//       vvvvvvv ts.index.compute def
+//                                vvvvv ts.index.scale def
+function compute(value: number, { scale }: { scale: number }): number {
+    //    v ts.index.x def
+    const x = square(value) // < "square" ts.util.square ref
+    //     vvvvv ts.index.scale ref
+    return scale * x * pi // < "x" ts.index.x ref < "pi" ts.util.PI ref
+}
+
+//            vvvvvvv ts.index.compute ref
+const total = compute(1, { scale: 2 })
+
+//       v ts.index.i def
+for (let i = 0; i < 3; i++) {
+    //          v ts.index.i ref
+    console.log(i)
+}
+
+//                   vvvvvvvvv ts.shapes.Rectangle ref
+//                                        vvvv ts.shapes.area ref
+const a = (r: shapes.Rectangle) => shapes.area(r)
+
+try {
+    new Counter() // < "Counter" ts.util.Counter ref
+    //   v ts.index.e def
+} catch (e) {
+    //          v ts.index.e ref
+    console.log(e, total, a)
+}
@@ -0,0 +1,15 @@
+//       vvvvvv ts.util.square ref
+import { square } from './util'
+
+//       v js.legacy.f def
+//         v js.legacy.f.p def
+//               vvvv js.legacy.f.rest def
+function f(p, ...rest) {
+    //      v js.legacy.f.y def
+    const { y } = p
+    //     v js.legacy.f.p ref
+    return p + y + rest.length // < "y" js.legacy.f.y ref < "rest" js.legacy.f.rest ref
+}
+
+//             v js.legacy.f ref
+const g = x => f(x) + square(x) // < "x" js.legacy.g.x def < "x)" js.legacy.g.x ref
@@ -0,0 +1,9 @@
+//               vvvvvvvvv ts.shapes.Rectangle def
+export interface Rectangle {
+    width: number
+}
+
+//              vvvv ts.shapes.area def
+export function area(r: Rectangle): number {
+    return r.width
+}
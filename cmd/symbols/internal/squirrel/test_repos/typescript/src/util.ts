@@ -0,0 +1,13 @@
+/**
+ * Squares a number.
+ */
+//              vvvvvv ts.util.square def
+export function square(n: number): number {
+    return n * n
+}
+
+//           vvvvv ts.util.PI def
+export const PI = 3.14
+
+//                   vvvvvvv ts.util.Counter def
+export default class Counter {}
@@ -112,7 +112,7 @@ func (i indexingHandler) HandleImpl(ctx context.Context, logger log.Logger, reco
 	tarStream, err := i.GitServerClient.ArchiveReader(
 		ctx,
 		api.RepoName(record.RepositoryName),
-		gitserver.ArchiveOptions{Treeish: string(record.Commit), Format: gitserver.ArchiveFormatTar},
+		gitserver.ArchiveOptions{Treeish: string(record.Commit), Format: gitserver.ArchiveFormatTar, LFSPointers: true},
 	)
 	if err != nil {
 		return SyntacticIndexingResult{}, errors.Newf("Failed to request TAR archive stream from Gitserver: %s", err)
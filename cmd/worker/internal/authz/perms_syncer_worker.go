@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -116,9 +117,25 @@ func (h *permsSyncerWorker) handlePermsSync(ctx context.Context, reqType request
 		h.logger.Error(fmt.Sprintf("failed to save permissions sync job(%d) results", recordID), log.Error(saveErr))
 	}
 
+	if err == nil && reqType == requestTypeRepo {
+		h.enqueueRepoPermsSyncWebhook(ctx, api.RepoID(reqID), result)
+	}
+
 	return err
 }
 
+// enqueueRepoPermsSyncWebhook enqueues an outbound webhook for a successful
+// permissions sync of the given repository.
+func (h *permsSyncerWorker) enqueueRepoPermsSyncWebhook(ctx context.Context, repoID api.RepoID, result *database.SetPermissionsResult) {
+	repo, err := database.ReposWith(h.logger, h.jobsStore).Get(ctx, repoID)
+	if err != nil {
+		h.logger.Warn("failed to get repo for outbound webhook", log.Int32("repoID", int32(repoID)), log.Error(err))
+		return
+	}
+
+	events.EnqueueRepositoryPermissionsSync(ctx, h.logger, h.jobsStore, repo, result)
+}
+
 func makeStore(observationCtx *observation.Context, dbHandle basestore.TransactableHandle, syncType syncType) dbworkerstore.Store[*database.PermissionSyncJob] {
 	name := "repo_permissions_sync_job_worker_store"
 	if syncType == syncTypeUser {
@@ -53,10 +53,11 @@ type stepCacheKey struct {
 }
 
 type workspaceCacheKey struct {
-	dbWorkspace   *btypes.BatchSpecWorkspace
-	repo          batcheslib.Repository
-	stepCacheKeys []stepCacheKey
-	skippedSteps  map[int]struct{}
+	dbWorkspace     *btypes.BatchSpecWorkspace
+	repo            batcheslib.Repository
+	repoServiceType string
+	stepCacheKeys   []stepCacheKey
+	skippedSteps    map[int]struct{}
 }
 
 // process runs one workspace creation run for the given job utilizing the given
@@ -194,10 +195,11 @@ func (r *batchSpecWorkspaceCreator) process(
 		}
 
 		cacheKeyWorkspaces = append(cacheKeyWorkspaces, workspaceCacheKey{
-			dbWorkspace:   workspace,
-			repo:          repo,
-			stepCacheKeys: stepCacheKeys,
-			skippedSteps:  skippedSteps,
+			dbWorkspace:     workspace,
+			repo:            repo,
+			repoServiceType: w.Repo.ExternalRepo.ServiceType,
+			stepCacheKeys:   stepCacheKeys,
+			skippedSteps:    skippedSteps,
 		})
 	}
 
@@ -292,6 +294,9 @@ func (r *batchSpecWorkspaceCreator) process(
 			if err != nil {
 				return err
 			}
+			if err := changesetSpec.ValidateCodeHostCapabilities(workspace.repoServiceType); err != nil {
+				return errors.Wrapf(err, "repository %s", workspace.repo.Name)
+			}
 			changesetSpec.BatchSpecID = spec.ID
 			changesetSpec.BaseRepoID = workspace.dbWorkspace.RepoID
 			changesetSpec.UserID = spec.UserID
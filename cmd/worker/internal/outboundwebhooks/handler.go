@@ -35,12 +35,23 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job *types.Outb
 		log.Stringp("job.scope", job.Scope),
 	)
 
+	eventTypes := []database.FilterEventType{{
+		EventType: job.EventType,
+		Scope:     job.Scope,
+	}}
+	if job.Scope != nil {
+		// Webhooks that subscribed to the event type without a scope receive
+		// the events of every scope.
+		noScope := database.FilterEventTypeNoScope
+		eventTypes = append(eventTypes, database.FilterEventType{
+			EventType: job.EventType,
+			Scope:     &noScope,
+		})
+	}
+
 	webhooks, err := h.store.List(ctx, database.OutboundWebhookListOpts{
 		OutboundWebhookCountOpts: database.OutboundWebhookCountOpts{
-			EventTypes: []database.FilterEventType{{
-				EventType: job.EventType,
-				Scope:     job.Scope,
-			}},
+			EventTypes: eventTypes,
 		},
 	})
 	if err != nil {
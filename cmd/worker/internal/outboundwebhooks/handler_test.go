@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/encryption"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -149,6 +150,46 @@ func TestHandler_Handle(t *testing.T) {
 		mockassert.CalledN(t, store.ListFunc, 1)
 		mockassert.CalledN(t, logStore.CreateFunc, 1)
 	})
+
+	t.Run("scoped job", func(t *testing.T) {
+		ctx := context.Background()
+		logger := logtest.Scoped(t)
+
+		scope := "github.com/sourcegraph/sourcegraph"
+		job := &types.OutboundWebhookJob{
+			ID:        1,
+			EventType: "event",
+			Scope:     &scope,
+			Payload:   encryption.NewUnencrypted(`"test payload"`),
+		}
+
+		store := dbmocks.NewMockOutboundWebhookStore()
+		store.ListFunc.SetDefaultReturn([]*types.OutboundWebhook{}, nil)
+
+		h := &handler{
+			client:   http.DefaultClient,
+			store:    store,
+			logStore: dbmocks.NewMockOutboundWebhookLogStore(),
+		}
+
+		err := h.Handle(ctx, logger, job)
+		assert.NoError(t, err)
+
+		// Webhooks subscribed to the job's scope as well as webhooks
+		// subscribed to all scopes should be listed.
+		noScope := database.FilterEventTypeNoScope
+		mockassert.CalledOnceWith(t, store.ListFunc, mockassert.Values(
+			mockassert.Skip,
+			database.OutboundWebhookListOpts{
+				OutboundWebhookCountOpts: database.OutboundWebhookCountOpts{
+					EventTypes: []database.FilterEventType{
+						{EventType: "event", Scope: &scope},
+						{EventType: "event", Scope: &noScope},
+					},
+				},
+			},
+		))
+	})
 }
 
 type badTransport struct {
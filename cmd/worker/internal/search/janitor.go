@@ -7,6 +7,7 @@ import (
 
 	"github.com/keegancsmith/sqlf"
 	"github.com/sourcegraph/conc"
+	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -15,12 +16,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func newJanitorJob(observationCtx *observation.Context, db database.DB, svc *service.Service) goroutine.BackgroundRoutine {
 	handler := goroutine.HandlerFunc(func(ctx context.Context) error {
-		return runJanitor(ctx, db, svc)
+		return runJanitor(ctx, observationCtx.Logger, db, svc)
 	})
 
 	operation := observationCtx.Operation(observation.Op{
@@ -42,7 +44,7 @@ func newJanitorJob(observationCtx *observation.Context, db database.DB, svc *ser
 	)
 }
 
-func runJanitor(ctx context.Context, db database.DB, svc *service.Service) error {
+func runJanitor(ctx context.Context, logger log.Logger, db database.DB, svc *service.Service) error {
 	jobs, err := listSearchJobs(ctx, db)
 	if err != nil {
 		return err
@@ -83,12 +85,37 @@ func runJanitor(ctx context.Context, db database.DB, svc *service.Service) error
 				_ = svc.DeleteJobLogs(ctx, job.ID)
 				continue
 			}
+
+			if job.ScheduleID != 0 {
+				// The job is aggregated at this point, so a failure here is
+				// not retried. The only consequence is a missing results
+				// diff on the job, so we don't fail the aggregation.
+				if err := svc.UpdateScheduledSearchJobResults(ctx, job.ID); err != nil {
+					errs = errors.Append(errs, err)
+				}
+			}
+
+			enqueueSearchJobWebhook(ctx, logger, db, svc, job.ID, aggStatus)
 		}
 	}
 
 	return errs
 }
 
+// enqueueSearchJobWebhook enqueues an outbound webhook for a search job that
+// was aggregated with the given terminal state.
+func enqueueSearchJobWebhook(ctx context.Context, logger log.Logger, db database.DB, svc *service.Service, searchJobID int64, state types.JobState) {
+	// We fetch the job again to include the result count of scheduled jobs,
+	// which is only known after the aggregation.
+	job, err := svc.GetSearchJob(ctx, searchJobID)
+	if err != nil {
+		logger.Warn("failed to get search job for outbound webhook", log.Int64("searchJobID", searchJobID), log.Error(err))
+		return
+	}
+
+	events.EnqueueSearchJob(ctx, logger, db, job, state)
+}
+
 func setJobAsAggregated(ctx context.Context, db database.DB, searchJobID int64) error {
 	q := sqlf.Sprintf("UPDATE exhaustive_search_jobs SET is_aggregated = true WHERE id = %s", searchJobID)
 	_, err := db.ExecContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
@@ -96,14 +123,15 @@ func setJobAsAggregated(ctx context.Context, db database.DB, searchJobID int64)
 }
 
 type job struct {
-	ID        int64
-	Initiator int32
+	ID         int64
+	Initiator  int32
+	ScheduleID int64
 }
 
 // listSearchJobs returns a list of search jobs that haven't been aggregated
 // yet.
 func listSearchJobs(ctx context.Context, db database.DB) ([]job, error) {
-	q := sqlf.Sprintf("SELECT id, initiator_id FROM exhaustive_search_jobs WHERE is_aggregated = false")
+	q := sqlf.Sprintf("SELECT id, initiator_id, COALESCE(schedule_id, 0) FROM exhaustive_search_jobs WHERE is_aggregated = false")
 	rows, err := db.QueryContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
 	if err != nil {
 		return nil, err
@@ -116,6 +144,7 @@ func listSearchJobs(ctx context.Context, db database.DB) ([]job, error) {
 		if err := rows.Scan(
 			&j.ID,
 			&j.Initiator,
+			&j.ScheduleID,
 		); err != nil {
 			return nil, err
 		}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log/logtest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -90,7 +91,7 @@ func TestJanitor(t *testing.T) {
 
 			// Use context.Background() to test if the janitor sets the user context
 			// correctly
-			err := runJanitor(context.Background(), db, svc)
+			err := runJanitor(context.Background(), logtest.Scoped(t), db, svc)
 			require.NoError(t, err)
 
 			j, err := exhaustiveStore.GetExhaustiveSearchJob(ctx, searchJobID)
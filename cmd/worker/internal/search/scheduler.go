@@ -0,0 +1,42 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
+)
+
+// newSchedulerJob returns a routine which creates the search jobs of due
+// search job schedules.
+func newSchedulerJob(observationCtx *observation.Context, svc *service.Service) goroutine.BackgroundRoutine {
+	handler := goroutine.HandlerFunc(func(ctx context.Context) error {
+		// 🚨 SECURITY: listing due schedules requires an internal actor. The
+		// search jobs themselves are created on behalf of the schedule's
+		// initiator.
+		ctx = actor.WithInternalActor(ctx)
+		return svc.RunDueSearchJobSchedules(ctx, time.Now())
+	})
+
+	operation := observationCtx.Operation(observation.Op{
+		Name: "search.jobs.scheduler",
+		Metrics: metrics.NewREDMetrics(
+			observationCtx.Registerer,
+			"search_jobs_scheduler",
+			metrics.WithCountHelp("Total number of search_jobs_scheduler executions"),
+		),
+	})
+
+	return goroutine.NewPeriodicGoroutine(
+		context.Background(),
+		handler,
+		goroutine.WithName("search_jobs_scheduler"),
+		goroutine.WithDescription("create search jobs for due search job schedules"),
+		goroutine.WithInterval(1*time.Minute),
+		goroutine.WithOperation(operation),
+	)
+}
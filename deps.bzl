@@ -629,8 +629,8 @@ def go_dependencies():
         name = "com_github_azure_azure_sdk_for_go_sdk_storage_azblob",
         build_file_proto_mode = "disable_global",
         importpath = "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob",
-        sum = "h1:QSdcrd/UFJv6Bp/CfoVf2SrENpFn9P6Yh8yb+xNhYMM=",
-        version = "v0.4.1",
+        sum = "h1:AMf7YbZOZIW5b66cXNHMWWT/zkjhz5+a+k/3x40EO7E=",
+        version = "v1.2.1",
     )
     go_repository(
         name = "com_github_azure_go_ansiterm",
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -48,6 +49,15 @@ type Actor struct {
 	// cookie, logout would be ineffective.)
 	FromSessionCookie bool `json:"-"`
 
+	// AccessTokenScopes are the scopes of the access token that was used to
+	// authenticate the actor. It is nil if the actor wasn't authenticated with
+	// an access token, in which case the actor isn't restricted by scopes.
+	AccessTokenScopes []string `json:"-"`
+
+	// AccessTokenRepoIDs restricts the actor to these repositories, if the
+	// access token used to authenticate the actor is restricted to them.
+	AccessTokenRepoIDs []api.RepoID `json:"-"`
+
 	// user is populated lazily by (*Actor).User()
 	user     *types.User
 	userErr  error
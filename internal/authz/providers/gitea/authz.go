@@ -0,0 +1,74 @@
+package gitea
+
+import (
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	atypes "github.com/sourcegraph/sourcegraph/internal/authz/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewAuthzProviders returns the set of Gitea authz providers derived from the connections.
+//
+// It also returns any simple validation problems with the config, separating these into "serious problems"
+// and "warnings". "Serious problems" are those that should make Sourcegraph set authz.allowAccessByDefault
+// to false. "Warnings" are all other validation problems.
+//
+// This constructor does not and should not directly check connectivity to external services - if
+// desired, callers should use `(*Provider).ValidateConnection` directly to get warnings related
+// to connection issues.
+func NewAuthzProviders(db database.DB, conns []*types.GiteaConnection) *atypes.ProviderInitResult {
+	initResults := &atypes.ProviderInitResult{}
+
+	for _, c := range conns {
+		p, err := newAuthzProvider(db, c)
+		if err != nil {
+			initResults.InvalidConnections = append(initResults.InvalidConnections, extsvc.TypeGitea)
+			initResults.Problems = append(initResults.Problems, err.Error())
+		}
+		if p == nil {
+			continue
+		}
+
+		initResults.Providers = append(initResults.Providers, p)
+	}
+
+	return initResults
+}
+
+func newAuthzProvider(
+	db database.DB,
+	c *types.GiteaConnection,
+) (authz.Provider, error) {
+	// If authorization is not set for this connection, we do not need an
+	// authz provider.
+	if c.Authorization == nil {
+		return nil, nil
+	}
+	if err := licensing.Check(licensing.FeatureACLs); err != nil {
+		return nil, err
+	}
+	if err := ValidateAuthz(c.GiteaConnection); err != nil {
+		return nil, err
+	}
+
+	p := NewProvider(db, c, ProviderOptions{})
+	if p == nil {
+		return nil, errors.Errorf("could not create Gitea authz provider for %q", c.Url)
+	}
+	return p, nil
+}
+
+// ValidateAuthz validates the authorization fields of the given Gitea
+// external service config.
+func ValidateAuthz(c *schema.GiteaConnection) error {
+	if _, err := url.Parse(c.Url); err != nil {
+		return errors.Wrap(err, "could not parse Gitea URL")
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Package gitea contains an authorization provider for Gitea and Forgejo.
+package gitea
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/oauthtoken"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Provider is an implementation of AuthzProvider that provides repository
+// permissions as determined from Gitea.
+type Provider struct {
+	urn      string
+	codeHost *extsvc.CodeHost
+	client   gitea.Client
+	db       database.DB
+}
+
+type ProviderOptions struct {
+	GiteaClient gitea.Client
+}
+
+var _ authz.Provider = (*Provider)(nil)
+
+// NewProvider returns a new Gitea authorization provider that uses the given
+// gitea.Client to talk to the Gitea API that is the source of truth for
+// permissions. Sourcegraph users will need a valid Gitea external account for
+// permissions to sync correctly.
+func NewProvider(db database.DB, conn *types.GiteaConnection, opts ProviderOptions) *Provider {
+	baseURL, err := url.Parse(conn.Url)
+	if err != nil {
+		return nil
+	}
+
+	if opts.GiteaClient == nil {
+		opts.GiteaClient, err = gitea.NewClient(conn.URN, baseURL, &auth.OAuthBearerToken{Token: conn.Token}, httpcli.ExternalDoer)
+		if err != nil {
+			return nil
+		}
+	}
+
+	return &Provider{
+		urn:      conn.URN,
+		codeHost: extsvc.NewCodeHost(baseURL, extsvc.TypeGitea),
+		client:   opts.GiteaClient,
+		db:       db,
+	}
+}
+
+// ValidateConnection validates that the Provider has access to the Gitea API
+// with the credentials it was configured with.
+func (p *Provider) ValidateConnection(ctx context.Context) error {
+	_, err := p.client.GetAuthenticatedUser(ctx)
+	return err
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ServiceID returns the absolute URL that identifies the Gitea instance this
+// provider is configured with.
+func (p *Provider) ServiceID() string { return p.codeHost.ServiceID }
+
+// ServiceType returns the type of this Provider, namely, "gitea".
+func (p *Provider) ServiceType() string { return p.codeHost.ServiceType }
+
+// FetchAccount satisfies the authz.Provider interface.
+func (p *Provider) FetchAccount(ctx context.Context, user *types.User, _ []*extsvc.Account, _ []string) (acct *extsvc.Account, err error) {
+	return nil, nil
+}
+
+// FetchUserPerms returns a list of repository IDs (on code host) that the given account
+// has read access on the code host. The repository ID has the same value as it would be
+// used as api.ExternalRepoSpec.ID. The returned list only includes private repository IDs
+// and repositories of organizations with limited visibility.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+//
+// API docs: https://gitea.com/api/swagger#/repository/repoSearch
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	switch {
+	case account == nil:
+		return nil, errors.New("no account provided")
+	case !extsvc.IsHostOfAccount(p.codeHost, account):
+		return nil, errors.Errorf("not a code host of the account: want %q but have %q",
+			p.codeHost.ServiceID, account.AccountSpec.ServiceID)
+	case account.Data == nil:
+		return nil, errors.New("no account data provided")
+	}
+
+	_, tok, err := gitea.GetExternalAccountData(ctx, &account.AccountData)
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, errors.New("no token found in the external account data")
+	}
+	oauthToken := &auth.OAuthBearerToken{
+		Token:              tok.AccessToken,
+		RefreshToken:       tok.RefreshToken,
+		Expiry:             tok.Expiry,
+		NeedsRefreshBuffer: 5,
+	}
+	oauthToken.RefreshFunc = oauthtoken.GetAccountRefreshAndStoreOAuthTokenFunc(p.db.UserExternalAccounts(), account.ID, gitea.GetOAuthContext(p.codeHost.BaseURL.String()))
+
+	client, err := p.client.WithAuthenticator(oauthToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var extIDs []extsvc.RepoID
+	for page := 1; ; page++ {
+		repos, hasNextPage, err := client.SearchRepos(ctx, gitea.SearchReposOptions{}, page)
+		if err != nil {
+			return &authz.ExternalUserPermissions{Exacts: extIDs}, err
+		}
+
+		for _, r := range repos {
+			// Public repositories are visible to everyone and not
+			// subject to permissions syncing.
+			if !r.Private && !r.Internal {
+				continue
+			}
+			extIDs = append(extIDs, extsvc.RepoID(strconv.FormatInt(r.ID, 10)))
+		}
+
+		if !hasNextPage {
+			break
+		}
+	}
+
+	return &authz.ExternalUserPermissions{
+		Exacts: extIDs,
+	}, nil
+}
+
+// FetchRepoPerms is not implemented for Gitea: the Gitea API only lists the
+// explicit collaborators of a repository, but not the members of teams that
+// have access to it. Permissions are synced from the user's perspective only.
+func (p *Provider) FetchRepoPerms(ctx context.Context, repo *extsvc.Repository, opts authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+	return nil, &authz.ErrUnimplemented{Feature: "gitea.FetchRepoPerms"}
+}
@@ -0,0 +1,172 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func mustURL(t *testing.T, u string) *url.URL {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func createTestServer(t *testing.T) *httptest.Server {
+	pages := [][]*gitea.Repo{
+		{{ID: 1, Private: true}, {ID: 2}},
+		{{ID: 3, Internal: true}},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repos/search" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got, want := r.Header.Get("Authorization"), "Bearer my-access-token"; got != want {
+			t.Errorf("Authorization header: want %q but got %q", want, got)
+		}
+
+		// Pretend that there are more repositories than fit on one page, so
+		// that the second page is requested as well.
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		var repos []*gitea.Repo
+		if page >= 1 && page <= len(pages) {
+			repos = pages[page-1]
+		}
+		if page == 1 {
+			w.Header().Set("X-Total-Count", "51")
+		}
+		json.NewEncoder(w).Encode(map[string]any{"ok": true, "data": repos})
+	}))
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	ratelimit.SetupForTest(t)
+
+	db := dbmocks.NewMockDB()
+	conn := &types.GiteaConnection{
+		GiteaConnection: &schema.GiteaConnection{
+			Url: "https://gitea.example.com",
+		},
+	}
+
+	t.Run("nil account", func(t *testing.T) {
+		p := NewProvider(db, conn, ProviderOptions{})
+		_, err := p.FetchUserPerms(context.Background(), nil, authz.FetchPermsOptions{})
+		want := "no account provided"
+		got := fmt.Sprintf("%v", err)
+		if got != want {
+			t.Fatalf("err: want %q but got %q", want, got)
+		}
+	})
+
+	t.Run("not the code host of the account", func(t *testing.T) {
+		p := NewProvider(db, conn, ProviderOptions{})
+		_, err := p.FetchUserPerms(context.Background(),
+			&extsvc.Account{
+				AccountSpec: extsvc.AccountSpec{
+					ServiceType: extsvc.TypeGitHub,
+					ServiceID:   "https://github.com/",
+				},
+			},
+			authz.FetchPermsOptions{},
+		)
+		want := `not a code host of the account: want "https://gitea.example.com/" but have "https://github.com/"`
+		got := fmt.Sprintf("%v", err)
+		if got != want {
+			t.Fatalf("err: want %q but got %q", want, got)
+		}
+	})
+
+	t.Run("no account data provided", func(t *testing.T) {
+		p := NewProvider(db, conn, ProviderOptions{})
+		_, err := p.FetchUserPerms(context.Background(),
+			&extsvc.Account{
+				AccountSpec: extsvc.AccountSpec{
+					ServiceType: extsvc.TypeGitea,
+					ServiceID:   "https://gitea.example.com/",
+				},
+			},
+			authz.FetchPermsOptions{},
+		)
+		want := `no account data provided`
+		got := fmt.Sprintf("%v", err)
+		if got != want {
+			t.Fatalf("err: want %q but got %q", want, got)
+		}
+	})
+
+	t.Run("fetch user permissions", func(t *testing.T) {
+		server := createTestServer(t)
+		defer server.Close()
+
+		client, err := gitea.NewClient(server.URL, mustURL(t, server.URL), &auth.OAuthBearerToken{Token: "site-token"}, http.DefaultClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		p := NewProvider(db,
+			&types.GiteaConnection{
+				GiteaConnection: &schema.GiteaConnection{Url: server.URL},
+			}, ProviderOptions{GiteaClient: client})
+
+		var acctData extsvc.AccountData
+		err = gitea.SetExternalAccountData(&acctData, &gitea.User{}, &oauth2.Token{AccessToken: "my-access-token"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		account := &extsvc.Account{
+			AccountSpec: extsvc.AccountSpec{
+				ServiceType: extsvc.TypeGitea,
+				ServiceID:   extsvc.NormalizeBaseURL(mustURL(t, server.URL)).String(),
+			},
+			AccountData: acctData,
+		}
+		userPerms, err := p.FetchUserPerms(context.Background(), account, authz.FetchPermsOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expRepoIDs := []extsvc.RepoID{"1", "3"}
+		if diff := cmp.Diff(expRepoIDs, userPerms.Exacts); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+func TestProvider_FetchRepoPerms(t *testing.T) {
+	p := NewProvider(dbmocks.NewMockDB(), &types.GiteaConnection{
+		GiteaConnection: &schema.GiteaConnection{
+			Url: "https://gitea.example.com",
+		},
+	}, ProviderOptions{})
+
+	_, err := p.FetchRepoPerms(context.Background(), &extsvc.Repository{
+		URI: "gitea.example.com/user/repo",
+	}, authz.FetchPermsOptions{})
+	if !errors.Is(err, &authz.ErrUnimplemented{}) {
+		t.Fatalf("want unimplemented error but got %v", err)
+	}
+}
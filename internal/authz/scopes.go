@@ -1,13 +1,92 @@
 package authz
 
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+)
+
 const (
 	// Access token scopes.
 	ScopeUserAll       = "user:all"        // Full control of all resources accessible to the user account.
 	ScopeSiteAdminSudo = "site-admin:sudo" // Ability to perform any action as any other user.
+
+	// Fine-grained access token scopes. A token with any of these scopes, but
+	// without ScopeUserAll, can only be used for the operations they cover.
+	ScopeSearchRead        = "search:read"         // Run searches.
+	ScopeRepoRead          = "repo:read"           // Read repository metadata and contents.
+	ScopeBatchChangesWrite = "batch-changes:write" // Create, apply and manage batch changes.
+	ScopeCodeIntelUpload   = "code-intel:upload"   // Upload precise code intelligence indexes.
+	ScopeInsightsRead      = "insights:read"       // Read code insights and their data.
 )
 
 // AllScopes is a list of all known access token scopes.
 var AllScopes = []string{
 	ScopeUserAll,
 	ScopeSiteAdminSudo,
+	ScopeSearchRead,
+	ScopeRepoRead,
+	ScopeBatchChangesWrite,
+	ScopeCodeIntelUpload,
+	ScopeInsightsRead,
+}
+
+// UserScopes is a list of all scopes that can be used to authenticate as the
+// subject user of an access token, i.e. all scopes except ScopeSiteAdminSudo.
+var UserScopes = []string{
+	ScopeUserAll,
+	ScopeSearchRead,
+	ScopeRepoRead,
+	ScopeBatchChangesWrite,
+	ScopeCodeIntelUpload,
+	ScopeInsightsRead,
+}
+
+// ScopesGrant reports whether an access token with the given scopes may be
+// used for operations that require scope. ScopeUserAll grants every scope
+// except ScopeSiteAdminSudo.
+func ScopesGrant(scopes []string, scope string) bool {
+	if slices.Contains(scopes, scope) {
+		return true
+	}
+	return scope != ScopeSiteAdminSudo && slices.Contains(scopes, ScopeUserAll)
+}
+
+// EffectiveScopes returns the scopes that an access token with the given
+// scopes grants, with ScopeUserAll expanded to all the scopes it implies.
+func EffectiveScopes(scopes []string) []string {
+	var effective []string
+	for _, scope := range AllScopes {
+		if ScopesGrant(scopes, scope) {
+			effective = append(effective, scope)
+		}
+	}
+	return effective
+}
+
+// ErrMissingScope is returned when an actor authenticated with an access
+// token that doesn't grant the scope required for an operation.
+type ErrMissingScope struct {
+	Scope string
+}
+
+func (e *ErrMissingScope) Error() string {
+	return fmt.Sprintf("access token is missing the required scope %q", e.Scope)
+}
+
+func (e *ErrMissingScope) Unauthorized() bool {
+	return true
+}
+
+// CheckActorScope returns an error if the actor in ctx authenticated with an
+// access token that doesn't grant scope. Actors that didn't authenticate with
+// an access token are not restricted by scopes.
+func CheckActorScope(ctx context.Context, scope string) error {
+	a := actor.FromContext(ctx)
+	if a.AccessTokenScopes == nil || ScopesGrant(a.AccessTokenScopes, scope) {
+		return nil
+	}
+	return &ErrMissingScope{Scope: scope}
 }
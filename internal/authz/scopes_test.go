@@ -0,0 +1,55 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+)
+
+func TestScopesGrant(t *testing.T) {
+	tests := []struct {
+		scopes []string
+		scope  string
+		want   bool
+	}{
+		{scopes: []string{ScopeSearchRead}, scope: ScopeSearchRead, want: true},
+		{scopes: []string{ScopeSearchRead}, scope: ScopeRepoRead, want: false},
+		{scopes: []string{ScopeSearchRead}, scope: ScopeUserAll, want: false},
+		{scopes: []string{ScopeUserAll}, scope: ScopeInsightsRead, want: true},
+		{scopes: []string{ScopeUserAll}, scope: ScopeSiteAdminSudo, want: false},
+		{scopes: []string{ScopeUserAll, ScopeSiteAdminSudo}, scope: ScopeSiteAdminSudo, want: true},
+		{scopes: nil, scope: ScopeUserAll, want: false},
+	}
+	for _, test := range tests {
+		if got := ScopesGrant(test.scopes, test.scope); got != test.want {
+			t.Errorf("ScopesGrant(%q, %q) = %v, want %v", test.scopes, test.scope, got, test.want)
+		}
+	}
+}
+
+func TestEffectiveScopes(t *testing.T) {
+	require.Equal(t, UserScopes, EffectiveScopes([]string{ScopeUserAll}))
+	require.Equal(t, AllScopes, EffectiveScopes([]string{ScopeUserAll, ScopeSiteAdminSudo}))
+	require.Equal(t, []string{ScopeSearchRead, ScopeCodeIntelUpload}, EffectiveScopes([]string{ScopeCodeIntelUpload, ScopeSearchRead}))
+}
+
+func TestCheckActorScope(t *testing.T) {
+	t.Run("not authenticated with an access token", func(t *testing.T) {
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+		require.NoError(t, CheckActorScope(ctx, ScopeUserAll))
+	})
+
+	t.Run("scope granted", func(t *testing.T) {
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1, AccessTokenScopes: []string{ScopeRepoRead}})
+		require.NoError(t, CheckActorScope(ctx, ScopeRepoRead))
+	})
+
+	t.Run("scope missing", func(t *testing.T) {
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1, AccessTokenScopes: []string{ScopeRepoRead}})
+		err := CheckActorScope(ctx, ScopeUserAll)
+		require.Equal(t, &ErrMissingScope{Scope: ScopeUserAll}, err)
+	})
+}
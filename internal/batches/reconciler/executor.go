@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"text/template"
@@ -39,6 +40,8 @@ func executePlan(ctx context.Context, logger log.Logger, client gitserver.Client
 		tx:                tx,
 		ch:                plan.Changeset,
 		spec:              plan.ChangesetSpec,
+		previousSpec:      plan.PreviousChangesetSpec,
+		delta:             plan.Delta,
 	}
 
 	return e.Run(ctx, plan)
@@ -52,6 +55,8 @@ type executor struct {
 	tx                *store.Store
 	ch                *btypes.Changeset
 	spec              *btypes.ChangesetSpec
+	previousSpec      *btypes.ChangesetSpec
+	delta             *ChangesetSpecDelta
 
 	// targetRepo represents the repo where the changeset should be opened.
 	targetRepo *types.Repo
@@ -267,6 +272,11 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 		Body:       body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		Reviewers:  e.spec.Reviewers,
+		Labels:     e.spec.Labels,
+		Assignees:  e.spec.Assignees,
+		Milestone:  e.spec.Milestone,
+		AutoMerge:  e.spec.AutoMerge,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
@@ -403,9 +413,25 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 		Body:       body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		Reviewers:  e.spec.Reviewers,
+		Labels:     e.spec.Labels,
+		Assignees:  e.spec.Assignees,
+		Milestone:  e.spec.Milestone,
+		AutoMerge:  e.spec.AutoMerge,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
+
+		UpdateMetadata: e.delta != nil && e.delta.NeedMetadataUpdate(),
+	}
+	if cs.UpdateMetadata && e.previousSpec != nil {
+		cs.RemovedReviewers = removedValues(e.previousSpec.Reviewers, e.spec.Reviewers)
+		cs.RemovedLabels = removedValues(e.previousSpec.Labels, e.spec.Labels)
+		cs.RemovedAssignees = removedValues(e.previousSpec.Assignees, e.spec.Assignees)
+		if e.previousSpec.Milestone != e.spec.Milestone {
+			cs.RemovedMilestone = e.previousSpec.Milestone
+		}
+		cs.DisableAutoMerge = e.previousSpec.AutoMerge && !e.spec.AutoMerge
 	}
 
 	if err := css.UpdateChangeset(ctx, &cs); err != nil {
@@ -542,6 +568,7 @@ func (e *executor) undraftChangeset(ctx context.Context) (afterDone func(store *
 		Body:       e.spec.Body,
 		BaseRef:    e.spec.BaseRef,
 		HeadRef:    e.spec.HeadRef,
+		AutoMerge:  e.spec.AutoMerge,
 		RemoteRepo: remoteRepo,
 		TargetRepo: e.targetRepo,
 		Changeset:  e.ch,
@@ -816,6 +843,17 @@ func decorateChangesetBody(ctx context.Context, tx getBatchChanger, nsStore getN
 	return fmt.Sprintf("%s\n\n%s", body, bcl), nil
 }
 
+// removedValues returns the values of previous that are not in current.
+func removedValues(previous, current []string) []string {
+	var removed []string
+	for _, v := range previous {
+		if !slices.Contains(current, v) {
+			removed = append(removed, v)
+		}
+	}
+	return removed
+}
+
 // errPublishSameBranch is returned by publish changeset if a changeset with
 // the same external branch already exists in the database and is owned by
 // another batch change.
@@ -1254,6 +1254,12 @@ func TestDecorateChangesetBody(t *testing.T) {
 	}
 }
 
+func TestRemovedValues(t *testing.T) {
+	assert.Equal(t, []string{"a", "c"}, removedValues([]string{"a", "b", "c"}, []string{"b", "d"}))
+	assert.Empty(t, removedValues([]string{"a"}, []string{"a", "b"}))
+	assert.Empty(t, removedValues(nil, []string{"a"}))
+}
+
 func TestHandleArchivedRepo(t *testing.T) {
 	ctx := context.Background()
 
@@ -3,6 +3,7 @@ package reconciler
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -101,6 +102,9 @@ type Plan struct {
 	// The changeset spec that is used in this plan.
 	ChangesetSpec *btypes.ChangesetSpec
 
+	// The changeset spec that was previously applied to the changeset, if any.
+	PreviousChangesetSpec *btypes.ChangesetSpec
+
 	// The operations that need to be done to reconcile the changeset.
 	Ops Operations
 
@@ -119,8 +123,9 @@ func (p *Plan) SetOp(op btypes.ReconcilerOperation) { p.Ops = Operations{op} }
 // error.
 func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChangeset, wantedChangeset *btypes.Changeset) (*Plan, error) {
 	pl := &Plan{
-		Changeset:     wantedChangeset,
-		ChangesetSpec: currentSpec,
+		Changeset:             wantedChangeset,
+		ChangesetSpec:         currentSpec,
+		PreviousChangesetSpec: previousSpec,
 	}
 
 	wantDetach := false
@@ -299,6 +304,21 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.BaseRef != current.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	if !slices.Equal(previous.Reviewers, current.Reviewers) {
+		delta.ReviewersChanged = true
+	}
+	if !slices.Equal(previous.Labels, current.Labels) {
+		delta.LabelsChanged = true
+	}
+	if !slices.Equal(previous.Assignees, current.Assignees) {
+		delta.AssigneesChanged = true
+	}
+	if previous.Milestone != current.Milestone {
+		delta.MilestoneChanged = true
+	}
+	if previous.AutoMerge != current.AutoMerge {
+		delta.AutoMergeChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -348,6 +368,11 @@ type ChangesetSpecDelta struct {
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
 	AuthorEmailChanged   bool
+	ReviewersChanged     bool
+	LabelsChanged        bool
+	AssigneesChanged     bool
+	MilestoneChanged     bool
+	AutoMergeChanged     bool
 }
 
 func (d *ChangesetSpecDelta) String() string { return fmt.Sprintf("%#v", d) }
@@ -357,7 +382,14 @@ func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged || d.NeedMetadataUpdate()
+}
+
+// NeedMetadataUpdate returns true when the reviewers, labels, assignees,
+// milestone or auto-merge setting of the changeset need to be updated on the
+// code host.
+func (d *ChangesetSpecDelta) NeedMetadataUpdate() bool {
+	return d.ReviewersChanged || d.LabelsChanged || d.AssigneesChanged || d.MilestoneChanged || d.AutoMergeChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
@@ -230,6 +230,30 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			// We expect a no-op here.
 			wantOperations: Operations{},
 		},
+		{
+			name:         "labels changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true, Labels: []string{"before"}},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Labels: []string{"before", "after"}},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:         "reviewers, assignees, milestone and auto-merge changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec: &bt.TestSpecOpts{
+				Published: true,
+				Reviewers: []string{"alice"},
+				Assignees: []string{"bob"},
+				Milestone: "v1.0",
+				AutoMerge: true,
+			},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
 		{
 			name:         "commit diff changed on published changeset",
 			previousSpec: &bt.TestSpecOpts{Published: true, CommitDiff: []byte("testDiff")},
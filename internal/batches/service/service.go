@@ -872,7 +872,11 @@ func (s *Service) CreateChangesetSpec(ctx context.Context, rawSpec string, userI
 
 	// 🚨 SECURITY: We use database.Repos.Get to check whether the user has access to
 	// the repository or not.
-	if _, err = s.store.Repos().Get(ctx, spec.BaseRepoID); err != nil {
+	repo, err := s.store.Repos().Get(ctx, spec.BaseRepoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.ValidateCodeHostCapabilities(repo.ExternalRepo.ServiceType); err != nil {
 		return nil, err
 	}
 
@@ -894,9 +898,13 @@ func (s *Service) CreateChangesetSpecs(ctx context.Context, rawSpecs []string, u
 
 		// 🚨 SECURITY: We use database.Repos.Get to check whether the user has access to
 		// the repository or not.
-		if _, err = s.store.Repos().Get(ctx, spec.BaseRepoID); err != nil {
+		repo, err := s.store.Repos().Get(ctx, spec.BaseRepoID)
+		if err != nil {
 			return nil, err
 		}
+		if err := spec.ValidateCodeHostCapabilities(repo.ExternalRepo.ServiceType); err != nil {
+			return nil, errors.Wrapf(err, "repository %s", repo.Name)
+		}
 
 		spec.UserID = userID
 		specs[i] = spec
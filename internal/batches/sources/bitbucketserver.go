@@ -2,6 +2,7 @@ package sources
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -108,6 +109,7 @@ func (s BitbucketServerSource) CreateChangeset(ctx context.Context, c *Changeset
 	targetRepo := c.TargetRepo.Metadata.(*bitbucketserver.Repo)
 
 	pr := &bitbucketserver.PullRequest{Title: c.Title, Description: c.Body}
+	pr.Reviewers = addBitbucketServerReviewers(nil, c.Reviewers)
 
 	pr.ToRef.Repository.Slug = targetRepo.Slug
 	pr.ToRef.Repository.ID = targetRepo.ID
@@ -232,6 +234,10 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 		// request when updating a pull request.
 		Reviewers: pr.Reviewers,
 	}
+	if c.UpdateMetadata {
+		reviewers := removeBitbucketServerReviewers(pr.Reviewers, c.RemovedReviewers)
+		update.Reviewers = addBitbucketServerReviewers(reviewers, c.Reviewers)
+	}
 	update.ToRef.ID = c.BaseRef
 	update.ToRef.Repository.Slug = pr.ToRef.Repository.Slug
 	update.ToRef.Repository.Project.Key = pr.ToRef.Repository.Project.Key
@@ -419,3 +425,31 @@ func (s BitbucketServerSource) checkAndCopy(targetRepo *types.Repo, fork *bitbuc
 
 	return forkRepo, nil
 }
+
+// removeBitbucketServerReviewers returns the given reviewers without the users
+// with the given names.
+func removeBitbucketServerReviewers(reviewers []bitbucketserver.Reviewer, names []string) []bitbucketserver.Reviewer {
+	if len(names) == 0 {
+		return reviewers
+	}
+	return slices.DeleteFunc(slices.Clone(reviewers), func(r bitbucketserver.Reviewer) bool {
+		return r.User != nil && slices.Contains(names, r.User.Name)
+	})
+}
+
+// addBitbucketServerReviewers returns the given reviewers together with the
+// users with the given names that aren't reviewers yet.
+func addBitbucketServerReviewers(reviewers []bitbucketserver.Reviewer, names []string) []bitbucketserver.Reviewer {
+	for _, name := range names {
+		if slices.ContainsFunc(reviewers, func(r bitbucketserver.Reviewer) bool {
+			return r.User != nil && r.User.Name == name
+		}) {
+			continue
+		}
+		reviewers = append(reviewers, bitbucketserver.Reviewer{
+			User: &bitbucketserver.User{Name: name},
+			Role: "REVIEWER",
+		})
+	}
+	return reviewers
+}
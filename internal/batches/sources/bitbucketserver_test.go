@@ -2,7 +2,10 @@ package sources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -15,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -572,6 +576,79 @@ func TestBitbucketServerSource_UpdateChangeset(t *testing.T) {
 	}
 }
 
+func TestBitbucketServerSource_UpdateChangeset_Metadata(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		updateMetadata bool
+		want           []string
+	}{
+		{name: "metadata unchanged", updateMetadata: false, want: []string{"alice"}},
+		{name: "metadata changed", updateMetadata: true, want: []string{"alice", "bob"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var update bitbucketserver.UpdatePullRequestInput
+			doer := httpcli.DoerFunc(func(r *http.Request) (*http.Response, error) {
+				if r.Method != http.MethodPut {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+					t.Fatal(err)
+				}
+				rec := httptest.NewRecorder()
+				fmt.Fprint(rec, `{"id":1,"version":2}`)
+				return rec.Result(), nil
+			})
+			client, err := bitbucketserver.NewClient("Test", &schema.BitbucketServerConnection{Url: "https://bitbucket.example.com"}, doer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			src := &BitbucketServerSource{client: client}
+
+			pr := &bitbucketserver.PullRequest{ID: 1, Version: 1, Reviewers: []bitbucketserver.Reviewer{
+				{User: &bitbucketserver.User{Name: "alice"}, Role: "REVIEWER", Approved: true},
+			}}
+			err = src.UpdateChangeset(context.Background(), &Changeset{
+				Title:          "Title",
+				BaseRef:        "refs/heads/main",
+				Reviewers:      []string{"alice", "bob"},
+				Changeset:      &btypes.Changeset{Metadata: pr},
+				UpdateMetadata: tc.updateMetadata,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var have []string
+			for _, r := range update.Reviewers {
+				have = append(have, r.User.Name)
+			}
+			assert.Equal(t, tc.want, have)
+			assert.True(t, update.Reviewers[0].Approved, "existing reviewer was not retained")
+		})
+	}
+}
+
+func TestAddBitbucketServerReviewers(t *testing.T) {
+	alice := bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: "alice"}, Role: "REVIEWER", Approved: true}
+	bob := bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: "bob"}, Role: "REVIEWER"}
+
+	for _, tc := range []struct {
+		name      string
+		reviewers []bitbucketserver.Reviewer
+		names     []string
+		want      []bitbucketserver.Reviewer
+	}{
+		{name: "no names", reviewers: []bitbucketserver.Reviewer{alice}, names: nil, want: []bitbucketserver.Reviewer{alice}},
+		{name: "no reviewers", reviewers: nil, names: []string{"bob"}, want: []bitbucketserver.Reviewer{bob}},
+		{name: "existing reviewer kept", reviewers: []bitbucketserver.Reviewer{alice}, names: []string{"alice", "bob"}, want: []bitbucketserver.Reviewer{alice, bob}},
+		{name: "reviewer without user", reviewers: []bitbucketserver.Reviewer{{Role: "REVIEWER"}}, names: []string{"bob"}, want: []bitbucketserver.Reviewer{{Role: "REVIEWER"}, bob}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, addBitbucketServerReviewers(tc.reviewers, tc.names))
+		})
+	}
+}
+
 func TestBitbucketServerSource_CreateComment(t *testing.T) {
 	ratelimit.SetupForTest(t)
 
@@ -1068,3 +1145,13 @@ func TestBitbucketServerSource_GetFork(t *testing.T) {
 		testutil.AssertGolden(t, "testdata/golden/"+name, update(name), fork)
 	})
 }
+
+func TestRemoveBitbucketServerReviewers(t *testing.T) {
+	alice := bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: "alice"}, Role: "REVIEWER", Approved: true}
+	bob := bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: "bob"}, Role: "REVIEWER"}
+
+	reviewers := []bitbucketserver.Reviewer{alice, bob}
+	assert.Equal(t, []bitbucketserver.Reviewer{bob}, removeBitbucketServerReviewers(reviewers, []string{"alice", "carol"}))
+	assert.Equal(t, []bitbucketserver.Reviewer{alice, bob}, reviewers, "input was modified")
+	assert.Equal(t, reviewers, removeBitbucketServerReviewers(reviewers, nil))
+}
@@ -126,6 +126,31 @@ type Changeset struct {
 	HeadRef string
 	BaseRef string
 
+	// Reviewers, Labels, Assignees, Milestone and AutoMerge are applied by the
+	// sources of code hosts that support them and ignored by all others.
+	// Reviewers, labels and assignees added on the code host by other means
+	// are kept.
+	Reviewers []string
+	Labels    []string
+	Assignees []string
+	Milestone string
+	AutoMerge bool
+	// UpdateMetadata is set when updating a changeset whose reviewers, labels,
+	// assignees, milestone or auto-merge setting changed. UpdateChangeset only
+	// applies them to the changeset if it is set, so that e.g. changing the
+	// title doesn't request reviews again.
+	UpdateMetadata bool
+	// RemovedReviewers, RemovedLabels, RemovedAssignees and RemovedMilestone
+	// were set by the previous changeset spec, but not by the current one.
+	// UpdateChangeset removes them from the changeset if it still has them.
+	// DisableAutoMerge is set if the previous changeset spec enabled
+	// auto-merge and the current one doesn't.
+	RemovedReviewers []string
+	RemovedLabels    []string
+	RemovedAssignees []string
+	RemovedMilestone string
+	DisableAutoMerge bool
+
 	// RemoteRepo is the repository the branch will be pushed to. This must be
 	// the same as TargetRepo if forking is not in use.
 	RemoteRepo *types.Repo
@@ -170,6 +195,13 @@ func (c *Changeset) IsOutdated() (bool, error) {
 	return false, nil
 }
 
+// removesMetadata returns true when UpdateChangeset needs to remove
+// reviewers, labels, assignees, the milestone or auto-merge from the
+// changeset.
+func (c *Changeset) removesMetadata() bool {
+	return len(c.RemovedReviewers) > 0 || len(c.RemovedLabels) > 0 || len(c.RemovedAssignees) > 0 || c.RemovedMilestone != "" || c.DisableAutoMerge
+}
+
 func BuildCommitOptsCommon(repo *types.Repo, spec *btypes.ChangesetSpec, pushOpts *protocol.PushConfig) protocol.CreateCommitFromPatchRequest {
 	// IMPORTANT: We add a trailing newline here, otherwise `git apply`
 	// will fail with "corrupt patch at line <N>" where N is the last line.
@@ -0,0 +1,660 @@
+package sources
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	giteabatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type GiteaSource struct {
+	client gitea.Client
+}
+
+var (
+	_ ForkableChangesetSource = GiteaSource{}
+	_ DraftChangesetSource    = GiteaSource{}
+)
+
+func NewGiteaSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GiteaSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GiteaConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating external client")
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Gitea URL")
+	}
+
+	client, err := gitea.NewClient(svc.URN(), u, &auth.OAuthBearerToken{Token: c.Token}, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Gitea client")
+	}
+
+	return &GiteaSource{client: client}, nil
+}
+
+func (s GiteaSource) AuthenticationStrategy() AuthenticationStrategy {
+	return AuthenticationStrategyUserCredential
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s GiteaSource) GitserverPushConfig(ctx context.Context, repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(ctx, repo, s.client.Authenticator())
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s GiteaSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.OAuthBearerToken,
+		*auth.OAuthBearerTokenWithSSH,
+		*auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("GiteaSource", a)
+	}
+
+	client, err := s.client.WithAuthenticator(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GiteaSource{client: client}, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s GiteaSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.GetAuthenticatedUser(ctx)
+	return err
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s GiteaSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repo)
+	number, err := strconv.ParseInt(cs.ExternalID, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "converting external ID %q", cs.ExternalID)
+	}
+
+	pr, err := s.client.GetPullRequest(ctx, repo.Namespace(), repo.Name, number)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, repo, pr, cs)
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+func (s GiteaSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repo)
+	input := s.changesetToPullRequestInput(cs)
+
+	exists := false
+	pr, err := s.client.CreatePullRequest(ctx, repo.Namespace(), repo.Name, input)
+	if err != nil {
+		if !gitea.IsConflict(err) {
+			return false, errors.Wrap(err, "creating pull request")
+		}
+
+		// Gitea refuses to create a second pull request for the same
+		// branches, so we load the existing one instead.
+		exists = true
+		pr, err = s.client.GetPullRequestByBranches(ctx, repo.Namespace(), repo.Name, input.Base, input.Head)
+		if err != nil {
+			return false, errors.Wrap(err, "fetching existing pull request")
+		}
+	}
+
+	pr, err = s.addPullRequestMetadata(ctx, repo, pr, cs)
+	if err != nil {
+		return false, err
+	}
+	pr, err = s.scheduleAutoMerge(ctx, repo, pr, cs)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.setChangesetMetadata(ctx, repo, pr, cs); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// CreateDraftChangeset creates the Changeset on the source as a work in
+// progress pull request. Gitea has no separate draft state, but treats pull
+// requests with a WIP prefix in their title as drafts. If it already exists,
+// *Changeset will be populated and the return value will be true.
+func (s GiteaSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	cs.Title = gitea.WIPTitle(cs.Title)
+
+	exists, err := s.CreateChangeset(ctx, cs)
+	if err != nil {
+		return exists, err
+	}
+
+	pr, ok := cs.Changeset.Metadata.(*giteabatches.AnnotatedPullRequest)
+	if !ok {
+		return false, errors.New("Changeset is not a Gitea pull request")
+	}
+
+	// If it already exists, but is not a WIP, we need to update the title.
+	if exists && !pr.IsDraft() {
+		if err := s.UpdateChangeset(ctx, cs); err != nil {
+			return exists, err
+		}
+	}
+	return exists, nil
+}
+
+// UndraftChangeset marks the changeset as *not* work in progress anymore by
+// removing the WIP prefix from its title.
+func (s GiteaSource) UndraftChangeset(ctx context.Context, cs *Changeset) error {
+	cs.Title = gitea.TrimWIPPrefix(cs.Title)
+	// Auto-merge can't be scheduled for drafts, so it is scheduled now.
+	return s.updatePullRequest(ctx, cs, cs.Title, true)
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost (e.g. "declined" on
+// Bitbucket Server).
+func (s GiteaSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	return s.setPullRequestState(ctx, cs, gitea.PullRequestStateClosed)
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s GiteaSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	return s.setPullRequestState(ctx, cs, gitea.PullRequestStateOpen)
+}
+
+// UpdateChangeset can update Changesets.
+func (s GiteaSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	// Avoid accidentally undrafting the changeset by checking its current
+	// status.
+	title := cs.Title
+	if pr.IsDraft() {
+		title = gitea.WIPTitle(title)
+	}
+
+	return s.updatePullRequest(ctx, cs, title, false)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s GiteaSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repo)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	_, err := s.client.CreateIssueComment(ctx, repo.Namespace(), repo.Name, pr.Number, comment)
+	return err
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, and the code host supports squash merges, the source
+// must attempt a squash merge. Otherwise, it is expected to perform a regular
+// merge. If the changeset cannot be merged, because it is in an unmergeable
+// state, ChangesetNotMergeableError must be returned.
+func (s GiteaSource) MergeChangeset(ctx context.Context, cs *Changeset, squash bool) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repo)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	style := gitea.MergeStyleMerge
+	if squash {
+		style = gitea.MergeStyleSquash
+	}
+
+	err := s.client.MergePullRequest(ctx, repo.Namespace(), repo.Name, pr.Number, gitea.MergePullRequestInput{
+		Do:                     style,
+		DeleteBranchAfterMerge: conf.Get().BatchChangesAutoDeleteBranch,
+	})
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return errors.Wrap(err, "merging pull request")
+		}
+		return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+	}
+
+	// Merging doesn't return the updated pull request, so we have to fetch it
+	// again.
+	updated, err := s.client.GetPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number)
+	if err != nil {
+		return errors.Wrap(err, "getting merged pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, repo, updated, cs)
+}
+
+// GetFork returns a repo pointing to a fork of the target repo, ensuring that the fork
+// exists and creating it if it doesn't. If namespace is not provided, the fork will be in
+// the currently authenticated user's namespace. If name is not provided, the fork will be
+// named with the default Sourcegraph convention: "${original-namespace}-${original-name}"
+func (s GiteaSource) GetFork(ctx context.Context, targetRepo *types.Repo, ns, n *string) (*types.Repo, error) {
+	user, err := s.client.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting the current user")
+	}
+
+	namespace := user.Login
+	if ns != nil {
+		namespace = *ns
+	}
+
+	tr := targetRepo.Metadata.(*gitea.Repo)
+
+	var name string
+	if n != nil {
+		name = *n
+	} else {
+		name = DefaultForkName(tr.Namespace(), tr.Name)
+	}
+
+	// Figure out if we already have a fork of the repo in the given namespace.
+	if fork, err := s.client.GetRepo(ctx, namespace, name); err == nil {
+		return s.checkAndCopy(targetRepo, fork)
+	} else if !errcode.IsNotFound(err) {
+		return nil, errors.Wrap(err, "checking for fork existence")
+	}
+
+	input := gitea.ForkRepoInput{Name: &name}
+	// Forks into the user's own namespace must not name an organization.
+	if namespace != user.Login {
+		input.Organization = &namespace
+	}
+
+	fork, err := s.client.ForkRepo(ctx, tr.Namespace(), tr.Name, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "forking repository")
+	}
+
+	return s.checkAndCopy(targetRepo, fork)
+}
+
+func (s GiteaSource) BuildCommitOpts(repo *types.Repo, _ *btypes.Changeset, spec *btypes.ChangesetSpec, pushOpts *protocol.PushConfig) protocol.CreateCommitFromPatchRequest {
+	return BuildCommitOptsCommon(repo, spec, pushOpts)
+}
+
+func (s GiteaSource) checkAndCopy(targetRepo *types.Repo, fork *gitea.Repo) (*types.Repo, error) {
+	tr := targetRepo.Metadata.(*gitea.Repo)
+
+	if fork.Parent == nil {
+		return nil, errors.New("repo is not a fork")
+	} else if fork.Parent.ID != tr.ID {
+		return nil, errors.New("repo was not forked from the given parent")
+	}
+
+	// Now we make a copy of targetRepo, but with its sources and metadata updated to
+	// point to the fork
+	forkRepo, err := CopyRepoAsFork(targetRepo, fork, tr.FullName, fork.FullName)
+	if err != nil {
+		return nil, errors.Wrap(err, "updating target repo sources and metadata")
+	}
+
+	return forkRepo, nil
+}
+
+// updatePullRequest updates the title, body and base of the pull request. The
+// metadata of the changeset is only added if cs.UpdateMetadata is set, and
+// auto-merge is only scheduled if either that or autoMerge is set.
+func (s GiteaSource) updatePullRequest(ctx context.Context, cs *Changeset, title string, autoMerge bool) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repo)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	base := gitdomain.AbbreviateRef(cs.BaseRef)
+	updated, err := s.client.EditPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number, gitea.EditPullRequestInput{
+		Title: &title,
+		Body:  &cs.Body,
+		Base:  &base,
+	})
+	if err != nil {
+		return errors.Wrap(err, "updating pull request")
+	}
+
+	if cs.UpdateMetadata {
+		updated, err = s.removePullRequestMetadata(ctx, repo, updated, cs)
+		if err != nil {
+			return err
+		}
+		updated, err = s.addPullRequestMetadata(ctx, repo, updated, cs)
+		if err != nil {
+			return err
+		}
+	}
+	if cs.UpdateMetadata || autoMerge {
+		updated, err = s.scheduleAutoMerge(ctx, repo, updated, cs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.setChangesetMetadata(ctx, repo, updated, cs)
+}
+
+func (s GiteaSource) setPullRequestState(ctx context.Context, cs *Changeset, state string) error {
+	repo := cs.TargetRepo.Metadata.(*gitea.Repo)
+	pr := cs.Metadata.(*giteabatches.AnnotatedPullRequest)
+
+	updated, err := s.client.EditPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number, gitea.EditPullRequestInput{
+		State: &state,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "setting pull request state to %q", state)
+	}
+
+	return s.setChangesetMetadata(ctx, repo, updated, cs)
+}
+
+// addPullRequestMetadata adds the labels, assignees, milestone and reviewers of
+// the changeset to the pull request, keeping the ones it already has. The
+// updated pull request is returned.
+func (s GiteaSource) addPullRequestMetadata(ctx context.Context, repo *gitea.Repo, pr *gitea.PullRequest, cs *Changeset) (*gitea.PullRequest, error) {
+	var input gitea.EditPullRequestInput
+	changed := false
+
+	if len(cs.Labels) > 0 {
+		labels, added, err := s.mergeLabelIDs(ctx, repo, pr.Labels, cs.Labels)
+		if err != nil {
+			return nil, err
+		}
+		if added {
+			input.Labels = labels
+			changed = true
+		}
+	}
+
+	assignees := make([]string, 0, len(pr.Assignees)+len(cs.Assignees))
+	for _, u := range pr.Assignees {
+		assignees = append(assignees, u.Login)
+	}
+	existingAssignees := len(assignees)
+	for _, login := range cs.Assignees {
+		if !slices.Contains(assignees, login) {
+			assignees = append(assignees, login)
+		}
+	}
+	if len(assignees) > existingAssignees {
+		input.Assignees = assignees
+		changed = true
+	}
+
+	if cs.Milestone != "" && (pr.Milestone == nil || pr.Milestone.Title != cs.Milestone) {
+		milestones, err := s.client.ListRepoMilestones(ctx, repo.Namespace(), repo.Name, cs.Milestone)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing milestones")
+		}
+		idx := slices.IndexFunc(milestones, func(m *gitea.Milestone) bool { return m.Title == cs.Milestone })
+		if idx < 0 {
+			return nil, errors.Newf("milestone %q not found", cs.Milestone)
+		}
+		input.Milestone = &milestones[idx].ID
+		changed = true
+	}
+
+	if changed {
+		updated, err := s.client.EditPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "adding labels, assignees and milestone to pull request")
+		}
+		pr = updated
+	}
+
+	// Reviews can't be requested from the author of the pull request, and
+	// entries of the form "org/team" request a review from a team.
+	var reviewers gitea.RequestReviewersInput
+	for _, r := range cs.Reviewers {
+		if org, team, ok := strings.Cut(r, "/"); ok && org != "" && team != "" {
+			reviewers.TeamReviewers = append(reviewers.TeamReviewers, team)
+			continue
+		}
+		if pr.User != nil && pr.User.Login == r {
+			continue
+		}
+		if slices.ContainsFunc(pr.RequestedReviewers, func(u *gitea.User) bool { return u.Login == r }) {
+			continue
+		}
+		reviewers.Reviewers = append(reviewers.Reviewers, r)
+	}
+	if len(reviewers.Reviewers) == 0 && len(reviewers.TeamReviewers) == 0 {
+		return pr, nil
+	}
+	if err := s.client.RequestPullRequestReviewers(ctx, repo.Namespace(), repo.Name, pr.Number, reviewers); err != nil {
+		return nil, errors.Wrap(err, "requesting reviewers")
+	}
+
+	// Requesting reviewers doesn't return the updated pull request, so we
+	// have to fetch it again.
+	updated, err := s.client.GetPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting updated pull request")
+	}
+	return updated, nil
+}
+
+// removePullRequestMetadata removes the labels, assignees, milestone and
+// reviewers that were removed from the changeset spec from the pull request
+// and cancels auto-merge, if it's no longer requested. Only metadata the pull
+// request still has is removed. The updated pull request is returned.
+func (s GiteaSource) removePullRequestMetadata(ctx context.Context, repo *gitea.Repo, pr *gitea.PullRequest, cs *Changeset) (*gitea.PullRequest, error) {
+	if !cs.removesMetadata() {
+		return pr, nil
+	}
+	changed := false
+
+	for _, l := range pr.Labels {
+		if !slices.Contains(cs.RemovedLabels, l.Name) {
+			continue
+		}
+		if err := s.client.RemoveIssueLabel(ctx, repo.Namespace(), repo.Name, pr.Number, l.ID); err != nil {
+			return nil, errors.Wrap(err, "removing label")
+		}
+		changed = true
+	}
+
+	// The pull request API can neither remove all assignees nor the
+	// milestone, so we use the issue API instead.
+	var input gitea.EditIssueInput
+	assignees := make([]string, 0, len(pr.Assignees))
+	for _, u := range pr.Assignees {
+		if !slices.Contains(cs.RemovedAssignees, u.Login) {
+			assignees = append(assignees, u.Login)
+		}
+	}
+	if len(assignees) < len(pr.Assignees) {
+		input.Assignees = &assignees
+	}
+	if cs.RemovedMilestone != "" && cs.Milestone == "" && pr.Milestone != nil && pr.Milestone.Title == cs.RemovedMilestone {
+		var unset int64
+		input.Milestone = &unset
+	}
+	if input.Assignees != nil || input.Milestone != nil {
+		if err := s.client.EditIssue(ctx, repo.Namespace(), repo.Name, pr.Number, input); err != nil {
+			return nil, errors.Wrap(err, "removing assignees and milestone from pull request")
+		}
+		changed = true
+	}
+
+	var reviewers gitea.RequestReviewersInput
+	for _, r := range cs.RemovedReviewers {
+		if org, team, ok := strings.Cut(r, "/"); ok && org != "" && team != "" {
+			reviewers.TeamReviewers = append(reviewers.TeamReviewers, team)
+		} else if slices.ContainsFunc(pr.RequestedReviewers, func(u *gitea.User) bool { return u.Login == r }) {
+			reviewers.Reviewers = append(reviewers.Reviewers, r)
+		}
+	}
+	if len(reviewers.Reviewers) > 0 || len(reviewers.TeamReviewers) > 0 {
+		if err := s.client.RemovePullRequestReviewers(ctx, repo.Namespace(), repo.Name, pr.Number, reviewers); err != nil {
+			return nil, errors.Wrap(err, "removing reviewers")
+		}
+		changed = true
+	}
+
+	if cs.DisableAutoMerge {
+		// Gitea responds with not found if no auto-merge is scheduled.
+		if err := s.client.CancelPullRequestAutoMerge(ctx, repo.Namespace(), repo.Name, pr.Number); err != nil && !gitea.IsNotFound(err) {
+			return nil, errors.Wrap(err, "cancelling auto-merge")
+		}
+		changed = true
+	}
+
+	if !changed {
+		return pr, nil
+	}
+	updated, err := s.client.GetPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting updated pull request")
+	}
+	return updated, nil
+}
+
+// scheduleAutoMerge schedules the pull request to be merged once its checks
+// pass, if the changeset asks for auto-merge. Work in progress pull requests
+// can't be merged, so auto-merge is only scheduled once they are undrafted.
+// The updated pull request is returned.
+func (s GiteaSource) scheduleAutoMerge(ctx context.Context, repo *gitea.Repo, pr *gitea.PullRequest, cs *Changeset) (*gitea.PullRequest, error) {
+	if !cs.AutoMerge || pr.State != gitea.PullRequestStateOpen || pr.IsDraft() {
+		return pr, nil
+	}
+
+	err := s.client.MergePullRequest(ctx, repo.Namespace(), repo.Name, pr.Number, gitea.MergePullRequestInput{
+		Do:                     gitea.MergeStyleMerge,
+		DeleteBranchAfterMerge: conf.Get().BatchChangesAutoDeleteBranch,
+		MergeWhenChecksSucceed: true,
+	})
+	if err != nil {
+		// Gitea responds with a conflict if auto-merge is already scheduled.
+		if gitea.IsConflict(err) {
+			return pr, nil
+		}
+		return nil, errors.Wrap(err, "scheduling auto-merge")
+	}
+
+	// Merging doesn't return the updated pull request, so we have to fetch it
+	// again.
+	updated, err := s.client.GetPullRequest(ctx, repo.Namespace(), repo.Name, pr.Number)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting updated pull request")
+	}
+	return updated, nil
+}
+
+// mergeLabelIDs returns the IDs of the existing labels together with the IDs
+// of the repository labels with the given names, and whether any label was
+// added.
+func (s GiteaSource) mergeLabelIDs(ctx context.Context, repo *gitea.Repo, existing []*gitea.Label, names []string) ([]int64, bool, error) {
+	repoLabels, err := s.client.ListRepoLabels(ctx, repo.Namespace(), repo.Name)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "listing labels")
+	}
+
+	ids := make([]int64, 0, len(existing)+len(names))
+	for _, l := range existing {
+		ids = append(ids, l.ID)
+	}
+
+	added := false
+	for _, name := range names {
+		idx := slices.IndexFunc(repoLabels, func(l *gitea.Label) bool { return l.Name == name })
+		if idx < 0 {
+			return nil, false, errors.Newf("label %q not found", name)
+		}
+		if id := repoLabels[idx].ID; !slices.Contains(ids, id) {
+			ids = append(ids, id)
+			added = true
+		}
+	}
+	return ids, added, nil
+}
+
+func (s GiteaSource) annotatePullRequest(ctx context.Context, repo *gitea.Repo, pr *gitea.PullRequest) (*giteabatches.AnnotatedPullRequest, error) {
+	reviews, err := s.client.ListPullRequestReviews(ctx, repo.Namespace(), repo.Name, pr.Number)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting pull request reviews")
+	}
+
+	statuses := []*gitea.CommitStatus{}
+	if pr.Head != nil && pr.Head.Sha != "" {
+		combined, err := s.client.GetCombinedStatus(ctx, repo.Namespace(), repo.Name, pr.Head.Sha)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting pull request statuses")
+		}
+		statuses = append(statuses, combined.Statuses...)
+	}
+
+	return &giteabatches.AnnotatedPullRequest{
+		PullRequest: pr,
+		Reviews:     reviews,
+		Statuses:    statuses,
+	}, nil
+}
+
+func (s GiteaSource) setChangesetMetadata(ctx context.Context, repo *gitea.Repo, pr *gitea.PullRequest, cs *Changeset) error {
+	apr, err := s.annotatePullRequest(ctx, repo, pr)
+	if err != nil {
+		return errors.Wrap(err, "annotating pull request")
+	}
+
+	if err := cs.SetMetadata(apr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+func (s GiteaSource) changesetToPullRequestInput(cs *Changeset) gitea.CreatePullRequestInput {
+	head := gitdomain.AbbreviateRef(cs.HeadRef)
+
+	// If we're forking, then the head branch lives in the fork and needs to
+	// be qualified with its owner.
+	if cs.RemoteRepo != cs.TargetRepo {
+		head = cs.RemoteRepo.Metadata.(*gitea.Repo).Namespace() + ":" + head
+	}
+
+	return gitea.CreatePullRequestInput{
+		Head:  head,
+		Base:  gitdomain.AbbreviateRef(cs.BaseRef),
+		Title: cs.Title,
+		Body:  cs.Body,
+	}
+}
@@ -238,6 +238,10 @@ func (s GitHubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 		exists = true
 	}
 
+	if err := s.addChangesetMetadata(ctx, c, pr); err != nil {
+		return exists, err
+	}
+
 	if err := c.SetMetadata(pr); err != nil {
 		return false, errors.Wrap(err, "setting changeset metadata")
 	}
@@ -245,6 +249,161 @@ func (s GitHubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 	return exists, nil
 }
 
+// addChangesetMetadata adds the reviewers, labels, assignees and milestone of
+// the given changeset to the pull request and enables auto-merge, if
+// requested. Auto-merge can't be enabled on draft pull requests; it is enabled
+// once the pull request is undrafted instead. Metadata removed from the
+// changeset spec is removed from the pull request first.
+//
+// If anything was changed, pr is reloaded from the code host.
+func (s GitHubSource) addChangesetMetadata(ctx context.Context, c *Changeset, pr *github.PullRequest) error {
+	if len(c.Reviewers) == 0 && len(c.Labels) == 0 && len(c.Assignees) == 0 && c.Milestone == "" && !c.AutoMerge && !c.removesMetadata() {
+		return nil
+	}
+
+	repo := c.TargetRepo.Metadata.(*github.Repository)
+	owner, name, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting repo owner and name")
+	}
+
+	if c.removesMetadata() {
+		if err := s.removeChangesetMetadata(ctx, c, owner, name, pr); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Labels) > 0 {
+		if err := s.client.AddLabelsToIssue(ctx, owner, name, pr.Number, c.Labels); err != nil {
+			return errors.Wrap(err, "adding labels")
+		}
+	}
+
+	if len(c.Assignees) > 0 {
+		if err := s.client.AddAssigneesToIssue(ctx, owner, name, pr.Number, c.Assignees); err != nil {
+			return errors.Wrap(err, "adding assignees")
+		}
+	}
+
+	if len(c.Reviewers) > 0 {
+		var reviewers, teamReviewers []string
+		for _, reviewer := range c.Reviewers {
+			if _, team, ok := strings.Cut(reviewer, "/"); ok {
+				teamReviewers = append(teamReviewers, team)
+			} else if !strings.EqualFold(reviewer, pr.Author.Login) {
+				// GitHub rejects review requests from the author of the pull request.
+				reviewers = append(reviewers, reviewer)
+			}
+		}
+		if len(reviewers) > 0 || len(teamReviewers) > 0 {
+			if err := s.client.RequestPullRequestReviewers(ctx, owner, name, pr.Number, reviewers, teamReviewers); err != nil {
+				return errors.Wrap(err, "requesting reviewers")
+			}
+		}
+	}
+
+	if c.Milestone != "" {
+		milestone, err := s.findMilestone(ctx, owner, name, c.Milestone)
+		if err != nil {
+			return err
+		}
+		if err := s.client.SetIssueMilestone(ctx, owner, name, pr.Number, milestone.Number); err != nil {
+			return errors.Wrap(err, "setting milestone")
+		}
+	}
+
+	if c.AutoMerge && !pr.IsDraft {
+		if err := s.client.EnablePullRequestAutoMerge(ctx, pr); err != nil {
+			return errors.Wrap(err, "enabling auto-merge")
+		}
+	}
+
+	pr.RepoWithOwner = repo.NameWithOwner
+	return errors.Wrap(s.client.LoadPullRequest(ctx, pr), "reloading pull request")
+}
+
+// removeChangesetMetadata removes the reviewers, labels, assignees and
+// milestone that were removed from the changeset spec from the pull request
+// and disables auto-merge, if it's no longer requested. Only metadata the pull
+// request still has is removed.
+func (s GitHubSource) removeChangesetMetadata(ctx context.Context, c *Changeset, owner, name string, pr *github.PullRequest) error {
+	current, err := s.client.GetPullRequestMetadata(ctx, owner, name, pr.Number)
+	if err != nil {
+		return errors.Wrap(err, "getting pull request metadata")
+	}
+
+	for _, label := range c.RemovedLabels {
+		if !current.HasLabel(label) {
+			continue
+		}
+		if err := s.client.RemoveLabelFromIssue(ctx, owner, name, pr.Number, label); err != nil {
+			return errors.Wrap(err, "removing label")
+		}
+	}
+
+	var assignees []string
+	for _, assignee := range c.RemovedAssignees {
+		if current.HasAssignee(assignee) {
+			assignees = append(assignees, assignee)
+		}
+	}
+	if len(assignees) > 0 {
+		if err := s.client.RemoveAssigneesFromIssue(ctx, owner, name, pr.Number, assignees); err != nil {
+			return errors.Wrap(err, "removing assignees")
+		}
+	}
+
+	var reviewers, teamReviewers []string
+	for _, reviewer := range c.RemovedReviewers {
+		if _, team, ok := strings.Cut(reviewer, "/"); ok {
+			if current.HasRequestedTeam(team) {
+				teamReviewers = append(teamReviewers, team)
+			}
+		} else if current.HasRequestedReviewer(reviewer) {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	if len(reviewers) > 0 || len(teamReviewers) > 0 {
+		if err := s.client.RemovePullRequestReviewers(ctx, owner, name, pr.Number, reviewers, teamReviewers); err != nil {
+			return errors.Wrap(err, "removing reviewers")
+		}
+	}
+
+	// A new milestone replaces the previous one, so we only need to clear it
+	// if the changeset spec no longer sets one.
+	if c.RemovedMilestone != "" && c.Milestone == "" && current.Milestone != nil && current.Milestone.Title == c.RemovedMilestone {
+		if err := s.client.ClearIssueMilestone(ctx, owner, name, pr.Number); err != nil {
+			return errors.Wrap(err, "clearing milestone")
+		}
+	}
+
+	if c.DisableAutoMerge && current.AutoMerge != nil {
+		if err := s.client.DisablePullRequestAutoMerge(ctx, pr); err != nil {
+			return errors.Wrap(err, "disabling auto-merge")
+		}
+	}
+
+	return nil
+}
+
+// findMilestone returns the open milestone with the given title.
+func (s GitHubSource) findMilestone(ctx context.Context, owner, name, title string) (*github.Milestone, error) {
+	for page := 1; ; page++ {
+		milestones, hasNextPage, err := s.client.ListMilestones(ctx, owner, name, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing milestones")
+		}
+		for _, milestone := range milestones {
+			if milestone.Title == title {
+				return milestone, nil
+			}
+		}
+		if !hasNextPage {
+			return nil, errors.Newf("no open milestone named %q in %s/%s", title, owner, name)
+		}
+	}
+}
+
 // CloseChangeset closes the given *Changeset on the code host and updates the
 // Metadata column in the *batches.Changeset to the newly closed pull request.
 func (s GitHubSource) CloseChangeset(ctx context.Context, c *Changeset) error {
@@ -284,6 +443,12 @@ func (s GitHubSource) UndraftChangeset(ctx context.Context, c *Changeset) error
 		return err
 	}
 
+	if c.AutoMerge {
+		if err := s.client.EnablePullRequestAutoMerge(ctx, pr); err != nil {
+			return errors.Wrap(err, "enabling auto-merge")
+		}
+	}
+
 	return c.Changeset.SetMetadata(pr)
 }
 
@@ -331,6 +496,12 @@ func (s GitHubSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
 		return err
 	}
 
+	if c.UpdateMetadata {
+		if err := s.addChangesetMetadata(ctx, c, updated); err != nil {
+			return err
+		}
+	}
+
 	return c.Changeset.SetMetadata(updated)
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"strings"
@@ -22,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -863,6 +865,175 @@ func TestGithubSource_DuplicateCommit(t *testing.T) {
 	}
 }
 
+func TestGithubSource_UpdateChangeset_Metadata(t *testing.T) {
+	newChangeset := func(updateMetadata bool) *Changeset {
+		return &Changeset{
+			Title:     "Title",
+			Body:      "Body",
+			BaseRef:   "refs/heads/main",
+			Reviewers: []string{"alice", "author", "org/team"},
+			Labels:    []string{"batch-change"},
+			Assignees: []string{"bob"},
+			Milestone: "v2",
+			AutoMerge: true,
+			TargetRepo: &types.Repo{
+				Metadata: &github.Repository{NameWithOwner: "owner/repo"},
+			},
+			Changeset: &btypes.Changeset{
+				Metadata: &github.PullRequest{ID: "PR_1", Number: 1},
+			},
+			UpdateMetadata: updateMetadata,
+		}
+	}
+
+	t.Run("metadata unchanged", func(t *testing.T) {
+		src, requests := newFakeGitHubSource(t)
+
+		if err := src.UpdateChangeset(context.Background(), newChangeset(false)); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, []string{"POST /graphql updatePullRequest"}, *requests)
+	})
+
+	t.Run("metadata changed", func(t *testing.T) {
+		src, requests := newFakeGitHubSource(t)
+
+		cs := newChangeset(true)
+		if err := src.UpdateChangeset(context.Background(), cs); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, []string{
+			"POST /graphql updatePullRequest",
+			`POST /repos/owner/repo/issues/1/labels {"labels":["batch-change"]}`,
+			`POST /repos/owner/repo/issues/1/assignees {"assignees":["bob"]}`,
+			`POST /repos/owner/repo/pulls/1/requested_reviewers {"reviewers":["alice"],"team_reviewers":["team"]}`,
+			"GET /repos/owner/repo/milestones page=1",
+			"GET /repos/owner/repo/milestones page=2",
+			`PATCH /repos/owner/repo/issues/1 {"milestone":2}`,
+			"POST /graphql enablePullRequestAutoMerge",
+			"POST /graphql pullRequest",
+		}, *requests)
+		assert.Equal(t, "Reloaded", cs.Changeset.Metadata.(*github.PullRequest).Title)
+	})
+
+	t.Run("metadata removed", func(t *testing.T) {
+		src, requests := newFakeGitHubSource(t)
+
+		cs := newChangeset(true)
+		cs.Reviewers, cs.Labels, cs.Assignees, cs.Milestone, cs.AutoMerge = nil, nil, nil, "", false
+		cs.RemovedReviewers = []string{"dave", "org/old-team", "erin"}
+		cs.RemovedLabels = []string{"old", "gone"}
+		cs.RemovedAssignees = []string{"carol"}
+		cs.RemovedMilestone = "v1"
+		cs.DisableAutoMerge = true
+		if err := src.UpdateChangeset(context.Background(), cs); err != nil {
+			t.Fatal(err)
+		}
+
+		// Only metadata the pull request still has is removed.
+		assert.Equal(t, []string{
+			"POST /graphql updatePullRequest",
+			"GET /repos/owner/repo/pulls/1",
+			"DELETE /repos/owner/repo/issues/1/labels/old ",
+			`DELETE /repos/owner/repo/issues/1/assignees {"assignees":["carol"]}`,
+			`DELETE /repos/owner/repo/pulls/1/requested_reviewers {"reviewers":["dave"],"team_reviewers":["old-team"]}`,
+			`PATCH /repos/owner/repo/issues/1 {"milestone":null}`,
+			"POST /graphql disablePullRequestAutoMerge",
+			"POST /graphql pullRequest",
+		}, *requests)
+	})
+}
+
+func TestGithubSource_findMilestone(t *testing.T) {
+	src, _ := newFakeGitHubSource(t)
+	ctx := context.Background()
+
+	milestone, err := src.findMilestone(ctx, "owner", "repo", "v2")
+	require.NoError(t, err)
+	assert.Equal(t, &github.Milestone{Number: 2, Title: "v2"}, milestone)
+
+	_, err = src.findMilestone(ctx, "owner", "repo", "v3")
+	assert.ErrorContains(t, err, `no open milestone named "v3" in owner/repo`)
+}
+
+// newFakeGitHubSource returns a GitHubSource talking to a fake GitHub API. The
+// requests it receives are recorded as "METHOD path detail", where detail is
+// the request body of REST mutations, the page of milestone listings and the
+// GraphQL operation otherwise.
+func newFakeGitHubSource(t *testing.T) (*GitHubSource, *[]string) {
+	t.Helper()
+
+	var requests []string
+	pr := `{"id":"PR_1","number":1,"title":"%s","author":{"login":"author"}}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		switch {
+		case r.URL.Path == "/graphql":
+			var op string
+			for _, name := range []string{"updatePullRequest", "disablePullRequestAutoMerge", "enablePullRequestAutoMerge", "pullRequest"} {
+				if bytes.Contains(body, []byte(name+"(")) {
+					op = name
+					break
+				}
+			}
+			requests = append(requests, "POST /graphql "+op)
+			switch op {
+			case "updatePullRequest":
+				fmt.Fprintf(w, `{"data":{"updatePullRequest":{"pullRequest":`+pr+`}}}`, "Title")
+			case "enablePullRequestAutoMerge":
+				fmt.Fprint(w, `{"data":{"enablePullRequestAutoMerge":{"clientMutationId":""}}}`)
+			case "disablePullRequestAutoMerge":
+				fmt.Fprint(w, `{"data":{"disablePullRequestAutoMerge":{"clientMutationId":""}}}`)
+			default:
+				fmt.Fprintf(w, `{"data":{"repository":{"pullRequest":`+pr+`}}}`, "Reloaded")
+			}
+
+		case r.URL.Path == "/repos/owner/repo/milestones":
+			page := r.URL.Query().Get("page")
+			requests = append(requests, "GET "+r.URL.Path+" page="+page)
+			if page == "1" {
+				w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/milestones?page=2>; rel="next"`)
+				fmt.Fprint(w, `[{"number":1,"title":"v1"}]`)
+			} else {
+				fmt.Fprint(w, `[{"number":2,"title":"v2"}]`)
+			}
+
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/pulls/1":
+			requests = append(requests, "GET "+r.URL.Path)
+			fmt.Fprint(w, `{
+				"labels": [{"name":"old"},{"name":"batch-change"}],
+				"assignees": [{"login":"carol"}],
+				"requested_reviewers": [{"login":"dave"}],
+				"requested_teams": [{"slug":"old-team"}],
+				"milestone": {"number":1,"title":"v1"},
+				"auto_merge": {"merge_method":"merge"}
+			}`)
+
+		default:
+			requests = append(requests, r.Method+" "+r.URL.Path+" "+string(bytes.TrimSpace(body)))
+			if strings.Contains(r.URL.Path, "/labels") {
+				fmt.Fprint(w, `[]`)
+			} else {
+				fmt.Fprint(w, `{}`)
+			}
+		}
+	})
+
+	doer := httpcli.DoerFunc(func(r *http.Request) (*http.Response, error) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		return rec.Result(), nil
+	})
+	apiURL, err := url.Parse("https://api.github.com")
+	require.NoError(t, err)
+
+	return &GitHubSource{client: github.NewV4Client("Test", apiURL, nil, doer)}, &requests
+}
+
 type mockGithubClientFork struct {
 	wantOrg *string
 	fork    *github.Repository
@@ -3,6 +3,7 @@ package sources
 import (
 	"context"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -125,6 +126,11 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	}
 	removeSource := conf.Get().BatchChangesAutoDeleteBranch
 
+	meta, err := s.resolveMergeRequestMetadata(ctx, targetProject, c)
+	if err != nil {
+		return exists, err
+	}
+
 	// We have to create the merge request against the remote project, not the
 	// target project, because that's how GitLab's API works: you provide the
 	// target project ID as one of the parameters. Yes, this is weird.
@@ -138,6 +144,10 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 		Title:              c.Title,
 		Description:        c.Body,
 		RemoveSourceBranch: removeSource,
+		Labels:             strings.Join(c.Labels, ","),
+		AssigneeIDs:        meta.assigneeIDs,
+		ReviewerIDs:        meta.reviewerIDs,
+		MilestoneID:        meta.milestoneID,
 	})
 	if err != nil {
 		if err == gitlab.ErrMergeRequestAlreadyExists {
@@ -152,6 +162,10 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 		}
 	}
 
+	if mr, err = s.enableAutoMerge(ctx, targetProject, mr, c); err != nil {
+		return exists, err
+	}
+
 	// These additional API calls can go away once we can use the GraphQL API.
 	if err := s.decorateMergeRequestData(ctx, targetProject, mr); err != nil {
 		return exists, errors.Wrapf(err, "retrieving additional data for merge request %d", mr.IID)
@@ -491,18 +505,49 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 		title = gitlab.SetWIPOrDraft(c.Title, v)
 	}
 
-	removeSource := conf.Get().BatchChangesAutoDeleteBranch
-
-	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, gitlab.UpdateMergeRequestOpts{
+	opts := gitlab.UpdateMergeRequestOpts{
 		Title:              title,
 		Description:        c.Body,
 		TargetBranch:       gitdomain.AbbreviateRef(c.BaseRef),
-		RemoveSourceBranch: removeSource,
-	})
+		RemoveSourceBranch: conf.Get().BatchChangesAutoDeleteBranch,
+	}
+
+	// Only touch the labels, assignees, reviewers and milestone if they
+	// changed, so that e.g. editing the title doesn't re-add a label somebody
+	// removed on GitLab.
+	if c.UpdateMetadata {
+		meta, err := s.resolveMergeRequestMetadata(ctx, project, c)
+		if err != nil {
+			return err
+		}
+		opts.AddLabels = strings.Join(c.Labels, ",")
+		opts.RemoveLabels = strings.Join(c.RemovedLabels, ",")
+		opts.AssigneeIDs = mergeUserIDs(mr.Assignees, meta.assigneeIDs, c.RemovedAssignees)
+		opts.ReviewerIDs = mergeUserIDs(mr.Reviewers, meta.reviewerIDs, c.RemovedReviewers)
+		if meta.milestoneID != 0 {
+			opts.MilestoneID = &meta.milestoneID
+		} else if c.RemovedMilestone != "" && mr.Milestone != nil && mr.Milestone.Title == c.RemovedMilestone {
+			var unset gitlab.ID
+			opts.MilestoneID = &unset
+		}
+	}
+
+	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, opts)
 	if err != nil {
 		return errors.Wrap(err, "updating GitLab merge request")
 	}
 
+	if c.UpdateMetadata {
+		if updated, err = s.enableAutoMerge(ctx, project, updated, c); err != nil {
+			return err
+		}
+		if c.DisableAutoMerge && updated.MergeWhenPipelineSucceeds {
+			if updated, err = s.client.CancelMergeRequestAutoMerge(ctx, project, updated); err != nil {
+				return errors.Wrap(err, "cancelling auto-merge of GitLab merge request")
+			}
+		}
+	}
+
 	// These additional API calls can go away once we can use the GraphQL API.
 	if err := s.decorateMergeRequestData(ctx, project, mr); err != nil {
 		return errors.Wrapf(err, "retrieving additional data for merge request %d", mr.IID)
@@ -529,7 +574,16 @@ func (s *GitLabSource) UndraftChangeset(ctx context.Context, c *Changeset) error
 	mr.Draft = false
 	mr.WorkInProgress = false
 
-	return s.UpdateChangeset(ctx, c)
+	if err := s.UpdateChangeset(ctx, c); err != nil {
+		return err
+	}
+
+	// Draft merge requests can't be merged, so auto-merge is enabled now.
+	updated, err := s.enableAutoMerge(ctx, c.TargetRepo.Metadata.(*gitlab.Project), c.Changeset.Metadata.(*gitlab.MergeRequest), c)
+	if err != nil {
+		return err
+	}
+	return c.Changeset.SetMetadata(updated)
 }
 
 // CreateComment posts a comment on the Changeset.
@@ -568,6 +622,113 @@ func (s *GitLabSource) MergeChangeset(ctx context.Context, c *Changeset, squash
 	return c.Changeset.SetMetadata(updated)
 }
 
+// mergeRequestMetadata holds the GitLab IDs of the users and milestone
+// referenced by a changeset by name.
+type mergeRequestMetadata struct {
+	assigneeIDs []int32
+	reviewerIDs []int32
+	milestoneID gitlab.ID
+}
+
+// resolveMergeRequestMetadata looks up the IDs of the assignees, reviewers and
+// milestone of the changeset, since GitLab only accepts IDs for them.
+func (s *GitLabSource) resolveMergeRequestMetadata(ctx context.Context, project *gitlab.Project, c *Changeset) (mergeRequestMetadata, error) {
+	var meta mergeRequestMetadata
+	var err error
+
+	if meta.assigneeIDs, err = s.resolveUserIDs(ctx, c.Assignees); err != nil {
+		return meta, errors.Wrap(err, "resolving assignees")
+	}
+	if meta.reviewerIDs, err = s.resolveUserIDs(ctx, c.Reviewers); err != nil {
+		return meta, errors.Wrap(err, "resolving reviewers")
+	}
+
+	if c.Milestone != "" {
+		milestones, err := s.client.ListProjectMilestones(ctx, project, c.Milestone)
+		if err != nil {
+			return meta, errors.Wrap(err, "resolving milestone")
+		}
+		// The title filter of the API is an exact match, but be defensive.
+		for _, m := range milestones {
+			if m.Title == c.Milestone {
+				meta.milestoneID = m.ID
+				break
+			}
+		}
+		if meta.milestoneID == 0 {
+			return meta, errors.Newf("milestone %q not found in project %s", c.Milestone, project.PathWithNamespace)
+		}
+	}
+
+	return meta, nil
+}
+
+func (s *GitLabSource) resolveUserIDs(ctx context.Context, usernames []string) ([]int32, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int32, 0, len(usernames))
+	for _, username := range usernames {
+		users, _, err := s.client.ListUsers(ctx, "users?username="+url.QueryEscape(username))
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, errors.Newf("user %q not found", username)
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return ids, nil
+}
+
+// mergeUserIDs returns the IDs of the existing users together with the given
+// IDs, minus the existing users whose username is in removed. GitLab replaces
+// assignees and reviewers on update, so we have to send the existing ones
+// along to only add to them. If nothing is added or removed, nil is returned so
+// that the existing users are left untouched.
+func mergeUserIDs(existing []gitlab.User, ids []int32, removed []string) []int32 {
+	merged := make([]int32, 0, len(existing)+len(ids))
+	changed := false
+	for _, u := range existing {
+		if slices.Contains(removed, u.Username) {
+			changed = true
+			continue
+		}
+		merged = append(merged, u.ID)
+	}
+	for _, id := range ids {
+		if !slices.Contains(merged, id) {
+			merged = append(merged, id)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+	if len(merged) == 0 {
+		// Empty lists are omitted from the request, so 0 removes all users.
+		return []int32{0}
+	}
+	return merged
+}
+
+// enableAutoMerge sets the merge request to be merged when its pipeline
+// succeeds, if the changeset asks for it. Draft merge requests cannot be
+// merged, so auto-merge is enabled once they are undrafted.
+func (s *GitLabSource) enableAutoMerge(ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, c *Changeset) (*gitlab.MergeRequest, error) {
+	if !c.AutoMerge || mr.Draft || mr.WorkInProgress || mr.MergeWhenPipelineSucceeds {
+		return mr, nil
+	}
+
+	updated, err := s.client.EnableMergeRequestAutoMerge(ctx, project, mr)
+	if err != nil {
+		return nil, errors.Wrap(err, "enabling auto-merge on GitLab merge request")
+	}
+	return updated, nil
+}
+
 func (*GitLabSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "ERROR: You are not allowed to push code to this project")
 }
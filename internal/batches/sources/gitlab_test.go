@@ -963,6 +963,229 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 	})
 }
 
+func TestGitLabSource_UpdateChangeset_Metadata(t *testing.T) {
+	setupMetadata := func(t *testing.T, updateMetadata bool) (*gitLabChangesetSourceTestProvider, *gitlab.UpdateMergeRequestOpts, *bool) {
+		in := &gitlab.MergeRequest{IID: 2, Assignees: []gitlab.User{{ID: 10}}}
+		out := &gitlab.MergeRequest{IID: 2}
+
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = in
+		p.changeset.Reviewers = []string{"alice"}
+		p.changeset.Labels = []string{"batch-change", "automated"}
+		p.changeset.Assignees = []string{"bob"}
+		p.changeset.Milestone = "v2"
+		p.changeset.AutoMerge = true
+		p.changeset.UpdateMetadata = updateMetadata
+
+		p.mockListUsers(map[string]int32{"alice": 11, "bob": 12})
+		p.mockListProjectMilestones([]*gitlab.Milestone{{ID: 20, Title: "v2"}}, nil)
+
+		var opts gitlab.UpdateMergeRequestOpts
+		gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, o gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			opts = o
+			return out, nil
+		}
+		autoMerge := false
+		gitlab.MockEnableMergeRequestAutoMerge = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest) (*gitlab.MergeRequest, error) {
+			autoMerge = true
+			return mr, nil
+		}
+
+		p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+		return p, &opts, &autoMerge
+	}
+
+	t.Run("metadata unchanged", func(t *testing.T) {
+		p, opts, autoMerge := setupMetadata(t, false)
+		gitlab.MockListUsers = nil
+		gitlab.MockListProjectMilestones = nil
+
+		if err := p.source.UpdateChangeset(p.ctx, p.changeset); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, gitlab.UpdateMergeRequestOpts{
+			Title:        "title",
+			Description:  "description",
+			TargetBranch: "base",
+		}, *opts)
+		assert.False(t, *autoMerge)
+	})
+
+	t.Run("metadata changed", func(t *testing.T) {
+		p, opts, autoMerge := setupMetadata(t, true)
+
+		if err := p.source.UpdateChangeset(p.ctx, p.changeset); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, gitlab.UpdateMergeRequestOpts{
+			Title:        "title",
+			Description:  "description",
+			TargetBranch: "base",
+			AddLabels:    "batch-change,automated",
+			AssigneeIDs:  []int32{10, 12},
+			ReviewerIDs:  []int32{11},
+			MilestoneID:  pointers.Ptr[gitlab.ID](20),
+		}, *opts)
+		assert.True(t, *autoMerge)
+	})
+
+	t.Run("metadata removed", func(t *testing.T) {
+		p, opts, autoMerge := setupMetadata(t, true)
+		mr := p.changeset.Changeset.Metadata.(*gitlab.MergeRequest)
+		mr.Assignees = []gitlab.User{{ID: 10, Username: "carol"}}
+		mr.Reviewers = []gitlab.User{{ID: 13, Username: "dave"}, {ID: 14, Username: "erin"}}
+		mr.Milestone = &gitlab.Milestone{ID: 19, Title: "v1"}
+		p.changeset.Reviewers, p.changeset.Assignees, p.changeset.Milestone, p.changeset.AutoMerge = nil, nil, "", false
+		p.changeset.RemovedLabels = []string{"old"}
+		p.changeset.RemovedAssignees = []string{"carol"}
+		p.changeset.RemovedReviewers = []string{"dave"}
+		p.changeset.RemovedMilestone = "v1"
+		p.changeset.DisableAutoMerge = true
+		gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, o gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			*opts = o
+			return &gitlab.MergeRequest{IID: 2, MergeWhenPipelineSucceeds: true}, nil
+		}
+		cancelled := false
+		gitlab.MockCancelMergeRequestAutoMerge = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest) (*gitlab.MergeRequest, error) {
+			cancelled = true
+			return &gitlab.MergeRequest{IID: 2}, nil
+		}
+		t.Cleanup(func() { gitlab.MockCancelMergeRequestAutoMerge = nil })
+
+		if err := p.source.UpdateChangeset(p.ctx, p.changeset); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, gitlab.UpdateMergeRequestOpts{
+			Title:        "title",
+			Description:  "description",
+			TargetBranch: "base",
+			AddLabels:    "batch-change,automated",
+			RemoveLabels: "old",
+			AssigneeIDs:  []int32{0},
+			ReviewerIDs:  []int32{14},
+			MilestoneID:  pointers.Ptr[gitlab.ID](0),
+		}, *opts)
+		assert.False(t, *autoMerge)
+		assert.True(t, cancelled)
+	})
+}
+
+func TestGitLabSource_resolveMergeRequestMetadata(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Reviewers = []string{"alice"}
+		p.changeset.Assignees = []string{"bob", "alice"}
+		p.changeset.Milestone = "v2"
+		p.mockListUsers(map[string]int32{"alice": 11, "bob": 12})
+		p.mockListProjectMilestones([]*gitlab.Milestone{{ID: 19, Title: "v2.1"}, {ID: 20, Title: "v2"}}, nil)
+
+		meta, err := p.source.resolveMergeRequestMetadata(p.ctx, p.changeset.TargetRepo.Metadata.(*gitlab.Project), p.changeset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, mergeRequestMetadata{
+			assigneeIDs: []int32{12, 11},
+			reviewerIDs: []int32{11},
+			milestoneID: 20,
+		}, meta)
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+
+		meta, err := p.source.resolveMergeRequestMetadata(p.ctx, p.changeset.TargetRepo.Metadata.(*gitlab.Project), p.changeset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, mergeRequestMetadata{}, meta)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Reviewers = []string{"mallory"}
+		p.mockListUsers(map[string]int32{"alice": 11})
+
+		_, err := p.source.resolveMergeRequestMetadata(p.ctx, p.changeset.TargetRepo.Metadata.(*gitlab.Project), p.changeset)
+		assert.ErrorContains(t, err, `resolving reviewers: user "mallory" not found`)
+	})
+
+	t.Run("unknown milestone", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Milestone = "v3"
+		p.mockListProjectMilestones([]*gitlab.Milestone{{ID: 20, Title: "v3.0"}}, nil)
+
+		_, err := p.source.resolveMergeRequestMetadata(p.ctx, p.changeset.TargetRepo.Metadata.(*gitlab.Project), p.changeset)
+		assert.ErrorContains(t, err, `milestone "v3" not found`)
+	})
+}
+
+func TestMergeUserIDs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		existing []gitlab.User
+		ids      []int32
+		removed  []string
+		want     []int32
+	}{
+		{name: "no ids", existing: []gitlab.User{{ID: 1}}, ids: nil, want: nil},
+		{name: "no existing users", existing: nil, ids: []int32{1, 2}, want: []int32{1, 2}},
+		{name: "merged", existing: []gitlab.User{{ID: 1}, {ID: 2}}, ids: []int32{2, 3}, want: []int32{1, 2, 3}},
+		{name: "unchanged", existing: []gitlab.User{{ID: 1}, {ID: 2}}, ids: []int32{2}, want: nil},
+		{name: "removed", existing: []gitlab.User{{ID: 1, Username: "a"}, {ID: 2, Username: "b"}}, ids: []int32{3}, removed: []string{"a"}, want: []int32{2, 3}},
+		{name: "removed unknown", existing: []gitlab.User{{ID: 1, Username: "a"}}, removed: []string{"b"}, want: nil},
+		{name: "all removed", existing: []gitlab.User{{ID: 1, Username: "a"}}, removed: []string{"a"}, want: []int32{0}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, mergeUserIDs(tc.existing, tc.ids, tc.removed))
+		})
+	}
+}
+
+func TestGitLabSource_enableAutoMerge(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		autoMerge bool
+		mr        *gitlab.MergeRequest
+		wantCall  bool
+	}{
+		{name: "not requested", autoMerge: false, mr: &gitlab.MergeRequest{}},
+		{name: "draft", autoMerge: true, mr: &gitlab.MergeRequest{Draft: true}},
+		{name: "work in progress", autoMerge: true, mr: &gitlab.MergeRequest{WorkInProgress: true}},
+		{name: "already enabled", autoMerge: true, mr: &gitlab.MergeRequest{MergeWhenPipelineSucceeds: true}},
+		{name: "enabled", autoMerge: true, mr: &gitlab.MergeRequest{}, wantCall: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.AutoMerge = tc.autoMerge
+
+			enabled := &gitlab.MergeRequest{MergeWhenPipelineSucceeds: true}
+			called := false
+			gitlab.MockEnableMergeRequestAutoMerge = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest) (*gitlab.MergeRequest, error) {
+				p.testCommonParams(ctx, client, project)
+				called = true
+				return enabled, nil
+			}
+
+			have, err := p.source.enableAutoMerge(p.ctx, p.changeset.TargetRepo.Metadata.(*gitlab.Project), tc.mr, p.changeset)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.wantCall, called)
+			if tc.wantCall {
+				assert.Same(t, enabled, have)
+			} else {
+				assert.Same(t, tc.mr, have)
+			}
+		})
+	}
+}
+
 func TestReadNotesUntilSeen(t *testing.T) {
 	commonNotes := []*gitlab.Note{
 		{ID: 1, System: true},
@@ -1258,6 +1481,27 @@ func (p *gitLabChangesetSourceTestProvider) mockGetVersion(expected string) {
 	}
 }
 
+func (p *gitLabChangesetSourceTestProvider) mockListUsers(ids map[string]int32) {
+	gitlab.MockListUsers = func(client *gitlab.Client, ctx context.Context, urlStr string) ([]*gitlab.AuthUser, *string, error) {
+		u, err := url.Parse(urlStr)
+		if err != nil {
+			p.t.Fatal(err)
+		}
+		username := u.Query().Get("username")
+		if id, ok := ids[username]; ok {
+			return []*gitlab.AuthUser{{ID: id, Username: username}}, nil, nil
+		}
+		return nil, nil, nil
+	}
+}
+
+func (p *gitLabChangesetSourceTestProvider) mockListProjectMilestones(milestones []*gitlab.Milestone, err error) {
+	gitlab.MockListProjectMilestones = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, title string) ([]*gitlab.Milestone, error) {
+		p.testCommonParams(ctx, client, project)
+		return milestones, err
+	}
+}
+
 func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockCreateMergeRequest = nil
 	gitlab.MockGetMergeRequest = nil
@@ -1267,6 +1511,9 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockEnableMergeRequestAutoMerge = nil
+	gitlab.MockListProjectMilestones = nil
+	gitlab.MockListUsers = nil
 
 	versions.MockGetVersions = nil
 }
@@ -22,6 +22,7 @@ import (
 	azuredevops "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	bitbucketcloud "github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	gerrit "github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	gitea "github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	gitolite "github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	store1 "github.com/sourcegraph/sourcegraph/internal/github_apps/store"
 	gitserver "github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -11220,6 +11221,3265 @@ func (c GerritClientWriteReviewCommentFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockGiteaClient is a mock implementation of the Client interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/extsvc/gitea)
+// used for unit testing.
+type MockGiteaClient struct {
+	// AuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method Authenticator.
+	AuthenticatorFunc *GiteaClientAuthenticatorFunc
+	// CancelPullRequestAutoMergeFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CancelPullRequestAutoMerge.
+	CancelPullRequestAutoMergeFunc *GiteaClientCancelPullRequestAutoMergeFunc
+	// CreateIssueCommentFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateIssueComment.
+	CreateIssueCommentFunc *GiteaClientCreateIssueCommentFunc
+	// CreatePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method CreatePullRequest.
+	CreatePullRequestFunc *GiteaClientCreatePullRequestFunc
+	// EditIssueFunc is an instance of a mock function object controlling
+	// the behavior of the method EditIssue.
+	EditIssueFunc *GiteaClientEditIssueFunc
+	// EditPullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method EditPullRequest.
+	EditPullRequestFunc *GiteaClientEditPullRequestFunc
+	// ForkRepoFunc is an instance of a mock function object controlling the
+	// behavior of the method ForkRepo.
+	ForkRepoFunc *GiteaClientForkRepoFunc
+	// GetAuthenticatedUserFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAuthenticatedUser.
+	GetAuthenticatedUserFunc *GiteaClientGetAuthenticatedUserFunc
+	// GetCombinedStatusFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCombinedStatus.
+	GetCombinedStatusFunc *GiteaClientGetCombinedStatusFunc
+	// GetPullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequest.
+	GetPullRequestFunc *GiteaClientGetPullRequestFunc
+	// GetPullRequestByBranchesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequestByBranches.
+	GetPullRequestByBranchesFunc *GiteaClientGetPullRequestByBranchesFunc
+	// GetRepoFunc is an instance of a mock function object controlling the
+	// behavior of the method GetRepo.
+	GetRepoFunc *GiteaClientGetRepoFunc
+	// GetURLFunc is an instance of a mock function object controlling the
+	// behavior of the method GetURL.
+	GetURLFunc *GiteaClientGetURLFunc
+	// ListAffiliatedReposFunc is an instance of a mock function object
+	// controlling the behavior of the method ListAffiliatedRepos.
+	ListAffiliatedReposFunc *GiteaClientListAffiliatedReposFunc
+	// ListAuthenticatedUserEmailsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListAuthenticatedUserEmails.
+	ListAuthenticatedUserEmailsFunc *GiteaClientListAuthenticatedUserEmailsFunc
+	// ListOrgReposFunc is an instance of a mock function object controlling
+	// the behavior of the method ListOrgRepos.
+	ListOrgReposFunc *GiteaClientListOrgReposFunc
+	// ListPullRequestReviewsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPullRequestReviews.
+	ListPullRequestReviewsFunc *GiteaClientListPullRequestReviewsFunc
+	// ListRepoLabelsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListRepoLabels.
+	ListRepoLabelsFunc *GiteaClientListRepoLabelsFunc
+	// ListRepoMilestonesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListRepoMilestones.
+	ListRepoMilestonesFunc *GiteaClientListRepoMilestonesFunc
+	// MergePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method MergePullRequest.
+	MergePullRequestFunc *GiteaClientMergePullRequestFunc
+	// RemoveIssueLabelFunc is an instance of a mock function object
+	// controlling the behavior of the method RemoveIssueLabel.
+	RemoveIssueLabelFunc *GiteaClientRemoveIssueLabelFunc
+	// RemovePullRequestReviewersFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// RemovePullRequestReviewers.
+	RemovePullRequestReviewersFunc *GiteaClientRemovePullRequestReviewersFunc
+	// RequestPullRequestReviewersFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// RequestPullRequestReviewers.
+	RequestPullRequestReviewersFunc *GiteaClientRequestPullRequestReviewersFunc
+	// SearchReposFunc is an instance of a mock function object controlling
+	// the behavior of the method SearchRepos.
+	SearchReposFunc *GiteaClientSearchReposFunc
+	// WithAuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method WithAuthenticator.
+	WithAuthenticatorFunc *GiteaClientWithAuthenticatorFunc
+}
+
+// NewMockGiteaClient creates a new mock of the Client interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockGiteaClient() *MockGiteaClient {
+	return &MockGiteaClient{
+		AuthenticatorFunc: &GiteaClientAuthenticatorFunc{
+			defaultHook: func() (r0 auth.Authenticator) {
+				return
+			},
+		},
+		CancelPullRequestAutoMergeFunc: &GiteaClientCancelPullRequestAutoMergeFunc{
+			defaultHook: func(context.Context, string, string, int64) (r0 error) {
+				return
+			},
+		},
+		CreateIssueCommentFunc: &GiteaClientCreateIssueCommentFunc{
+			defaultHook: func(context.Context, string, string, int64, string) (r0 *gitea.Comment, r1 error) {
+				return
+			},
+		},
+		CreatePullRequestFunc: &GiteaClientCreatePullRequestFunc{
+			defaultHook: func(context.Context, string, string, gitea.CreatePullRequestInput) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		EditIssueFunc: &GiteaClientEditIssueFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.EditIssueInput) (r0 error) {
+				return
+			},
+		},
+		EditPullRequestFunc: &GiteaClientEditPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.EditPullRequestInput) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		ForkRepoFunc: &GiteaClientForkRepoFunc{
+			defaultHook: func(context.Context, string, string, gitea.ForkRepoInput) (r0 *gitea.Repo, r1 error) {
+				return
+			},
+		},
+		GetAuthenticatedUserFunc: &GiteaClientGetAuthenticatedUserFunc{
+			defaultHook: func(context.Context) (r0 *gitea.User, r1 error) {
+				return
+			},
+		},
+		GetCombinedStatusFunc: &GiteaClientGetCombinedStatusFunc{
+			defaultHook: func(context.Context, string, string, string) (r0 *gitea.CombinedStatus, r1 error) {
+				return
+			},
+		},
+		GetPullRequestFunc: &GiteaClientGetPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetPullRequestByBranchesFunc: &GiteaClientGetPullRequestByBranchesFunc{
+			defaultHook: func(context.Context, string, string, string, string) (r0 *gitea.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetRepoFunc: &GiteaClientGetRepoFunc{
+			defaultHook: func(context.Context, string, string) (r0 *gitea.Repo, r1 error) {
+				return
+			},
+		},
+		GetURLFunc: &GiteaClientGetURLFunc{
+			defaultHook: func() (r0 *url.URL) {
+				return
+			},
+		},
+		ListAffiliatedReposFunc: &GiteaClientListAffiliatedReposFunc{
+			defaultHook: func(context.Context, int) (r0 []*gitea.Repo, r1 bool, r2 error) {
+				return
+			},
+		},
+		ListAuthenticatedUserEmailsFunc: &GiteaClientListAuthenticatedUserEmailsFunc{
+			defaultHook: func(context.Context) (r0 []*gitea.Email, r1 error) {
+				return
+			},
+		},
+		ListOrgReposFunc: &GiteaClientListOrgReposFunc{
+			defaultHook: func(context.Context, string, int) (r0 []*gitea.Repo, r1 bool, r2 error) {
+				return
+			},
+		},
+		ListPullRequestReviewsFunc: &GiteaClientListPullRequestReviewsFunc{
+			defaultHook: func(context.Context, string, string, int64) (r0 []*gitea.PullReview, r1 error) {
+				return
+			},
+		},
+		ListRepoLabelsFunc: &GiteaClientListRepoLabelsFunc{
+			defaultHook: func(context.Context, string, string) (r0 []*gitea.Label, r1 error) {
+				return
+			},
+		},
+		ListRepoMilestonesFunc: &GiteaClientListRepoMilestonesFunc{
+			defaultHook: func(context.Context, string, string, string) (r0 []*gitea.Milestone, r1 error) {
+				return
+			},
+		},
+		MergePullRequestFunc: &GiteaClientMergePullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.MergePullRequestInput) (r0 error) {
+				return
+			},
+		},
+		RemoveIssueLabelFunc: &GiteaClientRemoveIssueLabelFunc{
+			defaultHook: func(context.Context, string, string, int64, int64) (r0 error) {
+				return
+			},
+		},
+		RemovePullRequestReviewersFunc: &GiteaClientRemovePullRequestReviewersFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.RequestReviewersInput) (r0 error) {
+				return
+			},
+		},
+		RequestPullRequestReviewersFunc: &GiteaClientRequestPullRequestReviewersFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.RequestReviewersInput) (r0 error) {
+				return
+			},
+		},
+		SearchReposFunc: &GiteaClientSearchReposFunc{
+			defaultHook: func(context.Context, gitea.SearchReposOptions, int) (r0 []*gitea.Repo, r1 bool, r2 error) {
+				return
+			},
+		},
+		WithAuthenticatorFunc: &GiteaClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) (r0 gitea.Client, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGiteaClient creates a new mock of the Client interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockGiteaClient() *MockGiteaClient {
+	return &MockGiteaClient{
+		AuthenticatorFunc: &GiteaClientAuthenticatorFunc{
+			defaultHook: func() auth.Authenticator {
+				panic("unexpected invocation of MockGiteaClient.Authenticator")
+			},
+		},
+		CancelPullRequestAutoMergeFunc: &GiteaClientCancelPullRequestAutoMergeFunc{
+			defaultHook: func(context.Context, string, string, int64) error {
+				panic("unexpected invocation of MockGiteaClient.CancelPullRequestAutoMerge")
+			},
+		},
+		CreateIssueCommentFunc: &GiteaClientCreateIssueCommentFunc{
+			defaultHook: func(context.Context, string, string, int64, string) (*gitea.Comment, error) {
+				panic("unexpected invocation of MockGiteaClient.CreateIssueComment")
+			},
+		},
+		CreatePullRequestFunc: &GiteaClientCreatePullRequestFunc{
+			defaultHook: func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.CreatePullRequest")
+			},
+		},
+		EditIssueFunc: &GiteaClientEditIssueFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.EditIssueInput) error {
+				panic("unexpected invocation of MockGiteaClient.EditIssue")
+			},
+		},
+		EditPullRequestFunc: &GiteaClientEditPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.EditPullRequest")
+			},
+		},
+		ForkRepoFunc: &GiteaClientForkRepoFunc{
+			defaultHook: func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error) {
+				panic("unexpected invocation of MockGiteaClient.ForkRepo")
+			},
+		},
+		GetAuthenticatedUserFunc: &GiteaClientGetAuthenticatedUserFunc{
+			defaultHook: func(context.Context) (*gitea.User, error) {
+				panic("unexpected invocation of MockGiteaClient.GetAuthenticatedUser")
+			},
+		},
+		GetCombinedStatusFunc: &GiteaClientGetCombinedStatusFunc{
+			defaultHook: func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+				panic("unexpected invocation of MockGiteaClient.GetCombinedStatus")
+			},
+		},
+		GetPullRequestFunc: &GiteaClientGetPullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.GetPullRequest")
+			},
+		},
+		GetPullRequestByBranchesFunc: &GiteaClientGetPullRequestByBranchesFunc{
+			defaultHook: func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+				panic("unexpected invocation of MockGiteaClient.GetPullRequestByBranches")
+			},
+		},
+		GetRepoFunc: &GiteaClientGetRepoFunc{
+			defaultHook: func(context.Context, string, string) (*gitea.Repo, error) {
+				panic("unexpected invocation of MockGiteaClient.GetRepo")
+			},
+		},
+		GetURLFunc: &GiteaClientGetURLFunc{
+			defaultHook: func() *url.URL {
+				panic("unexpected invocation of MockGiteaClient.GetURL")
+			},
+		},
+		ListAffiliatedReposFunc: &GiteaClientListAffiliatedReposFunc{
+			defaultHook: func(context.Context, int) ([]*gitea.Repo, bool, error) {
+				panic("unexpected invocation of MockGiteaClient.ListAffiliatedRepos")
+			},
+		},
+		ListAuthenticatedUserEmailsFunc: &GiteaClientListAuthenticatedUserEmailsFunc{
+			defaultHook: func(context.Context) ([]*gitea.Email, error) {
+				panic("unexpected invocation of MockGiteaClient.ListAuthenticatedUserEmails")
+			},
+		},
+		ListOrgReposFunc: &GiteaClientListOrgReposFunc{
+			defaultHook: func(context.Context, string, int) ([]*gitea.Repo, bool, error) {
+				panic("unexpected invocation of MockGiteaClient.ListOrgRepos")
+			},
+		},
+		ListPullRequestReviewsFunc: &GiteaClientListPullRequestReviewsFunc{
+			defaultHook: func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+				panic("unexpected invocation of MockGiteaClient.ListPullRequestReviews")
+			},
+		},
+		ListRepoLabelsFunc: &GiteaClientListRepoLabelsFunc{
+			defaultHook: func(context.Context, string, string) ([]*gitea.Label, error) {
+				panic("unexpected invocation of MockGiteaClient.ListRepoLabels")
+			},
+		},
+		ListRepoMilestonesFunc: &GiteaClientListRepoMilestonesFunc{
+			defaultHook: func(context.Context, string, string, string) ([]*gitea.Milestone, error) {
+				panic("unexpected invocation of MockGiteaClient.ListRepoMilestones")
+			},
+		},
+		MergePullRequestFunc: &GiteaClientMergePullRequestFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+				panic("unexpected invocation of MockGiteaClient.MergePullRequest")
+			},
+		},
+		RemoveIssueLabelFunc: &GiteaClientRemoveIssueLabelFunc{
+			defaultHook: func(context.Context, string, string, int64, int64) error {
+				panic("unexpected invocation of MockGiteaClient.RemoveIssueLabel")
+			},
+		},
+		RemovePullRequestReviewersFunc: &GiteaClientRemovePullRequestReviewersFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+				panic("unexpected invocation of MockGiteaClient.RemovePullRequestReviewers")
+			},
+		},
+		RequestPullRequestReviewersFunc: &GiteaClientRequestPullRequestReviewersFunc{
+			defaultHook: func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+				panic("unexpected invocation of MockGiteaClient.RequestPullRequestReviewers")
+			},
+		},
+		SearchReposFunc: &GiteaClientSearchReposFunc{
+			defaultHook: func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error) {
+				panic("unexpected invocation of MockGiteaClient.SearchRepos")
+			},
+		},
+		WithAuthenticatorFunc: &GiteaClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) (gitea.Client, error) {
+				panic("unexpected invocation of MockGiteaClient.WithAuthenticator")
+			},
+		},
+	}
+}
+
+// NewMockGiteaClientFrom creates a new mock of the MockGiteaClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockGiteaClientFrom(i gitea.Client) *MockGiteaClient {
+	return &MockGiteaClient{
+		AuthenticatorFunc: &GiteaClientAuthenticatorFunc{
+			defaultHook: i.Authenticator,
+		},
+		CancelPullRequestAutoMergeFunc: &GiteaClientCancelPullRequestAutoMergeFunc{
+			defaultHook: i.CancelPullRequestAutoMerge,
+		},
+		CreateIssueCommentFunc: &GiteaClientCreateIssueCommentFunc{
+			defaultHook: i.CreateIssueComment,
+		},
+		CreatePullRequestFunc: &GiteaClientCreatePullRequestFunc{
+			defaultHook: i.CreatePullRequest,
+		},
+		EditIssueFunc: &GiteaClientEditIssueFunc{
+			defaultHook: i.EditIssue,
+		},
+		EditPullRequestFunc: &GiteaClientEditPullRequestFunc{
+			defaultHook: i.EditPullRequest,
+		},
+		ForkRepoFunc: &GiteaClientForkRepoFunc{
+			defaultHook: i.ForkRepo,
+		},
+		GetAuthenticatedUserFunc: &GiteaClientGetAuthenticatedUserFunc{
+			defaultHook: i.GetAuthenticatedUser,
+		},
+		GetCombinedStatusFunc: &GiteaClientGetCombinedStatusFunc{
+			defaultHook: i.GetCombinedStatus,
+		},
+		GetPullRequestFunc: &GiteaClientGetPullRequestFunc{
+			defaultHook: i.GetPullRequest,
+		},
+		GetPullRequestByBranchesFunc: &GiteaClientGetPullRequestByBranchesFunc{
+			defaultHook: i.GetPullRequestByBranches,
+		},
+		GetRepoFunc: &GiteaClientGetRepoFunc{
+			defaultHook: i.GetRepo,
+		},
+		GetURLFunc: &GiteaClientGetURLFunc{
+			defaultHook: i.GetURL,
+		},
+		ListAffiliatedReposFunc: &GiteaClientListAffiliatedReposFunc{
+			defaultHook: i.ListAffiliatedRepos,
+		},
+		ListAuthenticatedUserEmailsFunc: &GiteaClientListAuthenticatedUserEmailsFunc{
+			defaultHook: i.ListAuthenticatedUserEmails,
+		},
+		ListOrgReposFunc: &GiteaClientListOrgReposFunc{
+			defaultHook: i.ListOrgRepos,
+		},
+		ListPullRequestReviewsFunc: &GiteaClientListPullRequestReviewsFunc{
+			defaultHook: i.ListPullRequestReviews,
+		},
+		ListRepoLabelsFunc: &GiteaClientListRepoLabelsFunc{
+			defaultHook: i.ListRepoLabels,
+		},
+		ListRepoMilestonesFunc: &GiteaClientListRepoMilestonesFunc{
+			defaultHook: i.ListRepoMilestones,
+		},
+		MergePullRequestFunc: &GiteaClientMergePullRequestFunc{
+			defaultHook: i.MergePullRequest,
+		},
+		RemoveIssueLabelFunc: &GiteaClientRemoveIssueLabelFunc{
+			defaultHook: i.RemoveIssueLabel,
+		},
+		RemovePullRequestReviewersFunc: &GiteaClientRemovePullRequestReviewersFunc{
+			defaultHook: i.RemovePullRequestReviewers,
+		},
+		RequestPullRequestReviewersFunc: &GiteaClientRequestPullRequestReviewersFunc{
+			defaultHook: i.RequestPullRequestReviewers,
+		},
+		SearchReposFunc: &GiteaClientSearchReposFunc{
+			defaultHook: i.SearchRepos,
+		},
+		WithAuthenticatorFunc: &GiteaClientWithAuthenticatorFunc{
+			defaultHook: i.WithAuthenticator,
+		},
+	}
+}
+
+// GiteaClientAuthenticatorFunc describes the behavior when the
+// Authenticator method of the parent MockGiteaClient instance is invoked.
+type GiteaClientAuthenticatorFunc struct {
+	defaultHook func() auth.Authenticator
+	hooks       []func() auth.Authenticator
+	history     []GiteaClientAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// Authenticator delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGiteaClient) Authenticator() auth.Authenticator {
+	r0 := m.AuthenticatorFunc.nextHook()()
+	m.AuthenticatorFunc.appendCall(GiteaClientAuthenticatorFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authenticator method
+// of the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientAuthenticatorFunc) SetDefaultHook(hook func() auth.Authenticator) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authenticator method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientAuthenticatorFunc) PushHook(hook func() auth.Authenticator) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientAuthenticatorFunc) SetDefaultReturn(r0 auth.Authenticator) {
+	f.SetDefaultHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientAuthenticatorFunc) PushReturn(r0 auth.Authenticator) {
+	f.PushHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+func (f *GiteaClientAuthenticatorFunc) nextHook() func() auth.Authenticator {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientAuthenticatorFunc) appendCall(r0 GiteaClientAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientAuthenticatorFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientAuthenticatorFunc) History() []GiteaClientAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientAuthenticatorFuncCall is an object that describes an
+// invocation of method Authenticator on an instance of MockGiteaClient.
+type GiteaClientAuthenticatorFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 auth.Authenticator
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientCancelPullRequestAutoMergeFunc describes the behavior when the
+// CancelPullRequestAutoMerge method of the parent MockGiteaClient instance
+// is invoked.
+type GiteaClientCancelPullRequestAutoMergeFunc struct {
+	defaultHook func(context.Context, string, string, int64) error
+	hooks       []func(context.Context, string, string, int64) error
+	history     []GiteaClientCancelPullRequestAutoMergeFuncCall
+	mutex       sync.Mutex
+}
+
+// CancelPullRequestAutoMerge delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) CancelPullRequestAutoMerge(v0 context.Context, v1 string, v2 string, v3 int64) error {
+	r0 := m.CancelPullRequestAutoMergeFunc.nextHook()(v0, v1, v2, v3)
+	m.CancelPullRequestAutoMergeFunc.appendCall(GiteaClientCancelPullRequestAutoMergeFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CancelPullRequestAutoMerge method of the parent MockGiteaClient instance
+// is invoked and the hook queue is empty.
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) SetDefaultHook(hook func(context.Context, string, string, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CancelPullRequestAutoMerge method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) PushHook(hook func(context.Context, string, string, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) nextHook() func(context.Context, string, string, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) appendCall(r0 GiteaClientCancelPullRequestAutoMergeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GiteaClientCancelPullRequestAutoMergeFuncCall objects describing the
+// invocations of this function.
+func (f *GiteaClientCancelPullRequestAutoMergeFunc) History() []GiteaClientCancelPullRequestAutoMergeFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientCancelPullRequestAutoMergeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientCancelPullRequestAutoMergeFuncCall is an object that describes
+// an invocation of method CancelPullRequestAutoMerge on an instance of
+// MockGiteaClient.
+type GiteaClientCancelPullRequestAutoMergeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientCancelPullRequestAutoMergeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientCancelPullRequestAutoMergeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientCreateIssueCommentFunc describes the behavior when the
+// CreateIssueComment method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientCreateIssueCommentFunc struct {
+	defaultHook func(context.Context, string, string, int64, string) (*gitea.Comment, error)
+	hooks       []func(context.Context, string, string, int64, string) (*gitea.Comment, error)
+	history     []GiteaClientCreateIssueCommentFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateIssueComment delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) CreateIssueComment(v0 context.Context, v1 string, v2 string, v3 int64, v4 string) (*gitea.Comment, error) {
+	r0, r1 := m.CreateIssueCommentFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateIssueCommentFunc.appendCall(GiteaClientCreateIssueCommentFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateIssueComment
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientCreateIssueCommentFunc) SetDefaultHook(hook func(context.Context, string, string, int64, string) (*gitea.Comment, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateIssueComment method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientCreateIssueCommentFunc) PushHook(hook func(context.Context, string, string, int64, string) (*gitea.Comment, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientCreateIssueCommentFunc) SetDefaultReturn(r0 *gitea.Comment, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, string) (*gitea.Comment, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientCreateIssueCommentFunc) PushReturn(r0 *gitea.Comment, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64, string) (*gitea.Comment, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientCreateIssueCommentFunc) nextHook() func(context.Context, string, string, int64, string) (*gitea.Comment, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientCreateIssueCommentFunc) appendCall(r0 GiteaClientCreateIssueCommentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientCreateIssueCommentFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientCreateIssueCommentFunc) History() []GiteaClientCreateIssueCommentFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientCreateIssueCommentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientCreateIssueCommentFuncCall is an object that describes an
+// invocation of method CreateIssueComment on an instance of
+// MockGiteaClient.
+type GiteaClientCreateIssueCommentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.Comment
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientCreateIssueCommentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientCreateIssueCommentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientCreatePullRequestFunc describes the behavior when the
+// CreatePullRequest method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientCreatePullRequestFunc struct {
+	defaultHook func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)
+	history     []GiteaClientCreatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) CreatePullRequest(v0 context.Context, v1 string, v2 string, v3 gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+	r0, r1 := m.CreatePullRequestFunc.nextHook()(v0, v1, v2, v3)
+	m.CreatePullRequestFunc.appendCall(GiteaClientCreatePullRequestFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreatePullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientCreatePullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePullRequest method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientCreatePullRequestFunc) PushHook(hook func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientCreatePullRequestFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientCreatePullRequestFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientCreatePullRequestFunc) nextHook() func(context.Context, string, string, gitea.CreatePullRequestInput) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientCreatePullRequestFunc) appendCall(r0 GiteaClientCreatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientCreatePullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientCreatePullRequestFunc) History() []GiteaClientCreatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientCreatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientCreatePullRequestFuncCall is an object that describes an
+// invocation of method CreatePullRequest on an instance of MockGiteaClient.
+type GiteaClientCreatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 gitea.CreatePullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientCreatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientCreatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientEditIssueFunc describes the behavior when the EditIssue method
+// of the parent MockGiteaClient instance is invoked.
+type GiteaClientEditIssueFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.EditIssueInput) error
+	hooks       []func(context.Context, string, string, int64, gitea.EditIssueInput) error
+	history     []GiteaClientEditIssueFuncCall
+	mutex       sync.Mutex
+}
+
+// EditIssue delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) EditIssue(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.EditIssueInput) error {
+	r0 := m.EditIssueFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.EditIssueFunc.appendCall(GiteaClientEditIssueFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the EditIssue method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientEditIssueFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.EditIssueInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EditIssue method of the parent MockGiteaClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientEditIssueFunc) PushHook(hook func(context.Context, string, string, int64, gitea.EditIssueInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientEditIssueFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.EditIssueInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientEditIssueFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.EditIssueInput) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientEditIssueFunc) nextHook() func(context.Context, string, string, int64, gitea.EditIssueInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientEditIssueFunc) appendCall(r0 GiteaClientEditIssueFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientEditIssueFuncCall objects
+// describing the
+// invocations of this function.
+func (f *GiteaClientEditIssueFunc) History() []GiteaClientEditIssueFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientEditIssueFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientEditIssueFuncCall is an object that describes an invocation of
+// method EditIssue on an instance of MockGiteaClient.
+type GiteaClientEditIssueFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.EditIssueInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientEditIssueFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientEditIssueFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientEditPullRequestFunc describes the behavior when the
+// EditPullRequest method of the parent MockGiteaClient instance is invoked.
+type GiteaClientEditPullRequestFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)
+	history     []GiteaClientEditPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// EditPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) EditPullRequest(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+	r0, r1 := m.EditPullRequestFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.EditPullRequestFunc.appendCall(GiteaClientEditPullRequestFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the EditPullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientEditPullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EditPullRequest method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientEditPullRequestFunc) PushHook(hook func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientEditPullRequestFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientEditPullRequestFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientEditPullRequestFunc) nextHook() func(context.Context, string, string, int64, gitea.EditPullRequestInput) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientEditPullRequestFunc) appendCall(r0 GiteaClientEditPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientEditPullRequestFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientEditPullRequestFunc) History() []GiteaClientEditPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientEditPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientEditPullRequestFuncCall is an object that describes an
+// invocation of method EditPullRequest on an instance of MockGiteaClient.
+type GiteaClientEditPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.EditPullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientEditPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientEditPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientForkRepoFunc describes the behavior when the ForkRepo method
+// of the parent MockGiteaClient instance is invoked.
+type GiteaClientForkRepoFunc struct {
+	defaultHook func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error)
+	hooks       []func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error)
+	history     []GiteaClientForkRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// ForkRepo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) ForkRepo(v0 context.Context, v1 string, v2 string, v3 gitea.ForkRepoInput) (*gitea.Repo, error) {
+	r0, r1 := m.ForkRepoFunc.nextHook()(v0, v1, v2, v3)
+	m.ForkRepoFunc.appendCall(GiteaClientForkRepoFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ForkRepo method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientForkRepoFunc) SetDefaultHook(hook func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ForkRepo method of the parent MockGiteaClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientForkRepoFunc) PushHook(hook func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientForkRepoFunc) SetDefaultReturn(r0 *gitea.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientForkRepoFunc) PushReturn(r0 *gitea.Repo, r1 error) {
+	f.PushHook(func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientForkRepoFunc) nextHook() func(context.Context, string, string, gitea.ForkRepoInput) (*gitea.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientForkRepoFunc) appendCall(r0 GiteaClientForkRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientForkRepoFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientForkRepoFunc) History() []GiteaClientForkRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientForkRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientForkRepoFuncCall is an object that describes an invocation of
+// method ForkRepo on an instance of MockGiteaClient.
+type GiteaClientForkRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 gitea.ForkRepoInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientForkRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientForkRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetAuthenticatedUserFunc describes the behavior when the
+// GetAuthenticatedUser method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientGetAuthenticatedUserFunc struct {
+	defaultHook func(context.Context) (*gitea.User, error)
+	hooks       []func(context.Context) (*gitea.User, error)
+	history     []GiteaClientGetAuthenticatedUserFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAuthenticatedUser delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetAuthenticatedUser(v0 context.Context) (*gitea.User, error) {
+	r0, r1 := m.GetAuthenticatedUserFunc.nextHook()(v0)
+	m.GetAuthenticatedUserFunc.appendCall(GiteaClientGetAuthenticatedUserFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAuthenticatedUser
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientGetAuthenticatedUserFunc) SetDefaultHook(hook func(context.Context) (*gitea.User, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAuthenticatedUser method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientGetAuthenticatedUserFunc) PushHook(hook func(context.Context) (*gitea.User, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetAuthenticatedUserFunc) SetDefaultReturn(r0 *gitea.User, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*gitea.User, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetAuthenticatedUserFunc) PushReturn(r0 *gitea.User, r1 error) {
+	f.PushHook(func(context.Context) (*gitea.User, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetAuthenticatedUserFunc) nextHook() func(context.Context) (*gitea.User, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetAuthenticatedUserFunc) appendCall(r0 GiteaClientGetAuthenticatedUserFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetAuthenticatedUserFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientGetAuthenticatedUserFunc) History() []GiteaClientGetAuthenticatedUserFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetAuthenticatedUserFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetAuthenticatedUserFuncCall is an object that describes an
+// invocation of method GetAuthenticatedUser on an instance of
+// MockGiteaClient.
+type GiteaClientGetAuthenticatedUserFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.User
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetAuthenticatedUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetAuthenticatedUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetCombinedStatusFunc describes the behavior when the
+// GetCombinedStatus method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientGetCombinedStatusFunc struct {
+	defaultHook func(context.Context, string, string, string) (*gitea.CombinedStatus, error)
+	hooks       []func(context.Context, string, string, string) (*gitea.CombinedStatus, error)
+	history     []GiteaClientGetCombinedStatusFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCombinedStatus delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetCombinedStatus(v0 context.Context, v1 string, v2 string, v3 string) (*gitea.CombinedStatus, error) {
+	r0, r1 := m.GetCombinedStatusFunc.nextHook()(v0, v1, v2, v3)
+	m.GetCombinedStatusFunc.appendCall(GiteaClientGetCombinedStatusFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCombinedStatus
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientGetCombinedStatusFunc) SetDefaultHook(hook func(context.Context, string, string, string) (*gitea.CombinedStatus, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCombinedStatus method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientGetCombinedStatusFunc) PushHook(hook func(context.Context, string, string, string) (*gitea.CombinedStatus, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetCombinedStatusFunc) SetDefaultReturn(r0 *gitea.CombinedStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetCombinedStatusFunc) PushReturn(r0 *gitea.CombinedStatus, r1 error) {
+	f.PushHook(func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetCombinedStatusFunc) nextHook() func(context.Context, string, string, string) (*gitea.CombinedStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetCombinedStatusFunc) appendCall(r0 GiteaClientGetCombinedStatusFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetCombinedStatusFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientGetCombinedStatusFunc) History() []GiteaClientGetCombinedStatusFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetCombinedStatusFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetCombinedStatusFuncCall is an object that describes an
+// invocation of method GetCombinedStatus on an instance of MockGiteaClient.
+type GiteaClientGetCombinedStatusFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.CombinedStatus
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetCombinedStatusFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetCombinedStatusFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetPullRequestFunc describes the behavior when the
+// GetPullRequest method of the parent MockGiteaClient instance is invoked.
+type GiteaClientGetPullRequestFunc struct {
+	defaultHook func(context.Context, string, string, int64) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, int64) (*gitea.PullRequest, error)
+	history     []GiteaClientGetPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetPullRequest(v0 context.Context, v1 string, v2 string, v3 int64) (*gitea.PullRequest, error) {
+	r0, r1 := m.GetPullRequestFunc.nextHook()(v0, v1, v2, v3)
+	m.GetPullRequestFunc.appendCall(GiteaClientGetPullRequestFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientGetPullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, int64) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequest method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientGetPullRequestFunc) PushHook(hook func(context.Context, string, string, int64) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetPullRequestFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetPullRequestFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetPullRequestFunc) nextHook() func(context.Context, string, string, int64) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetPullRequestFunc) appendCall(r0 GiteaClientGetPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetPullRequestFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetPullRequestFunc) History() []GiteaClientGetPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetPullRequestFuncCall is an object that describes an
+// invocation of method GetPullRequest on an instance of MockGiteaClient.
+type GiteaClientGetPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetPullRequestByBranchesFunc describes the behavior when the
+// GetPullRequestByBranches method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientGetPullRequestByBranchesFunc struct {
+	defaultHook func(context.Context, string, string, string, string) (*gitea.PullRequest, error)
+	hooks       []func(context.Context, string, string, string, string) (*gitea.PullRequest, error)
+	history     []GiteaClientGetPullRequestByBranchesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestByBranches delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) GetPullRequestByBranches(v0 context.Context, v1 string, v2 string, v3 string, v4 string) (*gitea.PullRequest, error) {
+	r0, r1 := m.GetPullRequestByBranchesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetPullRequestByBranchesFunc.appendCall(GiteaClientGetPullRequestByBranchesFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetPullRequestByBranches method of the parent MockGiteaClient instance is
+// invoked and the hook queue is empty.
+func (f *GiteaClientGetPullRequestByBranchesFunc) SetDefaultHook(hook func(context.Context, string, string, string, string) (*gitea.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestByBranches method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientGetPullRequestByBranchesFunc) PushHook(hook func(context.Context, string, string, string, string) (*gitea.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetPullRequestByBranchesFunc) SetDefaultReturn(r0 *gitea.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetPullRequestByBranchesFunc) PushReturn(r0 *gitea.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetPullRequestByBranchesFunc) nextHook() func(context.Context, string, string, string, string) (*gitea.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetPullRequestByBranchesFunc) appendCall(r0 GiteaClientGetPullRequestByBranchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetPullRequestByBranchesFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientGetPullRequestByBranchesFunc) History() []GiteaClientGetPullRequestByBranchesFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetPullRequestByBranchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetPullRequestByBranchesFuncCall is an object that describes
+// an invocation of method GetPullRequestByBranches on an instance of
+// MockGiteaClient.
+type GiteaClientGetPullRequestByBranchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetPullRequestByBranchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetPullRequestByBranchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetRepoFunc describes the behavior when the GetRepo method of
+// the parent MockGiteaClient instance is invoked.
+type GiteaClientGetRepoFunc struct {
+	defaultHook func(context.Context, string, string) (*gitea.Repo, error)
+	hooks       []func(context.Context, string, string) (*gitea.Repo, error)
+	history     []GiteaClientGetRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) GetRepo(v0 context.Context, v1 string, v2 string) (*gitea.Repo, error) {
+	r0, r1 := m.GetRepoFunc.nextHook()(v0, v1, v2)
+	m.GetRepoFunc.appendCall(GiteaClientGetRepoFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepo method of
+// the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientGetRepoFunc) SetDefaultHook(hook func(context.Context, string, string) (*gitea.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepo method of the parent MockGiteaClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientGetRepoFunc) PushHook(hook func(context.Context, string, string) (*gitea.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetRepoFunc) SetDefaultReturn(r0 *gitea.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (*gitea.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetRepoFunc) PushReturn(r0 *gitea.Repo, r1 error) {
+	f.PushHook(func(context.Context, string, string) (*gitea.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientGetRepoFunc) nextHook() func(context.Context, string, string) (*gitea.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetRepoFunc) appendCall(r0 GiteaClientGetRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetRepoFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetRepoFunc) History() []GiteaClientGetRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetRepoFuncCall is an object that describes an invocation of
+// method GetRepo on an instance of MockGiteaClient.
+type GiteaClientGetRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitea.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientGetURLFunc describes the behavior when the GetURL method of
+// the parent MockGiteaClient instance is invoked.
+type GiteaClientGetURLFunc struct {
+	defaultHook func() *url.URL
+	hooks       []func() *url.URL
+	history     []GiteaClientGetURLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetURL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGiteaClient) GetURL() *url.URL {
+	r0 := m.GetURLFunc.nextHook()()
+	m.GetURLFunc.appendCall(GiteaClientGetURLFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the GetURL method of the
+// parent MockGiteaClient instance is invoked and the hook queue is empty.
+func (f *GiteaClientGetURLFunc) SetDefaultHook(hook func() *url.URL) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetURL method of the parent MockGiteaClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GiteaClientGetURLFunc) PushHook(hook func() *url.URL) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientGetURLFunc) SetDefaultReturn(r0 *url.URL) {
+	f.SetDefaultHook(func() *url.URL {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientGetURLFunc) PushReturn(r0 *url.URL) {
+	f.PushHook(func() *url.URL {
+		return r0
+	})
+}
+
+func (f *GiteaClientGetURLFunc) nextHook() func() *url.URL {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientGetURLFunc) appendCall(r0 GiteaClientGetURLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientGetURLFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientGetURLFunc) History() []GiteaClientGetURLFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientGetURLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientGetURLFuncCall is an object that describes an invocation of
+// method GetURL on an instance of MockGiteaClient.
+type GiteaClientGetURLFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *url.URL
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientGetURLFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientGetURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientListAffiliatedReposFunc describes the behavior when the
+// ListAffiliatedRepos method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientListAffiliatedReposFunc struct {
+	defaultHook func(context.Context, int) ([]*gitea.Repo, bool, error)
+	hooks       []func(context.Context, int) ([]*gitea.Repo, bool, error)
+	history     []GiteaClientListAffiliatedReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAffiliatedRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListAffiliatedRepos(v0 context.Context, v1 int) ([]*gitea.Repo, bool, error) {
+	r0, r1, r2 := m.ListAffiliatedReposFunc.nextHook()(v0, v1)
+	m.ListAffiliatedReposFunc.appendCall(GiteaClientListAffiliatedReposFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListAffiliatedRepos
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientListAffiliatedReposFunc) SetDefaultHook(hook func(context.Context, int) ([]*gitea.Repo, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAffiliatedRepos method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientListAffiliatedReposFunc) PushHook(hook func(context.Context, int) ([]*gitea.Repo, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListAffiliatedReposFunc) SetDefaultReturn(r0 []*gitea.Repo, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]*gitea.Repo, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListAffiliatedReposFunc) PushReturn(r0 []*gitea.Repo, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) ([]*gitea.Repo, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GiteaClientListAffiliatedReposFunc) nextHook() func(context.Context, int) ([]*gitea.Repo, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListAffiliatedReposFunc) appendCall(r0 GiteaClientListAffiliatedReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListAffiliatedReposFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientListAffiliatedReposFunc) History() []GiteaClientListAffiliatedReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListAffiliatedReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListAffiliatedReposFuncCall is an object that describes an
+// invocation of method ListAffiliatedRepos on an instance of
+// MockGiteaClient.
+type GiteaClientListAffiliatedReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListAffiliatedReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListAffiliatedReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GiteaClientListAuthenticatedUserEmailsFunc describes the behavior when
+// the ListAuthenticatedUserEmails method of the parent MockGiteaClient
+// instance is invoked.
+type GiteaClientListAuthenticatedUserEmailsFunc struct {
+	defaultHook func(context.Context) ([]*gitea.Email, error)
+	hooks       []func(context.Context) ([]*gitea.Email, error)
+	history     []GiteaClientListAuthenticatedUserEmailsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAuthenticatedUserEmails delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListAuthenticatedUserEmails(v0 context.Context) ([]*gitea.Email, error) {
+	r0, r1 := m.ListAuthenticatedUserEmailsFunc.nextHook()(v0)
+	m.ListAuthenticatedUserEmailsFunc.appendCall(GiteaClientListAuthenticatedUserEmailsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListAuthenticatedUserEmails method of the parent MockGiteaClient instance
+// is invoked and the hook queue is empty.
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) SetDefaultHook(hook func(context.Context) ([]*gitea.Email, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAuthenticatedUserEmails method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) PushHook(hook func(context.Context) ([]*gitea.Email, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) SetDefaultReturn(r0 []*gitea.Email, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]*gitea.Email, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) PushReturn(r0 []*gitea.Email, r1 error) {
+	f.PushHook(func(context.Context) ([]*gitea.Email, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) nextHook() func(context.Context) ([]*gitea.Email, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) appendCall(r0 GiteaClientListAuthenticatedUserEmailsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GiteaClientListAuthenticatedUserEmailsFuncCall objects describing the
+// invocations of this function.
+func (f *GiteaClientListAuthenticatedUserEmailsFunc) History() []GiteaClientListAuthenticatedUserEmailsFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListAuthenticatedUserEmailsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListAuthenticatedUserEmailsFuncCall is an object that
+// describes an invocation of method ListAuthenticatedUserEmails on an
+// instance of MockGiteaClient.
+type GiteaClientListAuthenticatedUserEmailsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Email
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListAuthenticatedUserEmailsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListAuthenticatedUserEmailsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientListOrgReposFunc describes the behavior when the ListOrgRepos
+// method of the parent MockGiteaClient instance is invoked.
+type GiteaClientListOrgReposFunc struct {
+	defaultHook func(context.Context, string, int) ([]*gitea.Repo, bool, error)
+	hooks       []func(context.Context, string, int) ([]*gitea.Repo, bool, error)
+	history     []GiteaClientListOrgReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListOrgRepos delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListOrgRepos(v0 context.Context, v1 string, v2 int) ([]*gitea.Repo, bool, error) {
+	r0, r1, r2 := m.ListOrgReposFunc.nextHook()(v0, v1, v2)
+	m.ListOrgReposFunc.appendCall(GiteaClientListOrgReposFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListOrgRepos method
+// of the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientListOrgReposFunc) SetDefaultHook(hook func(context.Context, string, int) ([]*gitea.Repo, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListOrgRepos method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientListOrgReposFunc) PushHook(hook func(context.Context, string, int) ([]*gitea.Repo, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListOrgReposFunc) SetDefaultReturn(r0 []*gitea.Repo, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]*gitea.Repo, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListOrgReposFunc) PushReturn(r0 []*gitea.Repo, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, string, int) ([]*gitea.Repo, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GiteaClientListOrgReposFunc) nextHook() func(context.Context, string, int) ([]*gitea.Repo, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListOrgReposFunc) appendCall(r0 GiteaClientListOrgReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListOrgReposFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientListOrgReposFunc) History() []GiteaClientListOrgReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListOrgReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListOrgReposFuncCall is an object that describes an invocation
+// of method ListOrgRepos on an instance of MockGiteaClient.
+type GiteaClientListOrgReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListOrgReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListOrgReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GiteaClientListPullRequestReviewsFunc describes the behavior when the
+// ListPullRequestReviews method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientListPullRequestReviewsFunc struct {
+	defaultHook func(context.Context, string, string, int64) ([]*gitea.PullReview, error)
+	hooks       []func(context.Context, string, string, int64) ([]*gitea.PullReview, error)
+	history     []GiteaClientListPullRequestReviewsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestReviews delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListPullRequestReviews(v0 context.Context, v1 string, v2 string, v3 int64) ([]*gitea.PullReview, error) {
+	r0, r1 := m.ListPullRequestReviewsFunc.nextHook()(v0, v1, v2, v3)
+	m.ListPullRequestReviewsFunc.appendCall(GiteaClientListPullRequestReviewsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestReviews method of the parent MockGiteaClient instance is
+// invoked and the hook queue is empty.
+func (f *GiteaClientListPullRequestReviewsFunc) SetDefaultHook(hook func(context.Context, string, string, int64) ([]*gitea.PullReview, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestReviews method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientListPullRequestReviewsFunc) PushHook(hook func(context.Context, string, string, int64) ([]*gitea.PullReview, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListPullRequestReviewsFunc) SetDefaultReturn(r0 []*gitea.PullReview, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListPullRequestReviewsFunc) PushReturn(r0 []*gitea.PullReview, r1 error) {
+	f.PushHook(func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientListPullRequestReviewsFunc) nextHook() func(context.Context, string, string, int64) ([]*gitea.PullReview, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListPullRequestReviewsFunc) appendCall(r0 GiteaClientListPullRequestReviewsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListPullRequestReviewsFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientListPullRequestReviewsFunc) History() []GiteaClientListPullRequestReviewsFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListPullRequestReviewsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListPullRequestReviewsFuncCall is an object that describes an
+// invocation of method ListPullRequestReviews on an instance of
+// MockGiteaClient.
+type GiteaClientListPullRequestReviewsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.PullReview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListPullRequestReviewsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListPullRequestReviewsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientListRepoLabelsFunc describes the behavior when the
+// ListRepoLabels method of the parent MockGiteaClient instance is invoked.
+type GiteaClientListRepoLabelsFunc struct {
+	defaultHook func(context.Context, string, string) ([]*gitea.Label, error)
+	hooks       []func(context.Context, string, string) ([]*gitea.Label, error)
+	history     []GiteaClientListRepoLabelsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRepoLabels delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListRepoLabels(v0 context.Context, v1 string, v2 string) ([]*gitea.Label, error) {
+	r0, r1 := m.ListRepoLabelsFunc.nextHook()(v0, v1, v2)
+	m.ListRepoLabelsFunc.appendCall(GiteaClientListRepoLabelsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRepoLabels
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientListRepoLabelsFunc) SetDefaultHook(hook func(context.Context, string, string) ([]*gitea.Label, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRepoLabels method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientListRepoLabelsFunc) PushHook(hook func(context.Context, string, string) ([]*gitea.Label, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListRepoLabelsFunc) SetDefaultReturn(r0 []*gitea.Label, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) ([]*gitea.Label, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListRepoLabelsFunc) PushReturn(r0 []*gitea.Label, r1 error) {
+	f.PushHook(func(context.Context, string, string) ([]*gitea.Label, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientListRepoLabelsFunc) nextHook() func(context.Context, string, string) ([]*gitea.Label, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListRepoLabelsFunc) appendCall(r0 GiteaClientListRepoLabelsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListRepoLabelsFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientListRepoLabelsFunc) History() []GiteaClientListRepoLabelsFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListRepoLabelsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListRepoLabelsFuncCall is an object that describes an
+// invocation of method ListRepoLabels on an instance of MockGiteaClient.
+type GiteaClientListRepoLabelsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Label
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListRepoLabelsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListRepoLabelsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientListRepoMilestonesFunc describes the behavior when the
+// ListRepoMilestones method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientListRepoMilestonesFunc struct {
+	defaultHook func(context.Context, string, string, string) ([]*gitea.Milestone, error)
+	hooks       []func(context.Context, string, string, string) ([]*gitea.Milestone, error)
+	history     []GiteaClientListRepoMilestonesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRepoMilestones delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) ListRepoMilestones(v0 context.Context, v1 string, v2 string, v3 string) ([]*gitea.Milestone, error) {
+	r0, r1 := m.ListRepoMilestonesFunc.nextHook()(v0, v1, v2, v3)
+	m.ListRepoMilestonesFunc.appendCall(GiteaClientListRepoMilestonesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRepoMilestones
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientListRepoMilestonesFunc) SetDefaultHook(hook func(context.Context, string, string, string) ([]*gitea.Milestone, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRepoMilestones method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientListRepoMilestonesFunc) PushHook(hook func(context.Context, string, string, string) ([]*gitea.Milestone, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientListRepoMilestonesFunc) SetDefaultReturn(r0 []*gitea.Milestone, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, string) ([]*gitea.Milestone, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientListRepoMilestonesFunc) PushReturn(r0 []*gitea.Milestone, r1 error) {
+	f.PushHook(func(context.Context, string, string, string) ([]*gitea.Milestone, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientListRepoMilestonesFunc) nextHook() func(context.Context, string, string, string) ([]*gitea.Milestone, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientListRepoMilestonesFunc) appendCall(r0 GiteaClientListRepoMilestonesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientListRepoMilestonesFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientListRepoMilestonesFunc) History() []GiteaClientListRepoMilestonesFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientListRepoMilestonesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientListRepoMilestonesFuncCall is an object that describes an
+// invocation of method ListRepoMilestones on an instance of
+// MockGiteaClient.
+type GiteaClientListRepoMilestonesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Milestone
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientListRepoMilestonesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientListRepoMilestonesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GiteaClientMergePullRequestFunc describes the behavior when the
+// MergePullRequest method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientMergePullRequestFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.MergePullRequestInput) error
+	hooks       []func(context.Context, string, string, int64, gitea.MergePullRequestInput) error
+	history     []GiteaClientMergePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// MergePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) MergePullRequest(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.MergePullRequestInput) error {
+	r0 := m.MergePullRequestFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.MergePullRequestFunc.appendCall(GiteaClientMergePullRequestFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the MergePullRequest
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientMergePullRequestFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.MergePullRequestInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MergePullRequest method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientMergePullRequestFunc) PushHook(hook func(context.Context, string, string, int64, gitea.MergePullRequestInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientMergePullRequestFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientMergePullRequestFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientMergePullRequestFunc) nextHook() func(context.Context, string, string, int64, gitea.MergePullRequestInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientMergePullRequestFunc) appendCall(r0 GiteaClientMergePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientMergePullRequestFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientMergePullRequestFunc) History() []GiteaClientMergePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientMergePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientMergePullRequestFuncCall is an object that describes an
+// invocation of method MergePullRequest on an instance of MockGiteaClient.
+type GiteaClientMergePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.MergePullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientMergePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientMergePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientRemoveIssueLabelFunc describes the behavior when the
+// RemoveIssueLabel method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientRemoveIssueLabelFunc struct {
+	defaultHook func(context.Context, string, string, int64, int64) error
+	hooks       []func(context.Context, string, string, int64, int64) error
+	history     []GiteaClientRemoveIssueLabelFuncCall
+	mutex       sync.Mutex
+}
+
+// RemoveIssueLabel delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) RemoveIssueLabel(v0 context.Context, v1 string, v2 string, v3 int64, v4 int64) error {
+	r0 := m.RemoveIssueLabelFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RemoveIssueLabelFunc.appendCall(GiteaClientRemoveIssueLabelFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RemoveIssueLabel
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientRemoveIssueLabelFunc) SetDefaultHook(hook func(context.Context, string, string, int64, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RemoveIssueLabel method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientRemoveIssueLabelFunc) PushHook(hook func(context.Context, string, string, int64, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientRemoveIssueLabelFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientRemoveIssueLabelFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, int64) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientRemoveIssueLabelFunc) nextHook() func(context.Context, string, string, int64, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientRemoveIssueLabelFunc) appendCall(r0 GiteaClientRemoveIssueLabelFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientRemoveIssueLabelFuncCall objects
+// describing the
+// invocations of this function.
+func (f *GiteaClientRemoveIssueLabelFunc) History() []GiteaClientRemoveIssueLabelFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientRemoveIssueLabelFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientRemoveIssueLabelFuncCall is an object that describes an
+// invocation of method RemoveIssueLabel on an instance of MockGiteaClient.
+type GiteaClientRemoveIssueLabelFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientRemoveIssueLabelFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientRemoveIssueLabelFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientRemovePullRequestReviewersFunc describes the behavior when the
+// RemovePullRequestReviewers method of the parent MockGiteaClient instance
+// is invoked.
+type GiteaClientRemovePullRequestReviewersFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.RequestReviewersInput) error
+	hooks       []func(context.Context, string, string, int64, gitea.RequestReviewersInput) error
+	history     []GiteaClientRemovePullRequestReviewersFuncCall
+	mutex       sync.Mutex
+}
+
+// RemovePullRequestReviewers delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) RemovePullRequestReviewers(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.RequestReviewersInput) error {
+	r0 := m.RemovePullRequestReviewersFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RemovePullRequestReviewersFunc.appendCall(GiteaClientRemovePullRequestReviewersFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// RemovePullRequestReviewers method of the parent MockGiteaClient instance
+// is invoked and the hook queue is empty.
+func (f *GiteaClientRemovePullRequestReviewersFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.RequestReviewersInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RemovePullRequestReviewers method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientRemovePullRequestReviewersFunc) PushHook(hook func(context.Context, string, string, int64, gitea.RequestReviewersInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientRemovePullRequestReviewersFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientRemovePullRequestReviewersFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientRemovePullRequestReviewersFunc) nextHook() func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientRemovePullRequestReviewersFunc) appendCall(r0 GiteaClientRemovePullRequestReviewersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GiteaClientRemovePullRequestReviewersFuncCall objects describing the
+// invocations of this function.
+func (f *GiteaClientRemovePullRequestReviewersFunc) History() []GiteaClientRemovePullRequestReviewersFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientRemovePullRequestReviewersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientRemovePullRequestReviewersFuncCall is an object that describes
+// an invocation of method RemovePullRequestReviewers on an instance of
+// MockGiteaClient.
+type GiteaClientRemovePullRequestReviewersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.RequestReviewersInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientRemovePullRequestReviewersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientRemovePullRequestReviewersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientRequestPullRequestReviewersFunc describes the behavior when
+// the RequestPullRequestReviewers method of the parent MockGiteaClient
+// instance is invoked.
+type GiteaClientRequestPullRequestReviewersFunc struct {
+	defaultHook func(context.Context, string, string, int64, gitea.RequestReviewersInput) error
+	hooks       []func(context.Context, string, string, int64, gitea.RequestReviewersInput) error
+	history     []GiteaClientRequestPullRequestReviewersFuncCall
+	mutex       sync.Mutex
+}
+
+// RequestPullRequestReviewers delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) RequestPullRequestReviewers(v0 context.Context, v1 string, v2 string, v3 int64, v4 gitea.RequestReviewersInput) error {
+	r0 := m.RequestPullRequestReviewersFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RequestPullRequestReviewersFunc.appendCall(GiteaClientRequestPullRequestReviewersFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// RequestPullRequestReviewers method of the parent MockGiteaClient instance
+// is invoked and the hook queue is empty.
+func (f *GiteaClientRequestPullRequestReviewersFunc) SetDefaultHook(hook func(context.Context, string, string, int64, gitea.RequestReviewersInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RequestPullRequestReviewers method of the parent MockGiteaClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GiteaClientRequestPullRequestReviewersFunc) PushHook(hook func(context.Context, string, string, int64, gitea.RequestReviewersInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientRequestPullRequestReviewersFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientRequestPullRequestReviewersFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+		return r0
+	})
+}
+
+func (f *GiteaClientRequestPullRequestReviewersFunc) nextHook() func(context.Context, string, string, int64, gitea.RequestReviewersInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientRequestPullRequestReviewersFunc) appendCall(r0 GiteaClientRequestPullRequestReviewersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GiteaClientRequestPullRequestReviewersFuncCall objects describing the
+// invocations of this function.
+func (f *GiteaClientRequestPullRequestReviewersFunc) History() []GiteaClientRequestPullRequestReviewersFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientRequestPullRequestReviewersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientRequestPullRequestReviewersFuncCall is an object that
+// describes an invocation of method RequestPullRequestReviewers on an
+// instance of MockGiteaClient.
+type GiteaClientRequestPullRequestReviewersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitea.RequestReviewersInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientRequestPullRequestReviewersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientRequestPullRequestReviewersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GiteaClientSearchReposFunc describes the behavior when the SearchRepos
+// method of the parent MockGiteaClient instance is invoked.
+type GiteaClientSearchReposFunc struct {
+	defaultHook func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error)
+	hooks       []func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error)
+	history     []GiteaClientSearchReposFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchRepos delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGiteaClient) SearchRepos(v0 context.Context, v1 gitea.SearchReposOptions, v2 int) ([]*gitea.Repo, bool, error) {
+	r0, r1, r2 := m.SearchReposFunc.nextHook()(v0, v1, v2)
+	m.SearchReposFunc.appendCall(GiteaClientSearchReposFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the SearchRepos method
+// of the parent MockGiteaClient instance is invoked and the hook queue is
+// empty.
+func (f *GiteaClientSearchReposFunc) SetDefaultHook(hook func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchRepos method of the parent MockGiteaClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GiteaClientSearchReposFunc) PushHook(hook func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientSearchReposFunc) SetDefaultReturn(r0 []*gitea.Repo, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientSearchReposFunc) PushReturn(r0 []*gitea.Repo, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GiteaClientSearchReposFunc) nextHook() func(context.Context, gitea.SearchReposOptions, int) ([]*gitea.Repo, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientSearchReposFunc) appendCall(r0 GiteaClientSearchReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientSearchReposFuncCall objects
+// describing the invocations of this function.
+func (f *GiteaClientSearchReposFunc) History() []GiteaClientSearchReposFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientSearchReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientSearchReposFuncCall is an object that describes an invocation
+// of method SearchRepos on an instance of MockGiteaClient.
+type GiteaClientSearchReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 gitea.SearchReposOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitea.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientSearchReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientSearchReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GiteaClientWithAuthenticatorFunc describes the behavior when the
+// WithAuthenticator method of the parent MockGiteaClient instance is
+// invoked.
+type GiteaClientWithAuthenticatorFunc struct {
+	defaultHook func(auth.Authenticator) (gitea.Client, error)
+	hooks       []func(auth.Authenticator) (gitea.Client, error)
+	history     []GiteaClientWithAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// WithAuthenticator delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGiteaClient) WithAuthenticator(v0 auth.Authenticator) (gitea.Client, error) {
+	r0, r1 := m.WithAuthenticatorFunc.nextHook()(v0)
+	m.WithAuthenticatorFunc.appendCall(GiteaClientWithAuthenticatorFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the WithAuthenticator
+// method of the parent MockGiteaClient instance is invoked and the hook
+// queue is empty.
+func (f *GiteaClientWithAuthenticatorFunc) SetDefaultHook(hook func(auth.Authenticator) (gitea.Client, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithAuthenticator method of the parent MockGiteaClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GiteaClientWithAuthenticatorFunc) PushHook(hook func(auth.Authenticator) (gitea.Client, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GiteaClientWithAuthenticatorFunc) SetDefaultReturn(r0 gitea.Client, r1 error) {
+	f.SetDefaultHook(func(auth.Authenticator) (gitea.Client, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GiteaClientWithAuthenticatorFunc) PushReturn(r0 gitea.Client, r1 error) {
+	f.PushHook(func(auth.Authenticator) (gitea.Client, error) {
+		return r0, r1
+	})
+}
+
+func (f *GiteaClientWithAuthenticatorFunc) nextHook() func(auth.Authenticator) (gitea.Client, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GiteaClientWithAuthenticatorFunc) appendCall(r0 GiteaClientWithAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GiteaClientWithAuthenticatorFuncCall
+// objects describing the invocations of this function.
+func (f *GiteaClientWithAuthenticatorFunc) History() []GiteaClientWithAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]GiteaClientWithAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GiteaClientWithAuthenticatorFuncCall is an object that describes an
+// invocation of method WithAuthenticator on an instance of MockGiteaClient.
+type GiteaClientWithAuthenticatorFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 auth.Authenticator
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitea.Client
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GiteaClientWithAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GiteaClientWithAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockGitserverClient is a mock implementation of the Client interface
 // (from the package github.com/sourcegraph/sourcegraph/internal/gitserver)
 // used for unit testing.
@@ -11239,6 +14499,9 @@ type MockGitserverClient struct {
 	// CheckPerforceCredentialsFunc is an instance of a mock function object
 	// controlling the behavior of the method CheckPerforceCredentials.
 	CheckPerforceCredentialsFunc *GitserverClientCheckPerforceCredentialsFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *GitserverClientCommitGraphFunc
 	// CommitsFunc is an instance of a mock function object controlling the
 	// behavior of the method Commits.
 	CommitsFunc *GitserverClientCommitsFunc
@@ -11287,6 +14550,9 @@ type MockGitserverClient struct {
 	// NewFileReaderFunc is an instance of a mock function object
 	// controlling the behavior of the method NewFileReader.
 	NewFileReaderFunc *GitserverClientNewFileReaderFunc
+	// NewLFSPointerReaderFunc is an instance of a mock function object
+	// controlling the behavior of the method NewLFSPointerReader.
+	NewLFSPointerReaderFunc *GitserverClientNewLFSPointerReaderFunc
 	// PerforceGetChangelistFunc is an instance of a mock function object
 	// controlling the behavior of the method PerforceGetChangelist.
 	PerforceGetChangelistFunc *GitserverClientPerforceGetChangelistFunc
@@ -11363,6 +14629,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName, gitserver.CommitGraphOptions) (r0 []*gitdomain.CommitGraphNode, r1 error) {
+				return
+			},
+		},
 		CommitsFunc: &GitserverClientCommitsFunc{
 			defaultHook: func(context.Context, api.RepoName, gitserver.CommitsOptions) (r0 []*gitdomain.Commit, r1 error) {
 				return
@@ -11443,6 +14714,11 @@ func NewMockGitserverClient() *MockGitserverClient {
 				return
 			},
 		},
+		NewLFSPointerReaderFunc: &GitserverClientNewLFSPointerReaderFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
 		PerforceGetChangelistFunc: &GitserverClientPerforceGetChangelistFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails, string) (r0 *perforce.Changelist, r1 error) {
 				return
@@ -11550,6 +14826,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.CheckPerforceCredentials")
 			},
 		},
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+				panic("unexpected invocation of MockGitserverClient.CommitGraph")
+			},
+		},
 		CommitsFunc: &GitserverClientCommitsFunc{
 			defaultHook: func(context.Context, api.RepoName, gitserver.CommitsOptions) ([]*gitdomain.Commit, error) {
 				panic("unexpected invocation of MockGitserverClient.Commits")
@@ -11630,6 +14911,11 @@ func NewStrictMockGitserverClient() *MockGitserverClient {
 				panic("unexpected invocation of MockGitserverClient.NewFileReader")
 			},
 		},
+		NewLFSPointerReaderFunc: &GitserverClientNewLFSPointerReaderFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockGitserverClient.NewLFSPointerReader")
+			},
+		},
 		PerforceGetChangelistFunc: &GitserverClientPerforceGetChangelistFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
 				panic("unexpected invocation of MockGitserverClient.PerforceGetChangelist")
@@ -11728,6 +15014,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		CheckPerforceCredentialsFunc: &GitserverClientCheckPerforceCredentialsFunc{
 			defaultHook: i.CheckPerforceCredentials,
 		},
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: i.CommitGraph,
+		},
 		CommitsFunc: &GitserverClientCommitsFunc{
 			defaultHook: i.Commits,
 		},
@@ -11776,6 +15065,9 @@ func NewMockGitserverClientFrom(i gitserver.Client) *MockGitserverClient {
 		NewFileReaderFunc: &GitserverClientNewFileReaderFunc{
 			defaultHook: i.NewFileReader,
 		},
+		NewLFSPointerReaderFunc: &GitserverClientNewLFSPointerReaderFunc{
+			defaultHook: i.NewLFSPointerReader,
+		},
 		PerforceGetChangelistFunc: &GitserverClientPerforceGetChangelistFunc{
 			defaultHook: i.PerforceGetChangelist,
 		},
@@ -12379,6 +15671,117 @@ func (c GitserverClientCheckPerforceCredentialsFuncCall) Results() []interface{}
 	return []interface{}{c.Result0}
 }
 
+// GitserverClientCommitGraphFunc describes the behavior when the
+// CommitGraph method of the parent MockGitserverClient instance is invoked.
+type GitserverClientCommitGraphFunc struct {
+	defaultHook func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)
+	hooks       []func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)
+	history     []GitserverClientCommitGraphFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) CommitGraph(v0 context.Context, v1 api.RepoName, v2 gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
+	m.CommitGraphFunc.appendCall(GitserverClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientCommitGraphFunc) SetDefaultReturn(r0 []*gitdomain.CommitGraphNode, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientCommitGraphFunc) PushReturn(r0 []*gitdomain.CommitGraphNode, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, api.RepoName, gitserver.CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientCommitGraphFunc) appendCall(r0 GitserverClientCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientCommitGraphFunc) History() []GitserverClientCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientCommitGraphFuncCall is an object that describes an
+// invocation of method CommitGraph on an instance of MockGitserverClient.
+type GitserverClientCommitGraphFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gitserver.CommitGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitdomain.CommitGraphNode
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientCommitsFunc describes the behavior when the Commits method
 // of the parent MockGitserverClient instance is invoked.
 type GitserverClientCommitsFunc struct {
@@ -14175,6 +17578,123 @@ func (c GitserverClientNewFileReaderFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverClientNewLFSPointerReaderFunc describes the behavior when the
+// NewLFSPointerReader method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientNewLFSPointerReaderFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
+	history     []GitserverClientNewLFSPointerReaderFuncCall
+	mutex       sync.Mutex
+}
+
+// NewLFSPointerReader delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverClient) NewLFSPointerReader(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (io.ReadCloser, error) {
+	r0, r1 := m.NewLFSPointerReaderFunc.nextHook()(v0, v1, v2, v3)
+	m.NewLFSPointerReaderFunc.appendCall(GitserverClientNewLFSPointerReaderFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the NewLFSPointerReader
+// method of the parent MockGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverClientNewLFSPointerReaderFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// NewLFSPointerReader method of the parent MockGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverClientNewLFSPointerReaderFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverClientNewLFSPointerReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverClientNewLFSPointerReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientNewLFSPointerReaderFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientNewLFSPointerReaderFunc) appendCall(r0 GitserverClientNewLFSPointerReaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientNewLFSPointerReaderFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverClientNewLFSPointerReaderFunc) History() []GitserverClientNewLFSPointerReaderFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientNewLFSPointerReaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientNewLFSPointerReaderFuncCall is an object that describes an
+// invocation of method NewLFSPointerReader on an instance of
+// MockGitserverClient.
+type GitserverClientNewLFSPointerReaderFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientNewLFSPointerReaderFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientNewLFSPointerReaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverClientPerforceGetChangelistFunc describes the behavior when the
 // PerforceGetChangelist method of the parent MockGitserverClient instance
 // is invoked.
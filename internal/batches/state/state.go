@@ -10,9 +10,11 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/batches/sources/azuredevops"
 	gerritbatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	adobatches "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/perforce"
 
 	"github.com/sourcegraph/go-diff/diff"
@@ -123,6 +125,8 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 		return computeAzureDevOpsBuildState(m)
 	case *gerritbatches.AnnotatedChange:
 		return computeGerritBuildState(m)
+	case *giteabatches.AnnotatedPullRequest:
+		return computeGiteaBuildState(m)
 	case *perforce.ChangelistState:
 		// Perforce doesn't have builds built-in, its better to be explicit by still
 		// including this case for clarity.
@@ -331,6 +335,35 @@ func computeGerritBuildState(ac *gerritbatches.AnnotatedChange) btypes.Changeset
 	return combineCheckStates(states)
 }
 
+func computeGiteaBuildState(apr *giteabatches.AnnotatedPullRequest) btypes.ChangesetCheckState {
+	stateMap := make(map[string]btypes.ChangesetCheckState)
+
+	// States from last sync. Gitea only returns the latest status per
+	// context, so the context is a sufficient key.
+	for _, status := range apr.Statuses {
+		stateMap[status.Context] = parseGiteaBuildState(status.State)
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(stateMap))
+	for _, v := range stateMap {
+		states = append(states, v)
+	}
+	return combineCheckStates(states)
+}
+
+func parseGiteaBuildState(s gitea.CommitStatusState) btypes.ChangesetCheckState {
+	switch s {
+	case gitea.CommitStatusError, gitea.CommitStatusFailure:
+		return btypes.ChangesetCheckStateFailed
+	case gitea.CommitStatusPending:
+		return btypes.ChangesetCheckStatePending
+	case gitea.CommitStatusSuccess, gitea.CommitStatusWarning:
+		return btypes.ChangesetCheckStatePassed
+	default:
+		return btypes.ChangesetCheckStateUnknown
+	}
+}
+
 func parseGerritBuildState(s string) btypes.ChangesetCheckState {
 	switch s {
 	case "-2", "-1":
@@ -640,6 +673,21 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Gerrit Change state: %s", m.Change.Status)
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		switch {
+		case m.HasMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case m.State == gitea.PullRequestStateClosed:
+			s = btypes.ChangesetExternalStateClosed
+		case m.State == gitea.PullRequestStateOpen:
+			if m.IsDraft() {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Gitea pull request state: %s", m.State)
+		}
 	case *perforce.Changelist:
 		switch m.State {
 		case perforce.ChangelistStateClosed:
@@ -759,6 +807,36 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 
 		}
+	case *giteabatches.AnnotatedPullRequest:
+		// Reviews are returned in chronological order, and only the latest
+		// approval or change request of each reviewer counts.
+		latest := map[int64]gitea.ReviewState{}
+		for _, review := range m.Reviews {
+			if review.User == nil || review.Dismissed {
+				continue
+			}
+			switch review.State {
+			case gitea.ReviewStateApproved, gitea.ReviewStateRequestChanges:
+				latest[review.User.ID] = review.State
+			case gitea.ReviewStateRequestReview:
+				if _, ok := latest[review.User.ID]; !ok {
+					latest[review.User.ID] = review.State
+				}
+			}
+		}
+		if len(latest) == 0 {
+			states[btypes.ChangesetReviewStatePending] = true
+		}
+		for _, state := range latest {
+			switch state {
+			case gitea.ReviewStateApproved:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case gitea.ReviewStateRequestChanges:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				states[btypes.ChangesetReviewStatePending] = true
+			}
+		}
 	case *perforce.Changelist:
 		states[btypes.ChangesetReviewStatePending] = true
 	default:
@@ -45,6 +45,11 @@ var changesetSpecInsertColumns = []string{
 	"commit_author_name",
 	"commit_author_email",
 	"type",
+	"reviewers",
+	"labels",
+	"assignees",
+	"milestone",
+	"auto_merge",
 }
 
 // changesetSpecColumns are used by the changeset spec related Store methods to
@@ -72,6 +77,11 @@ var changesetSpecColumns = SQLColumns{
 	"changeset_specs.commit_author_name",
 	"changeset_specs.commit_author_email",
 	"changeset_specs.type",
+	"changeset_specs.reviewers",
+	"changeset_specs.labels",
+	"changeset_specs.assignees",
+	"changeset_specs.milestone",
+	"changeset_specs.auto_merge",
 }
 
 var oneGigabyte = 1000000000
@@ -137,6 +147,11 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				dbutil.NewNullString(c.CommitAuthorName),
 				dbutil.NewNullString(c.CommitAuthorEmail),
 				c.Type,
+				pq.Array(c.Reviewers),
+				pq.Array(c.Labels),
+				pq.Array(c.Assignees),
+				dbutil.NewNullString(c.Milestone),
+				c.AutoMerge,
 			); err != nil {
 				return err
 			}
@@ -563,6 +578,11 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &c.CommitAuthorName},
 		&dbutil.NullString{S: &c.CommitAuthorEmail},
 		&typ,
+		pq.Array(&c.Reviewers),
+		pq.Array(&c.Labels),
+		pq.Array(&c.Assignees),
+		&dbutil.NullString{S: &c.Milestone},
+		&c.AutoMerge,
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset spec")
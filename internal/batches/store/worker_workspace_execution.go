@@ -241,6 +241,9 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		if err != nil {
 			return false, errors.Wrap(err, "failed to build db changeset specs")
 		}
+		if err := changesetSpec.ValidateCodeHostCapabilities(repo.ExternalRepo.ServiceType); err != nil {
+			return false, err
+		}
 		changesetSpec.BatchSpecID = batchSpec.ID
 		changesetSpec.BaseRepoID = repo.ID
 		changesetSpec.UserID = batchSpec.UserID
@@ -39,6 +39,12 @@ type TestSpecOpts struct {
 	BaseRev string
 	BaseRef string
 
+	Reviewers []string
+	Labels    []string
+	Assignees []string
+	Milestone string
+	AutoMerge bool
+
 	Typ btypes.ChangesetSpecType
 }
 
@@ -72,6 +78,11 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 		Diff:              opts.CommitDiff,
 		CommitAuthorEmail: opts.CommitAuthorEmail,
 		CommitAuthorName:  opts.CommitAuthorName,
+		Reviewers:         opts.Reviewers,
+		Labels:            opts.Labels,
+		Assignees:         opts.Assignees,
+		Milestone:         opts.Milestone,
+		AutoMerge:         opts.AutoMerge,
 		DiffStatAdded:     TestChangsetSpecDiffStat.Added,
 		DiffStatDeleted:   TestChangsetSpecDiffStat.Deleted,
 		Type:              opts.Typ,
@@ -12,6 +12,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	gitlabwebhooks "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab/webhooks"
@@ -125,6 +126,13 @@ const (
 	ChangesetEventKindGerritChangeBuildFailed             ChangesetEventKind = "gerrit:change:build_failed"
 	ChangesetEventKindGerritChangeBuildPending            ChangesetEventKind = "gerrit:change:build_pending"
 
+	// These changeset events are created as the result of regular syncs with
+	// Gitea.
+	ChangesetEventKindGiteaApproved         ChangesetEventKind = "gitea:approved"
+	ChangesetEventKindGiteaChangesRequested ChangesetEventKind = "gitea:changes_requested"
+	ChangesetEventKindGiteaCommitStatus     ChangesetEventKind = "gitea:commit_status"
+	ChangesetEventKindGiteaReviewed         ChangesetEventKind = "gitea:reviewed"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -180,6 +188,12 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
 		return meta.ChangesRequest.User.UUID
 
+	case *gitea.PullReview:
+		if meta.User == nil {
+			return ""
+		}
+		return meta.User.Login
+
 	case *azuredevops.PullRequestApprovedEvent:
 		if len(meta.PullRequest.Reviewers) == 0 {
 			return meta.PullRequest.CreatedBy.UniqueName
@@ -214,7 +228,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 		ChangesetEventKindGitLabApproved,
 		ChangesetEventKindBitbucketCloudApproved,
 		ChangesetEventKindBitbucketCloudPullRequestApproved,
-		ChangesetEventKindAzureDevOpsPullRequestApproved:
+		ChangesetEventKindAzureDevOpsPullRequestApproved,
+		ChangesetEventKindGiteaApproved:
 		return ChangesetReviewStateApproved, nil
 
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
@@ -223,7 +238,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 		ChangesetEventKindBitbucketCloudChangesRequested,
 		ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated,
 		ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor,
-		ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions:
+		ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+		ChangesetEventKindGiteaChangesRequested:
 		return ChangesetReviewStateChangesRequested, nil
 
 	case ChangesetEventKindGitHubReviewed:
@@ -334,6 +350,10 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.ParticipatedOn
 	case *bitbucketcloud.PullRequestStatus:
 		t = ev.CreatedOn
+	case *gitea.PullReview:
+		t = ev.SubmittedAt
+	case *gitea.CommitStatus:
+		t = ev.UpdatedAt
 	case *bitbucketcloud.PullRequestApprovedEvent:
 		t = ev.Approval.Date
 	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
@@ -913,6 +933,13 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 	case *azuredevops.PullRequestRejectedEvent:
 		o := o.Metadata.(*azuredevops.PullRequestRejectedEvent)
 		*e = *o
+
+	case *gitea.PullReview:
+		o := o.Metadata.(*gitea.PullReview)
+		*e = *o
+	case *gitea.CommitStatus:
+		o := o.Metadata.(*gitea.CommitStatus)
+		*e = *o
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -12,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func NewChangesetSpecFromRaw(rawSpec string) (*ChangesetSpec, error) {
@@ -74,12 +76,44 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		c.CommitMessage = commitMsg
 		c.CommitAuthorName = authorName
 		c.CommitAuthorEmail = authorEmail
+		c.Reviewers = spec.Reviewers
+		c.Labels = spec.Labels
+		c.Assignees = spec.Assignees
+		c.Milestone = spec.Milestone
+		c.AutoMerge = spec.AutoMerge
 	}
 
 	c.computeForkNamespace(spec.Fork)
 	return c, c.computeDiffStat()
 }
 
+// ValidateCodeHostCapabilities returns an error if the changeset spec sets
+// reviewers, labels, assignees, a milestone or auto-merge, but changesets on
+// code hosts of the given external service type don't support them.
+func (s *ChangesetSpec) ValidateCodeHostCapabilities(extSvcType string) error {
+	var unsupported []string
+	for _, field := range []struct {
+		name       string
+		set        bool
+		capability CodehostCapability
+	}{
+		{"reviewers", len(s.Reviewers) > 0, CodehostCapabilityReviewers},
+		{"labels", len(s.Labels) > 0, CodehostCapabilityLabels},
+		{"assignees", len(s.Assignees) > 0, CodehostCapabilityAssignees},
+		{"milestone", s.Milestone != "", CodehostCapabilityMilestone},
+		{"autoMerge", s.AutoMerge, CodehostCapabilityAutoMerge},
+	} {
+		if field.set && !ExternalServiceSupports(extSvcType, field.capability) {
+			unsupported = append(unsupported, field.name)
+		}
+	}
+
+	if len(unsupported) == 0 {
+		return nil
+	}
+	return errors.Newf("changeset spec sets %s, which changesets on %s code hosts don't support", strings.Join(unsupported, ", "), extSvcType)
+}
+
 type ChangesetSpecType string
 
 const (
@@ -115,6 +149,12 @@ type ChangesetSpec struct {
 	CommitAuthorName  string
 	CommitAuthorEmail string
 
+	Reviewers []string
+	Labels    []string
+	Assignees []string
+	Milestone string
+	AutoMerge bool
+
 	ForkNamespace *string
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
@@ -49,3 +50,28 @@ func TestChangesetSpec_SetForkToUser(t *testing.T) {
 	assert.NotNil(t, cs.ForkNamespace)
 	assert.Equal(t, changesetSpecForkNamespaceUser, *cs.ForkNamespace)
 }
+
+func TestChangesetSpec_ValidateCodeHostCapabilities(t *testing.T) {
+	spec := &ChangesetSpec{
+		Reviewers: []string{"alice"},
+		Labels:    []string{"batch-change"},
+		Assignees: []string{"bob"},
+		Milestone: "v1",
+		AutoMerge: true,
+	}
+
+	for _, extSvcType := range []string{extsvc.TypeGitHub, extsvc.TypeGitLab, extsvc.TypeGitea} {
+		assert.NoError(t, spec.ValidateCodeHostCapabilities(extSvcType), extSvcType)
+	}
+
+	assert.EqualError(t, spec.ValidateCodeHostCapabilities(extsvc.TypeBitbucketServer),
+		"changeset spec sets labels, assignees, milestone, autoMerge, which changesets on bitbucketServer code hosts don't support")
+	assert.NoError(t, (&ChangesetSpec{Reviewers: []string{"alice"}}).ValidateCodeHostCapabilities(extsvc.TypeBitbucketServer))
+
+	for _, extSvcType := range []string{extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps, extsvc.TypeGerrit} {
+		assert.EqualError(t, (&ChangesetSpec{AutoMerge: true}).ValidateCodeHostCapabilities(extSvcType),
+			"changeset spec sets autoMerge, which changesets on "+extSvcType+" code hosts don't support")
+	}
+
+	assert.NoError(t, (&ChangesetSpec{}).ValidateCodeHostCapabilities(extsvc.TypeGerrit))
+}
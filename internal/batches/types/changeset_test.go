@@ -11,11 +11,13 @@ import (
 	adobatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/azuredevops"
 	bbcs "github.com/sourcegraph/sourcegraph/internal/batches/sources/bitbucketcloud"
 	gerritbatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gerrit"
+	giteabatches "github.com/sourcegraph/sourcegraph/internal/batches/sources/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
@@ -242,6 +244,31 @@ func TestChangeset_SetMetadata(t *testing.T) {
 				ExternalUpdatedAt:     time.Unix(10, 0),
 			},
 		},
+		"Gitea with fork": {
+			meta: &giteabatches.AnnotatedPullRequest{
+				PullRequest: &gitea.PullRequest{
+					Number: 12345,
+					Base:   &gitea.PRBranchInfo{Ref: "main", RepoID: 1},
+					Head: &gitea.PRBranchInfo{
+						Ref:    "branch",
+						RepoID: 2,
+						Repository: &gitea.Repo{
+							Owner: &gitea.User{Login: "fork"},
+							Name:  "repo",
+						},
+					},
+					UpdatedAt: time.Unix(10, 0),
+				},
+			},
+			want: &Changeset{
+				ExternalID:            "12345",
+				ExternalServiceType:   extsvc.TypeGitea,
+				ExternalBranch:        "refs/heads/branch",
+				ExternalForkNamespace: "fork",
+				ExternalForkName:      "repo",
+				ExternalUpdatedAt:     time.Unix(10, 0),
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			have := &Changeset{}
@@ -281,6 +308,9 @@ func TestChangeset_Title(t *testing.T) {
 		"GitLab": &gitlab.MergeRequest{
 			Title: want,
 		},
+		"Gitea": &giteabatches.AnnotatedPullRequest{
+			PullRequest: &gitea.PullRequest{Title: want},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			c := &Changeset{Metadata: meta}
@@ -798,6 +828,18 @@ func TestChangeset_Labels(t *testing.T) {
 				{Name: "green", Color: "000000"},
 			},
 		},
+		"Gitea": {
+			meta: &giteabatches.AnnotatedPullRequest{
+				PullRequest: &gitea.PullRequest{
+					Labels: []*gitea.Label{
+						{Name: "bug", Color: "ee0701", Description: "Something is broken"},
+					},
+				},
+			},
+			want: []ChangesetLabel{
+				{Name: "bug", Color: "ee0701", Description: "Something is broken"},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			c := &Changeset{Metadata: tc.meta}
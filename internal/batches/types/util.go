@@ -13,22 +13,42 @@ type CodehostCapability string
 const (
 	CodehostCapabilityLabels          CodehostCapability = "Labels"
 	CodehostCapabilityDraftChangesets CodehostCapability = "DraftChangesets"
+	CodehostCapabilityReviewers       CodehostCapability = "Reviewers"
+	CodehostCapabilityAssignees       CodehostCapability = "Assignees"
+	CodehostCapabilityMilestone       CodehostCapability = "Milestone"
+	CodehostCapabilityAutoMerge       CodehostCapability = "AutoMerge"
 )
 
 type CodehostCapabilities map[CodehostCapability]bool
 
+// changesetMetadataCapabilities are the capabilities of code hosts that support
+// all of the reviewers, labels, assignees, milestone and auto-merge fields of
+// changeset templates.
+var changesetMetadataCapabilities = CodehostCapabilities{
+	CodehostCapabilityLabels:    true,
+	CodehostCapabilityReviewers: true,
+	CodehostCapabilityAssignees: true,
+	CodehostCapabilityMilestone: true,
+	CodehostCapabilityAutoMerge: true,
+}
+
 // GetSupportedExternalServices returns the external service types currently supported
 // by the batch changes feature. Repos that are associated with external services
 // whose type is not in this list will simply be filtered out from the search
 // results.
+//
+// The capabilities of each external service type also determine which fields of
+// changeset templates can be used for its repositories: changeset specs setting
+// a field the code host doesn't support are rejected.
 func GetSupportedExternalServices() map[string]CodehostCapabilities {
 	supportedExternalServices := map[string]CodehostCapabilities{
-		extsvc.TypeGitHub:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
-		extsvc.TypeBitbucketServer: {},
-		extsvc.TypeGitLab:          {CodehostCapabilityLabels: true, CodehostCapabilityDraftChangesets: true},
+		extsvc.TypeGitHub:          withCapabilities(changesetMetadataCapabilities, CodehostCapabilityDraftChangesets),
+		extsvc.TypeBitbucketServer: {CodehostCapabilityReviewers: true},
+		extsvc.TypeGitLab:          withCapabilities(changesetMetadataCapabilities, CodehostCapabilityDraftChangesets),
 		extsvc.TypeBitbucketCloud:  {},
 		extsvc.TypeAzureDevOps:     {CodehostCapabilityDraftChangesets: true},
 		extsvc.TypeGerrit:          {CodehostCapabilityDraftChangesets: true},
+		extsvc.TypeGitea:           withCapabilities(changesetMetadataCapabilities, CodehostCapabilityDraftChangesets),
 	}
 	if c := conf.Get(); c.ExperimentalFeatures != nil && c.ExperimentalFeatures.BatchChangesEnablePerforce {
 		supportedExternalServices[extsvc.TypePerforce] = CodehostCapabilities{}
@@ -37,6 +57,19 @@ func GetSupportedExternalServices() map[string]CodehostCapabilities {
 	return supportedExternalServices
 }
 
+// withCapabilities returns a copy of the given capabilities with the given
+// additional capabilities enabled.
+func withCapabilities(base CodehostCapabilities, capabilities ...CodehostCapability) CodehostCapabilities {
+	c := make(CodehostCapabilities, len(base)+len(capabilities))
+	for k, v := range base {
+		c[k] = v
+	}
+	for _, capability := range capabilities {
+		c[capability] = true
+	}
+	return c
+}
+
 // IsRepoSupported returns whether the given ExternalRepoSpec is supported by
 // the batch changes feature, based on the external service type.
 func IsRepoSupported(spec *api.ExternalRepoSpec) bool {
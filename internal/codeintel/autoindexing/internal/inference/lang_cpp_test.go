@@ -0,0 +1,132 @@
+package inference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestCppGenerator(t *testing.T) {
+	testGenerators(t,
+		generatorTestCase{
+			description: "cpp without configured indexer",
+			repositoryContents: map[string]string{
+				"compile_commands.json": "[]",
+				"src/main.cc":           "",
+			},
+		},
+	)
+
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	// Without images for the build systems, only checked-in compilation
+	// databases are indexed.
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			CodeIntelAutoIndexingIndexerMap: map[string]string{"cpp": "registry.example.com/scip-clang@sha256:0123"},
+		},
+	})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "cpp CMake project without configured build image",
+			repositoryContents: map[string]string{
+				"CMakeLists.txt": "",
+				"lib/foo.cpp":    "",
+			},
+		},
+	)
+
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			CodeIntelAutoIndexingIndexerMap: map[string]string{
+				"cpp":   "registry.example.com/scip-clang@sha256:0123",
+				"cmake": "registry.example.com/cmake@sha256:4567",
+				"bazel": "registry.example.com/bazel@sha256:89ab",
+			},
+		},
+	})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "cpp compile_commands.json",
+			repositoryContents: map[string]string{
+				"compile_commands.json": "[]",
+				"CMakeLists.txt":        "",
+				"src/main.cc":           "",
+			},
+		},
+		generatorTestCase{
+			description: "cpp nested compile_commands.json",
+			repositoryContents: map[string]string{
+				"client/compile_commands.json": "[]",
+				"server/compile_commands.json": "[]",
+			},
+		},
+		generatorTestCase{
+			description: "cpp CMake project without compile_commands.json",
+			repositoryContents: map[string]string{
+				"CMakeLists.txt":     "",
+				"lib/CMakeLists.txt": "",
+				"lib/foo.cpp":        "",
+			},
+		},
+		generatorTestCase{
+			description: "cpp cmake",
+			repositoryContents: map[string]string{
+				"CMakeLists.txt":           "",
+				"lib/CMakeLists.txt":       "",
+				"lib/foo.cpp":              "",
+				"tools/bar/CMakeLists.txt": "",
+			},
+		},
+		generatorTestCase{
+			description: "cpp cmake nested projects",
+			repositoryContents: map[string]string{
+				"client/CMakeLists.txt":     "",
+				"client/src/CMakeLists.txt": "",
+				"server/CMakeLists.txt":     "",
+			},
+		},
+		generatorTestCase{
+			description: "cpp bazel",
+			repositoryContents: map[string]string{
+				"WORKSPACE":          `http_archive(name = "hedron_compile_commands")`,
+				"BUILD.bazel":        "",
+				"other/MODULE.bazel": `bazel_dep(name = "rules_go")`,
+			},
+		},
+	)
+}
+
+func TestCppGeneratorCMakeSteps(t *testing.T) {
+	t.Cleanup(func() { conf.Mock(nil) })
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			CodeIntelAutoIndexingIndexerMap: map[string]string{
+				"cpp":   "registry.example.com/scip-clang@sha256:0123",
+				"cmake": "registry.example.com/cmake@sha256:4567",
+			},
+		},
+	})
+
+	service := testService(t, map[string]string{"CMakeLists.txt": "", "src/main.cc": ""})
+	result, err := service.InferIndexJobs(context.Background(), "github.com/test/test", "HEAD", "")
+	require.NoError(t, err)
+	require.Len(t, result.IndexJobs, 1)
+
+	// Generated headers only exist after building the project, so configuring
+	// it is not enough for scip-clang.
+	job := result.IndexJobs[0]
+	require.Len(t, job.Steps, 1)
+	require.Equal(t, "registry.example.com/cmake@sha256:4567", job.Steps[0].Image)
+	require.Equal(t, []string{
+		"cmake -S . -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON",
+		"cmake --build build",
+	}, job.Steps[0].Commands)
+	require.Equal(t, []string{"scip-clang", "--compdb-path=build/compile_commands.json"}, job.IndexerArgs)
+}
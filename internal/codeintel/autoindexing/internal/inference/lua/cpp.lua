@@ -0,0 +1,187 @@
+local path = require "path"
+local recognizer = require "sg.autoindex.recognizer"
+local pattern = require "sg.autoindex.patterns"
+
+local shared = require "sg.autoindex.shared"
+local util = require "sg.autoindex.util"
+
+local indexes = require "sg.autoindex.indexes"
+
+-- There is no published scip-clang image that we could pin a default to, so
+-- C and C++ projects are only indexed once an indexer is configured for "cpp"
+-- via codeIntelAutoIndexing.indexerMap in the site configuration.
+local has_indexer, indexer = pcall(indexes.get, "cpp")
+
+-- The scip-clang image doesn't ship any build systems. Generating a
+-- compilation database from the build configuration requires an image with
+-- the build system, configured as "cmake" or "bazel" in the indexer map.
+--
+-- The compilation database written by hedron_compile_commands points into
+-- the external/ and bazel-out/ symlinks of Bazel's output base, which lives
+-- outside of the shared workspace. So the "bazel" image must ship scip-clang
+-- as well, and runs both the extraction and the indexer in one container.
+local has_cmake, cmake_image = pcall(indexes.get, "cmake")
+local has_bazel, bazel_image = pcall(indexes.get, "bazel")
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine(shared.exclude_paths, {
+  pattern.new_path_segment "build",
+  pattern.new_path_segment "third_party",
+  pattern.new_path_segment "vendor",
+})
+
+local make_job = function(root, steps, compdb_path)
+  return {
+    steps = steps,
+    root = root,
+    indexer = indexer,
+    indexer_args = { "scip-clang", "--compdb-path=" .. compdb_path },
+    outfile = outfile,
+  }
+end
+
+-- Returns the directories of the given paths that are not nested within
+-- the directory of another path. Nested CMakeLists.txt files are pulled in
+-- by their parent project via add_subdirectory and must not be indexed on
+-- their own.
+local top_level_roots = function(paths)
+  local roots = {}
+  for i = 1, #paths do
+    table.insert(roots, path.dirname(paths[i]))
+  end
+
+  local top_level = {}
+  for i = 1, #roots do
+    local ancestors = path.ancestors(roots[i])
+
+    local nested = false
+    for j = 1, #ancestors do
+      if ancestors[j] ~= roots[i] and util.contains(roots, ancestors[j]) then
+        nested = true
+        break
+      end
+    end
+
+    if not nested then
+      table.insert(top_level, roots[i])
+    end
+  end
+
+  return top_level
+end
+
+local compdb_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "compile_commands.json",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when a compilation database is checked into the repository
+  generate = function(_, paths)
+    if not has_indexer then
+      return {}
+    end
+
+    local jobs = {}
+    for i = 1, #paths do
+      table.insert(jobs, make_job(path.dirname(paths[i]), {}, "compile_commands.json"))
+    end
+
+    return jobs
+  end,
+}
+
+local cmake_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "CMakeLists.txt",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when CMake projects exist; the compilation database is
+  -- generated during configuration of each top-level project. The project is
+  -- built as well, as sources commonly include headers that are only
+  -- generated during the build. The build directory is part of the shared
+  -- workspace, so scip-clang can read them.
+  generate = function(_, paths)
+    if not has_indexer or not has_cmake then
+      return {}
+    end
+
+    local jobs = {}
+    for _, root in ipairs(top_level_roots(paths)) do
+      table.insert(
+        jobs,
+        make_job(root, {
+          {
+            root = root,
+            image = cmake_image,
+            commands = {
+              "cmake -S . -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON",
+              "cmake --build build",
+            },
+          },
+        }, "build/compile_commands.json")
+      )
+    end
+
+    return jobs
+  end,
+}
+
+local bazel_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "WORKSPACE",
+    pattern.new_path_basename "WORKSPACE.bazel",
+    pattern.new_path_basename "MODULE.bazel",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  patterns_for_content = {
+    pattern.new_path_basename "WORKSPACE",
+    pattern.new_path_basename "WORKSPACE.bazel",
+    pattern.new_path_basename "MODULE.bazel",
+  },
+
+  -- Invoked when Bazel workspaces exist. Bazel cannot emit a compilation
+  -- database on its own, so we only generate jobs for workspaces that have
+  -- the hedron_compile_commands extractor configured. The extractor runs as a
+  -- local step of the indexer, so that scip-clang sees the same output base.
+  generate = function(_, paths, contents_by_path)
+    if not has_bazel then
+      return {}
+    end
+
+    local roots = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+      local content = contents_by_path[paths[i]] or ""
+      if string.find(content, "hedron_compile_commands", 1, true) and not util.contains(roots, root) then
+        table.insert(roots, root)
+      end
+    end
+    -- Sort roots so the generated jobs are stable
+    table.sort(roots)
+
+    local jobs = {}
+    for _, root in ipairs(roots) do
+      table.insert(jobs, {
+        steps = {},
+        local_steps = { "bazel run @hedron_compile_commands//:refresh_all" },
+        root = root,
+        indexer = bazel_image,
+        indexer_args = { "scip-clang", "--compdb-path=compile_commands.json" },
+        outfile = outfile,
+      })
+    end
+
+    return jobs
+  end,
+}
+
+-- A checked-in compilation database takes precedence over one we would
+-- have to generate from the build configuration
+return recognizer.new_fallback_recognizer {
+  compdb_recognizer,
+  cmake_recognizer,
+  bazel_recognizer,
+}
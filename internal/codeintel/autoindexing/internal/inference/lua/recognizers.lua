@@ -9,6 +9,7 @@ for _, name in ipairs({
   "test",
   "typescript",
   "dotnet",
+  "cpp",
 }) do
   -- Backdoor set `sg.`-prefixed recognizers
   rawset(config, "sg." .. name, require("sg.autoindex." .. name))
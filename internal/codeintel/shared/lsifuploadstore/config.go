@@ -27,16 +27,22 @@ type Config struct {
 	GCSProjectID               string
 	GCSCredentialsFile         string
 	GCSCredentialsFileContents string
+
+	AzureAccountName string
+	AzureAccountKey  string
+	AzureEndpoint    string
+
+	LocalDir string
 }
 
 func (c *Config) Load() {
-	c.Backend = strings.ToLower(c.Get("PRECISE_CODE_INTEL_UPLOAD_BACKEND", "blobstore", "The target file service for code intelligence uploads. S3, GCS, and Blobstore are supported."))
+	c.Backend = strings.ToLower(c.Get("PRECISE_CODE_INTEL_UPLOAD_BACKEND", "blobstore", "The target file service for code intelligence uploads. S3, GCS, Azure, Local, and Blobstore are supported."))
 	c.ManageBucket = c.GetBool("PRECISE_CODE_INTEL_UPLOAD_MANAGE_BUCKET", "false", "Whether or not the client should manage the target bucket configuration.")
 	c.Bucket = c.Get("PRECISE_CODE_INTEL_UPLOAD_BUCKET", "lsif-uploads", "The name of the bucket to store LSIF uploads in.")
 	c.TTL = c.GetInterval("PRECISE_CODE_INTEL_UPLOAD_TTL", "168h", "The maximum age of an upload before deletion.")
 
-	if c.Backend != "blobstore" && c.Backend != "s3" && c.Backend != "gcs" {
-		c.AddError(errors.Errorf("invalid backend %q for PRECISE_CODE_INTEL_UPLOAD_BACKEND: must be S3, GCS, or Blobstore", c.Backend))
+	if c.Backend != "blobstore" && c.Backend != "s3" && c.Backend != "gcs" && c.Backend != "azure" && c.Backend != "local" {
+		c.AddError(errors.Errorf("invalid backend %q for PRECISE_CODE_INTEL_UPLOAD_BACKEND: must be S3, GCS, Azure, Local, or Blobstore", c.Backend))
 	}
 
 	if c.Backend == "blobstore" || c.Backend == "s3" {
@@ -54,5 +60,15 @@ func (c *Config) Load() {
 		c.GCSProjectID = c.Get("PRECISE_CODE_INTEL_UPLOAD_GCP_PROJECT_ID", "", "The project containing the GCS bucket.")
 		c.GCSCredentialsFile = c.GetOptional("PRECISE_CODE_INTEL_UPLOAD_GOOGLE_APPLICATION_CREDENTIALS_FILE", "The path to a service account key file with access to GCS.")
 		c.GCSCredentialsFileContents = c.GetOptional("PRECISE_CODE_INTEL_UPLOAD_GOOGLE_APPLICATION_CREDENTIALS_FILE_CONTENT", "The contents of a service account key file with access to GCS.")
+	} else if c.Backend == "azure" {
+		c.AzureAccountName = c.GetOptional("PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_NAME", "The Azure storage account containing the container named by the bucket.")
+		c.AzureAccountKey = c.GetOptional("PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_KEY", "An optional shared key of the Azure storage account. If unset, the default Azure credential chain is used.")
+		c.AzureEndpoint = c.GetOptional("PRECISE_CODE_INTEL_UPLOAD_AZURE_ENDPOINT", "An optional URL of the Azure blob service, e.g. for Azurite.")
+
+		if c.AzureAccountName == "" && c.AzureEndpoint == "" {
+			c.AddError(errors.New("PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_NAME or PRECISE_CODE_INTEL_UPLOAD_AZURE_ENDPOINT must be set for the Azure backend"))
+		}
+	} else if c.Backend == "local" {
+		c.LocalDir = c.Get("PRECISE_CODE_INTEL_UPLOAD_LOCAL_DIR", "", "The directory to store code intelligence uploads in, which contains a directory per bucket.")
 	}
 }
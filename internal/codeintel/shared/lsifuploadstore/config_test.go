@@ -106,6 +106,58 @@ func TestConfigGCS(t *testing.T) {
 	}
 }
 
+func TestConfigAzure(t *testing.T) {
+	env := map[string]string{
+		"PRECISE_CODE_INTEL_UPLOAD_BACKEND":            "Azure",
+		"PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_NAME": "test-account",
+		"PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_KEY":  "test-account-key",
+	}
+
+	config := Config{}
+	config.SetMockGetter(mapGetter(env))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if config.AzureAccountName != "test-account" {
+		t.Errorf("unexpected value for Azure.AccountName. want=%s have=%s", "test-account", config.AzureAccountName)
+	}
+	if config.AzureAccountKey != "test-account-key" {
+		t.Errorf("unexpected value for Azure.AccountKey. want=%s have=%s", "test-account-key", config.AzureAccountKey)
+	}
+}
+
+func TestConfigAzureMissingAccount(t *testing.T) {
+	config := Config{}
+	config.SetMockGetter(mapGetter(map[string]string{"PRECISE_CODE_INTEL_UPLOAD_BACKEND": "azure"}))
+	config.Load()
+
+	if err := config.Validate(); err == nil {
+		t.Fatal("expected a validation error")
+	}
+}
+
+func TestConfigLocal(t *testing.T) {
+	env := map[string]string{
+		"PRECISE_CODE_INTEL_UPLOAD_BACKEND":   "local",
+		"PRECISE_CODE_INTEL_UPLOAD_LOCAL_DIR": "/data/uploads",
+	}
+
+	config := Config{}
+	config.SetMockGetter(mapGetter(env))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if config.LocalDir != "/data/uploads" {
+		t.Errorf("unexpected value for Local.Dir. want=%s have=%s", "/data/uploads", config.LocalDir)
+	}
+}
+
 func mapGetter(env map[string]string) func(name, defaultValue, description string) string {
 	return func(name, defaultValue, description string) string {
 		if v, ok := env[name]; ok {
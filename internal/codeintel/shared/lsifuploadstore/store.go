@@ -25,6 +25,14 @@ func New(ctx context.Context, observationCtx *observation.Context, conf *Config)
 			CredentialsFile:         conf.GCSCredentialsFile,
 			CredentialsFileContents: conf.GCSCredentialsFileContents,
 		},
+		Azure: object.AzureConfig{
+			AccountName: conf.AzureAccountName,
+			AccountKey:  conf.AzureAccountKey,
+			Endpoint:    conf.AzureEndpoint,
+		},
+		Local: object.LocalConfig{
+			Dir: conf.LocalDir,
+		},
 	}
 
 	return object.CreateLazyStorage(ctx, c, object.NewOperations(observationCtx, "codeintel", "uploadstore"))
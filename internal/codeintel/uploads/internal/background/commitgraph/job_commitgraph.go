@@ -156,7 +156,7 @@ func (s *commitGraphUpdater) getCommitGraph(ctx context.Context, repositoryID in
 	}
 	commitWithDate, ok := optCommitWithDate.Get()
 	if !ok {
-		return commitgraph.ParseCommitGraph(nil), nil
+		return commitgraph.NewCommitGraph(nil), nil
 	}
 
 	siteConfig := conf.SiteConfig()
@@ -176,18 +176,18 @@ func (s *commitGraphUpdater) getCommitGraph(ctx context.Context, repositoryID in
 		}
 	}
 
-	var opts gitserver.CommitsOptions
+	var opts gitserver.CommitGraphOptions
 	switch strat {
 	case HeadTopoOnly:
-		opts = gitserver.CommitsOptions{
+		opts = gitserver.CommitGraphOptions{
 			Ranges: []string{string(commitWithDate.Commit) + ".." + defaultBranchRef},
 			Order:  gitserver.CommitsOrderTopoDate,
 		}
 	case AllRefsSince:
-		opts = gitserver.CommitsOptions{
+		opts = gitserver.CommitGraphOptions{
 			AllRefs: true,
 			Order:   gitserver.CommitsOrderTopoDate,
-			// The --since flag for git log is exclusive, but we want to include the commit where the
+			// The --since flag for git rev-list is exclusive, but we want to include the commit where the
 			// oldest dump is defined. This flag only has second resolution, so we shouldn't be pulling
 			// back any more data than we wanted.
 			After: commitWithDate.CommitterDate.Add(-time.Second),
@@ -196,12 +196,14 @@ func (s *commitGraphUpdater) getCommitGraph(ctx context.Context, repositoryID in
 		panic(fmt.Sprintf("Unhandled case for strategy: %q", strat))
 	}
 
-	commits, err := s.gitserverClient.Commits(ctx, repo, opts)
+	// We only need the ancestry of the commits, which is a lot cheaper to fetch
+	// than full commits for repositories with many commits.
+	nodes, err := s.gitserverClient.CommitGraph(ctx, repo, opts)
 	if err != nil {
-		return nil, errors.Wrap(err, "gitserver.Commits")
+		return nil, errors.Wrap(err, "gitserver.CommitGraph")
 	}
 
-	commitGraph := commitgraph.ParseCommitGraph(commits)
+	commitGraph := commitgraph.NewCommitGraph(nodes)
 
 	return commitGraph, nil
 }
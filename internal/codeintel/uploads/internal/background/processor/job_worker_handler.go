@@ -24,11 +24,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/codegraph"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/store"
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/object"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -129,10 +131,21 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, upload uploadss
 	}()
 
 	requeued, err = h.HandleRawUpload(ctx, logger, upload, h.uploadStore, tr)
+	if !requeued && (err == nil || isTerminalFailure(upload, err)) {
+		events.EnqueuePreciseIndex(ctx, logger, h.store.Handle(), upload, err)
+	}
 
 	return err
 }
 
+// isTerminalFailure returns true if the upload won't be processed again after
+// failing with err, mirroring how the worker decides between marking the record
+// as failed or errored.
+func isTerminalFailure(upload uploadsshared.Upload, err error) bool {
+	opts := store.UploadWorkerStoreOptions
+	return errcode.IsNonRetryable(err) || opts.RetryAfter == 0 || upload.NumFailures+1 >= opts.MaxNumRetries
+}
+
 func (h *handler) PreDequeue(_ context.Context, _ log.Logger) (bool, any, error) {
 	if !h.enableBudget {
 		return true, nil, nil
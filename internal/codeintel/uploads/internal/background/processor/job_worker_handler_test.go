@@ -26,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/storemocks"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -453,6 +454,31 @@ func setupRepoMocks(t *testing.T) {
 	}
 }
 
+func TestIsTerminalFailure(t *testing.T) {
+	oldOptions := store.UploadWorkerStoreOptions
+	t.Cleanup(func() { store.UploadWorkerStoreOptions = oldOptions })
+
+	err := errors.New("uh-oh")
+
+	store.UploadWorkerStoreOptions.RetryAfter = 0
+	store.UploadWorkerStoreOptions.MaxNumRetries = 0
+	if !isTerminalFailure(shared.Upload{}, err) {
+		t.Error("expected failure to be terminal when retries are disabled")
+	}
+
+	store.UploadWorkerStoreOptions.RetryAfter = time.Minute
+	store.UploadWorkerStoreOptions.MaxNumRetries = 3
+	if isTerminalFailure(shared.Upload{NumFailures: 1}, err) {
+		t.Error("expected failure to be retried")
+	}
+	if !isTerminalFailure(shared.Upload{NumFailures: 2}, err) {
+		t.Error("expected last failure to be terminal")
+	}
+	if !isTerminalFailure(shared.Upload{NumFailures: 0}, errcode.MakeNonRetryable(err)) {
+		t.Error("expected non-retryable failure to be terminal")
+	}
+}
+
 func defaultMockRepoStore() *dbmocks.MockRepoStore {
 	repoStore := dbmocks.NewMockRepoStore()
 	repoStore.GetFunc.SetDefaultHook(func(ctx context.Context, id api.RepoID) (*internaltypes.Repo, error) {
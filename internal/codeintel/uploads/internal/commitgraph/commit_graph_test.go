@@ -162,6 +162,56 @@ func TestCalculateVisibleUploadsAlternateCommitGraph(t *testing.T) {
 // Benchmarks
 //
 
+func TestNewCommitGraph(t *testing.T) {
+	oid := func(b byte) gitdomain.OID { return gitdomain.OID{b} }
+	node := func(id byte, parents ...byte) *gitdomain.CommitGraphNode {
+		n := &gitdomain.CommitGraphNode{ID: oid(id), Parents: []gitdomain.OID{}}
+		for _, parent := range parents {
+			n.Parents = append(n.Parents, oid(parent))
+		}
+		return n
+	}
+	commit := func(id byte, parents ...byte) *gitdomain.Commit {
+		parentIDs := make([]string, 0, len(parents))
+		for _, parent := range parents {
+			parentIDs = append(parentIDs, oid(parent).String())
+		}
+		return gitCommit(oid(id).String(), parentIDs...)
+	}
+
+	// Commit 2 is outside of the requested range, but referenced as a parent.
+	graph := NewCommitGraph([]*gitdomain.CommitGraphNode{
+		node(5, 3, 4),
+		node(4, 1),
+		node(3, 1, 2),
+		node(1),
+	})
+	expected := ParseCommitGraph([]*gitdomain.Commit{
+		commit(5, 3, 4),
+		commit(4, 1),
+		commit(3, 1, 2),
+		commit(1),
+	})
+
+	if diff := cmp.Diff(expected.Graph(), graph.Graph()); diff != "" {
+		t.Errorf("unexpected graph (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expected.Order(), graph.Order()); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s", diff)
+	}
+
+	expectedOrder := []api.CommitID{
+		api.CommitID(oid(2).String()),
+		api.CommitID(oid(1).String()),
+		api.CommitID(oid(3).String()),
+		api.CommitID(oid(4).String()),
+		api.CommitID(oid(5).String()),
+	}
+	if diff := cmp.Diff(expectedOrder, graph.Order()); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s", diff)
+	}
+}
+
 func BenchmarkCalculateVisibleUploads(b *testing.B) {
 	commitGraph, err := readBenchmarkCommitGraph()
 	if err != nil {
@@ -20,28 +20,46 @@ func (c *CommitGraph) Order() []api.CommitID                  { return c.order }
 // ordering is to be correct, the given commits must be ordered with
 // gitserver.CommitsOrderTopoDate.
 func ParseCommitGraph(commits []*gitdomain.Commit) *CommitGraph {
-	// Process lines backwards so that we see all parents before children. We get a
-	// topological ordering by simply scraping the keys off in this order.
+	return buildCommitGraph(len(commits), func(i int) (api.CommitID, []api.CommitID) {
+		return commits[i].ID, commits[i].Parents
+	})
+}
 
-	n := len(commits) - 1
-	for i := range len(commits) / 2 {
-		commits[i], commits[n-i] = commits[n-i], commits[i]
-	}
+// NewCommitGraph is like ParseCommitGraph, but builds the graph from the nodes
+// returned by gitserver's CommitGraph, which are a lot cheaper to fetch than full
+// commits. If the ordering is to be correct, the given nodes must be ordered with
+// gitserver.CommitsOrderTopoDate.
+func NewCommitGraph(nodes []*gitdomain.CommitGraphNode) *CommitGraph {
+	return buildCommitGraph(len(nodes), func(i int) (api.CommitID, []api.CommitID) {
+		parents := make([]api.CommitID, len(nodes[i].Parents))
+		for j, parent := range nodes[i].Parents {
+			parents[j] = api.CommitID(parent.String())
+		}
+		return api.CommitID(nodes[i].ID.String()), parents
+	})
+}
 
-	graph := make(map[api.CommitID][]api.CommitID, len(commits))
-	order := make([]api.CommitID, 0, len(commits))
+// buildCommitGraph builds the commit graph of n commits, where commit returns the
+// ID and parents of the commit at the given index. Commits must be ordered such
+// that children come before their parents.
+func buildCommitGraph(n int, commit func(i int) (api.CommitID, []api.CommitID)) *CommitGraph {
+	graph := make(map[api.CommitID][]api.CommitID, n)
+	order := make([]api.CommitID, 0, n)
 
+	// Process commits backwards so that we see all parents before children. We get a
+	// topological ordering by simply scraping the keys off in this order.
 	var prefix []api.CommitID
-	for _, commit := range commits {
-		if len(commit.Parents) == 0 {
-			graph[commit.ID] = []api.CommitID{}
+	for i := n - 1; i >= 0; i-- {
+		id, parents := commit(i)
+		if len(parents) == 0 {
+			graph[id] = []api.CommitID{}
 		} else {
-			graph[commit.ID] = commit.Parents
+			graph[id] = parents
 		}
 
-		order = append(order, commit.ID)
+		order = append(order, id)
 
-		for _, parent := range commit.Parents {
+		for _, parent := range parents {
 			if _, ok := graph[parent]; !ok {
 				graph[parent] = []api.CommitID{}
 				prefix = append(prefix, parent)
@@ -0,0 +1,472 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxIssueResults is the maximum number of matched commits listed in a single
+// issue or issue comment.
+const maxIssueResults = 50
+
+// maxIssueSearchPages is the maximum number of pages of open issues searched
+// for an issue previously opened by a code monitor.
+const maxIssueSearchPages = 10
+
+// issueClient opens issues and comments on them in the repository an issue
+// action is configured for.
+type issueClient interface {
+	// IssueIsOpen reports whether the issue with the given number is still open.
+	IssueIsOpen(ctx context.Context, number int32) (bool, error)
+	// CreateIssue opens a new issue and returns its number.
+	CreateIssue(ctx context.Context, title, body string) (int32, error)
+	// CreateComment adds a comment to the issue with the given number.
+	CreateComment(ctx context.Context, number int32, body string) error
+	// HasComment reports whether one of the most recent comments on the issue
+	// with the given number contains marker.
+	HasComment(ctx context.Context, number int32, marker string) (bool, error)
+	// FindOpenIssue returns the open issue whose body contains marker, or nil
+	// if there is none.
+	FindOpenIssue(ctx context.Context, marker string) (*openIssue, error)
+}
+
+// openIssue is an open issue found by issueClient.FindOpenIssue.
+type openIssue struct {
+	Number int32
+	Body   string
+}
+
+// issueMonitorMarker returns the hidden marker embedded in the body of every
+// issue opened by a code monitor, so issues can be traced back to the monitor
+// that opened them.
+func issueMonitorMarker(monitorID int64) string {
+	return fmt.Sprintf("<!-- sourcegraph-code-monitor-id: %d -->", monitorID)
+}
+
+// issueJobMarker returns the hidden marker embedded in the body of an issue or
+// comment created by the given action job, so a retry of the job doesn't report
+// the same matches twice.
+func issueJobMarker(jobID int32) string {
+	return fmt.Sprintf("<!-- sourcegraph-code-monitor-action-job-id: %d -->", jobID)
+}
+
+// upsertIssue comments on the issue previously opened for the monitor if it is
+// still open, and opens a new issue otherwise. It returns the number of the
+// issue that was commented on or opened, and whether it differs from
+// issueNumber.
+//
+// Since the number of a newly opened issue may fail to be stored, the open
+// issues of the repository are searched for one opened by the monitor before
+// opening a new one.
+func upsertIssue(ctx context.Context, client issueClient, issueNumber *int32, jobID int32, args actionArgs) (_ int32, changed bool, _ error) {
+	if issueNumber != nil {
+		open, err := client.IssueIsOpen(ctx, *issueNumber)
+		if err != nil {
+			return 0, false, errors.Wrap(err, "checking issue state")
+		}
+		if open {
+			if err := commentOnIssue(ctx, client, *issueNumber, jobID, args); err != nil {
+				return 0, false, err
+			}
+			return *issueNumber, false, nil
+		}
+	}
+
+	issue, err := client.FindOpenIssue(ctx, issueMonitorMarker(args.MonitorID))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "finding open issue")
+	}
+	if issue != nil {
+		// If the issue was opened by an earlier attempt of this job, it
+		// already lists the matches.
+		if !strings.Contains(issue.Body, issueJobMarker(jobID)) {
+			if err := commentOnIssue(ctx, client, issue.Number, jobID, args); err != nil {
+				return 0, false, err
+			}
+		}
+		return issue.Number, true, nil
+	}
+
+	body := issueMarkdown(args) + "\n" + issueMonitorMarker(args.MonitorID) + "\n" + issueJobMarker(jobID) + "\n"
+	number, err := client.CreateIssue(ctx, issueTitle(args), body)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "creating issue")
+	}
+	return number, true, nil
+}
+
+// commentOnIssue lists the matches in a comment on the issue with the given
+// number, unless an earlier attempt of the job commented on it already.
+func commentOnIssue(ctx context.Context, client issueClient, number int32, jobID int32, args actionArgs) error {
+	commented, err := client.HasComment(ctx, number, issueJobMarker(jobID))
+	if err != nil {
+		return errors.Wrap(err, "checking issue comments")
+	}
+	if commented {
+		return nil
+	}
+
+	body := issueMarkdown(args) + "\n" + issueJobMarker(jobID) + "\n"
+	return errors.Wrap(client.CreateComment(ctx, number, body), "creating issue comment")
+}
+
+func issueTitle(args actionArgs) string {
+	return fmt.Sprintf("Code monitor %q detected new matches", args.MonitorDescription)
+}
+
+// issueMarkdown renders the matched commits as a Markdown list, optionally
+// followed by the content of each match.
+func issueMarkdown(args actionArgs) string {
+	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, maxIssueResults)
+
+	var b strings.Builder
+	fmt.Fprintf(&b,
+		"%s's Sourcegraph code monitor, **%s**, detected **%d** new matches.\n\n",
+		args.MonitorOwnerName,
+		args.MonitorDescription,
+		totalCount,
+	)
+
+	for _, result := range truncatedResults {
+		resultType := "Message"
+		if result.DiffPreview != nil {
+			resultType = "Diff"
+		}
+		fmt.Fprintf(&b, "- %s match: [%s@%s](%s)\n",
+			resultType,
+			result.Repo.Name,
+			result.Commit.ID.Short(),
+			getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+		)
+		if args.IncludeResults {
+			fmt.Fprintf(&b, "\n  %s\n\n", strings.ReplaceAll(formatIssueCodeBlock(truncateMatchContent(result)), "\n", "\n  "))
+		}
+	}
+	if truncatedCount > 0 {
+		fmt.Fprintf(&b, "- ...and %d more matches.\n", truncatedCount)
+	}
+
+	fmt.Fprintf(&b, "\n[View results](%s) | [Edit code monitor](%s)\n",
+		getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+	)
+	return b.String()
+}
+
+func formatIssueCodeBlock(s string) string {
+	return "```\n" + strings.TrimSuffix(strings.ReplaceAll(s, "```", "\\`\\`\\`"), "\n") + "\n```"
+}
+
+// newIssueClient returns an issueClient for the given repository, authenticated
+// with the token of the code host connection the repository is synced from.
+// The owner of the monitor must be a site admin who can see the repository.
+func newIssueClient(ctx context.Context, db database.DB, doer httpcli.Doer, ownerID int32, repoID api.RepoID) (issueClient, error) {
+	// 🚨 SECURITY: The token of the code host connection can usually write to
+	// repositories the owner can only read, so only site admins may use issue
+	// actions. The owner may have been demoted since configuring the action.
+	owner, err := db.Users().GetByID(ctx, ownerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting monitor owner")
+	}
+	if !owner.SiteAdmin {
+		return nil, errors.Errorf("monitor owner %q is not a site admin", owner.Username)
+	}
+
+	// 🚨 SECURITY: Load the repository as the owner, so that issues aren't
+	// opened in repositories the owner lost access to.
+	repo, err := db.Repos().Get(actor.WithActor(ctx, actor.FromUser(ownerID)), repoID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting repository")
+	}
+
+	token, err := loadIssueToken(ctx, db.ExternalServices(), repo)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL, err := url.Parse(repo.ExternalRepo.ServiceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing code host URL")
+	}
+
+	switch meta := repo.Metadata.(type) {
+	case *github.Repository:
+		apiURL, _ := github.APIRoot(baseURL)
+		return &githubIssueClient{
+			doer:          doer,
+			apiURL:        apiURL,
+			token:         token,
+			nameWithOwner: meta.NameWithOwner,
+		}, nil
+	case *gitlab.Project:
+		return &gitlabIssueClient{
+			doer:      doer,
+			apiURL:    baseURL.ResolveReference(&url.URL{Path: path.Join(baseURL.Path, "api/v4") + "/"}),
+			token:     token,
+			projectID: meta.ID,
+		}, nil
+	default:
+		return nil, errors.Errorf("issue actions are not supported for repositories of type %q", repo.ExternalRepo.ServiceType)
+	}
+}
+
+// loadIssueToken returns the token of the first GitHub or GitLab code host
+// connection that syncs the given repository.
+func loadIssueToken(ctx context.Context, s database.ExternalServiceStore, repo *types.Repo) (string, error) {
+	svcs, err := s.List(ctx, database.ExternalServicesListOptions{
+		RepoID: repo.ID,
+		Kinds:  []string{extsvc.KindGitHub, extsvc.KindGitLab},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "listing code host connections")
+	}
+
+	for _, svc := range svcs {
+		token, err := extsvc.ExtractEncryptableToken(ctx, svc.Config, svc.Kind)
+		if err != nil {
+			return "", err
+		}
+		if token != "" {
+			return token, nil
+		}
+	}
+	return "", errors.Errorf("no code host connection with a token found for repository %q", repo.Name)
+}
+
+type githubIssueClient struct {
+	doer          httpcli.Doer
+	apiURL        *url.URL
+	token         string
+	nameWithOwner string
+}
+
+func (c *githubIssueClient) url(elem ...string) string {
+	return c.apiURL.JoinPath(append([]string{"repos", c.nameWithOwner, "issues"}, elem...)...).String()
+}
+
+func (c *githubIssueClient) IssueIsOpen(ctx context.Context, number int32) (bool, error) {
+	var issue struct {
+		State string `json:"state"`
+	}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url(strconv.Itoa(int(number))), nil, &issue); err != nil {
+		return false, err
+	}
+	return issue.State == "open", nil
+}
+
+func (c *githubIssueClient) CreateIssue(ctx context.Context, title, body string) (int32, error) {
+	var issue struct {
+		Number int32 `json:"number"`
+	}
+	payload := map[string]string{"title": title, "body": body}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodPost, c.url(), payload, &issue); err != nil {
+		return 0, err
+	}
+	return issue.Number, nil
+}
+
+func (c *githubIssueClient) CreateComment(ctx context.Context, number int32, body string) error {
+	payload := map[string]string{"body": body}
+	return doIssueRequest(ctx, c.doer, c.token, http.MethodPost, c.url(strconv.Itoa(int(number)), "comments"), payload, nil)
+}
+
+func (c *githubIssueClient) HasComment(ctx context.Context, number int32, marker string) (bool, error) {
+	// Comments are listed oldest first, so we look at the last page.
+	var issue struct {
+		Comments int `json:"comments"`
+	}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url(strconv.Itoa(int(number))), nil, &issue); err != nil {
+		return false, err
+	}
+	if issue.Comments == 0 {
+		return false, nil
+	}
+
+	q := url.Values{
+		"per_page": {"100"},
+		"page":     {strconv.Itoa((issue.Comments + 99) / 100)},
+	}
+	var comments []struct {
+		Body string `json:"body"`
+	}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url(strconv.Itoa(int(number)), "comments")+"?"+q.Encode(), nil, &comments); err != nil {
+		return false, err
+	}
+	for _, comment := range comments {
+		if strings.Contains(comment.Body, marker) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *githubIssueClient) FindOpenIssue(ctx context.Context, marker string) (*openIssue, error) {
+	// The issues API doesn't support searching the body, so we look through
+	// the most recently opened issues.
+	for page := 1; page <= maxIssueSearchPages; page++ {
+		q := url.Values{
+			"state":     {"open"},
+			"sort":      {"created"},
+			"direction": {"desc"},
+			"per_page":  {"100"},
+			"page":      {strconv.Itoa(page)},
+		}
+		var issues []struct {
+			Number      int32           `json:"number"`
+			Body        string          `json:"body"`
+			PullRequest json.RawMessage `json:"pull_request"`
+		}
+		if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url()+"?"+q.Encode(), nil, &issues); err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			// Pull requests are listed as issues as well.
+			if issue.PullRequest == nil && strings.Contains(issue.Body, marker) {
+				return &openIssue{Number: issue.Number, Body: issue.Body}, nil
+			}
+		}
+		if len(issues) < 100 {
+			break
+		}
+	}
+	return nil, nil
+}
+
+type gitlabIssueClient struct {
+	doer      httpcli.Doer
+	apiURL    *url.URL
+	token     string
+	projectID int
+}
+
+func (c *gitlabIssueClient) url(elem ...string) string {
+	return c.apiURL.JoinPath(append([]string{"projects", strconv.Itoa(c.projectID), "issues"}, elem...)...).String()
+}
+
+func (c *gitlabIssueClient) IssueIsOpen(ctx context.Context, number int32) (bool, error) {
+	var issue struct {
+		State string `json:"state"`
+	}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url(strconv.Itoa(int(number))), nil, &issue); err != nil {
+		return false, err
+	}
+	return issue.State == "opened", nil
+}
+
+func (c *gitlabIssueClient) CreateIssue(ctx context.Context, title, body string) (int32, error) {
+	var issue struct {
+		IID int32 `json:"iid"`
+	}
+	payload := map[string]string{"title": title, "description": body}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodPost, c.url(), payload, &issue); err != nil {
+		return 0, err
+	}
+	return issue.IID, nil
+}
+
+func (c *gitlabIssueClient) CreateComment(ctx context.Context, number int32, body string) error {
+	payload := map[string]string{"body": body}
+	return doIssueRequest(ctx, c.doer, c.token, http.MethodPost, c.url(strconv.Itoa(int(number)), "notes"), payload, nil)
+}
+
+func (c *gitlabIssueClient) HasComment(ctx context.Context, number int32, marker string) (bool, error) {
+	q := url.Values{
+		"sort":     {"desc"},
+		"order_by": {"created_at"},
+		"per_page": {"100"},
+	}
+	var notes []struct {
+		Body string `json:"body"`
+	}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url(strconv.Itoa(int(number)), "notes")+"?"+q.Encode(), nil, &notes); err != nil {
+		return false, err
+	}
+	for _, note := range notes {
+		if strings.Contains(note.Body, marker) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *gitlabIssueClient) FindOpenIssue(ctx context.Context, marker string) (*openIssue, error) {
+	q := url.Values{
+		"state":    {"opened"},
+		"search":   {marker},
+		"in":       {"description"},
+		"per_page": {"100"},
+	}
+	var issues []struct {
+		IID         int32  `json:"iid"`
+		Description string `json:"description"`
+	}
+	if err := doIssueRequest(ctx, c.doer, c.token, http.MethodGet, c.url()+"?"+q.Encode(), nil, &issues); err != nil {
+		return nil, err
+	}
+	// The search is a full text search, so we check for the exact marker.
+	for _, issue := range issues {
+		if strings.Contains(issue.Description, marker) {
+			return &openIssue{Number: issue.IID, Body: issue.Description}, nil
+		}
+	}
+	return nil, nil
+}
+
+func doIssueRequest(ctx context.Context, doer httpcli.Doer, token, method, u string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrap(err, "marshal failed")
+		}
+		body = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	if result == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(result), "decoding response")
+}
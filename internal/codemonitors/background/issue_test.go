@@ -0,0 +1,333 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeIssueClient struct {
+	open     map[int32]bool
+	next     int32
+	created  []string
+	comments map[int32][]string
+}
+
+func (c *fakeIssueClient) FindOpenIssue(_ context.Context, marker string) (*openIssue, error) {
+	for i, body := range c.created {
+		number := int32(i + 1)
+		if c.open[number] && strings.Contains(body, marker) {
+			return &openIssue{Number: number, Body: body}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *fakeIssueClient) IssueIsOpen(_ context.Context, number int32) (bool, error) {
+	return c.open[number], nil
+}
+
+func (c *fakeIssueClient) CreateIssue(_ context.Context, title, body string) (int32, error) {
+	c.next++
+	c.open[c.next] = true
+	c.created = append(c.created, body)
+	return c.next, nil
+}
+
+func (c *fakeIssueClient) CreateComment(_ context.Context, number int32, body string) error {
+	c.comments[number] = append(c.comments[number], body)
+	return nil
+}
+
+func (c *fakeIssueClient) HasComment(_ context.Context, number int32, marker string) (bool, error) {
+	for _, body := range c.comments[number] {
+		if strings.Contains(body, marker) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestUpsertIssue(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	args := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		MonitorID:          42,
+		ExternalURL:        eu,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+	}
+
+	ctx := context.Background()
+	client := &fakeIssueClient{open: map[int32]bool{}, comments: map[int32][]string{}}
+
+	// No issue yet, so one is opened.
+	number, changed, err := upsertIssue(ctx, client, nil, 1, args)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int32(1), number)
+	require.Contains(t, client.created[0], issueMonitorMarker(42))
+	require.Contains(t, client.created[0], issueJobMarker(1))
+
+	// The job is retried because the issue number couldn't be stored. The
+	// issue is found and not commented on, since it lists the matches already.
+	number, changed, err = upsertIssue(ctx, client, nil, 1, args)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int32(1), number)
+	require.Len(t, client.created, 1)
+	require.Empty(t, client.comments[1])
+
+	// The issue is still open, so we comment on it.
+	number, changed, err = upsertIssue(ctx, client, &number, 2, args)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, int32(1), number)
+	require.Len(t, client.comments[1], 1)
+	require.NotContains(t, client.comments[1][0], issueMonitorMarker(42))
+	require.Contains(t, client.comments[1][0], issueJobMarker(2))
+
+	// The job is retried because it failed after commenting. The comment
+	// lists the matches already, so we don't comment again.
+	number, changed, err = upsertIssue(ctx, client, &number, 2, args)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, int32(1), number)
+	require.Len(t, client.comments[1], 1)
+
+	// The issue number wasn't stored by an earlier job, so the issue is found
+	// and commented on.
+	number, changed, err = upsertIssue(ctx, client, nil, 3, args)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int32(1), number)
+	require.Len(t, client.comments[1], 2)
+
+	// Retrying that job doesn't comment again either.
+	number, changed, err = upsertIssue(ctx, client, nil, 3, args)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int32(1), number)
+	require.Len(t, client.comments[1], 2)
+
+	// Once the issue is closed, a new one is opened.
+	client.open[1] = false
+	number, changed, err = upsertIssue(ctx, client, &number, 4, args)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int32(2), number)
+}
+
+func TestNewIssueClient(t *testing.T) {
+	newDB := func(siteAdmin bool) (*dbmocks.MockDB, *dbmocks.MockRepoStore) {
+		users := dbmocks.NewMockUserStore()
+		users.GetByIDFunc.SetDefaultReturn(&types.User{ID: 1, Username: "owner", SiteAdmin: siteAdmin}, nil)
+		repos := dbmocks.NewMockRepoStore()
+		repos.GetFunc.SetDefaultReturn(nil, errors.New("not found"))
+
+		db := dbmocks.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+		db.ReposFunc.SetDefaultReturn(repos)
+		return db, repos
+	}
+
+	t.Run("owner is not a site admin", func(t *testing.T) {
+		db, repos := newDB(false)
+
+		_, err := newIssueClient(context.Background(), db, http.DefaultClient, 1, 1)
+		require.ErrorContains(t, err, "not a site admin")
+		require.Empty(t, repos.GetFunc.History())
+	})
+
+	t.Run("repository is loaded as the owner", func(t *testing.T) {
+		db, repos := newDB(true)
+
+		_, err := newIssueClient(actor.WithInternalActor(context.Background()), db, http.DefaultClient, 1, 1)
+		require.Error(t, err)
+		require.Len(t, repos.GetFunc.History(), 1)
+		call := repos.GetFunc.History()[0]
+		require.Equal(t, int32(1), actor.FromContext(call.Arg0).UID)
+		require.False(t, actor.FromContext(call.Arg0).IsInternal())
+		require.Equal(t, api.RepoID(1), call.Arg1)
+	})
+}
+
+func TestIssueMarkdown(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	args := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+	}
+
+	t.Run("without results", func(t *testing.T) {
+		md := issueMarkdown(args)
+		require.Equal(t, 2, strings.Count(md, "\n- "))
+		require.NotContains(t, md, "```")
+	})
+
+	t.Run("with results", func(t *testing.T) {
+		argsCopy := args
+		argsCopy.IncludeResults = true
+		md := issueMarkdown(argsCopy)
+		require.Equal(t, 2, strings.Count(md, "\n- "))
+		require.Equal(t, 4, strings.Count(md, "```"))
+	})
+}
+
+func TestGitHubIssueClient(t *testing.T) {
+	var requests []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/sourcegraph/sourcegraph/issues/7":
+			_ = json.NewEncoder(w).Encode(map[string]any{"number": 7, "state": "closed"})
+		case "POST /repos/sourcegraph/sourcegraph/issues":
+			var payload map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			require.Equal(t, "title", payload["title"])
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"number": 8})
+		case "POST /repos/sourcegraph/sourcegraph/issues/8/comments":
+			w.WriteHeader(http.StatusCreated)
+		case "GET /repos/sourcegraph/sourcegraph/issues/8":
+			_ = json.NewEncoder(w).Encode(map[string]any{"number": 8, "state": "open", "comments": 101})
+		case "GET /repos/sourcegraph/sourcegraph/issues/8/comments":
+			// Only the last page is requested.
+			require.Equal(t, "2", r.URL.Query().Get("page"))
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"body": "matches\nmarker\n"},
+			})
+		case "GET /repos/sourcegraph/sourcegraph/issues":
+			require.Equal(t, "open", r.URL.Query().Get("state"))
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"number": 10, "body": "marker", "pull_request": map[string]any{}},
+				{"number": 9, "body": "unrelated"},
+				{"number": 8, "body": "body\nmarker\n"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	apiURL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	client := &githubIssueClient{doer: s.Client(), apiURL: apiURL, token: "secret", nameWithOwner: "sourcegraph/sourcegraph"}
+
+	ctx := context.Background()
+	open, err := client.IssueIsOpen(ctx, 7)
+	require.NoError(t, err)
+	require.False(t, open)
+
+	number, err := client.CreateIssue(ctx, "title", "body")
+	require.NoError(t, err)
+	require.Equal(t, int32(8), number)
+
+	require.NoError(t, client.CreateComment(ctx, 8, "body"))
+	require.Error(t, client.CreateComment(ctx, 9, "body"))
+
+	issue, err := client.FindOpenIssue(ctx, "marker")
+	require.NoError(t, err)
+	require.Equal(t, &openIssue{Number: 8, Body: "body\nmarker\n"}, issue)
+
+	issue, err = client.FindOpenIssue(ctx, "other marker")
+	require.NoError(t, err)
+	require.Nil(t, issue)
+
+	commented, err := client.HasComment(ctx, 8, "marker")
+	require.NoError(t, err)
+	require.True(t, commented)
+
+	require.Equal(t, []string{
+		"GET /repos/sourcegraph/sourcegraph/issues/7",
+		"POST /repos/sourcegraph/sourcegraph/issues",
+		"POST /repos/sourcegraph/sourcegraph/issues/8/comments",
+		"POST /repos/sourcegraph/sourcegraph/issues/9/comments",
+		"GET /repos/sourcegraph/sourcegraph/issues",
+		"GET /repos/sourcegraph/sourcegraph/issues",
+		"GET /repos/sourcegraph/sourcegraph/issues/8",
+		"GET /repos/sourcegraph/sourcegraph/issues/8/comments",
+	}, requests)
+}
+
+func TestGitLabIssueClient(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v4/projects/12/issues/3":
+			_ = json.NewEncoder(w).Encode(map[string]any{"iid": 3, "state": "opened"})
+		case "POST /api/v4/projects/12/issues":
+			var payload map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			require.Equal(t, "body", payload["description"])
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"iid": 4})
+		case "POST /api/v4/projects/12/issues/3/notes":
+			w.WriteHeader(http.StatusCreated)
+		case "GET /api/v4/projects/12/issues/3/notes":
+			require.Equal(t, "desc", r.URL.Query().Get("sort"))
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"body": "matches\nmarker\n"},
+			})
+		case "GET /api/v4/projects/12/issues":
+			require.Equal(t, "opened", r.URL.Query().Get("state"))
+			require.Equal(t, "description", r.URL.Query().Get("in"))
+			search := r.URL.Query().Get("search")
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"iid": 5, "description": "similar to " + strings.ToUpper(search)},
+				{"iid": 3, "description": "body\n" + search + "\n"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	apiURL, err := url.Parse(s.URL + "/api/v4/")
+	require.NoError(t, err)
+	client := &gitlabIssueClient{doer: s.Client(), apiURL: apiURL, token: "secret", projectID: 12}
+
+	ctx := context.Background()
+	open, err := client.IssueIsOpen(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, open)
+
+	number, err := client.CreateIssue(ctx, "title", "body")
+	require.NoError(t, err)
+	require.Equal(t, int32(4), number)
+
+	require.NoError(t, client.CreateComment(ctx, 3, "body"))
+
+	issue, err := client.FindOpenIssue(ctx, "marker")
+	require.NoError(t, err)
+	require.Equal(t, &openIssue{Number: 3, Body: "body\nmarker\n"}, issue)
+
+	commented, err := client.HasComment(ctx, 3, "marker")
+	require.NoError(t, err)
+	require.True(t, commented)
+
+	commented, err = client.HasComment(ctx, 3, "other marker")
+	require.NoError(t, err)
+	require.False(t, commented)
+}
@@ -0,0 +1,162 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func sendTeamsNotification(ctx context.Context, url string, args actionArgs) error {
+	return postTeamsWebhook(ctx, httpcli.ExternalDoer, url, teamsPayload(args))
+}
+
+// teamsMessage is the envelope Microsoft Teams incoming webhooks and workflows
+// expect when posting an adaptive card.
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string            `json:"contentType"`
+	ContentURL  *string           `json:"contentUrl"`
+	Content     teamsAdaptiveCard `json:"content"`
+}
+
+type teamsAdaptiveCard struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []teamsCardElement `json:"body"`
+	Actions []teamsCardAction  `json:"actions,omitempty"`
+}
+
+type teamsCardElement struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Wrap     bool   `json:"wrap,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	FontType string `json:"fontType,omitempty"`
+}
+
+type teamsCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func newTeamsMessage(body []teamsCardElement, actions []teamsCardAction) *teamsMessage {
+	return &teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: teamsAdaptiveCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}
+}
+
+func teamsText(s string) teamsCardElement {
+	return teamsCardElement{Type: "TextBlock", Text: s, Wrap: true}
+}
+
+func teamsPayload(args actionArgs) *teamsMessage {
+	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+
+	body := []teamsCardElement{
+		teamsText(fmt.Sprintf(
+			"%s's Sourcegraph Code monitor, **%s**, detected **%d** new matches.",
+			args.MonitorOwnerName,
+			args.MonitorDescription,
+			totalCount,
+		)),
+	}
+
+	if args.IncludeResults {
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			body = append(body, teamsText(fmt.Sprintf(
+				"%s match: [%s@%s](%s)",
+				resultType,
+				result.Repo.Name,
+				result.Commit.ID.Short(),
+				getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+			)))
+			body = append(body, teamsCardElement{
+				Type:     "TextBlock",
+				Text:     truncateMatchContent(result),
+				Wrap:     true,
+				FontType: "Monospace",
+			})
+		}
+		if truncatedCount > 0 {
+			body = append(body, teamsText(fmt.Sprintf("...and %d more matches.", truncatedCount)))
+		}
+	}
+
+	return newTeamsMessage(body, []teamsCardAction{
+		{
+			Type:  "Action.OpenUrl",
+			Title: "View results",
+			URL:   getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		},
+		{
+			Type:  "Action.OpenUrl",
+			Title: "Edit code monitor",
+			URL:   getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+		},
+	})
+}
+
+func postTeamsWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *teamsMessage) error {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post webhook")
+	}
+	defer resp.Body.Close()
+
+	// Workflow-based Teams webhooks respond with 202 Accepted rather than 200.
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
+func SendTestTeamsWebhook(ctx context.Context, doer httpcli.Doer, description, url string) error {
+	testMessage := newTeamsMessage([]teamsCardElement{
+		teamsText(fmt.Sprintf("Test message for Code Monitor '%s'", description)),
+	}, nil)
+
+	return postTeamsWebhook(ctx, doer, url, testMessage)
+}
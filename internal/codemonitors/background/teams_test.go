@@ -0,0 +1,95 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestTeamsWebhook(t *testing.T) {
+	t.Parallel()
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	action := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     false,
+	}
+
+	for _, status := range []int{http.StatusOK, http.StatusAccepted} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var msg teamsMessage
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+				require.Equal(t, "message", msg.Type)
+				require.Len(t, msg.Attachments, 1)
+				require.Equal(t, "application/vnd.microsoft.card.adaptive", msg.Attachments[0].ContentType)
+				require.Equal(t, "AdaptiveCard", msg.Attachments[0].Content.Type)
+				w.WriteHeader(status)
+			}))
+			defer s.Close()
+
+			err := postTeamsWebhook(context.Background(), s.Client(), s.URL, teamsPayload(action))
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("error is returned", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		}))
+		defer s.Close()
+
+		err := postTeamsWebhook(context.Background(), s.Client(), s.URL, teamsPayload(action))
+		require.Error(t, err)
+	})
+
+	t.Run("without results", func(t *testing.T) {
+		card := teamsPayload(action).Attachments[0].Content
+		require.Len(t, card.Body, 1)
+		require.Equal(t, "Camden Cheek's Sourcegraph Code monitor, **My test monitor**, detected **3** new matches.", card.Body[0].Text)
+		require.Len(t, card.Actions, 2)
+	})
+
+	t.Run("with results", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		card := teamsPayload(actionCopy).Attachments[0].Content
+		// Summary, then a link and a content block per result.
+		require.Len(t, card.Body, 5)
+		require.Equal(t, "Monospace", card.Body[2].FontType)
+	})
+
+	t.Run("with truncated results", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		actionCopy.Results = append(actionCopy.Results, actionCopy.Results...)
+		actionCopy.Results = append(actionCopy.Results, actionCopy.Results...)
+		card := teamsPayload(actionCopy).Attachments[0].Content
+		require.Equal(t, "...and 7 more matches.", card.Body[len(card.Body)-1].Text)
+	})
+}
+
+func TestTriggerTestTeamsWebhookAction(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg teamsMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		require.Equal(t, "Test message for Code Monitor 'My test monitor'", msg.Attachments[0].Content.Body[0].Text)
+		w.WriteHeader(200)
+	}))
+	defer s.Close()
+
+	err := SendTestTeamsWebhook(context.Background(), s.Client(), "My test monitor", s.URL)
+	require.NoError(t, err)
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -206,6 +208,8 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 		if err != nil {
 			return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
 		}
+
+		events.EnqueueCodeMonitorTrigger(ctx, logger, r.db, m, q.QueryString, results, cm.Clock()())
 	}
 	return nil
 }
@@ -223,8 +227,12 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, j *databas
 		return errors.Wrap(r.handleWebhook(ctx, j), "Webhook")
 	case j.SlackWebhook != nil:
 		return errors.Wrap(r.handleSlackWebhook(ctx, j), "SlackWebhook")
+	case j.TeamsWebhook != nil:
+		return errors.Wrap(r.handleTeamsWebhook(ctx, j), "TeamsWebhook")
+	case j.IssueAction != nil:
+		return errors.Wrap(r.handleIssueAction(ctx, j), "IssueAction")
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, teams webhook, or issue")
 	}
 }
 
@@ -358,6 +366,95 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 	return sendSlackNotification(ctx, w.URL, args)
 }
 
+func (r *actionRunner) handleTeamsWebhook(ctx context.Context, j *database.ActionJob) error {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
+	}
+
+	w, err := s.GetTeamsWebhookAction(ctx, *j.TeamsWebhook)
+	if err != nil {
+		return errors.Wrap(err, "GetTeamsWebhookAction")
+	}
+
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
+	if err != nil {
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          w.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          "code-monitor-teams-webhook",
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            m.Results,
+		IncludeResults:     w.IncludeResults,
+	}
+
+	return sendTeamsNotification(ctx, w.URL, args)
+}
+
+func (r *actionRunner) handleIssueAction(ctx context.Context, j *database.ActionJob) (err error) {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
+	}
+
+	a, err := s.GetIssueAction(ctx, *j.IssueAction)
+	if err != nil {
+		return errors.Wrap(err, "GetIssueAction")
+	}
+
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
+	if err != nil {
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          a.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          "code-monitor-issue",
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            m.Results,
+		IncludeResults:     a.IncludeResults,
+	}
+
+	monitor, err := s.GetMonitor(ctx, a.Monitor)
+	if err != nil {
+		return errors.Wrap(err, "GetMonitor")
+	}
+
+	client, err := newIssueClient(ctx, database.NewDBWith(log.Scoped("handleIssueAction"), s), httpcli.ExternalDoer, monitor.UserID, a.RepoID)
+	if err != nil {
+		return errors.Wrap(err, "newIssueClient")
+	}
+
+	number, changed, err := upsertIssue(ctx, client, a.IssueNumber, j.ID, args)
+	if err != nil {
+		return err
+	}
+	if changed {
+		return errors.Wrap(s.SetIssueActionIssueNumber(ctx, a.ID, number), "SetIssueActionIssueNumber")
+	}
+	return nil
+}
+
 type StatusCodeError struct {
 	Code   int
 	Status string
@@ -0,0 +1,475 @@
+package codemonitors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// IsContentQuery returns whether the query is a plain file content search
+// rather than a type:commit or type:diff search. Code monitors over content
+// searches fire when the set of matching lines in a repository changes
+// instead of when a new commit matches. Lines that newly match are reported as
+// added lines and lines that stop matching as removed lines.
+func IsContentQuery(q string) (bool, error) {
+	plan, err := query.Pipeline(query.Init(q, query.SearchTypeStandard))
+	if err != nil {
+		return false, err
+	}
+
+	for _, basic := range plan {
+		types, _ := basic.IncludeExcludeValues(query.FieldType)
+		for _, t := range types {
+			if t != "file" {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// SnapshotContent runs a content search and returns the keys of the lines that
+// currently match in each repository, so that only lines matching after the
+// snapshot is taken are treated as new.
+func SnapshotContent(ctx context.Context, logger log.Logger, db database.DB, query string) (map[api.RepoID][]string, error) {
+	if db.Handle().InTransaction() {
+		return nil, errors.New("SnapshotContent cannot be run in a transaction")
+	}
+
+	searchClient := client.New(logger, db, gitserver.NewClient("monitors.search.snapshot"))
+	inputs, err := searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		query,
+		search.Precise,
+		search.Streaming,
+		pointers.Ptr(int32(0)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	planJob, err := jobutil.NewPlanJob(inputs, inputs.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	agg := streaming.NewAggregatingStream()
+	_, err = planJob.Run(ctx, searchClient.JobClients(), agg)
+	if err != nil {
+		return nil, err
+	}
+
+	return contentMatchKeys(agg.Results), nil
+}
+
+// maxCarriedOverMatchKeys limits how many keys of lines missing from a search
+// that hit a limit are kept in the snapshot of a repository. Without it, the
+// snapshot of a monitor whose search always hits a limit would grow forever.
+const maxCarriedOverMatchKeys = 10_000
+
+// searchContent runs a content search for a code monitor and returns the files
+// containing lines that did not match on the previous run, or that matched on
+// the previous run but no longer do. They are formatted as diffs adding or
+// removing those lines so they can be sent through the regular code monitor
+// actions.
+//
+// Lines that no longer match are dropped from the snapshot, so that they are
+// reported again if they reappear.
+func searchContent(ctx context.Context, db database.DB, clients job.RuntimeClients, planJob job.Job, monitorID int64) ([]*result.CommitMatch, error) {
+	agg := streaming.NewAggregatingStream()
+	_, err := planJob.Run(ctx, clients, agg)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := db.CodeMonitors()
+	previous, err := cm.GetContentSnapshot(ctx, monitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the search hit a limit, lines missing from the results may still
+	// match, so we can't tell which lines were removed.
+	results, orphaned := contentChanges(agg.Results, previous, !agg.Stats.IsLimitHit)
+	orphanedResults, err := orphanedContentMatches(ctx, db, clients.Gitserver, orphaned)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, orphanedResults...)
+	if err := fillContentCommits(ctx, clients.Gitserver, results); err != nil {
+		return nil, err
+	}
+
+	// Keep lines missing from the results of a search that hit a limit in the
+	// snapshot so they don't fire again on a later run. Lines beyond the cap
+	// may fire again once they show up in the results.
+	current := contentMatchKeys(agg.Results)
+	if agg.Stats.IsLimitHit {
+		current = mergeMatchKeys(previous, current, maxCarriedOverMatchKeys)
+	}
+
+	if err := cm.ReplaceContentSnapshot(ctx, monitorID, current); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// maxMatchKeyLineBytes limits how much of a matched line is kept in its key.
+const maxMatchKeyLineBytes = 1000
+
+// contentMatchKey identifies a matched line by its path and content rather than
+// its line number, so that edits elsewhere in a file that shift the line don't
+// cause it to be reported again. The key contains the path and the line, so that
+// lines which no longer match can be reported from the snapshot alone. Long
+// lines are truncated to maxMatchKeyLineBytes.
+func contentMatchKey(path, line string) string {
+	if len(line) > maxMatchKeyLineBytes {
+		end := maxMatchKeyLineBytes
+		for end > 0 && !utf8.RuneStart(line[end]) {
+			end--
+		}
+		line = line[:end]
+	}
+	return path + "\n" + line
+}
+
+// parseContentMatchKey returns the path and line of a key returned by
+// contentMatchKey. Lines never contain a newline, but paths may.
+func parseContentMatchKey(key string) (path, line string, ok bool) {
+	i := strings.LastIndexByte(key, '\n')
+	if i < 0 {
+		return "", "", false
+	}
+	return key[:i], key[i+1:], true
+}
+
+// contentMatchKeys returns the sorted, deduplicated keys of all matched lines
+// in the given results, grouped by repository.
+func contentMatchKeys(matches result.Matches) map[api.RepoID][]string {
+	sets := make(map[api.RepoID]map[string]struct{})
+	for _, match := range matches {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		for _, lm := range fm.ChunkMatches.AsLineMatches() {
+			if sets[fm.Repo.ID] == nil {
+				sets[fm.Repo.ID] = make(map[string]struct{})
+			}
+			sets[fm.Repo.ID][contentMatchKey(fm.Path, lm.Preview)] = struct{}{}
+		}
+	}
+
+	keys := make(map[api.RepoID][]string, len(sets))
+	for repoID, set := range sets {
+		repoKeys := make([]string, 0, len(set))
+		for key := range set {
+			repoKeys = append(repoKeys, key)
+		}
+		sort.Strings(repoKeys)
+		keys[repoID] = repoKeys
+	}
+	return keys
+}
+
+// mergeMatchKeys returns the keys of current with the keys of previous carried
+// over. Per repository, keys of previous are only carried over until the
+// repository has max keys, but all keys of current are kept.
+func mergeMatchKeys(previous, current map[api.RepoID][]string, max int) map[api.RepoID][]string {
+	merged := make(map[api.RepoID][]string, len(previous)+len(current))
+	for repoID, repoKeys := range current {
+		merged[repoID] = append([]string(nil), repoKeys...)
+	}
+	for repoID, repoKeys := range previous {
+		inCurrent := make(map[string]struct{}, len(current[repoID]))
+		for _, key := range current[repoID] {
+			inCurrent[key] = struct{}{}
+		}
+		for _, key := range repoKeys {
+			if len(merged[repoID]) >= max {
+				break
+			}
+			if _, ok := inCurrent[key]; !ok {
+				merged[repoID] = append(merged[repoID], key)
+			}
+		}
+	}
+	for repoID, repoKeys := range merged {
+		sort.Strings(repoKeys)
+		merged[repoID] = dedupeSorted(repoKeys)
+	}
+	return merged
+}
+
+func dedupeSorted(keys []string) []string {
+	out := keys[:0]
+	for i, key := range keys {
+		if i == 0 || key != keys[i-1] {
+			out = append(out, key)
+		}
+	}
+	return out
+}
+
+// contentChanges returns a result for every file containing a matched line
+// whose key is not in the previous snapshot of its repository and, if
+// withRemoved is set, every file with a line in the previous snapshot that no
+// longer matches. Removed lines in repositories without any match are returned
+// separately by repository and path, as the matches don't tell their
+// repository name and commit.
+func contentChanges(matches result.Matches, previous map[api.RepoID][]string, withRemoved bool) ([]*result.CommitMatch, map[api.RepoID]map[string][]string) {
+	seen := make(map[api.RepoID]map[string]struct{}, len(previous))
+	for repoID, keys := range previous {
+		set := make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			set[key] = struct{}{}
+		}
+		seen[repoID] = set
+	}
+
+	var removed map[api.RepoID]map[string][]string
+	if withRemoved {
+		removed = removedContentLines(previous, contentMatchKeys(matches))
+	}
+
+	type repoCommit struct {
+		repo   types.MinimalRepo
+		commit api.CommitID
+	}
+	commits := make(map[api.RepoID]repoCommit)
+
+	var results []*result.CommitMatch
+	for _, match := range matches {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		commits[fm.Repo.ID] = repoCommit{repo: fm.Repo, commit: fm.CommitID}
+
+		var newLines []*result.LineMatch
+		for _, lm := range fm.ChunkMatches.AsLineMatches() {
+			key := contentMatchKey(fm.Path, lm.Preview)
+			if _, ok := seen[fm.Repo.ID][key]; ok {
+				continue
+			}
+			if seen[fm.Repo.ID] == nil {
+				seen[fm.Repo.ID] = make(map[string]struct{})
+			}
+			// Only report the first occurrence of a duplicated line.
+			seen[fm.Repo.ID][key] = struct{}{}
+			newLines = append(newLines, lm)
+		}
+		removedLines := removed[fm.Repo.ID][fm.Path]
+		delete(removed[fm.Repo.ID], fm.Path)
+		if len(newLines) > 0 || len(removedLines) > 0 {
+			results = append(results, contentCommitMatch(fm.Repo, fm.CommitID, fm.Path, newLines, removedLines))
+		}
+	}
+
+	// Files that no longer contain any match, in repositories that still do.
+	for _, repoID := range sortedRepoIDs(removed) {
+		rc, ok := commits[repoID]
+		if !ok {
+			continue
+		}
+		for _, path := range sortedPaths(removed[repoID]) {
+			results = append(results, contentCommitMatch(rc.repo, rc.commit, path, nil, removed[repoID][path]))
+		}
+		delete(removed, repoID)
+	}
+	return results, removed
+}
+
+// removedContentLines returns the lines of keys in previous that are not in
+// current, by repository and path.
+func removedContentLines(previous, current map[api.RepoID][]string) map[api.RepoID]map[string][]string {
+	removed := make(map[api.RepoID]map[string][]string)
+	for repoID, repoKeys := range previous {
+		inCurrent := make(map[string]struct{}, len(current[repoID]))
+		for _, key := range current[repoID] {
+			inCurrent[key] = struct{}{}
+		}
+		for _, key := range repoKeys {
+			if _, ok := inCurrent[key]; ok {
+				continue
+			}
+			path, line, ok := parseContentMatchKey(key)
+			if !ok {
+				continue
+			}
+			if removed[repoID] == nil {
+				removed[repoID] = make(map[string][]string)
+			}
+			removed[repoID][path] = append(removed[repoID][path], line)
+		}
+	}
+	return removed
+}
+
+// orphanedContentMatches returns a result for every file with removed lines in
+// repositories without any match, at the head of their default branch.
+// Repositories that were deleted or are empty are skipped.
+func orphanedContentMatches(ctx context.Context, db database.DB, gs gitserver.Client, removed map[api.RepoID]map[string][]string) ([]*result.CommitMatch, error) {
+	if len(removed) == 0 {
+		return nil, nil
+	}
+
+	repos, err := db.Repos().GetByIDs(ctx, sortedRepoIDs(removed)...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(repos, func(i, j int) bool { return repos[i].ID < repos[j].ID })
+
+	var results []*result.CommitMatch
+	for _, repo := range repos {
+		_, commit, err := gs.GetDefaultBranch(ctx, repo.Name, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting default branch of %s", repo.Name)
+		}
+		if commit == "" {
+			continue
+		}
+		minimalRepo := types.MinimalRepo{ID: repo.ID, Name: repo.Name, Stars: repo.Stars}
+		for _, path := range sortedPaths(removed[repo.ID]) {
+			results = append(results, contentCommitMatch(minimalRepo, commit, path, nil, removed[repo.ID][path]))
+		}
+	}
+	return results, nil
+}
+
+func sortedRepoIDs[T any](m map[api.RepoID]T) []api.RepoID {
+	ids := make([]api.RepoID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sortedPaths(m map[string][]string) []string {
+	paths := make([]string, 0, len(m))
+	for path := range m {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// contentCommitMatch formats the added and removed matched lines in a file as a
+// diff at the given commit. Added lines are placed at their line number.
+// Removed lines are only known by their content, so they are placed in a
+// single hunk at line 0.
+func contentCommitMatch(repo types.MinimalRepo, commit api.CommitID, path string, added []*result.LineMatch, removed []string) *result.CommitMatch {
+	diffFile := result.DiffFile{OrigName: path, NewName: path}
+	header := result.FormatDiffFiles([]result.DiffFile{diffFile})
+
+	var ranges result.Ranges
+	offset, lineCount := len(header), 1
+	if len(removed) > 0 {
+		hunk := result.Hunk{OldCount: len(removed)}
+		for _, line := range removed {
+			hunk.Lines = append(hunk.Lines, "-"+line)
+		}
+		diffFile.Hunks = append(diffFile.Hunks, hunk)
+
+		offset += len(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount))
+		lineCount++
+		for _, line := range hunk.Lines {
+			offset += len(line) + 1
+			lineCount++
+		}
+	}
+	for _, lm := range added {
+		lineNumber := int(lm.LineNumber) + 1
+		hunk := result.Hunk{
+			OldStart: lineNumber,
+			NewStart: lineNumber,
+			NewCount: 1,
+			Lines:    []string{"+" + lm.Preview},
+		}
+		diffFile.Hunks = append(diffFile.Hunks, hunk)
+
+		// Skip the hunk header and the added line's "+" prefix.
+		offset += len(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount))
+		lineCount++
+		lineStart := offset + 1
+		for _, ol := range lm.OffsetAndLengths {
+			start := runeOffset(lm.Preview, int(ol[0]))
+			end := runeOffset(lm.Preview, int(ol[0]+ol[1]))
+			ranges = append(ranges, result.Range{
+				Start: result.Location{Offset: lineStart + start, Line: lineCount, Column: int(ol[0]) + 1},
+				End:   result.Location{Offset: lineStart + end, Line: lineCount, Column: int(ol[0]+ol[1]) + 1},
+			})
+		}
+		offset += len(hunk.Lines[0]) + 1
+		lineCount++
+	}
+
+	diff := []result.DiffFile{diffFile}
+	return &result.CommitMatch{
+		Commit: gitdomain.Commit{ID: commit},
+		Repo:   repo,
+		DiffPreview: &result.MatchedString{
+			Content:       result.FormatDiffFiles(diff),
+			MatchedRanges: ranges,
+		},
+		Diff:          diff,
+		ModifiedFiles: []string{path},
+	}
+}
+
+// fillContentCommits replaces the commits of results, which only have an ID,
+// with the full commits, so that actions can render their author, date and
+// message. Each commit is fetched once, even if it has several new files.
+func fillContentCommits(ctx context.Context, gs gitserver.Client, results []*result.CommitMatch) error {
+	type repoCommit struct {
+		repo   api.RepoName
+		commit api.CommitID
+	}
+	commits := make(map[repoCommit]*gitdomain.Commit)
+	for _, res := range results {
+		key := repoCommit{repo: res.Repo.Name, commit: res.Commit.ID}
+		commit, ok := commits[key]
+		if !ok {
+			var err error
+			commit, err = gs.GetCommit(ctx, key.repo, key.commit)
+			if err != nil {
+				return errors.Wrapf(err, "getting commit %s in %s", key.commit, key.repo)
+			}
+			commits[key] = commit
+		}
+		res.Commit = *commit
+	}
+	return nil
+}
+
+// runeOffset returns the byte offset of the n-th rune in s.
+func runeOffset(s string, n int) int {
+	offset := 0
+	for i := 0; i < n && offset < len(s); i++ {
+		_, size := utf8.DecodeRuneInString(s[offset:])
+		offset += size
+	}
+	return offset
+}
@@ -0,0 +1,297 @@
+package codemonitors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestIsContentQuery(t *testing.T) {
+	cases := []struct {
+		query string
+		want  bool
+	}{
+		{"BANNED", true},
+		{"repo:foo type:file BANNED", true},
+		{"repo:foo lang:go BANNED or FORBIDDEN", true},
+		{"type:diff BANNED", false},
+		{"type:commit BANNED", false},
+		{"type:symbol BANNED", false},
+		{"(type:diff a) or b", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			got, err := IsContentQuery(tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := IsContentQuery("BANNED count:notanumber")
+		require.Error(t, err)
+	})
+}
+
+func newTestFileMatch(repoID api.RepoID, path string, lines ...string) *result.FileMatch {
+	fm := &result.FileMatch{
+		File: result.File{
+			Repo:     types.MinimalRepo{ID: repoID, Name: api.RepoName("repo")},
+			CommitID: "deadbeef",
+			Path:     path,
+		},
+	}
+	for i, line := range lines {
+		// Match the first word of each line.
+		end := len([]rune(line))
+		for j, r := range []rune(line) {
+			if r == ' ' {
+				end = j
+				break
+			}
+		}
+		fm.ChunkMatches = append(fm.ChunkMatches, result.ChunkMatch{
+			Content:      line,
+			ContentStart: result.Location{Line: i * 10},
+			Ranges: result.Ranges{{
+				Start: result.Location{Line: i * 10, Column: 0},
+				End:   result.Location{Line: i * 10, Column: end},
+			}},
+		})
+	}
+	return fm
+}
+
+func TestContentMatchKeys(t *testing.T) {
+	matches := result.Matches{
+		newTestFileMatch(1, "a.go", "BANNED one", "BANNED one", "BANNED two"),
+		newTestFileMatch(2, "a.go", "BANNED one"),
+		&result.RepoMatch{ID: 3},
+	}
+
+	keys := contentMatchKeys(matches)
+	require.Len(t, keys, 2)
+	require.Len(t, keys[1], 2)
+	require.Equal(t, []string{contentMatchKey("a.go", "BANNED one")}, keys[2])
+
+	// The same line in another file has another key.
+	require.NotEqual(t, contentMatchKey("a.go", "BANNED one"), contentMatchKey("b.go", "BANNED one"))
+}
+
+func TestParseContentMatchKey(t *testing.T) {
+	path, line, ok := parseContentMatchKey(contentMatchKey("dir/a b.go", "BANNED one"))
+	require.True(t, ok)
+	require.Equal(t, "dir/a b.go", path)
+	require.Equal(t, "BANNED one", line)
+
+	long := strings.Repeat("a", maxMatchKeyLineBytes-1) + "ü"
+	_, line, ok = parseContentMatchKey(contentMatchKey("a.go", long))
+	require.True(t, ok)
+	require.Equal(t, long[:maxMatchKeyLineBytes-1], line)
+
+	_, _, ok = parseContentMatchKey("not a key")
+	require.False(t, ok)
+}
+
+func TestMergeMatchKeys(t *testing.T) {
+	merged := mergeMatchKeys(
+		map[api.RepoID][]string{1: {"a", "c"}, 2: {"x"}},
+		map[api.RepoID][]string{1: {"b", "c"}, 3: {"y"}},
+		10,
+	)
+	require.Equal(t, map[api.RepoID][]string{
+		1: {"a", "b", "c"},
+		2: {"x"},
+		3: {"y"},
+	}, merged)
+
+	t.Run("cap", func(t *testing.T) {
+		merged := mergeMatchKeys(
+			map[api.RepoID][]string{1: {"a", "b", "c", "d"}, 2: {"x", "y"}},
+			map[api.RepoID][]string{1: {"e", "f"}, 2: {"z", "zz", "zzz"}},
+			3,
+		)
+		// Current keys are always kept, even beyond the cap.
+		require.Equal(t, map[api.RepoID][]string{
+			1: {"a", "e", "f"},
+			2: {"z", "zz", "zzz"},
+		}, merged)
+	})
+}
+
+func TestContentChanges(t *testing.T) {
+	matches := result.Matches{
+		newTestFileMatch(1, "a.go", "BANNED one", "BANNED two"),
+		newTestFileMatch(1, "b.go", "BANNED one"),
+		newTestFileMatch(2, "a.go", "ünïcode BANNED"),
+	}
+
+	t.Run("first run reports everything", func(t *testing.T) {
+		results, orphaned := contentChanges(matches, nil, true)
+		require.Len(t, results, 3)
+		require.Empty(t, orphaned)
+	})
+
+	t.Run("nothing new", func(t *testing.T) {
+		results, orphaned := contentChanges(matches, contentMatchKeys(matches), true)
+		require.Empty(t, results)
+		require.Empty(t, orphaned)
+	})
+
+	t.Run("new line", func(t *testing.T) {
+		previous := map[api.RepoID][]string{
+			1: {contentMatchKey("a.go", "BANNED one"), contentMatchKey("b.go", "BANNED one")},
+			2: {contentMatchKey("a.go", "ünïcode BANNED")},
+		}
+		results, _ := contentChanges(matches, previous, true)
+		require.Len(t, results, 1)
+
+		res := results[0]
+		require.Equal(t, api.RepoID(1), res.Repo.ID)
+		require.Equal(t, api.CommitID("deadbeef"), res.Commit.ID)
+		require.Equal(t, []string{"a.go"}, res.ModifiedFiles)
+		require.Equal(t, "a.go a.go\n@@ -11,0 +11,1 @@\n+BANNED two\n", res.DiffPreview.Content)
+		require.Equal(t, res.Diff, mustParseDiff(t, res.DiffPreview.Content))
+		require.Len(t, res.DiffPreview.MatchedRanges, 1)
+		rr := res.DiffPreview.MatchedRanges[0]
+		require.Equal(t, "BANNED", res.DiffPreview.Content[rr.Start.Offset:rr.End.Offset])
+		require.Equal(t, result.Location{Offset: 29, Line: 2, Column: 1}, rr.Start)
+	})
+
+	t.Run("line disappears and reappears", func(t *testing.T) {
+		withLine := result.Matches{newTestFileMatch(1, "a.go", "BANNED one", "BANNED two")}
+		withoutLine := result.Matches{newTestFileMatch(1, "a.go", "BANNED one")}
+
+		snapshot := contentMatchKeys(withLine)
+
+		// The removal is reported, and the line is dropped from the snapshot.
+		results, _ := contentChanges(withoutLine, snapshot, true)
+		require.Len(t, results, 1)
+		require.Equal(t, "a.go a.go\n@@ -0,1 +0,0 @@\n-BANNED two\n", results[0].DiffPreview.Content)
+		require.Equal(t, results[0].Diff, mustParseDiff(t, results[0].DiffPreview.Content))
+		require.Empty(t, results[0].DiffPreview.MatchedRanges)
+		snapshot = contentMatchKeys(withoutLine)
+
+		results, _ = contentChanges(withLine, snapshot, true)
+		require.Len(t, results, 1)
+		require.Equal(t, "a.go a.go\n@@ -11,0 +11,1 @@\n+BANNED two\n", results[0].DiffPreview.Content)
+	})
+
+	t.Run("added and removed lines", func(t *testing.T) {
+		previous := contentMatchKeys(result.Matches{
+			newTestFileMatch(1, "a.go", "BANNED one", "BANNED old"),
+			newTestFileMatch(1, "c.go", "BANNED gone"),
+			newTestFileMatch(3, "d.go", "BANNED elsewhere"),
+		})
+		current := result.Matches{newTestFileMatch(1, "a.go", "BANNED one", "BANNED two")}
+
+		results, orphaned := contentChanges(current, previous, true)
+		require.Len(t, results, 2)
+
+		// Added and removed lines of the same file are in one diff.
+		require.Equal(t, "a.go a.go\n@@ -0,1 +0,0 @@\n-BANNED old\n@@ -11,0 +11,1 @@\n+BANNED two\n", results[0].DiffPreview.Content)
+		require.Equal(t, results[0].Diff, mustParseDiff(t, results[0].DiffPreview.Content))
+		require.Len(t, results[0].DiffPreview.MatchedRanges, 1)
+		rr := results[0].DiffPreview.MatchedRanges[0]
+		require.Equal(t, "BANNED", results[0].DiffPreview.Content[rr.Start.Offset:rr.End.Offset])
+		require.Equal(t, 4, rr.Start.Line)
+
+		// Files without any match left use the commit of the repository's
+		// other matches.
+		require.Equal(t, "c.go c.go\n@@ -0,1 +0,0 @@\n-BANNED gone\n", results[1].DiffPreview.Content)
+		require.Equal(t, api.CommitID("deadbeef"), results[1].Commit.ID)
+
+		// Repositories without any match left are returned separately.
+		require.Equal(t, map[api.RepoID]map[string][]string{3: {"d.go": {"BANNED elsewhere"}}}, orphaned)
+
+		t.Run("not reported if the search hit a limit", func(t *testing.T) {
+			results, orphaned := contentChanges(current, previous, false)
+			require.Len(t, results, 1)
+			require.Equal(t, "a.go a.go\n@@ -11,0 +11,1 @@\n+BANNED two\n", results[0].DiffPreview.Content)
+			require.Empty(t, orphaned)
+		})
+	})
+
+	t.Run("ranges use byte offsets", func(t *testing.T) {
+		results, _ := contentChanges(matches[2:], nil, true)
+		require.Len(t, results, 1)
+		rr := results[0].DiffPreview.MatchedRanges[0]
+		require.Equal(t, "ünïcode", results[0].DiffPreview.Content[rr.Start.Offset:rr.End.Offset])
+		require.Equal(t, 8, rr.End.Column)
+	})
+}
+
+func TestFillContentCommits(t *testing.T) {
+	gs := gitserver.NewMockClient()
+	gs.GetCommitFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, id api.CommitID) (*gitdomain.Commit, error) {
+		return &gitdomain.Commit{
+			ID:      id,
+			Author:  gitdomain.Signature{Name: "alice", Email: "alice@example.com"},
+			Message: "add BANNED",
+		}, nil
+	})
+
+	results, _ := contentChanges(result.Matches{
+		newTestFileMatch(1, "a.go", "BANNED one"),
+		newTestFileMatch(1, "b.go", "BANNED one"),
+	}, nil, true)
+	require.NoError(t, fillContentCommits(context.Background(), gs, results))
+
+	// Both files are at the same commit, so it is only fetched once.
+	require.Len(t, gs.GetCommitFunc.History(), 1)
+	for _, res := range results {
+		require.Equal(t, api.CommitID("deadbeef"), res.Commit.ID)
+		require.Equal(t, "alice", res.Commit.Author.Name)
+		require.Equal(t, gitdomain.Message("add BANNED"), res.Commit.Message)
+	}
+}
+
+func mustParseDiff(t *testing.T, diff string) []result.DiffFile {
+	t.Helper()
+	parsed, err := result.ParseDiffString(diff)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestOrphanedContentMatches(t *testing.T) {
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetByIDsFunc.SetDefaultHook(func(_ context.Context, ids ...api.RepoID) ([]*types.Repo, error) {
+		// Repository 4 was deleted.
+		require.Equal(t, []api.RepoID{3, 4, 5}, ids)
+		return []*types.Repo{{ID: 5, Name: "empty"}, {ID: 3, Name: "repo3"}}, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	gs := gitserver.NewMockClient()
+	gs.GetDefaultBranchFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, _ bool) (string, api.CommitID, error) {
+		if repo == "empty" {
+			return "", "", nil
+		}
+		return "main", "cafebabe", nil
+	})
+
+	results, err := orphanedContentMatches(context.Background(), db, gs, map[api.RepoID]map[string][]string{
+		3: {"b.go": {"BANNED two"}, "a.go": {"BANNED one"}},
+		4: {"a.go": {"BANNED one"}},
+		5: {"a.go": {"BANNED one"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for i, path := range []string{"a.go", "b.go"} {
+		require.Equal(t, api.RepoName("repo3"), results[i].Repo.Name)
+		require.Equal(t, api.CommitID("cafebabe"), results[i].Commit.ID)
+		require.Equal(t, []string{path}, results[i].ModifiedFiles)
+	}
+	require.Equal(t, "b.go b.go\n@@ -0,1 +0,0 @@\n-BANNED two\n", results[1].DiffPreview.Content)
+}
@@ -47,6 +47,14 @@ func Search(ctx context.Context, logger log.Logger, db database.DB, query string
 		return nil, errcode.MakeNonRetryable(err)
 	}
 
+	isContentQuery, err := IsContentQuery(query)
+	if err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+	if isContentQuery {
+		return searchContent(ctx, db, clients, planJob, monitorID)
+	}
+
 	hook := func(ctx context.Context, db database.DB, gs commit.GitserverClient, args *gitprotocol.SearchRequest, repoID api.RepoID, doSearch commit.DoSearchFunc) error {
 		return hookWithID(ctx, logger, db, gs, monitorID, triggerID, repoID, args, doSearch)
 	}
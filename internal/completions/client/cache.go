@@ -0,0 +1,263 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/completions/tokenusage"
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	defaultCacheTTLSeconds    = 60 * 60
+	defaultCacheMaxEntryBytes = 64 * 1024
+
+	// coalescedRequestTimeout bounds provider requests that are shared by
+	// coalesced callers, which outlive the caller that started them. It matches
+	// the maximum duration of a request to the completions API.
+	coalescedRequestTimeout = time.Minute
+)
+
+// inflight coalesces identical concurrent Complete requests. It is shared by all
+// cached clients, since a new client is created for every completions request.
+var inflight singleflight.Group
+
+// responseCache is the subset of rcache.Cache used to store completion responses.
+type responseCache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, b []byte)
+}
+
+// cacheHitRecorder is the subset of tokenusage.Manager used to count cache hits.
+type cacheHitRecorder interface {
+	RecordCacheHit(model, feature string, provider tokenusage.Provider) error
+}
+
+// newCachedClientFromConfig wraps inner with a response cache if it is enabled in
+// the given site configuration, and returns inner unchanged otherwise.
+func newCachedClientFromConfig(config *schema.CodyCompletionsCache, inner types.CompletionsClient) types.CompletionsClient {
+	if config == nil || !config.Enabled {
+		return inner
+	}
+
+	ttlSeconds := config.TtlSeconds
+	if ttlSeconds <= 0 {
+		ttlSeconds = defaultCacheTTLSeconds
+	}
+	maxEntryBytes := config.MaxEntryBytes
+	if maxEntryBytes <= 0 {
+		maxEntryBytes = defaultCacheMaxEntryBytes
+	}
+
+	return newCachedClient(
+		inner,
+		rcache.NewWithTTL(redispool.Cache, "completions-response", ttlSeconds),
+		tokenusage.NewManager(),
+		maxEntryBytes,
+	)
+}
+
+func newCachedClient(inner types.CompletionsClient, cache responseCache, hits cacheHitRecorder, maxEntryBytes int) *cachedClient {
+	return &cachedClient{
+		inner:         inner,
+		cache:         cache,
+		hits:          hits,
+		maxEntryBytes: maxEntryBytes,
+	}
+}
+
+// cachedClient answers completion requests that are identical to a recent request
+// from a cache, instead of sending them to the LLM provider again.
+type cachedClient struct {
+	inner         types.CompletionsClient
+	cache         responseCache
+	hits          cacheHitRecorder
+	maxEntryBytes int
+}
+
+var _ types.CompletionsClient = (*cachedClient)(nil)
+
+// Stream replays a cached response as a single event, which holds the complete
+// response just like the last event of a provider stream. Streams aren't
+// coalesced, as a follower would only see events once the whole response is
+// available.
+func (c *cachedClient) Stream(ctx context.Context, logger log.Logger, request types.CompletionRequest, send types.SendCompletionEvent) error {
+	key, err := cacheKey(ctx, request)
+	if err != nil {
+		logger.Warn("failed to compute completions cache key", log.Error(err))
+		return c.inner.Stream(ctx, logger, request, send)
+	}
+
+	if resp, ok := c.get(ctx, logger, key); ok {
+		c.recordHit(logger, request)
+		return send(*resp)
+	}
+
+	var last *types.CompletionResponse
+	capturingSend := func(event types.CompletionResponse) error {
+		last = &event
+		return send(event)
+	}
+	if err := c.inner.Stream(ctx, logger, request, capturingSend); err != nil {
+		return err
+	}
+
+	// Some providers end the stream without an error when the request is canceled,
+	// in which case the last event is an incomplete response.
+	if last != nil && ctx.Err() == nil {
+		c.set(logger, key, last)
+	}
+	return nil
+}
+
+// Complete coalesces identical concurrent requests into a single provider request,
+// which isn't canceled while there are callers waiting for it.
+func (c *cachedClient) Complete(ctx context.Context, logger log.Logger, request types.CompletionRequest) (*types.CompletionResponse, error) {
+	key, err := cacheKey(ctx, request)
+	if err != nil {
+		logger.Warn("failed to compute completions cache key", log.Error(err))
+		return c.inner.Complete(ctx, logger, request)
+	}
+
+	if resp, ok := c.get(ctx, logger, key); ok {
+		c.recordHit(logger, request)
+		return resp, nil
+	}
+
+	// Only the caller whose function is executed sends a provider request, all
+	// other callers reuse its response.
+	executed := false
+	ch := inflight.DoChan(key, func() (any, error) {
+		executed = true
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), coalescedRequestTimeout)
+		defer cancel()
+		resp, err := c.inner.Complete(ctx, logger, request)
+		if err != nil {
+			return nil, err
+		}
+		c.set(logger, key, resp)
+		return resp, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		if !executed {
+			c.recordHit(logger, request)
+		}
+		// Callers may modify the response, so don't hand out the shared value.
+		resp := *res.Val.(*types.CompletionResponse)
+		return &resp, nil
+	}
+}
+
+func (c *cachedClient) get(ctx context.Context, logger log.Logger, key string) (*types.CompletionResponse, bool) {
+	b, ok := c.cache.Get(key)
+	trace.FromContext(ctx).AddEvent("checked completions cache", attribute.Bool("hit", ok))
+	if !ok {
+		return nil, false
+	}
+
+	var resp types.CompletionResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		logger.Warn("failed to decode cached completion response", log.Error(err))
+		return nil, false
+	}
+	return &resp, true
+}
+
+func (c *cachedClient) set(logger log.Logger, key string, resp *types.CompletionResponse) {
+	// Empty responses are most likely the result of a provider hiccup, so we
+	// rather ask again next time.
+	if resp == nil || resp.Completion == "" {
+		return
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		logger.Warn("failed to encode completion response for caching", log.Error(err))
+		return
+	}
+	if len(b) > c.maxEntryBytes {
+		return
+	}
+	c.cache.Set(key, b)
+}
+
+func (c *cachedClient) recordHit(logger log.Logger, request types.CompletionRequest) {
+	err := c.hits.RecordCacheHit(
+		request.ModelConfigInfo.Model.ModelName,
+		string(request.Feature),
+		tokenusage.Provider(request.ModelConfigInfo.Provider.ID))
+	if err != nil {
+		logger.Warn("failed to record completions cache hit", log.Error(err))
+	}
+}
+
+// cacheKey returns the key under which the response to the given request is
+// cached. Requests that only differ in ways that don't affect the response, like
+// whether it is streamed, map to the same key.
+//
+// 🚨 SECURITY: Prompts are commonly built from context only the requesting user
+// can access, so responses are cached per actor and never served to others.
+func cacheKey(ctx context.Context, request types.CompletionRequest) (string, error) {
+	params := request.Parameters
+	// The requested model is untrusted input, the model that actually serves the
+	// request is part of the key below.
+	params.RequestedModel = ""
+	params.Stream = nil
+	params.StopSequences = slices.Clone(params.StopSequences)
+	slices.Sort(params.StopSequences)
+	params.StopSequences = slices.Compact(params.StopSequences)
+
+	b, err := json.Marshal(struct {
+		Actor      string                            `json:"actor"`
+		Model      string                            `json:"model"`
+		Feature    types.CompletionsFeature          `json:"feature"`
+		Version    types.CompletionsVersion          `json:"version"`
+		Parameters types.CompletionRequestParameters `json:"parameters"`
+	}{
+		Actor:      cacheScope(actor.FromContext(ctx)),
+		Model:      string(request.ModelConfigInfo.Model.ModelRef),
+		Feature:    request.Feature,
+		Version:    request.Version,
+		Parameters: params,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "encoding request")
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// cacheScope returns the part of the cache key that identifies the actor whose
+// cached responses can be shared.
+func cacheScope(a *actor.Actor) string {
+	switch {
+	case a.IsAuthenticated():
+		return "user:" + a.UIDString()
+	case a.IsInternal():
+		return "internal"
+	default:
+		return "anonymous:" + a.AnonymousUID
+	}
+}
@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/completions/tokenusage"
+	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	modelconfigSDK "github.com/sourcegraph/sourcegraph/internal/modelconfig/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestCachedClient(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+
+	newRequest := func(text string) types.CompletionRequest {
+		return types.CompletionRequest{
+			Feature: types.CompletionsFeatureChat,
+			ModelConfigInfo: types.ModelConfigInfo{
+				Provider: modelconfigSDK.Provider{ID: "anthropic"},
+				Model: modelconfigSDK.Model{
+					ModelRef:  "anthropic::2023-06-01::claude-3-haiku",
+					ModelName: "claude-3-haiku",
+				},
+			},
+			Parameters: types.CompletionRequestParameters{
+				Messages:      []types.Message{{Speaker: types.HUMAN_MESSAGE_SPEAKER, Text: text}},
+				StopSequences: []string{"a", "b"},
+			},
+			Version: types.CompletionsV1,
+		}
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		inner := &fakeCompletionsClient{}
+		hits := &fakeCacheHitRecorder{}
+		client := newCachedClient(inner, newMapCache(), hits, defaultCacheMaxEntryBytes)
+
+		resp, err := client.Complete(ctx, logger, newRequest("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, "response to hello", resp.Completion)
+
+		// Whether the response is streamed, the order of stop sequences and the
+		// requested model don't change the response.
+		request := newRequest("hello")
+		request.Parameters.Stream = pointers.Ptr(false)
+		request.Parameters.StopSequences = []string{"b", "a", "b"}
+		request.Parameters.RequestedModel = "something-else"
+		resp, err = client.Complete(ctx, logger, request)
+		require.NoError(t, err)
+		assert.Equal(t, "response to hello", resp.Completion)
+
+		_, err = client.Complete(ctx, logger, newRequest("goodbye"))
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, inner.calls)
+		assert.Equal(t, []string{"anthropic:claude-3-haiku:chat_completions"}, hits.hits)
+	})
+
+	t.Run("stream replays cached response", func(t *testing.T) {
+		inner := &fakeCompletionsClient{}
+		hits := &fakeCacheHitRecorder{}
+		client := newCachedClient(inner, newMapCache(), hits, defaultCacheMaxEntryBytes)
+
+		var first []types.CompletionResponse
+		err := client.Stream(ctx, logger, newRequest("hello"), func(event types.CompletionResponse) error {
+			first = append(first, event)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+
+		var second []types.CompletionResponse
+		err = client.Stream(ctx, logger, newRequest("hello"), func(event types.CompletionResponse) error {
+			second = append(second, event)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, first[len(first)-1:], second)
+
+		// Streamed responses are also used for non-streaming requests.
+		resp, err := client.Complete(ctx, logger, newRequest("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, first[len(first)-1], *resp)
+
+		assert.Equal(t, 1, inner.calls)
+		assert.Len(t, hits.hits, 2)
+	})
+
+	t.Run("responses exceeding the size limit are not cached", func(t *testing.T) {
+		inner := &fakeCompletionsClient{}
+		client := newCachedClient(inner, newMapCache(), &fakeCacheHitRecorder{}, 10)
+
+		for range 2 {
+			_, err := client.Complete(ctx, logger, newRequest("hello"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("concurrent requests are coalesced", func(t *testing.T) {
+		release := make(chan struct{})
+		inner := &fakeCompletionsClient{block: release, started: make(chan struct{})}
+		hits := &fakeCacheHitRecorder{}
+		client := newCachedClient(inner, newMapCache(), hits, defaultCacheMaxEntryBytes)
+
+		var wg sync.WaitGroup
+		responses := make([]*types.CompletionResponse, 5)
+		for i := range responses {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Complete(ctx, logger, newRequest("concurrent"))
+				assert.NoError(t, err)
+				responses[i] = resp
+			}()
+		}
+
+		// Wait for the first request to reach the provider before letting it
+		// respond, so that the others have a chance to join it.
+		<-inner.started
+		close(release)
+		wg.Wait()
+
+		for _, resp := range responses {
+			require.NotNil(t, resp)
+			assert.Equal(t, "response to concurrent", resp.Completion)
+		}
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("responses are cached per actor", func(t *testing.T) {
+		inner := &fakeCompletionsClient{}
+		client := newCachedClient(inner, newMapCache(), &fakeCacheHitRecorder{}, defaultCacheMaxEntryBytes)
+
+		for _, a := range []*actor.Actor{
+			actor.FromUser(1),
+			actor.FromUser(1),
+			actor.FromUser(2),
+			actor.FromAnonymousUser("anonymous"),
+			actor.Internal(),
+		} {
+			_, err := client.Complete(actor.WithActor(ctx, a), logger, newRequest("hello"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 4, inner.calls)
+	})
+
+	t.Run("coalesced requests have a timeout", func(t *testing.T) {
+		inner := &fakeCompletionsClient{}
+		client := newCachedClient(inner, newMapCache(), &fakeCacheHitRecorder{}, defaultCacheMaxEntryBytes)
+
+		_, err := client.Complete(ctx, logger, newRequest("deadline"))
+		require.NoError(t, err)
+		require.Len(t, inner.deadlines, 1)
+		assert.WithinDuration(t, time.Now().Add(coalescedRequestTimeout), inner.deadlines[0], 10*time.Second)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		inner := &fakeCompletionsClient{}
+		assert.Same(t, inner, newCachedClientFromConfig(nil, inner))
+		assert.Same(t, inner, newCachedClientFromConfig(&schema.CodyCompletionsCache{Enabled: false}, inner))
+	})
+}
+
+type fakeCompletionsClient struct {
+	mu        sync.Mutex
+	calls     int
+	deadlines []time.Time
+	block     chan struct{}
+	started   chan struct{}
+}
+
+func (c *fakeCompletionsClient) call(ctx context.Context) {
+	c.mu.Lock()
+	c.calls++
+	if deadline, ok := ctx.Deadline(); ok {
+		c.deadlines = append(c.deadlines, deadline)
+	}
+	c.mu.Unlock()
+
+	if c.block != nil {
+		close(c.started)
+		<-c.block
+	}
+}
+
+func (c *fakeCompletionsClient) Stream(ctx context.Context, _ log.Logger, request types.CompletionRequest, send types.SendCompletionEvent) error {
+	c.call(ctx)
+	text := "response to " + request.Parameters.Messages[0].Text
+	if err := send(types.CompletionResponse{Completion: text[:8]}); err != nil {
+		return err
+	}
+	return send(types.CompletionResponse{Completion: text, StopReason: "stop"})
+}
+
+func (c *fakeCompletionsClient) Complete(ctx context.Context, _ log.Logger, request types.CompletionRequest) (*types.CompletionResponse, error) {
+	c.call(ctx)
+	return &types.CompletionResponse{
+		Completion: "response to " + request.Parameters.Messages[0].Text,
+		StopReason: "stop",
+	}, nil
+}
+
+type fakeCacheHitRecorder struct {
+	mu   sync.Mutex
+	hits []string
+}
+
+func (r *fakeCacheHitRecorder) RecordCacheHit(model, feature string, provider tokenusage.Provider) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hits = append(r.hits, string(provider)+":"+model+":"+feature)
+	return nil
+}
+
+type mapCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func newMapCache() *mapCache {
+	return &mapCache{entries: map[string][]byte{}}
+}
+
+func (c *mapCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.entries[key]
+	return b, ok
+}
+
+func (c *mapCache) Set(key string, b []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = b
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/completions/client/openaicompatible"
 	"github.com/sourcegraph/sourcegraph/internal/completions/tokenusage"
 	"github.com/sourcegraph/sourcegraph/internal/completions/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/telemetry"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -28,6 +29,7 @@ func Get(
 	if err != nil {
 		return nil, err
 	}
+	client = newCachedClientFromConfig(conf.Get().CodyCompletionsCache, client)
 	return newObservedClient(logger, events, client), nil
 }
 
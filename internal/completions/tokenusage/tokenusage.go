@@ -11,6 +11,9 @@ import (
 
 type Manager struct {
 	cache *rcache.Cache
+	// cacheHits is kept apart from cache, whose keys are all reported as
+	// token counts.
+	cacheHits *rcache.Cache
 }
 
 type ModelData struct {
@@ -20,16 +23,22 @@ type ModelData struct {
 
 func NewManager() *Manager {
 	return &Manager{
-		cache: rcache.New(redispool.Store, "LLMUsage"),
+		cache:     rcache.New(redispool.Store, "LLMUsage"),
+		cacheHits: newCacheHitsCache(),
 	}
 }
 
 func NewManagerWithCache(cache *rcache.Cache) *Manager {
 	return &Manager{
-		cache: cache,
+		cache:     cache,
+		cacheHits: newCacheHitsCache(),
 	}
 }
 
+func newCacheHitsCache() *rcache.Cache {
+	return rcache.New(redispool.Store, "LLMCacheHits")
+}
+
 type Provider string
 
 const (
@@ -52,6 +61,24 @@ func (m *Manager) UpdateTokenCountsFromModelUsage(inputTokens, outputTokens int,
 	return nil
 }
 
+// RecordCacheHit counts a completion request that was answered from the
+// completions response cache, without sending any tokens to the provider.
+func (m *Manager) RecordCacheHit(model, feature string, provider Provider) error {
+	key := fmt.Sprintf("%s:%s:%s", provider, model, feature)
+	if _, err := m.cacheHits.IncrByInt64(key, 1); err != nil {
+		return errors.Newf("failed to increment cache hits for key %s: %w", key, err)
+	}
+	return nil
+}
+
+// CacheHits returns the number of completion requests for the given model and
+// feature that were answered from the completions response cache.
+func (m *Manager) CacheHits(model, feature string, provider Provider) (int64, error) {
+	key := fmt.Sprintf("%s:%s:%s", provider, model, feature)
+	hits, _, err := m.cacheHits.GetInt64(key)
+	return hits, err
+}
+
 func (m *Manager) updateTokenCounts(key string, tokenCount int64) error {
 	if _, err := m.cache.IncrByInt64(key, tokenCount); err != nil {
 		return errors.Newf("failed to increment token count for key %s: %w", key, err)
@@ -43,3 +43,43 @@ func TestGetAllTokenUsageData(t *testing.T) {
 		}
 	}
 }
+
+func TestRecordCacheHit(t *testing.T) {
+	rcache.SetupForTest(t)
+	manager := tokenusage.NewManager()
+
+	if err := manager.UpdateTokenCountsFromModelUsage(10, 20, "model1", "feature1", tokenusage.Anthropic); err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if err := manager.RecordCacheHit("model1", "feature1", tokenusage.Anthropic); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hits, err := manager.CacheHits("model1", "feature1", tokenusage.Anthropic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hits != 2 {
+		t.Errorf("Expected 2 cache hits, got %d", hits)
+	}
+
+	// Cache hits must not be reported as token counts.
+	usage, err := manager.FetchTokenUsageDataForAnalysis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]float64{
+		"anthropic:model1:feature1:input":  10,
+		"anthropic:model1:feature1:output": 20,
+	}
+	if len(usage) != len(expected) {
+		t.Fatalf("Expected %d token counts, got %v", len(expected), usage)
+	}
+	for key, tokens := range expected {
+		if usage[key] != tokens {
+			t.Errorf("Expected %f tokens for %s, got %f", tokens, key, usage[key])
+		}
+	}
+}
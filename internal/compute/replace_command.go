@@ -54,7 +54,8 @@ func replace(ctx context.Context, content []byte, matchPattern MatchPattern, rep
 func (c *Replace) Run(ctx context.Context, gitserverClient gitserver.Client, r result.Match) (Result, error) {
 	switch m := r.(type) {
 	case *result.FileMatch:
-		r, err := gitserverClient.NewFileReader(ctx, m.Repo.Name, m.CommitID, m.Path)
+		// Matches are found in Git LFS pointer files, so we replace in those.
+		r, err := gitserverClient.NewLFSPointerReader(ctx, m.Repo.Name, m.CommitID, m.Path)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,47 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type Mercurial struct {
+	*schema.MercurialConnection
+}
+
+var _ RepoSource = Mercurial{}
+
+func (c Mercurial) CloneURLToRepoName(cloneURL string) (repoName api.RepoName, err error) {
+	parsedCloneURL, baseURL, match, err := parseURLs(cloneURL, c.Url)
+	if err != nil {
+		return "", err
+	}
+	if !match {
+		return "", nil
+	}
+	// Repositories are served relative to the path of the hgweb server URL.
+	name := strings.Trim(parsedCloneURL.Path, "/")
+	if basePath := strings.Trim(baseURL.Path, "/"); basePath != "" {
+		var ok bool
+		if name, ok = strings.CutPrefix(name, basePath+"/"); !ok {
+			return "", nil
+		}
+	}
+	if name == "" {
+		return "", nil
+	}
+	return MercurialRepoName(c.RepositoryPathPattern, baseURL.Hostname(), name), nil
+}
+
+func MercurialRepoName(repositoryPathPattern, host, name string) api.RepoName {
+	if repositoryPathPattern == "" {
+		repositoryPathPattern = "{host}/{name}"
+	}
+
+	return api.RepoName(strings.NewReplacer(
+		"{host}", host,
+		"{name}", name,
+	).Replace(repositoryPathPattern))
+}
@@ -257,6 +257,9 @@ func UnredactSecrets(input string, raw conftypes.RawUnified) (string, error) {
 		if ap.AzureDevOps != nil {
 			oldAuthProviderSecrets[ap.AzureDevOps.ClientID] = ap.AzureDevOps.ClientSecret
 		}
+		if ap.Gitea != nil {
+			oldAuthProviderSecrets[ap.Gitea.ClientID] = ap.Gitea.ClientSecret
+		}
 	}
 
 	newAuthProviderCfg, err := ParseConfig(conftypes.RawUnified{
@@ -281,6 +284,9 @@ func UnredactSecrets(input string, raw conftypes.RawUnified) (string, error) {
 		if ap.AzureDevOps != nil && ap.AzureDevOps.ClientSecret == redactedSecret {
 			ap.AzureDevOps.ClientSecret = oldAuthProviderSecrets[ap.AzureDevOps.ClientID]
 		}
+		if ap.Gitea != nil && ap.Gitea.ClientSecret == redactedSecret {
+			ap.Gitea.ClientSecret = oldAuthProviderSecrets[ap.Gitea.ClientID]
+		}
 	}
 
 	unredactedSite, err := jsonc.Edit(input, newAuthProviderCfg.AuthProviders, "auth.providers")
@@ -412,6 +418,9 @@ func redactConfSecrets(raw conftypes.RawUnified, hashSecrets bool) (empty confty
 		if ap.AzureDevOps != nil {
 			ap.AzureDevOps.ClientSecret = getRedactedSecret(ap.AzureDevOps.ClientSecret, hashSecrets)
 		}
+		if ap.Gitea != nil {
+			ap.Gitea.ClientSecret = getRedactedSecret(ap.Gitea.ClientSecret, hashSecrets)
+		}
 	}
 
 	for _, oa := range cfg.ObservabilityAlerts {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/accesstoken"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -27,6 +28,9 @@ type AccessToken struct {
 	ID            int64
 	SubjectUserID int32 // the user whose privileges the access token grants
 	Scopes        []string
+	// RepoIDs restricts the access token to these repositories. It is empty
+	// for tokens that can access all repositories the subject user can access.
+	RepoIDs       []api.RepoID
 	Note          string
 	CreatorUserID int32
 	// Internal determines whether or not the token shows up in the UI. Tokens
@@ -85,6 +89,14 @@ type AccessTokenStore interface {
 	// specified user (i.e., that the actor is either the user or a site admin).
 	Create(ctx context.Context, subjectUserID int32, scopes []string, note string, creatorUserID int32, expiresAt time.Time) (id int64, token string, err error)
 
+	// CreateForRepos is like Create, but the access token can only be used to
+	// access the given repositories. An empty repoIDs is the same as calling
+	// Create.
+	//
+	// 🚨 SECURITY: The caller must ensure that the actor is permitted to create tokens for the
+	// specified user (i.e., that the actor is either the user or a site admin).
+	CreateForRepos(ctx context.Context, subjectUserID int32, scopes []string, repoIDs []api.RepoID, note string, creatorUserID int32, expiresAt time.Time) (id int64, token string, err error)
+
 	// CreateInternal creates an *internal* access token for the specified user. An
 	// internal access token will be used by Sourcegraph to talk to its API from
 	// other services, i.e. executor jobs. Internal tokens do not show up in the UI.
@@ -132,16 +144,16 @@ type AccessTokenStore interface {
 	// options.
 	List(context.Context, AccessTokensListOptions) ([]*AccessToken, error)
 
-	// Lookup looks up the access token. If it's valid and contains the required scope, it returns the
-	// subject's user ID. Otherwise ErrAccessTokenNotFound is returned.
+	// Lookup looks up the access token. If it's valid and contains at least one of the required
+	// scopes, it returns the token. Otherwise ErrAccessTokenNotFound is returned.
 	//
 	// The token prefix "sgp_", if present, is stripped.
 	//
 	// Calling Lookup also updates the access token's last-used-at date as applicable.
 	//
-	// 🚨 SECURITY: This returns a user ID if and only if the token corresponds to a valid,
+	// 🚨 SECURITY: This returns a token if and only if the token corresponds to a valid,
 	// non-deleted access token.
-	Lookup(ctx context.Context, token string, opts TokenLookupOpts) (subjectUserID int32, err error)
+	Lookup(ctx context.Context, token string, opts TokenLookupOpts) (*AccessToken, error)
 
 	WithTransact(context.Context, func(AccessTokenStore) error) error
 	With(basestore.ShareableStore) AccessTokenStore
@@ -171,14 +183,18 @@ func (s *accessTokenStore) WithTransact(ctx context.Context, f func(AccessTokenS
 }
 
 func (s *accessTokenStore) Create(ctx context.Context, subjectUserID int32, scopes []string, note string, creatorUserID int32, expiresAt time.Time) (id int64, token string, err error) {
-	return s.createToken(ctx, subjectUserID, scopes, note, creatorUserID, expiresAt, false)
+	return s.createToken(ctx, subjectUserID, scopes, nil, note, creatorUserID, expiresAt, false)
+}
+
+func (s *accessTokenStore) CreateForRepos(ctx context.Context, subjectUserID int32, scopes []string, repoIDs []api.RepoID, note string, creatorUserID int32, expiresAt time.Time) (id int64, token string, err error) {
+	return s.createToken(ctx, subjectUserID, scopes, repoIDs, note, creatorUserID, expiresAt, false)
 }
 
 func (s *accessTokenStore) CreateInternal(ctx context.Context, subjectUserID int32, scopes []string, note string, creatorUserID int32) (id int64, token string, err error) {
-	return s.createToken(ctx, subjectUserID, scopes, note, creatorUserID, time.Time{}, true)
+	return s.createToken(ctx, subjectUserID, scopes, nil, note, creatorUserID, time.Time{}, true)
 }
 
-func (s *accessTokenStore) createToken(ctx context.Context, subjectUserID int32, scopes []string, note string, creatorUserID int32, expiresAt time.Time, internal bool) (id int64, token string, err error) {
+func (s *accessTokenStore) createToken(ctx context.Context, subjectUserID int32, scopes []string, repoIDs []api.RepoID, note string, creatorUserID int32, expiresAt time.Time, internal bool) (id int64, token string, err error) {
 	if len(scopes) == 0 {
 		// Prevent mistakes. There is no point in creating an access token with no scopes, and the
 		// GraphQL API wouldn't let you do so anyway.
@@ -222,13 +238,13 @@ creator_user AS (
   SELECT id FROM users WHERE id=$5 AND deleted_at IS NULL FOR UPDATE
 ),
 insert_values AS (
-  SELECT subject_user.id AS subject_user_id, $2::text[] AS scopes, $3::bytea AS value_sha256, $4::text AS note, creator_user.id AS creator_user_id, $6::timestamp with time zone AS expires_at, $7::boolean AS internal
+  SELECT subject_user.id AS subject_user_id, $2::text[] AS scopes, $3::bytea AS value_sha256, $4::text AS note, creator_user.id AS creator_user_id, $6::timestamp with time zone AS expires_at, $7::boolean AS internal, $9::integer[] AS repo_ids
   FROM subject_user, creator_user
   WHERE subject_user.active_tokens < $8::int OR $7::boolean
 )
-INSERT INTO access_tokens(subject_user_id, scopes, value_sha256, note, creator_user_id, expires_at, internal) SELECT * FROM insert_values RETURNING id
+INSERT INTO access_tokens(subject_user_id, scopes, value_sha256, note, creator_user_id, expires_at, internal, repo_ids) SELECT * FROM insert_values RETURNING id
 `,
-		subjectUserID, pq.Array(scopes), hashutil.ToSHA256Bytes(b[:]), note, creatorUserID, dbutil.NullTimeColumn(expiresAt), internal, conf.AccessTokensMaxPerUser(),
+		subjectUserID, pq.Array(scopes), hashutil.ToSHA256Bytes(b[:]), note, creatorUserID, dbutil.NullTimeColumn(expiresAt), internal, conf.AccessTokensMaxPerUser(), repoIDsColumn(repoIDs),
 	).Scan(&id); err != nil {
 		// if creation failed check to see if it was because too many tokens already
 		count, countErr := s.Count(ctx, AccessTokensListOptions{SubjectUserID: subjectUserID})
@@ -245,15 +261,17 @@ INSERT INTO access_tokens(subject_user_id, scopes, value_sha256, note, creator_u
 	// only log access tokens created by users
 	if !internal {
 		arg := struct {
-			SubjectUserId int32     `json:"subject_user_id"`
-			CreatorUserId int32     `json:"creator_user_id"`
-			Scopes        []string  `json:"scopes"`
-			Note          string    `json:"note"`
-			ExpiresAt     time.Time `json:"expires_at"`
+			SubjectUserId int32        `json:"subject_user_id"`
+			CreatorUserId int32        `json:"creator_user_id"`
+			Scopes        []string     `json:"scopes"`
+			RepoIDs       []api.RepoID `json:"repo_ids,omitempty"`
+			Note          string       `json:"note"`
+			ExpiresAt     time.Time    `json:"expires_at"`
 		}{
 			SubjectUserId: subjectUserID,
 			CreatorUserId: creatorUserID,
 			Scopes:        scopes,
+			RepoIDs:       repoIDs,
 			Note:          note,
 			ExpiresAt:     expiresAt,
 		}
@@ -304,8 +322,10 @@ func (s *accessTokenStore) getInternalToken(ctx context.Context, subjectUserID i
 }
 
 type TokenLookupOpts struct {
-	OnlyAdmin     bool
-	RequiredScope string
+	OnlyAdmin bool
+	// RequiredScopes is the list of scopes of which the token must have at
+	// least one.
+	RequiredScopes []string
 }
 
 // Returns a query to upload the token's LastUsedAt column to "now". The returned query
@@ -317,16 +337,13 @@ func (o TokenLookupOpts) toUpdateLastUsedQuery() string {
 `
 }
 
-// toGetQuery returns a SQL query that will return the following columns from
-// the access_tokens table.
-// - id
-// - subject_user_id
-// - last_used_at
+// toGetQuery returns a SQL query that will return the columns of the
+// access_tokens table in the order expected by scanAccessToken.
 //
 // The query requires two parameters: the token's value_sha256 and scopes.
 func (o TokenLookupOpts) toGetQuery() string {
 	query := `
-	SELECT t.id, t.subject_user_id, t.last_used_at
+	SELECT t.id, t.subject_user_id, t.scopes, t.repo_ids, t.note, t.creator_user_id, t.internal, t.created_at, t.last_used_at, t.expires_at
 	FROM access_tokens t
 	JOIN users subject_user ON t.subject_user_id=subject_user.id AND subject_user.deleted_at IS NULL
 	JOIN users creator_user ON t.creator_user_id=creator_user.id AND creator_user.deleted_at IS NULL
@@ -337,7 +354,7 @@ func (o TokenLookupOpts) toGetQuery() string {
 		AND
 		(t.expires_at IS NULL OR t.expires_at > NOW())
 		AND
-	    $2 = ANY (t.scopes)
+	    t.scopes && $2::text[]
 `
 
 	if o.OnlyAdmin {
@@ -347,36 +364,31 @@ func (o TokenLookupOpts) toGetQuery() string {
 	return query
 }
 
-func (s *accessTokenStore) Lookup(ctx context.Context, token string, opts TokenLookupOpts) (subjectUserID int32, err error) {
-	if opts.RequiredScope == "" {
-		return 0, errors.New("no scope provided in access token lookup")
+func (s *accessTokenStore) Lookup(ctx context.Context, token string, opts TokenLookupOpts) (*AccessToken, error) {
+	if len(opts.RequiredScopes) == 0 {
+		return nil, errors.New("no scope provided in access token lookup")
 	}
 
 	tokenHash, err := tokenSHA256Hash(token)
 	if err != nil {
-		return 0, errors.Wrap(err, "AccessTokens.Lookup")
+		return nil, errors.Wrap(err, "AccessTokens.Lookup")
 	}
 
-	var (
-		tokenID    int64
-		subjectID  int32
-		lastUsedAt *time.Time
-	)
 	row := s.Handle().QueryRowContext(ctx,
 		// Ensure that subject and creator users still exist.
 		opts.toGetQuery(),
-		tokenHash, opts.RequiredScope)
-	err = row.Scan(&tokenID, &subjectID, &lastUsedAt)
+		tokenHash, pq.Array(opts.RequiredScopes))
+	t, err := scanAccessToken(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, ErrAccessTokenNotFound
+			return nil, ErrAccessTokenNotFound
 		}
-		return 0, err
+		return nil, err
 	}
 
-	if lastUsedAt == nil || time.Until(*lastUsedAt) < -MaxAccessTokenLastUsedAtAge {
-		logger := s.logger.With(log.Int64("tokenID", tokenID), log.Int32("subjectID", subjectID))
-		_, err := s.Handle().ExecContext(ctx, opts.toUpdateLastUsedQuery(), tokenID)
+	if t.LastUsedAt == nil || time.Until(*t.LastUsedAt) < -MaxAccessTokenLastUsedAtAge {
+		logger := s.logger.With(log.Int64("tokenID", t.ID), log.Int32("subjectID", t.SubjectUserID))
+		_, err := s.Handle().ExecContext(ctx, opts.toUpdateLastUsedQuery(), t.ID)
 		if err != nil {
 			logger.Warn("error trying to update token's last_used_at value", log.Error(err))
 		} else {
@@ -384,7 +396,7 @@ func (s *accessTokenStore) Lookup(ctx context.Context, token string, opts TokenL
 		}
 	}
 
-	return subjectID, nil
+	return t, nil
 }
 
 func (s *accessTokenStore) GetByID(ctx context.Context, id int64) (*AccessToken, error) {
@@ -444,7 +456,7 @@ func (s *accessTokenStore) List(ctx context.Context, opt AccessTokensListOptions
 
 func (s *accessTokenStore) list(ctx context.Context, conds []*sqlf.Query, limitOffset *LimitOffset) ([]*AccessToken, error) {
 	q := sqlf.Sprintf(`
-SELECT id, subject_user_id, scopes, note, creator_user_id, internal, created_at, last_used_at, expires_at FROM access_tokens
+SELECT id, subject_user_id, scopes, repo_ids, note, creator_user_id, internal, created_at, last_used_at, expires_at FROM access_tokens
 WHERE (%s)
 ORDER BY now() - created_at < interval '5 minutes' DESC, -- show recently created tokens first
 last_used_at DESC NULLS FIRST, -- ensure newly created tokens show first
@@ -462,21 +474,11 @@ created_at DESC
 
 	var results []*AccessToken
 	for rows.Next() {
-		var t AccessToken
-		if err := rows.Scan(
-			&t.ID,
-			&t.SubjectUserID,
-			pq.Array(&t.Scopes),
-			&t.Note,
-			&t.CreatorUserID,
-			&t.Internal,
-			&t.CreatedAt,
-			&t.LastUsedAt,
-			&dbutil.NullTime{Time: &t.ExpiresAt},
-		); err != nil {
+		t, err := scanAccessToken(rows)
+		if err != nil {
 			return nil, err
 		}
-		results = append(results, &t)
+		results = append(results, t)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -485,6 +487,44 @@ created_at DESC
 	return results, nil
 }
 
+func scanAccessToken(sc dbutil.Scanner) (*AccessToken, error) {
+	var (
+		t       AccessToken
+		repoIDs []int32
+	)
+	if err := sc.Scan(
+		&t.ID,
+		&t.SubjectUserID,
+		pq.Array(&t.Scopes),
+		pq.Array(&repoIDs),
+		&t.Note,
+		&t.CreatorUserID,
+		&t.Internal,
+		&t.CreatedAt,
+		&t.LastUsedAt,
+		&dbutil.NullTime{Time: &t.ExpiresAt},
+	); err != nil {
+		return nil, err
+	}
+	for _, id := range repoIDs {
+		t.RepoIDs = append(t.RepoIDs, api.RepoID(id))
+	}
+	return &t, nil
+}
+
+// repoIDsColumn returns the value to store in the repo_ids column for the
+// given repository restriction. No restriction is stored as NULL.
+func repoIDsColumn(repoIDs []api.RepoID) any {
+	if len(repoIDs) == 0 {
+		return nil
+	}
+	ids := make([]int32, 0, len(repoIDs))
+	for _, id := range repoIDs {
+		ids = append(ids, int32(id))
+	}
+	return pq.Array(ids)
+}
+
 func (s *accessTokenStore) Count(ctx context.Context, opt AccessTokensListOptions) (int, error) {
 	q := sqlf.Sprintf("SELECT COUNT(*) FROM access_tokens WHERE (%s)", sqlf.Join(opt.sqlConditions(), ") AND ("))
 	var count int
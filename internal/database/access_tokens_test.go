@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
@@ -105,12 +106,12 @@ func testAccessTokens_Create(t *testing.T) {
 		t.Errorf("got %q, want %q", got.Note, want)
 	}
 
-	gotSubjectUserID, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"})
+	gotToken, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if want := subject.ID; gotSubjectUserID != want {
-		t.Errorf("got %v, want %v", gotSubjectUserID, want)
+	if want := subject.ID; gotToken.SubjectUserID != want {
+		t.Errorf("got %v, want %v", gotToken.SubjectUserID, want)
 	}
 
 	ts, err := db.AccessTokens().List(ctx, AccessTokensListOptions{SubjectUserID: subject.ID})
@@ -376,35 +377,60 @@ func testAccessTokens_Lookup(t *testing.T) {
 	}
 
 	for _, scope := range []string{"a", "b"} {
-		gotSubjectUserID, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: scope})
+		gotToken, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{scope}})
 		if err != nil {
 			t.Fatal(err)
 		}
-		if want := subject.ID; gotSubjectUserID != want {
-			t.Errorf("got %v, want %v", gotSubjectUserID, want)
+		if want := subject.ID; gotToken.SubjectUserID != want {
+			t.Errorf("got %v, want %v", gotToken.SubjectUserID, want)
 		}
 	}
 
 	// Lookup with a nonexistent scope and ensure it fails.
-	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "x"}); err == nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"x"}}); err == nil {
 		t.Fatal(err)
 	}
 
 	// Lookup with an empty scope and ensure it fails.
-	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: ""}); err == nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{}); err == nil {
 		t.Fatal(err)
 	}
 
+	// Lookup with several scopes succeeds if the token has any of them.
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"x", "b"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tokens restricted to repositories return the repositories on Lookup.
+	_, tv1, err := db.AccessTokens().CreateForRepos(ctx, subject.ID, []string{"a"}, []api.RepoID{1, 2}, "n1", creator.ID, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotToken, err := db.AccessTokens().Lookup(ctx, tv1, TokenLookupOpts{RequiredScopes: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []api.RepoID{1, 2}; !reflect.DeepEqual(gotToken.RepoIDs, want) {
+		t.Errorf("got %v, want %v", gotToken.RepoIDs, want)
+	}
+	gotToken, err = db.AccessTokens().GetByToken(ctx, tv1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []api.RepoID{1, 2}; !reflect.DeepEqual(gotToken.RepoIDs, want) {
+		t.Errorf("got %v, want %v", gotToken.RepoIDs, want)
+	}
+
 	// Delete a token and ensure Lookup fails on it.
 	if err := db.AccessTokens().DeleteByID(ctx, tid0); err != nil {
 		t.Fatal(err)
 	}
-	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"}); err == nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}}); err == nil {
 		t.Fatal(err)
 	}
 
 	// Try to Lookup a token that was never created.
-	if _, err := db.AccessTokens().Lookup(ctx, "abcdefg" /* this token value was never created */, TokenLookupOpts{RequiredScope: "a"}); err == nil {
+	if _, err := db.AccessTokens().Lookup(ctx, "abcdefg" /* this token value was never created */, TokenLookupOpts{RequiredScopes: []string{"a"}}); err == nil {
 		t.Fatal(err)
 	}
 
@@ -444,7 +470,7 @@ func testAccessTokens_Lookup(t *testing.T) {
 
 		// Confirm that a side-effect of Lookup will initialize last_used_at.
 		// When we fetch the token again, it's value should be recent.
-		_, err = db.AccessTokens().Lookup(ctx, testTokenValue, TokenLookupOpts{RequiredScope: "a"})
+		_, err = db.AccessTokens().Lookup(ctx, testTokenValue, TokenLookupOpts{RequiredScopes: []string{"a"}})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -472,7 +498,7 @@ func testAccessTokens_Lookup(t *testing.T) {
 		}
 
 		// Now lookup the token. A side-effect of this will update the last_used_at.
-		_, err = db.AccessTokens().Lookup(ctx, testTokenValue, TokenLookupOpts{RequiredScope: "a"})
+		_, err = db.AccessTokens().Lookup(ctx, testTokenValue, TokenLookupOpts{RequiredScopes: []string{"a"}})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -526,7 +552,7 @@ func testAccessTokens_Lookup_deletedUser(t *testing.T) {
 		if err := db.Users().Delete(ctx, subject.ID); err != nil {
 			t.Fatal(err)
 		}
-		if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"}); err == nil {
+		if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}}); err == nil {
 			t.Fatal("Lookup: want error looking up token for deleted subject user")
 		}
 
@@ -562,7 +588,7 @@ func testAccessTokens_Lookup_deletedUser(t *testing.T) {
 		if err := db.Users().Delete(ctx, creator.ID); err != nil {
 			t.Fatal(err)
 		}
-		if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"}); err == nil {
+		if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}}); err == nil {
 			t.Fatal("Lookup: want error looking up token for deleted creator user")
 		}
 
@@ -608,7 +634,7 @@ func testAccessTokens_Expiration(t *testing.T) {
 	}
 
 	// Ensure we can lookup the token
-	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"}); err != nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}}); err != nil {
 		t.Fatal("Lookup: no error expected")
 	}
 
@@ -622,7 +648,7 @@ func testAccessTokens_Expiration(t *testing.T) {
 	}
 
 	// Ensure we can no longer lookup the token
-	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"}); err == nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}}); err == nil {
 		t.Fatal("Lookup: want error looking up expired token")
 	}
 
@@ -692,10 +718,10 @@ func TestAccessTokens_Limits(t *testing.T) {
 	}
 
 	// Ensure we can lookup the tokens
-	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScope: "a"}); err != nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv0, TokenLookupOpts{RequiredScopes: []string{"a"}}); err != nil {
 		t.Fatal("Lookup: no error expected")
 	}
-	if _, err := db.AccessTokens().Lookup(ctx, tv1, TokenLookupOpts{RequiredScope: "a"}); err != nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tv1, TokenLookupOpts{RequiredScopes: []string{"a"}}); err != nil {
 		t.Fatal("Lookup: no error expected")
 	}
 
@@ -717,7 +743,7 @@ func TestAccessTokens_Limits(t *testing.T) {
 		t.Fatal(err)
 	}
 	// Ensure we can lookup the internal token
-	if _, err := db.AccessTokens().Lookup(ctx, tvInternal, TokenLookupOpts{RequiredScope: "a"}); err != nil {
+	if _, err := db.AccessTokens().Lookup(ctx, tvInternal, TokenLookupOpts{RequiredScopes: []string{"a"}}); err != nil {
 		t.Fatal("Lookup: no error expected")
 	}
 
@@ -764,10 +790,10 @@ func testAccessTokens_Lookup_expiredLicense(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if _, err := db.AccessTokens().Lookup(ctx, adminToken, TokenLookupOpts{RequiredScope: "a", OnlyAdmin: true}); err != nil {
+	if _, err := db.AccessTokens().Lookup(ctx, adminToken, TokenLookupOpts{RequiredScopes: []string{"a"}, OnlyAdmin: true}); err != nil {
 		t.Fatal("Lookup: lookup should not fail for admin user")
 	}
-	if _, err := db.AccessTokens().Lookup(ctx, regularToken, TokenLookupOpts{RequiredScope: "a", OnlyAdmin: true}); err == nil {
+	if _, err := db.AccessTokens().Lookup(ctx, regularToken, TokenLookupOpts{RequiredScopes: []string{"a"}, OnlyAdmin: true}); err == nil {
 		t.Fatal("Lookup: lookup should fail for regular user")
 	}
 }
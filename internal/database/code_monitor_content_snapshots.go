@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func (s *codeMonitorStore) GetContentSnapshot(ctx context.Context, monitorID int64) (map[api.RepoID][]string, error) {
+	rawQuery := `
+	SELECT repo_id, match_keys
+	FROM cm_content_snapshots
+	WHERE monitor_id = %s
+	`
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(rawQuery, monitorID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshot := make(map[api.RepoID][]string)
+	for rows.Next() {
+		var (
+			repoID    api.RepoID
+			matchKeys []string
+		)
+		if err := rows.Scan(&repoID, (*pq.StringArray)(&matchKeys)); err != nil {
+			return nil, err
+		}
+		snapshot[repoID] = matchKeys
+	}
+	return snapshot, rows.Err()
+}
+
+func (s *codeMonitorStore) ReplaceContentSnapshot(ctx context.Context, monitorID int64, matchKeys map[api.RepoID][]string) (err error) {
+	tx, err := s.Store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	deleteQuery := `
+	DELETE FROM cm_content_snapshots
+	WHERE monitor_id = %s
+	`
+	if err := tx.Exec(ctx, sqlf.Sprintf(deleteQuery, monitorID)); err != nil {
+		return err
+	}
+
+	insertQuery := `
+	INSERT INTO cm_content_snapshots (monitor_id, repo_id, match_keys)
+	VALUES (%s, %s, %s)
+	`
+	for repoID, keys := range matchKeys {
+		// Appease non-null constraint on column
+		if keys == nil {
+			keys = []string{}
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(insertQuery, monitorID, int64(repoID), pq.StringArray(keys))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreContentSnapshot(t *testing.T) {
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	t.Run("replace get replace get", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		db := NewDB(logger, dbtest.NewDB(t))
+		fixtures := populateCodeMonitorFixtures(t, db)
+		cm := db.CodeMonitors()
+
+		// Nothing recorded yet
+		snapshot, err := cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Empty(t, snapshot)
+
+		// Replace
+		insertSnapshot := map[api.RepoID][]string{fixtures.Repo.ID: {"key1", "key2"}}
+		err = cm.ReplaceContentSnapshot(ctx, fixtures.Monitor.ID, insertSnapshot)
+		require.NoError(t, err)
+
+		// Get
+		snapshot, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, insertSnapshot, snapshot)
+
+		// Replace with a repo without matches
+		updateSnapshot := map[api.RepoID][]string{fixtures.Repo.ID: nil}
+		err = cm.ReplaceContentSnapshot(ctx, fixtures.Monitor.ID, updateSnapshot)
+		require.NoError(t, err)
+
+		// Get
+		snapshot, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[api.RepoID][]string{fixtures.Repo.ID: {}}, snapshot)
+
+		// Replace with nothing
+		err = cm.ReplaceContentSnapshot(ctx, fixtures.Monitor.ID, nil)
+		require.NoError(t, err)
+
+		// Get
+		snapshot, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Empty(t, snapshot)
+	})
+}
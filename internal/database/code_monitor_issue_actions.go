@@ -0,0 +1,238 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// IssueAction is a code monitor action that opens an issue in a GitHub or
+// GitLab repository, or comments on the issue it previously opened while that
+// issue is still open.
+type IssueAction struct {
+	ID             int64
+	Monitor        int64
+	Enabled        bool
+	RepoID         api.RepoID
+	IncludeResults bool
+
+	// IssueNumber is the number of the issue last opened by this action, or
+	// nil if no issue has been opened yet.
+	IssueNumber *int32
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+const updateIssueActionQuery = `
+UPDATE cm_issue_actions
+SET enabled = %s,
+	include_results = %s,
+	repo_id = %s,
+	issue_number = CASE WHEN repo_id = %s THEN issue_number ELSE NULL END,
+	changed_by = %s,
+	changed_at = %s
+WHERE
+	id = %s
+	AND EXISTS (
+		SELECT 1 FROM cm_monitors
+		WHERE cm_monitors.id = cm_issue_actions.monitor
+			AND %s
+	)
+RETURNING %s;
+`
+
+// UpdateIssueAction updates the issue action with the given ID. Moving the
+// action to a different repository forgets the previously opened issue.
+func (s *codeMonitorStore) UpdateIssueAction(ctx context.Context, id int64, enabled, includeResults bool, repoID api.RepoID) (*IssueAction, error) {
+	a := actor.FromContext(ctx)
+
+	user, err := a.User(ctx, s.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		updateIssueActionQuery,
+		enabled,
+		includeResults,
+		repoID,
+		repoID,
+		a.UID,
+		s.Now(),
+		id,
+		namespaceScopeQuery(user),
+		sqlf.Join(issueActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanIssueAction(row)
+}
+
+const setIssueActionIssueNumberQuery = `
+UPDATE cm_issue_actions
+SET issue_number = %s
+WHERE id = %s
+`
+
+// SetIssueActionIssueNumber records the issue that subsequent runs of the issue
+// action should comment on.
+func (s *codeMonitorStore) SetIssueActionIssueNumber(ctx context.Context, id int64, issueNumber int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(setIssueActionIssueNumberQuery, issueNumber, id))
+}
+
+const createIssueActionQuery = `
+INSERT INTO cm_issue_actions
+(monitor, enabled, include_results, repo_id, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateIssueAction(ctx context.Context, monitorID int64, enabled, includeResults bool, repoID api.RepoID) (*IssueAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createIssueActionQuery,
+		monitorID,
+		enabled,
+		includeResults,
+		repoID,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(issueActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanIssueAction(row)
+}
+
+const deleteIssueActionQuery = `
+DELETE FROM cm_issue_actions
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteIssueActions(ctx context.Context, monitorID int64, actionIDs ...int64) error {
+	if len(actionIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(actionIDs))
+	for _, ids := range actionIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteIssueActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countIssueActionsQuery = `
+SELECT COUNT(*)
+FROM cm_issue_actions
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountIssueActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countIssueActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getIssueActionQuery = `
+SELECT %s -- IssueActionColumns
+FROM cm_issue_actions
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetIssueAction(ctx context.Context, id int64) (*IssueAction, error) {
+	q := sqlf.Sprintf(
+		getIssueActionQuery,
+		sqlf.Join(issueActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanIssueAction(row)
+}
+
+const listIssueActionsQuery = `
+SELECT %s -- IssueActionColumns
+FROM cm_issue_actions
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListIssueActions(ctx context.Context, opts ListActionsOpts) ([]*IssueAction, error) {
+	q := sqlf.Sprintf(
+		listIssueActionsQuery,
+		sqlf.Join(issueActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanIssueActions(rows)
+}
+
+// issueActionColumns is the set of columns in the cm_issue_actions table
+// This must be kept in sync with scanIssueAction
+var issueActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_issue_actions.id"),
+	sqlf.Sprintf("cm_issue_actions.monitor"),
+	sqlf.Sprintf("cm_issue_actions.enabled"),
+	sqlf.Sprintf("cm_issue_actions.repo_id"),
+	sqlf.Sprintf("cm_issue_actions.include_results"),
+	sqlf.Sprintf("cm_issue_actions.issue_number"),
+	sqlf.Sprintf("cm_issue_actions.created_by"),
+	sqlf.Sprintf("cm_issue_actions.created_at"),
+	sqlf.Sprintf("cm_issue_actions.changed_by"),
+	sqlf.Sprintf("cm_issue_actions.changed_at"),
+}
+
+func scanIssueActions(rows *sql.Rows) ([]*IssueAction, error) {
+	var as []*IssueAction
+	for rows.Next() {
+		a, err := scanIssueAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	return as, rows.Err()
+}
+
+// scanIssueAction scans an IssueAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with issueActionColumns.
+func scanIssueAction(scanner dbutil.Scanner) (*IssueAction, error) {
+	var a IssueAction
+	err := scanner.Scan(
+		&a.ID,
+		&a.Monitor,
+		&a.Enabled,
+		&a.RepoID,
+		&a.IncludeResults,
+		&a.IssueNumber,
+		&a.CreatedBy,
+		&a.CreatedAt,
+		&a.ChangedBy,
+		&a.ChangedAt,
+	)
+	return &a, err
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreIssueActions(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+		repo, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/issues")
+
+		action, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, true, false, repo.ID)
+		require.NoError(t, err)
+		require.Nil(t, action.IssueNumber)
+
+		got, err := s.GetIssueAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+	})
+
+	t.Run("SetIssueNumberThenUpdate", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+		repo1, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/issues")
+		repo2, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/other-issues")
+
+		action, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, true, false, repo1.ID)
+		require.NoError(t, err)
+
+		err = s.SetIssueActionIssueNumber(ctx, action.ID, 42)
+		require.NoError(t, err)
+
+		// Updating without changing the repository keeps the issue.
+		updated, err := s.UpdateIssueAction(ctx, action.ID, false, true, repo1.ID)
+		require.NoError(t, err)
+		require.False(t, updated.Enabled)
+		require.True(t, updated.IncludeResults)
+		require.NotNil(t, updated.IssueNumber)
+		require.Equal(t, int32(42), *updated.IssueNumber)
+
+		// Moving the action to another repository forgets the issue.
+		updated, err = s.UpdateIssueAction(ctx, action.ID, true, true, repo2.ID)
+		require.NoError(t, err)
+		require.Equal(t, repo2.ID, updated.RepoID)
+		require.Nil(t, updated.IssueNumber)
+	})
+
+	t.Run("CreateDeleteCountList", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+		repo, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/issues")
+
+		action1, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, true, false, repo.ID)
+		require.NoError(t, err)
+		action2, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, true, false, repo.ID)
+		require.NoError(t, err)
+
+		count, err := s.CountIssueActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		err = s.DeleteIssueActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetIssueAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		actions, err := s.ListIssueActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		require.Equal(t, action2.ID, actions[0].ID)
+	})
+
+	t.Run("Update permissions", func(t *testing.T) {
+		ctx, db, s := newTestStore(t)
+		uid1 := insertTestUser(ctx, t, db, "u1", false)
+		ctx1 := actor.WithActor(ctx, actor.FromUser(uid1))
+		uid2 := insertTestUser(ctx, t, db, "u2", false)
+		ctx2 := actor.WithActor(ctx, actor.FromUser(uid2))
+		fixtures := s.insertTestMonitor(ctx1, t)
+		_ = s.insertTestMonitor(ctx2, t)
+		repo, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/issues")
+
+		action, err := s.CreateIssueAction(ctx1, fixtures.monitor.ID, true, true, repo.ID)
+		require.NoError(t, err)
+
+		// User1 can update it
+		_, err = s.UpdateIssueAction(ctx1, action.ID, false, true, repo.ID)
+		require.NoError(t, err)
+
+		// User2 cannot update it
+		_, err = s.UpdateIssueAction(ctx2, action.ID, true, true, repo.ID)
+		require.Error(t, err)
+	})
+}
@@ -45,6 +45,7 @@ type DB interface {
 	OutboundWebhooks(encryption.Key) OutboundWebhookStore
 	OutboundWebhookJobs(encryption.Key) OutboundWebhookJobStore
 	OutboundWebhookLogs(encryption.Key) OutboundWebhookLogStore
+	OwnershipCoverage() OwnershipCoverageStore
 	OwnershipStats() OwnershipStatsStore
 	RecentContributionSignals() RecentContributionSignalStore
 	Perms() PermsStore
@@ -226,6 +227,10 @@ func (d *db) OutboundWebhookLogs(key encryption.Key) OutboundWebhookLogStore {
 	return OutboundWebhookLogsWith(d.Store, key)
 }
 
+func (d *db) OwnershipCoverage() OwnershipCoverageStore {
+	return OwnershipCoverageWith(d.Store)
+}
+
 func (d *db) OwnershipStats() OwnershipStatsStore {
 	return &ownershipStats{d.Store}
 }
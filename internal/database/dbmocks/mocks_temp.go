@@ -1180,6 +1180,9 @@ type MockAccessTokenStore struct {
 	// CreateFunc is an instance of a mock function object controlling the
 	// behavior of the method Create.
 	CreateFunc *AccessTokenStoreCreateFunc
+	// CreateForReposFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateForRepos.
+	CreateForReposFunc *AccessTokenStoreCreateForReposFunc
 	// CreateInternalFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateInternal.
 	CreateInternalFunc *AccessTokenStoreCreateInternalFunc
@@ -1233,6 +1236,11 @@ func NewMockAccessTokenStore() *MockAccessTokenStore {
 				return
 			},
 		},
+		CreateForReposFunc: &AccessTokenStoreCreateForReposFunc{
+			defaultHook: func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (r0 int64, r1 string, r2 error) {
+				return
+			},
+		},
 		CreateInternalFunc: &AccessTokenStoreCreateInternalFunc{
 			defaultHook: func(context.Context, int32, []string, string, int32) (r0 int64, r1 string, r2 error) {
 				return
@@ -1279,7 +1287,7 @@ func NewMockAccessTokenStore() *MockAccessTokenStore {
 			},
 		},
 		LookupFunc: &AccessTokenStoreLookupFunc{
-			defaultHook: func(context.Context, string, database.TokenLookupOpts) (r0 int32, r1 error) {
+			defaultHook: func(context.Context, string, database.TokenLookupOpts) (r0 *database.AccessToken, r1 error) {
 				return
 			},
 		},
@@ -1310,6 +1318,11 @@ func NewStrictMockAccessTokenStore() *MockAccessTokenStore {
 				panic("unexpected invocation of MockAccessTokenStore.Create")
 			},
 		},
+		CreateForReposFunc: &AccessTokenStoreCreateForReposFunc{
+			defaultHook: func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error) {
+				panic("unexpected invocation of MockAccessTokenStore.CreateForRepos")
+			},
+		},
 		CreateInternalFunc: &AccessTokenStoreCreateInternalFunc{
 			defaultHook: func(context.Context, int32, []string, string, int32) (int64, string, error) {
 				panic("unexpected invocation of MockAccessTokenStore.CreateInternal")
@@ -1356,7 +1369,7 @@ func NewStrictMockAccessTokenStore() *MockAccessTokenStore {
 			},
 		},
 		LookupFunc: &AccessTokenStoreLookupFunc{
-			defaultHook: func(context.Context, string, database.TokenLookupOpts) (int32, error) {
+			defaultHook: func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error) {
 				panic("unexpected invocation of MockAccessTokenStore.Lookup")
 			},
 		},
@@ -1384,6 +1397,9 @@ func NewMockAccessTokenStoreFrom(i database.AccessTokenStore) *MockAccessTokenSt
 		CreateFunc: &AccessTokenStoreCreateFunc{
 			defaultHook: i.Create,
 		},
+		CreateForReposFunc: &AccessTokenStoreCreateForReposFunc{
+			defaultHook: i.CreateForRepos,
+		},
 		CreateInternalFunc: &AccessTokenStoreCreateInternalFunc{
 			defaultHook: i.CreateInternal,
 		},
@@ -1654,6 +1670,134 @@ func (c AccessTokenStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// AccessTokenStoreCreateForReposFunc describes the behavior when the
+// CreateForRepos method of the parent MockAccessTokenStore instance is
+// invoked.
+type AccessTokenStoreCreateForReposFunc struct {
+	defaultHook func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error)
+	hooks       []func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error)
+	history     []AccessTokenStoreCreateForReposFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateForRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAccessTokenStore) CreateForRepos(v0 context.Context, v1 int32, v2 []string, v3 []api.RepoID, v4 string, v5 int32, v6 time.Time) (int64, string, error) {
+	r0, r1, r2 := m.CreateForReposFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.CreateForReposFunc.appendCall(AccessTokenStoreCreateForReposFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the CreateForRepos
+// method of the parent MockAccessTokenStore instance is invoked and the
+// hook queue is empty.
+func (f *AccessTokenStoreCreateForReposFunc) SetDefaultHook(hook func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateForRepos method of the parent MockAccessTokenStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AccessTokenStoreCreateForReposFunc) PushHook(hook func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AccessTokenStoreCreateForReposFunc) SetDefaultReturn(r0 int64, r1 string, r2 error) {
+	f.SetDefaultHook(func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AccessTokenStoreCreateForReposFunc) PushReturn(r0 int64, r1 string, r2 error) {
+	f.PushHook(func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *AccessTokenStoreCreateForReposFunc) nextHook() func(context.Context, int32, []string, []api.RepoID, string, int32, time.Time) (int64, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AccessTokenStoreCreateForReposFunc) appendCall(r0 AccessTokenStoreCreateForReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AccessTokenStoreCreateForReposFuncCall
+// objects describing the invocations of this function.
+func (f *AccessTokenStoreCreateForReposFunc) History() []AccessTokenStoreCreateForReposFuncCall {
+	f.mutex.Lock()
+	history := make([]AccessTokenStoreCreateForReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AccessTokenStoreCreateForReposFuncCall is an object that describes an
+// invocation of method CreateForRepos on an instance of
+// MockAccessTokenStore.
+type AccessTokenStoreCreateForReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []api.RepoID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int32
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AccessTokenStoreCreateForReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AccessTokenStoreCreateForReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // AccessTokenStoreCreateInternalFunc describes the behavior when the
 // CreateInternal method of the parent MockAccessTokenStore instance is
 // invoked.
@@ -2636,15 +2780,15 @@ func (c AccessTokenStoreListFuncCall) Results() []interface{} {
 // AccessTokenStoreLookupFunc describes the behavior when the Lookup method
 // of the parent MockAccessTokenStore instance is invoked.
 type AccessTokenStoreLookupFunc struct {
-	defaultHook func(context.Context, string, database.TokenLookupOpts) (int32, error)
-	hooks       []func(context.Context, string, database.TokenLookupOpts) (int32, error)
+	defaultHook func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error)
+	hooks       []func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error)
 	history     []AccessTokenStoreLookupFuncCall
 	mutex       sync.Mutex
 }
 
 // Lookup delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockAccessTokenStore) Lookup(v0 context.Context, v1 string, v2 database.TokenLookupOpts) (int32, error) {
+func (m *MockAccessTokenStore) Lookup(v0 context.Context, v1 string, v2 database.TokenLookupOpts) (*database.AccessToken, error) {
 	r0, r1 := m.LookupFunc.nextHook()(v0, v1, v2)
 	m.LookupFunc.appendCall(AccessTokenStoreLookupFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
@@ -2653,7 +2797,7 @@ func (m *MockAccessTokenStore) Lookup(v0 context.Context, v1 string, v2 database
 // SetDefaultHook sets function that is called when the Lookup method of the
 // parent MockAccessTokenStore instance is invoked and the hook queue is
 // empty.
-func (f *AccessTokenStoreLookupFunc) SetDefaultHook(hook func(context.Context, string, database.TokenLookupOpts) (int32, error)) {
+func (f *AccessTokenStoreLookupFunc) SetDefaultHook(hook func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error)) {
 	f.defaultHook = hook
 }
 
@@ -2661,7 +2805,7 @@ func (f *AccessTokenStoreLookupFunc) SetDefaultHook(hook func(context.Context, s
 // Lookup method of the parent MockAccessTokenStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *AccessTokenStoreLookupFunc) PushHook(hook func(context.Context, string, database.TokenLookupOpts) (int32, error)) {
+func (f *AccessTokenStoreLookupFunc) PushHook(hook func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2669,20 +2813,20 @@ func (f *AccessTokenStoreLookupFunc) PushHook(hook func(context.Context, string,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AccessTokenStoreLookupFunc) SetDefaultReturn(r0 int32, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, database.TokenLookupOpts) (int32, error) {
+func (f *AccessTokenStoreLookupFunc) SetDefaultReturn(r0 *database.AccessToken, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AccessTokenStoreLookupFunc) PushReturn(r0 int32, r1 error) {
-	f.PushHook(func(context.Context, string, database.TokenLookupOpts) (int32, error) {
+func (f *AccessTokenStoreLookupFunc) PushReturn(r0 *database.AccessToken, r1 error) {
+	f.PushHook(func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error) {
 		return r0, r1
 	})
 }
 
-func (f *AccessTokenStoreLookupFunc) nextHook() func(context.Context, string, database.TokenLookupOpts) (int32, error) {
+func (f *AccessTokenStoreLookupFunc) nextHook() func(context.Context, string, database.TokenLookupOpts) (*database.AccessToken, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2726,7 +2870,7 @@ type AccessTokenStoreLookupFuncCall struct {
 	Arg2 database.TokenLookupOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int32
+	Result0 *database.AccessToken
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6349,6 +6493,9 @@ type MockCodeMonitorStore struct {
 	// CountActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountActionJobs.
 	CountActionJobsFunc *CodeMonitorStoreCountActionJobsFunc
+	// CountIssueActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountIssueActions.
+	CountIssueActionsFunc *CodeMonitorStoreCountIssueActionsFunc
 	// CountMonitorsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountMonitors.
 	CountMonitorsFunc *CodeMonitorStoreCountMonitorsFunc
@@ -6361,12 +6508,18 @@ type MockCodeMonitorStore struct {
 	// CountSlackWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountSlackWebhookActions.
 	CountSlackWebhookActionsFunc *CodeMonitorStoreCountSlackWebhookActionsFunc
+	// CountTeamsWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountTeamsWebhookActions.
+	CountTeamsWebhookActionsFunc *CodeMonitorStoreCountTeamsWebhookActionsFunc
 	// CountWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountWebhookActions.
 	CountWebhookActionsFunc *CodeMonitorStoreCountWebhookActionsFunc
 	// CreateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateEmailAction.
 	CreateEmailActionFunc *CodeMonitorStoreCreateEmailActionFunc
+	// CreateIssueActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateIssueAction.
+	CreateIssueActionFunc *CodeMonitorStoreCreateIssueActionFunc
 	// CreateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateMonitor.
 	CreateMonitorFunc *CodeMonitorStoreCreateMonitorFunc
@@ -6379,12 +6532,18 @@ type MockCodeMonitorStore struct {
 	// CreateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateSlackWebhookAction.
 	CreateSlackWebhookActionFunc *CodeMonitorStoreCreateSlackWebhookActionFunc
+	// CreateTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateTeamsWebhookAction.
+	CreateTeamsWebhookActionFunc *CodeMonitorStoreCreateTeamsWebhookActionFunc
 	// CreateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateWebhookAction.
 	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
+	// DeleteIssueActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteIssueActions.
+	DeleteIssueActionsFunc *CodeMonitorStoreDeleteIssueActionsFunc
 	// DeleteMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteMonitor.
 	DeleteMonitorFunc *CodeMonitorStoreDeleteMonitorFunc
@@ -6398,6 +6557,10 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// DeleteSlackWebhookActions.
 	DeleteSlackWebhookActionsFunc *CodeMonitorStoreDeleteSlackWebhookActionsFunc
+	// DeleteTeamsWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteTeamsWebhookActions.
+	DeleteTeamsWebhookActionsFunc *CodeMonitorStoreDeleteTeamsWebhookActionsFunc
 	// DeleteWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteWebhookActions.
 	DeleteWebhookActionsFunc *CodeMonitorStoreDeleteWebhookActionsFunc
@@ -6420,9 +6583,15 @@ type MockCodeMonitorStore struct {
 	// GetActionJobMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method GetActionJobMetadata.
 	GetActionJobMetadataFunc *CodeMonitorStoreGetActionJobMetadataFunc
+	// GetContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method GetContentSnapshot.
+	GetContentSnapshotFunc *CodeMonitorStoreGetContentSnapshotFunc
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
+	// GetIssueActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIssueAction.
+	GetIssueActionFunc *CodeMonitorStoreGetIssueActionFunc
 	// GetLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method GetLastSearched.
 	GetLastSearchedFunc *CodeMonitorStoreGetLastSearchedFunc
@@ -6439,6 +6608,9 @@ type MockCodeMonitorStore struct {
 	// GetSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetSlackWebhookAction.
 	GetSlackWebhookActionFunc *CodeMonitorStoreGetSlackWebhookActionFunc
+	// GetTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetTeamsWebhookAction.
+	GetTeamsWebhookActionFunc *CodeMonitorStoreGetTeamsWebhookActionFunc
 	// GetWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetWebhookAction.
 	GetWebhookActionFunc *CodeMonitorStoreGetWebhookActionFunc
@@ -6454,6 +6626,9 @@ type MockCodeMonitorStore struct {
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
+	// ListIssueActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListIssueActions.
+	ListIssueActionsFunc *CodeMonitorStoreListIssueActionsFunc
 	// ListMonitorsFunc is an instance of a mock function object controlling
 	// the behavior of the method ListMonitors.
 	ListMonitorsFunc *CodeMonitorStoreListMonitorsFunc
@@ -6466,16 +6641,26 @@ type MockCodeMonitorStore struct {
 	// ListSlackWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListSlackWebhookActions.
 	ListSlackWebhookActionsFunc *CodeMonitorStoreListSlackWebhookActionsFunc
+	// ListTeamsWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListTeamsWebhookActions.
+	ListTeamsWebhookActionsFunc *CodeMonitorStoreListTeamsWebhookActionsFunc
 	// ListWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListWebhookActions.
 	ListWebhookActionsFunc *CodeMonitorStoreListWebhookActionsFunc
 	// NowFunc is an instance of a mock function object controlling the
 	// behavior of the method Now.
 	NowFunc *CodeMonitorStoreNowFunc
+	// ReplaceContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method ReplaceContentSnapshot.
+	ReplaceContentSnapshotFunc *CodeMonitorStoreReplaceContentSnapshotFunc
 	// ResetQueryTriggerTimestampsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// ResetQueryTriggerTimestamps.
 	ResetQueryTriggerTimestampsFunc *CodeMonitorStoreResetQueryTriggerTimestampsFunc
+	// SetIssueActionIssueNumberFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// SetIssueActionIssueNumber.
+	SetIssueActionIssueNumberFunc *CodeMonitorStoreSetIssueActionIssueNumberFunc
 	// SetQueryTriggerNextRunFunc is an instance of a mock function object
 	// controlling the behavior of the method SetQueryTriggerNextRun.
 	SetQueryTriggerNextRunFunc *CodeMonitorStoreSetQueryTriggerNextRunFunc
@@ -6485,6 +6670,9 @@ type MockCodeMonitorStore struct {
 	// UpdateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateEmailAction.
 	UpdateEmailActionFunc *CodeMonitorStoreUpdateEmailActionFunc
+	// UpdateIssueActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateIssueAction.
+	UpdateIssueActionFunc *CodeMonitorStoreUpdateIssueActionFunc
 	// UpdateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateMonitor.
 	UpdateMonitorFunc *CodeMonitorStoreUpdateMonitorFunc
@@ -6497,6 +6685,9 @@ type MockCodeMonitorStore struct {
 	// UpdateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSlackWebhookAction.
 	UpdateSlackWebhookActionFunc *CodeMonitorStoreUpdateSlackWebhookActionFunc
+	// UpdateTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateTeamsWebhookAction.
+	UpdateTeamsWebhookActionFunc *CodeMonitorStoreUpdateTeamsWebhookActionFunc
 	// UpdateTriggerJobWithLogsFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateTriggerJobWithLogs.
 	UpdateTriggerJobWithLogsFunc *CodeMonitorStoreUpdateTriggerJobWithLogsFunc
@@ -6527,6 +6718,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountIssueActionsFunc: &CodeMonitorStoreCountIssueActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, database.ListMonitorsOpts) (r0 int32, r1 error) {
 				return
@@ -6547,6 +6743,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
 				return
@@ -6557,6 +6758,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateIssueActionFunc: &CodeMonitorStoreCreateIssueActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, api.RepoID) (r0 *database.IssueAction, r1 error) {
+				return
+			},
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: func(context.Context, database.MonitorArgs) (r0 *database.Monitor, r1 error) {
 				return
@@ -6577,6 +6783,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.WebhookAction, r1 error) {
 				return
@@ -6587,6 +6798,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteIssueActionsFunc: &CodeMonitorStoreDeleteIssueActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) (r0 error) {
 				return
@@ -6607,6 +6823,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
 				return
@@ -6642,11 +6863,21 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (r0 map[api.RepoID][]string, r1 error) {
+				return
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *database.EmailAction, r1 error) {
 				return
 			},
 		},
+		GetIssueActionFunc: &CodeMonitorStoreGetIssueActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.IssueAction, r1 error) {
+				return
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) (r0 []string, r1 error) {
 				return
@@ -6672,6 +6903,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *database.WebhookAction, r1 error) {
 				return
@@ -6697,6 +6933,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListIssueActionsFunc: &CodeMonitorStoreListIssueActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.IssueAction, r1 error) {
+				return
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, database.ListMonitorsOpts) (r0 []*database.Monitor, r1 error) {
 				return
@@ -6717,6 +6958,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.WebhookAction, r1 error) {
 				return
@@ -6727,11 +6973,21 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ReplaceContentSnapshotFunc: &CodeMonitorStoreReplaceContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, map[api.RepoID][]string) (r0 error) {
+				return
+			},
+		},
 		ResetQueryTriggerTimestampsFunc: &CodeMonitorStoreResetQueryTriggerTimestampsFunc{
 			defaultHook: func(context.Context, int64) (r0 error) {
 				return
 			},
 		},
+		SetIssueActionIssueNumberFunc: &CodeMonitorStoreSetIssueActionIssueNumberFunc{
+			defaultHook: func(context.Context, int64, int32) (r0 error) {
+				return
+			},
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) (r0 error) {
 				return
@@ -6747,6 +7003,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateIssueActionFunc: &CodeMonitorStoreUpdateIssueActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, api.RepoID) (r0 *database.IssueAction, r1 error) {
+				return
+			},
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: func(context.Context, int64, database.MonitorArgs) (r0 *database.Monitor, r1 error) {
 				return
@@ -6767,6 +7028,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *database.TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		UpdateTriggerJobWithLogsFunc: &CodeMonitorStoreUpdateTriggerJobWithLogsFunc{
 			defaultHook: func(context.Context, int32, database.TriggerJobLogs) (r0 error) {
 				return
@@ -6804,6 +7070,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountActionJobs")
 			},
 		},
+		CountIssueActionsFunc: &CodeMonitorStoreCountIssueActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountIssueActions")
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, database.ListMonitorsOpts) (int32, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountMonitors")
@@ -6824,6 +7095,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountSlackWebhookActions")
 			},
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountTeamsWebhookActions")
+			},
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (int, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountWebhookActions")
@@ -6834,6 +7110,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateEmailAction")
 			},
 		},
+		CreateIssueActionFunc: &CodeMonitorStoreCreateIssueActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateIssueAction")
+			},
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: func(context.Context, database.MonitorArgs) (*database.Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateMonitor")
@@ -6854,6 +7135,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateSlackWebhookAction")
 			},
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateTeamsWebhookAction")
+			},
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: func(context.Context, int64, bool, bool, string) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateWebhookAction")
@@ -6864,6 +7150,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
 			},
 		},
+		DeleteIssueActionsFunc: &CodeMonitorStoreDeleteIssueActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteIssueActions")
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteMonitor")
@@ -6884,6 +7175,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteSlackWebhookActions")
 			},
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteTeamsWebhookActions")
+			},
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: func(context.Context, int64, ...int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteWebhookActions")
@@ -6919,11 +7215,21 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetActionJobMetadata")
 			},
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (map[api.RepoID][]string, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetContentSnapshot")
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
 			},
 		},
+		GetIssueActionFunc: &CodeMonitorStoreGetIssueActionFunc{
+			defaultHook: func(context.Context, int64) (*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetIssueAction")
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) ([]string, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetLastSearched")
@@ -6949,6 +7255,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetSlackWebhookAction")
 			},
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetTeamsWebhookAction")
+			},
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: func(context.Context, int64) (*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetWebhookAction")
@@ -6974,6 +7285,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListEmailActions")
 			},
 		},
+		ListIssueActionsFunc: &CodeMonitorStoreListIssueActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListIssueActions")
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, database.ListMonitorsOpts) ([]*database.Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListMonitors")
@@ -6994,6 +7310,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListSlackWebhookActions")
 			},
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListTeamsWebhookActions")
+			},
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListWebhookActions")
@@ -7004,11 +7325,21 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.Now")
 			},
 		},
+		ReplaceContentSnapshotFunc: &CodeMonitorStoreReplaceContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, map[api.RepoID][]string) error {
+				panic("unexpected invocation of MockCodeMonitorStore.ReplaceContentSnapshot")
+			},
+		},
 		ResetQueryTriggerTimestampsFunc: &CodeMonitorStoreResetQueryTriggerTimestampsFunc{
 			defaultHook: func(context.Context, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.ResetQueryTriggerTimestamps")
 			},
 		},
+		SetIssueActionIssueNumberFunc: &CodeMonitorStoreSetIssueActionIssueNumberFunc{
+			defaultHook: func(context.Context, int64, int32) error {
+				panic("unexpected invocation of MockCodeMonitorStore.SetIssueActionIssueNumber")
+			},
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) error {
 				panic("unexpected invocation of MockCodeMonitorStore.SetQueryTriggerNextRun")
@@ -7024,6 +7355,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateEmailAction")
 			},
 		},
+		UpdateIssueActionFunc: &CodeMonitorStoreUpdateIssueActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateIssueAction")
+			},
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: func(context.Context, int64, database.MonitorArgs) (*database.Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateMonitor")
@@ -7044,6 +7380,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTeamsWebhookAction")
+			},
+		},
 		UpdateTriggerJobWithLogsFunc: &CodeMonitorStoreUpdateTriggerJobWithLogsFunc{
 			defaultHook: func(context.Context, int32, database.TriggerJobLogs) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithLogs")
@@ -7078,6 +7419,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountActionJobsFunc: &CodeMonitorStoreCountActionJobsFunc{
 			defaultHook: i.CountActionJobs,
 		},
+		CountIssueActionsFunc: &CodeMonitorStoreCountIssueActionsFunc{
+			defaultHook: i.CountIssueActions,
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: i.CountMonitors,
 		},
@@ -7090,12 +7434,18 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountSlackWebhookActionsFunc: &CodeMonitorStoreCountSlackWebhookActionsFunc{
 			defaultHook: i.CountSlackWebhookActions,
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: i.CountTeamsWebhookActions,
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: i.CountWebhookActions,
 		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: i.CreateEmailAction,
 		},
+		CreateIssueActionFunc: &CodeMonitorStoreCreateIssueActionFunc{
+			defaultHook: i.CreateIssueAction,
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: i.CreateMonitor,
 		},
@@ -7108,12 +7458,18 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
 			defaultHook: i.CreateSlackWebhookAction,
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: i.CreateTeamsWebhookAction,
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: i.CreateWebhookAction,
 		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
+		DeleteIssueActionsFunc: &CodeMonitorStoreDeleteIssueActionsFunc{
+			defaultHook: i.DeleteIssueActions,
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: i.DeleteMonitor,
 		},
@@ -7126,6 +7482,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		DeleteSlackWebhookActionsFunc: &CodeMonitorStoreDeleteSlackWebhookActionsFunc{
 			defaultHook: i.DeleteSlackWebhookActions,
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: i.DeleteTeamsWebhookActions,
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: i.DeleteWebhookActions,
 		},
@@ -7147,9 +7506,15 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetActionJobMetadataFunc: &CodeMonitorStoreGetActionJobMetadataFunc{
 			defaultHook: i.GetActionJobMetadata,
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: i.GetContentSnapshot,
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
+		GetIssueActionFunc: &CodeMonitorStoreGetIssueActionFunc{
+			defaultHook: i.GetIssueAction,
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: i.GetLastSearched,
 		},
@@ -7165,6 +7530,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetSlackWebhookActionFunc: &CodeMonitorStoreGetSlackWebhookActionFunc{
 			defaultHook: i.GetSlackWebhookAction,
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: i.GetTeamsWebhookAction,
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: i.GetWebhookAction,
 		},
@@ -7180,6 +7548,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
+		ListIssueActionsFunc: &CodeMonitorStoreListIssueActionsFunc{
+			defaultHook: i.ListIssueActions,
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: i.ListMonitors,
 		},
@@ -7192,15 +7563,24 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		ListSlackWebhookActionsFunc: &CodeMonitorStoreListSlackWebhookActionsFunc{
 			defaultHook: i.ListSlackWebhookActions,
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: i.ListTeamsWebhookActions,
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: i.ListWebhookActions,
 		},
 		NowFunc: &CodeMonitorStoreNowFunc{
 			defaultHook: i.Now,
 		},
+		ReplaceContentSnapshotFunc: &CodeMonitorStoreReplaceContentSnapshotFunc{
+			defaultHook: i.ReplaceContentSnapshot,
+		},
 		ResetQueryTriggerTimestampsFunc: &CodeMonitorStoreResetQueryTriggerTimestampsFunc{
 			defaultHook: i.ResetQueryTriggerTimestamps,
 		},
+		SetIssueActionIssueNumberFunc: &CodeMonitorStoreSetIssueActionIssueNumberFunc{
+			defaultHook: i.SetIssueActionIssueNumber,
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: i.SetQueryTriggerNextRun,
 		},
@@ -7210,6 +7590,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: i.UpdateEmailAction,
 		},
+		UpdateIssueActionFunc: &CodeMonitorStoreUpdateIssueActionFunc{
+			defaultHook: i.UpdateIssueAction,
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: i.UpdateMonitor,
 		},
@@ -7222,6 +7605,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
 			defaultHook: i.UpdateSlackWebhookAction,
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: i.UpdateTeamsWebhookAction,
+		},
 		UpdateTriggerJobWithLogsFunc: &CodeMonitorStoreUpdateTriggerJobWithLogsFunc{
 			defaultHook: i.UpdateTriggerJobWithLogs,
 		},
@@ -7447,6 +7833,117 @@ func (c CodeMonitorStoreCountActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountIssueActionsFunc describes the behavior when the
+// CountIssueActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCountIssueActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountIssueActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountIssueActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountIssueActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountIssueActionsFunc.nextHook()(v0, v1)
+	m.CountIssueActionsFunc.appendCall(CodeMonitorStoreCountIssueActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountIssueActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCountIssueActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountIssueActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCountIssueActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountIssueActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountIssueActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountIssueActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountIssueActionsFunc) appendCall(r0 CodeMonitorStoreCountIssueActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCountIssueActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCountIssueActionsFunc) History() []CodeMonitorStoreCountIssueActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountIssueActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountIssueActionsFuncCall is an object that describes an
+// invocation of method CountIssueActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCountIssueActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountIssueActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountIssueActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountMonitorsFunc describes the behavior when the
 // CountMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -7892,6 +8389,118 @@ func (c CodeMonitorStoreCountSlackWebhookActionsFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountTeamsWebhookActionsFunc describes the behavior when
+// the CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCountTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountTeamsWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountTeamsWebhookActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountTeamsWebhookActionsFunc.nextHook()(v0, v1)
+	m.CountTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreCountTeamsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreCountTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) History() []CodeMonitorStoreCountTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method CountTeamsWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCountTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountTeamsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountWebhookActionsFunc describes the behavior when the
 // CountWebhookActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8117,6 +8726,126 @@ func (c CodeMonitorStoreCreateEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateIssueActionFunc describes the behavior when the
+// CreateIssueAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCreateIssueActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)
+	hooks       []func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)
+	history     []CodeMonitorStoreCreateIssueActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateIssueAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateIssueAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 api.RepoID) (*database.IssueAction, error) {
+	r0, r1 := m.CreateIssueActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateIssueActionFunc.appendCall(CodeMonitorStoreCreateIssueActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateIssueAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCreateIssueActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateIssueAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCreateIssueActionFunc) PushHook(hook func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateIssueActionFunc) SetDefaultReturn(r0 *database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateIssueActionFunc) PushReturn(r0 *database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateIssueActionFunc) nextHook() func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateIssueActionFunc) appendCall(r0 CodeMonitorStoreCreateIssueActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCreateIssueActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCreateIssueActionFunc) History() []CodeMonitorStoreCreateIssueActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateIssueActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateIssueActionFuncCall is an object that describes an
+// invocation of method CreateIssueAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCreateIssueActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateIssueActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateIssueActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateMonitorFunc describes the behavior when the
 // CreateMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8579,6 +9308,127 @@ func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateTeamsWebhookActionFunc describes the behavior when
+// the CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCreateTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreCreateTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateTeamsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.TeamsWebhookAction, error) {
+	r0, r1 := m.CreateTeamsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateTeamsWebhookActionFunc.appendCall(CodeMonitorStoreCreateTeamsWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) SetDefaultReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) PushReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) History() []CodeMonitorStoreCreateTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateTeamsWebhookActionFuncCall is an object that
+// describes an invocation of method CreateTeamsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCreateTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateWebhookActionFunc describes the behavior when the
 // CreateWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8810,6 +9660,124 @@ func (c CodeMonitorStoreDeleteEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteIssueActionsFunc describes the behavior when the
+// DeleteIssueActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreDeleteIssueActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteIssueActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteIssueActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteIssueActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteIssueActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteIssueActionsFunc.appendCall(CodeMonitorStoreDeleteIssueActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteIssueActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteIssueActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) appendCall(r0 CodeMonitorStoreDeleteIssueActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreDeleteIssueActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) History() []CodeMonitorStoreDeleteIssueActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteIssueActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteIssueActionsFuncCall is an object that describes an
+// invocation of method DeleteIssueActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreDeleteIssueActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteIssueActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteIssueActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteMonitorFunc describes the behavior when the
 // DeleteMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -9253,6 +10221,125 @@ func (c CodeMonitorStoreDeleteSlackWebhookActionsFuncCall) Results() []interface
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteTeamsWebhookActionsFunc describes the behavior when
+// the DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreDeleteTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteTeamsWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteTeamsWebhookActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteTeamsWebhookActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) History() []CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method DeleteTeamsWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteWebhookActionsFunc describes the behavior when the
 // DeleteWebhookActions method of the parent MockCodeMonitorStore instance
 // is invoked.
@@ -10024,6 +11111,117 @@ func (c CodeMonitorStoreGetActionJobMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetContentSnapshotFunc describes the behavior when the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64) (map[api.RepoID][]string, error)
+	hooks       []func(context.Context, int64) (map[api.RepoID][]string, error)
+	history     []CodeMonitorStoreGetContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// GetContentSnapshot delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetContentSnapshot(v0 context.Context, v1 int64) (map[api.RepoID][]string, error) {
+	r0, r1 := m.GetContentSnapshotFunc.nextHook()(v0, v1)
+	m.GetContentSnapshotFunc.appendCall(CodeMonitorStoreGetContentSnapshotFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetContentSnapshot
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64) (map[api.RepoID][]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushHook(hook func(context.Context, int64) (map[api.RepoID][]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.PushHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetContentSnapshotFunc) nextHook() func(context.Context, int64) (map[api.RepoID][]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetContentSnapshotFunc) appendCall(r0 CodeMonitorStoreGetContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetContentSnapshotFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) History() []CodeMonitorStoreGetContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetContentSnapshotFuncCall is an object that describes an
+// invocation of method GetContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[api.RepoID][]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetEmailActionFunc describes the behavior when the
 // GetEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -10134,6 +11332,116 @@ func (c CodeMonitorStoreGetEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetIssueActionFunc describes the behavior when the
+// GetIssueAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetIssueActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.IssueAction, error)
+	hooks       []func(context.Context, int64) (*database.IssueAction, error)
+	history     []CodeMonitorStoreGetIssueActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIssueAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetIssueAction(v0 context.Context, v1 int64) (*database.IssueAction, error) {
+	r0, r1 := m.GetIssueActionFunc.nextHook()(v0, v1)
+	m.GetIssueActionFunc.appendCall(CodeMonitorStoreGetIssueActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetIssueAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetIssueActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIssueAction method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreGetIssueActionFunc) PushHook(hook func(context.Context, int64) (*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetIssueActionFunc) SetDefaultReturn(r0 *database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetIssueActionFunc) PushReturn(r0 *database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetIssueActionFunc) nextHook() func(context.Context, int64) (*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetIssueActionFunc) appendCall(r0 CodeMonitorStoreGetIssueActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetIssueActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetIssueActionFunc) History() []CodeMonitorStoreGetIssueActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetIssueActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetIssueActionFuncCall is an object that describes an
+// invocation of method GetIssueAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetIssueActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetIssueActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetIssueActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetLastSearchedFunc describes the behavior when the
 // GetLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -10692,6 +12000,118 @@ func (c CodeMonitorStoreGetSlackWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetTeamsWebhookActionFunc describes the behavior when the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreGetTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64) (*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreGetTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetTeamsWebhookAction(v0 context.Context, v1 int64) (*database.TeamsWebhookAction, error) {
+	r0, r1 := m.GetTeamsWebhookActionFunc.nextHook()(v0, v1)
+	m.GetTeamsWebhookActionFunc.appendCall(CodeMonitorStoreGetTeamsWebhookActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64) (*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) SetDefaultReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) PushReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) nextHook() func(context.Context, int64) (*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreGetTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) History() []CodeMonitorStoreGetTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetTeamsWebhookActionFuncCall is an object that describes
+// an invocation of method GetTeamsWebhookAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetWebhookActionFunc describes the behavior when the
 // GetWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11234,6 +12654,117 @@ func (c CodeMonitorStoreListEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListIssueActionsFunc describes the behavior when the
+// ListIssueActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreListIssueActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)
+	history     []CodeMonitorStoreListIssueActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListIssueActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListIssueActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.IssueAction, error) {
+	r0, r1 := m.ListIssueActionsFunc.nextHook()(v0, v1)
+	m.ListIssueActionsFunc.appendCall(CodeMonitorStoreListIssueActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListIssueActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreListIssueActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListIssueActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreListIssueActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListIssueActionsFunc) SetDefaultReturn(r0 []*database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListIssueActionsFunc) PushReturn(r0 []*database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListIssueActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListIssueActionsFunc) appendCall(r0 CodeMonitorStoreListIssueActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListIssueActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListIssueActionsFunc) History() []CodeMonitorStoreListIssueActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListIssueActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListIssueActionsFuncCall is an object that describes an
+// invocation of method ListIssueActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListIssueActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListIssueActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListIssueActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListMonitorsFunc describes the behavior when the
 // ListMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11677,6 +13208,118 @@ func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Results() []interface{}
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListTeamsWebhookActionsFunc describes the behavior when
+// the ListTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreListTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreListTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListTeamsWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListTeamsWebhookActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+	r0, r1 := m.ListTeamsWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreListTeamsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) SetDefaultReturn(r0 []*database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) PushReturn(r0 []*database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) History() []CodeMonitorStoreListTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method ListTeamsWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreListTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListTeamsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListWebhookActionsFunc describes the behavior when the
 // ListWebhookActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11887,6 +13530,118 @@ func (c CodeMonitorStoreNowFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreReplaceContentSnapshotFunc describes the behavior when
+// the ReplaceContentSnapshot method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreReplaceContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64, map[api.RepoID][]string) error
+	hooks       []func(context.Context, int64, map[api.RepoID][]string) error
+	history     []CodeMonitorStoreReplaceContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// ReplaceContentSnapshot delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ReplaceContentSnapshot(v0 context.Context, v1 int64, v2 map[api.RepoID][]string) error {
+	r0 := m.ReplaceContentSnapshotFunc.nextHook()(v0, v1, v2)
+	m.ReplaceContentSnapshotFunc.appendCall(CodeMonitorStoreReplaceContentSnapshotFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// ReplaceContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64, map[api.RepoID][]string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReplaceContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) PushHook(hook func(context.Context, int64, map[api.RepoID][]string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, map[api.RepoID][]string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, map[api.RepoID][]string) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) nextHook() func(context.Context, int64, map[api.RepoID][]string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) appendCall(r0 CodeMonitorStoreReplaceContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreReplaceContentSnapshotFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreReplaceContentSnapshotFunc) History() []CodeMonitorStoreReplaceContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreReplaceContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreReplaceContentSnapshotFuncCall is an object that
+// describes an invocation of method ReplaceContentSnapshot on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreReplaceContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 map[api.RepoID][]string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreReplaceContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreReplaceContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreResetQueryTriggerTimestampsFunc describes the behavior
 // when the ResetQueryTriggerTimestamps method of the parent
 // MockCodeMonitorStore instance is invoked.
@@ -11996,6 +13751,118 @@ func (c CodeMonitorStoreResetQueryTriggerTimestampsFuncCall) Results() []interfa
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreSetIssueActionIssueNumberFunc describes the behavior when
+// the SetIssueActionIssueNumber method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreSetIssueActionIssueNumberFunc struct {
+	defaultHook func(context.Context, int64, int32) error
+	hooks       []func(context.Context, int64, int32) error
+	history     []CodeMonitorStoreSetIssueActionIssueNumberFuncCall
+	mutex       sync.Mutex
+}
+
+// SetIssueActionIssueNumber delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) SetIssueActionIssueNumber(v0 context.Context, v1 int64, v2 int32) error {
+	r0 := m.SetIssueActionIssueNumberFunc.nextHook()(v0, v1, v2)
+	m.SetIssueActionIssueNumberFunc.appendCall(CodeMonitorStoreSetIssueActionIssueNumberFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// SetIssueActionIssueNumber method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) SetDefaultHook(hook func(context.Context, int64, int32) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetIssueActionIssueNumber method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) PushHook(hook func(context.Context, int64, int32) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, int32) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, int32) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) nextHook() func(context.Context, int64, int32) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) appendCall(r0 CodeMonitorStoreSetIssueActionIssueNumberFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreSetIssueActionIssueNumberFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreSetIssueActionIssueNumberFunc) History() []CodeMonitorStoreSetIssueActionIssueNumberFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreSetIssueActionIssueNumberFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreSetIssueActionIssueNumberFuncCall is an object that
+// describes an invocation of method SetIssueActionIssueNumber on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreSetIssueActionIssueNumberFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreSetIssueActionIssueNumberFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreSetIssueActionIssueNumberFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreSetQueryTriggerNextRunFunc describes the behavior when
 // the SetQueryTriggerNextRun method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -12330,6 +14197,126 @@ func (c CodeMonitorStoreUpdateEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateIssueActionFunc describes the behavior when the
+// UpdateIssueAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreUpdateIssueActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)
+	hooks       []func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)
+	history     []CodeMonitorStoreUpdateIssueActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateIssueAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateIssueAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 api.RepoID) (*database.IssueAction, error) {
+	r0, r1 := m.UpdateIssueActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpdateIssueActionFunc.appendCall(CodeMonitorStoreUpdateIssueActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UpdateIssueAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateIssueAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) PushHook(hook func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) SetDefaultReturn(r0 *database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) PushReturn(r0 *database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateIssueActionFunc) nextHook() func(context.Context, int64, bool, bool, api.RepoID) (*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateIssueActionFunc) appendCall(r0 CodeMonitorStoreUpdateIssueActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreUpdateIssueActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) History() []CodeMonitorStoreUpdateIssueActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateIssueActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateIssueActionFuncCall is an object that describes an
+// invocation of method UpdateIssueAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpdateIssueActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateIssueActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateIssueActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreUpdateMonitorFunc describes the behavior when the
 // UpdateMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12790,6 +14777,127 @@ func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateTeamsWebhookActionFunc describes the behavior when
+// the UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreUpdateTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)
+	history     []CodeMonitorStoreUpdateTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTeamsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*database.TeamsWebhookAction, error) {
+	r0, r1 := m.UpdateTeamsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpdateTeamsWebhookActionFunc.appendCall(CodeMonitorStoreUpdateTeamsWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) SetDefaultReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) PushReturn(r0 *database.TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*database.TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) History() []CodeMonitorStoreUpdateTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTeamsWebhookActionFuncCall is an object that
+// describes an invocation of method UpdateTeamsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreUpdateTriggerJobWithLogsFunc describes the behavior when
 // the UpdateTriggerJobWithLogs method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -15252,6 +17360,9 @@ type MockDB struct {
 	// OwnSignalConfigurationsFunc is an instance of a mock function object
 	// controlling the behavior of the method OwnSignalConfigurations.
 	OwnSignalConfigurationsFunc *DBOwnSignalConfigurationsFunc
+	// OwnershipCoverageFunc is an instance of a mock function object
+	// controlling the behavior of the method OwnershipCoverage.
+	OwnershipCoverageFunc *DBOwnershipCoverageFunc
 	// OwnershipStatsFunc is an instance of a mock function object
 	// controlling the behavior of the method OwnershipStats.
 	OwnershipStatsFunc *DBOwnershipStatsFunc
@@ -15520,6 +17631,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		OwnershipCoverageFunc: &DBOwnershipCoverageFunc{
+			defaultHook: func() (r0 database.OwnershipCoverageStore) {
+				return
+			},
+		},
 		OwnershipStatsFunc: &DBOwnershipStatsFunc{
 			defaultHook: func() (r0 database.OwnershipStatsStore) {
 				return
@@ -15857,6 +17973,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.OwnSignalConfigurations")
 			},
 		},
+		OwnershipCoverageFunc: &DBOwnershipCoverageFunc{
+			defaultHook: func() database.OwnershipCoverageStore {
+				panic("unexpected invocation of MockDB.OwnershipCoverage")
+			},
+		},
 		OwnershipStatsFunc: &DBOwnershipStatsFunc{
 			defaultHook: func() database.OwnershipStatsStore {
 				panic("unexpected invocation of MockDB.OwnershipStats")
@@ -16132,6 +18253,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: i.OwnSignalConfigurations,
 		},
+		OwnershipCoverageFunc: &DBOwnershipCoverageFunc{
+			defaultHook: i.OwnershipCoverage,
+		},
 		OwnershipStatsFunc: &DBOwnershipStatsFunc{
 			defaultHook: i.OwnershipStats,
 		},
@@ -19325,6 +21449,105 @@ func (c DBOwnSignalConfigurationsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBOwnershipCoverageFunc describes the behavior when the OwnershipCoverage
+// method of the parent MockDB instance is invoked.
+type DBOwnershipCoverageFunc struct {
+	defaultHook func() database.OwnershipCoverageStore
+	hooks       []func() database.OwnershipCoverageStore
+	history     []DBOwnershipCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// OwnershipCoverage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) OwnershipCoverage() database.OwnershipCoverageStore {
+	r0 := m.OwnershipCoverageFunc.nextHook()()
+	m.OwnershipCoverageFunc.appendCall(DBOwnershipCoverageFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the OwnershipCoverage
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBOwnershipCoverageFunc) SetDefaultHook(hook func() database.OwnershipCoverageStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// OwnershipCoverage method of the parent MockDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBOwnershipCoverageFunc) PushHook(hook func() database.OwnershipCoverageStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBOwnershipCoverageFunc) SetDefaultReturn(r0 database.OwnershipCoverageStore) {
+	f.SetDefaultHook(func() database.OwnershipCoverageStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBOwnershipCoverageFunc) PushReturn(r0 database.OwnershipCoverageStore) {
+	f.PushHook(func() database.OwnershipCoverageStore {
+		return r0
+	})
+}
+
+func (f *DBOwnershipCoverageFunc) nextHook() func() database.OwnershipCoverageStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBOwnershipCoverageFunc) appendCall(r0 DBOwnershipCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBOwnershipCoverageFuncCall objects describing
+// the invocations of this function.
+func (f *DBOwnershipCoverageFunc) History() []DBOwnershipCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]DBOwnershipCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBOwnershipCoverageFuncCall is an object that describes an invocation of
+// method OwnershipCoverage on an instance of MockDB.
+type DBOwnershipCoverageFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.OwnershipCoverageStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBOwnershipCoverageFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBOwnershipCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBOwnershipStatsFunc describes the behavior when the OwnershipStats
 // method of the parent MockDB instance is invoked.
 type DBOwnershipStatsFunc struct {
@@ -37203,6 +39426,9 @@ type MockGitserverRepoStore struct {
 	// GetLastSyncOutputFunc is an instance of a mock function object
 	// controlling the behavior of the method GetLastSyncOutput.
 	GetLastSyncOutputFunc *GitserverRepoStoreGetLastSyncOutputFunc
+	// GetTieredStatsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetTieredStats.
+	GetTieredStatsFunc *GitserverRepoStoreGetTieredStatsFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *GitserverRepoStoreHandleFunc
@@ -37272,6 +39498,11 @@ func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 				return
 			},
 		},
+		GetTieredStatsFunc: &GitserverRepoStoreGetTieredStatsFunc{
+			defaultHook: func(context.Context, string) (r0 int64, r1 int64, r2 error) {
+				return
+			},
+		},
 		HandleFunc: &GitserverRepoStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
@@ -37365,6 +39596,11 @@ func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 				panic("unexpected invocation of MockGitserverRepoStore.GetLastSyncOutput")
 			},
 		},
+		GetTieredStatsFunc: &GitserverRepoStoreGetTieredStatsFunc{
+			defaultHook: func(context.Context, string) (int64, int64, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetTieredStats")
+			},
+		},
 		HandleFunc: &GitserverRepoStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
 				panic("unexpected invocation of MockGitserverRepoStore.Handle")
@@ -37448,6 +39684,9 @@ func NewMockGitserverRepoStoreFrom(i database.GitserverRepoStore) *MockGitserver
 		GetLastSyncOutputFunc: &GitserverRepoStoreGetLastSyncOutputFunc{
 			defaultHook: i.GetLastSyncOutput,
 		},
+		GetTieredStatsFunc: &GitserverRepoStoreGetTieredStatsFunc{
+			defaultHook: i.GetTieredStats,
+		},
 		HandleFunc: &GitserverRepoStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
@@ -38042,6 +40281,120 @@ func (c GitserverRepoStoreGetLastSyncOutputFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// GitserverRepoStoreGetTieredStatsFunc describes the behavior when the
+// GetTieredStats method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreGetTieredStatsFunc struct {
+	defaultHook func(context.Context, string) (int64, int64, error)
+	hooks       []func(context.Context, string) (int64, int64, error)
+	history     []GitserverRepoStoreGetTieredStatsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTieredStats delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetTieredStats(v0 context.Context, v1 string) (int64, int64, error) {
+	r0, r1, r2 := m.GetTieredStatsFunc.nextHook()(v0, v1)
+	m.GetTieredStatsFunc.appendCall(GitserverRepoStoreGetTieredStatsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetTieredStats
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreGetTieredStatsFunc) SetDefaultHook(hook func(context.Context, string) (int64, int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTieredStats method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreGetTieredStatsFunc) PushHook(hook func(context.Context, string) (int64, int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetTieredStatsFunc) SetDefaultReturn(r0 int64, r1 int64, r2 error) {
+	f.SetDefaultHook(func(context.Context, string) (int64, int64, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetTieredStatsFunc) PushReturn(r0 int64, r1 int64, r2 error) {
+	f.PushHook(func(context.Context, string) (int64, int64, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GitserverRepoStoreGetTieredStatsFunc) nextHook() func(context.Context, string) (int64, int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetTieredStatsFunc) appendCall(r0 GitserverRepoStoreGetTieredStatsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreGetTieredStatsFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreGetTieredStatsFunc) History() []GitserverRepoStoreGetTieredStatsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetTieredStatsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetTieredStatsFuncCall is an object that describes an
+// invocation of method GetTieredStats on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreGetTieredStatsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int64
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreGetTieredStatsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetTieredStatsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // GitserverRepoStoreHandleFunc describes the behavior when the Handle
 // method of the parent MockGitserverRepoStore instance is invoked.
 type GitserverRepoStoreHandleFunc struct {
@@ -48796,6 +51149,420 @@ func (c OutboundWebhookStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockOwnershipCoverageStore is a mock implementation of the
+// OwnershipCoverageStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockOwnershipCoverageStore struct {
+	// CreateSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateSnapshot.
+	CreateSnapshotFunc *OwnershipCoverageStoreCreateSnapshotFunc
+	// DeleteSnapshotsBeforeFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteSnapshotsBefore.
+	DeleteSnapshotsBeforeFunc *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc
+	// ListSnapshotsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListSnapshots.
+	ListSnapshotsFunc *OwnershipCoverageStoreListSnapshotsFunc
+}
+
+// NewMockOwnershipCoverageStore creates a new mock of the
+// OwnershipCoverageStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockOwnershipCoverageStore() *MockOwnershipCoverageStore {
+	return &MockOwnershipCoverageStore{
+		CreateSnapshotFunc: &OwnershipCoverageStoreCreateSnapshotFunc{
+			defaultHook: func(context.Context, *database.OwnershipCoverageSnapshot) (r0 error) {
+				return
+			},
+		},
+		DeleteSnapshotsBeforeFunc: &OwnershipCoverageStoreDeleteSnapshotsBeforeFunc{
+			defaultHook: func(context.Context, api.RepoID, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+		ListSnapshotsFunc: &OwnershipCoverageStoreListSnapshotsFunc{
+			defaultHook: func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) (r0 []*database.OwnershipCoverageSnapshot, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockOwnershipCoverageStore creates a new mock of the
+// OwnershipCoverageStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockOwnershipCoverageStore() *MockOwnershipCoverageStore {
+	return &MockOwnershipCoverageStore{
+		CreateSnapshotFunc: &OwnershipCoverageStoreCreateSnapshotFunc{
+			defaultHook: func(context.Context, *database.OwnershipCoverageSnapshot) error {
+				panic("unexpected invocation of MockOwnershipCoverageStore.CreateSnapshot")
+			},
+		},
+		DeleteSnapshotsBeforeFunc: &OwnershipCoverageStoreDeleteSnapshotsBeforeFunc{
+			defaultHook: func(context.Context, api.RepoID, time.Time) (int, error) {
+				panic("unexpected invocation of MockOwnershipCoverageStore.DeleteSnapshotsBefore")
+			},
+		},
+		ListSnapshotsFunc: &OwnershipCoverageStoreListSnapshotsFunc{
+			defaultHook: func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error) {
+				panic("unexpected invocation of MockOwnershipCoverageStore.ListSnapshots")
+			},
+		},
+	}
+}
+
+// NewMockOwnershipCoverageStoreFrom creates a new mock of the
+// MockOwnershipCoverageStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockOwnershipCoverageStoreFrom(i database.OwnershipCoverageStore) *MockOwnershipCoverageStore {
+	return &MockOwnershipCoverageStore{
+		CreateSnapshotFunc: &OwnershipCoverageStoreCreateSnapshotFunc{
+			defaultHook: i.CreateSnapshot,
+		},
+		DeleteSnapshotsBeforeFunc: &OwnershipCoverageStoreDeleteSnapshotsBeforeFunc{
+			defaultHook: i.DeleteSnapshotsBefore,
+		},
+		ListSnapshotsFunc: &OwnershipCoverageStoreListSnapshotsFunc{
+			defaultHook: i.ListSnapshots,
+		},
+	}
+}
+
+// OwnershipCoverageStoreCreateSnapshotFunc describes the behavior when the
+// CreateSnapshot method of the parent MockOwnershipCoverageStore instance
+// is invoked.
+type OwnershipCoverageStoreCreateSnapshotFunc struct {
+	defaultHook func(context.Context, *database.OwnershipCoverageSnapshot) error
+	hooks       []func(context.Context, *database.OwnershipCoverageSnapshot) error
+	history     []OwnershipCoverageStoreCreateSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateSnapshot delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockOwnershipCoverageStore) CreateSnapshot(v0 context.Context, v1 *database.OwnershipCoverageSnapshot) error {
+	r0 := m.CreateSnapshotFunc.nextHook()(v0, v1)
+	m.CreateSnapshotFunc.appendCall(OwnershipCoverageStoreCreateSnapshotFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CreateSnapshot
+// method of the parent MockOwnershipCoverageStore instance is invoked and
+// the hook queue is empty.
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) SetDefaultHook(hook func(context.Context, *database.OwnershipCoverageSnapshot) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateSnapshot method of the parent MockOwnershipCoverageStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) PushHook(hook func(context.Context, *database.OwnershipCoverageSnapshot) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *database.OwnershipCoverageSnapshot) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *database.OwnershipCoverageSnapshot) error {
+		return r0
+	})
+}
+
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) nextHook() func(context.Context, *database.OwnershipCoverageSnapshot) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) appendCall(r0 OwnershipCoverageStoreCreateSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// OwnershipCoverageStoreCreateSnapshotFuncCall objects describing the
+// invocations of this function.
+func (f *OwnershipCoverageStoreCreateSnapshotFunc) History() []OwnershipCoverageStoreCreateSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnershipCoverageStoreCreateSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnershipCoverageStoreCreateSnapshotFuncCall is an object that describes
+// an invocation of method CreateSnapshot on an instance of
+// MockOwnershipCoverageStore.
+type OwnershipCoverageStoreCreateSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *database.OwnershipCoverageSnapshot
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnershipCoverageStoreCreateSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnershipCoverageStoreCreateSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OwnershipCoverageStoreDeleteSnapshotsBeforeFunc describes the behavior
+// when the DeleteSnapshotsBefore method of the parent
+// MockOwnershipCoverageStore instance is invoked.
+type OwnershipCoverageStoreDeleteSnapshotsBeforeFunc struct {
+	defaultHook func(context.Context, api.RepoID, time.Time) (int, error)
+	hooks       []func(context.Context, api.RepoID, time.Time) (int, error)
+	history     []OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteSnapshotsBefore delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockOwnershipCoverageStore) DeleteSnapshotsBefore(v0 context.Context, v1 api.RepoID, v2 time.Time) (int, error) {
+	r0, r1 := m.DeleteSnapshotsBeforeFunc.nextHook()(v0, v1, v2)
+	m.DeleteSnapshotsBeforeFunc.appendCall(OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteSnapshotsBefore method of the parent MockOwnershipCoverageStore
+// instance is invoked and the hook queue is empty.
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) SetDefaultHook(hook func(context.Context, api.RepoID, time.Time) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteSnapshotsBefore method of the parent MockOwnershipCoverageStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) PushHook(hook func(context.Context, api.RepoID, time.Time) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) nextHook() func(context.Context, api.RepoID, time.Time) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) appendCall(r0 OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall objects describing
+// the invocations of this function.
+func (f *OwnershipCoverageStoreDeleteSnapshotsBeforeFunc) History() []OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall is an object that
+// describes an invocation of method DeleteSnapshotsBefore on an instance of
+// MockOwnershipCoverageStore.
+type OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnershipCoverageStoreDeleteSnapshotsBeforeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// OwnershipCoverageStoreListSnapshotsFunc describes the behavior when the
+// ListSnapshots method of the parent MockOwnershipCoverageStore instance is
+// invoked.
+type OwnershipCoverageStoreListSnapshotsFunc struct {
+	defaultHook func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error)
+	hooks       []func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error)
+	history     []OwnershipCoverageStoreListSnapshotsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListSnapshots delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockOwnershipCoverageStore) ListSnapshots(v0 context.Context, v1 database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error) {
+	r0, r1 := m.ListSnapshotsFunc.nextHook()(v0, v1)
+	m.ListSnapshotsFunc.appendCall(OwnershipCoverageStoreListSnapshotsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListSnapshots method
+// of the parent MockOwnershipCoverageStore instance is invoked and the hook
+// queue is empty.
+func (f *OwnershipCoverageStoreListSnapshotsFunc) SetDefaultHook(hook func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListSnapshots method of the parent MockOwnershipCoverageStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnershipCoverageStoreListSnapshotsFunc) PushHook(hook func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnershipCoverageStoreListSnapshotsFunc) SetDefaultReturn(r0 []*database.OwnershipCoverageSnapshot, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnershipCoverageStoreListSnapshotsFunc) PushReturn(r0 []*database.OwnershipCoverageSnapshot, r1 error) {
+	f.PushHook(func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error) {
+		return r0, r1
+	})
+}
+
+func (f *OwnershipCoverageStoreListSnapshotsFunc) nextHook() func(context.Context, database.ListOwnershipCoverageSnapshotsOpts) ([]*database.OwnershipCoverageSnapshot, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnershipCoverageStoreListSnapshotsFunc) appendCall(r0 OwnershipCoverageStoreListSnapshotsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OwnershipCoverageStoreListSnapshotsFuncCall
+// objects describing the invocations of this function.
+func (f *OwnershipCoverageStoreListSnapshotsFunc) History() []OwnershipCoverageStoreListSnapshotsFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnershipCoverageStoreListSnapshotsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnershipCoverageStoreListSnapshotsFuncCall is an object that describes
+// an invocation of method ListSnapshots on an instance of
+// MockOwnershipCoverageStore.
+type OwnershipCoverageStoreListSnapshotsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListOwnershipCoverageSnapshotsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.OwnershipCoverageSnapshot
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnershipCoverageStoreListSnapshotsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnershipCoverageStoreListSnapshotsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockOwnershipStatsStore is a mock implementation of the
 // OwnershipStatsStore interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
@@ -58472,6 +61239,9 @@ type MockRecentContributionSignalStore struct {
 	// ClearSignalsFunc is an instance of a mock function object controlling
 	// the behavior of the method ClearSignals.
 	ClearSignalsFunc *RecentContributionSignalStoreClearSignalsFunc
+	// FindContributedPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method FindContributedPaths.
+	FindContributedPathsFunc *RecentContributionSignalStoreFindContributedPathsFunc
 	// FindRecentAuthorsFunc is an instance of a mock function object
 	// controlling the behavior of the method FindRecentAuthors.
 	FindRecentAuthorsFunc *RecentContributionSignalStoreFindRecentAuthorsFunc
@@ -58495,6 +61265,11 @@ func NewMockRecentContributionSignalStore() *MockRecentContributionSignalStore {
 				return
 			},
 		},
+		FindContributedPathsFunc: &RecentContributionSignalStoreFindContributedPathsFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 []string, r1 error) {
+				return
+			},
+		},
 		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
 			defaultHook: func(context.Context, api.RepoID, string) (r0 []database.RecentContributorSummary, r1 error) {
 				return
@@ -58523,6 +61298,11 @@ func NewStrictMockRecentContributionSignalStore() *MockRecentContributionSignalS
 				panic("unexpected invocation of MockRecentContributionSignalStore.ClearSignals")
 			},
 		},
+		FindContributedPathsFunc: &RecentContributionSignalStoreFindContributedPathsFunc{
+			defaultHook: func(context.Context, api.RepoID) ([]string, error) {
+				panic("unexpected invocation of MockRecentContributionSignalStore.FindContributedPaths")
+			},
+		},
 		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
 			defaultHook: func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
 				panic("unexpected invocation of MockRecentContributionSignalStore.FindRecentAuthors")
@@ -58547,6 +61327,9 @@ func NewMockRecentContributionSignalStoreFrom(i database.RecentContributionSigna
 		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
 			defaultHook: i.ClearSignals,
 		},
+		FindContributedPathsFunc: &RecentContributionSignalStoreFindContributedPathsFunc{
+			defaultHook: i.FindContributedPaths,
+		},
 		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
 			defaultHook: i.FindRecentAuthors,
 		},
@@ -58774,6 +61557,118 @@ func (c RecentContributionSignalStoreClearSignalsFuncCall) Results() []interface
 	return []interface{}{c.Result0}
 }
 
+// RecentContributionSignalStoreFindContributedPathsFunc describes the
+// behavior when the FindContributedPaths method of the parent
+// MockRecentContributionSignalStore instance is invoked.
+type RecentContributionSignalStoreFindContributedPathsFunc struct {
+	defaultHook func(context.Context, api.RepoID) ([]string, error)
+	hooks       []func(context.Context, api.RepoID) ([]string, error)
+	history     []RecentContributionSignalStoreFindContributedPathsFuncCall
+	mutex       sync.Mutex
+}
+
+// FindContributedPaths delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRecentContributionSignalStore) FindContributedPaths(v0 context.Context, v1 api.RepoID) ([]string, error) {
+	r0, r1 := m.FindContributedPathsFunc.nextHook()(v0, v1)
+	m.FindContributedPathsFunc.appendCall(RecentContributionSignalStoreFindContributedPathsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindContributedPaths
+// method of the parent MockRecentContributionSignalStore instance is
+// invoked and the hook queue is empty.
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindContributedPaths method of the parent
+// MockRecentContributionSignalStore instance invokes the hook at the front
+// of the queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) PushHook(hook func(context.Context, api.RepoID) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) nextHook() func(context.Context, api.RepoID) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) appendCall(r0 RecentContributionSignalStoreFindContributedPathsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RecentContributionSignalStoreFindContributedPathsFuncCall objects
+// describing the invocations of this function.
+func (f *RecentContributionSignalStoreFindContributedPathsFunc) History() []RecentContributionSignalStoreFindContributedPathsFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentContributionSignalStoreFindContributedPathsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentContributionSignalStoreFindContributedPathsFuncCall is an object
+// that describes an invocation of method FindContributedPaths on an
+// instance of MockRecentContributionSignalStore.
+type RecentContributionSignalStoreFindContributedPathsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentContributionSignalStoreFindContributedPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentContributionSignalStoreFindContributedPathsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // RecentContributionSignalStoreFindRecentAuthorsFunc describes the behavior
 // when the FindRecentAuthors method of the parent
 // MockRecentContributionSignalStore instance is invoked.
@@ -50,14 +50,16 @@ type GitserverRepoStore interface {
 	// If a matching row does not exist, a new one will be created.
 	// Only one record will be maintained, so this records only the most recent output.
 	SetLastOutput(ctx context.Context, name api.RepoName, output string) error
-	// SetLastFetched will attempt to update ONLY the last fetched data (last_fetched, last_changed, shard_id) of a GitServerRepo and ensures it is marked as cloned.
+	// SetLastFetched will attempt to update ONLY the last fetched data (last_fetched, last_changed, shard_id) of a GitServerRepo and ensures it is marked as cloned,
+	// unless it has been offloaded to cold storage.
 	SetLastFetched(ctx context.Context, name api.RepoName, data GitserverFetchData) error
 	// SetRepoSize will attempt to update ONLY the repo size of a GitServerRepo. If
 	// a matching row does not yet exist a new one will be created.
 	// If the size value hasn't changed, the row will not be updated.
 	SetRepoSize(ctx context.Context, name api.RepoName, size int64, shardID string) error
 	// ListPurgeableRepos returns all purgeable repos. These are repos that
-	// are cloned on disk but have been deleted or blocked.
+	// are cloned on disk or tiered to cold storage but have been deleted or
+	// blocked.
 	ListPurgeableRepos(ctx context.Context, options ListPurgableReposOptions) ([]api.RepoName, error)
 	// UpdateRepoSizes sets repo sizes according to input map. Key is repoID, value is repo_size_bytes.
 	UpdateRepoSizes(ctx context.Context, logger log.Logger, shardID string, repos map[api.RepoName]int64) (int, error)
@@ -67,6 +69,9 @@ type GitserverRepoStore interface {
 	// GetGitserverGitDirSize returns the total size of all git directories of cloned
 	// repos across all gitservers.
 	GetGitserverGitDirSize(ctx context.Context) (sizeBytes int64, err error)
+	// GetTieredStats returns the number and total size of the repos on the given
+	// shard that have been offloaded to cold storage.
+	GetTieredStats(ctx context.Context, shardID string) (count, sizeBytes int64, err error)
 }
 
 var _ GitserverRepoStore = (*gitserverRepoStore)(nil)
@@ -167,7 +172,7 @@ func (s *gitserverRepoStore) ListPurgeableRepos(ctx context.Context, options Lis
 	if options.Limit > 0 {
 		query = query + fmt.Sprintf(" LIMIT %d", options.Limit)
 	}
-	return scanRepoNames(s.Query(ctx, sqlf.Sprintf(query, deletedAtClause, types.CloneStatusCloned)))
+	return scanRepoNames(s.Query(ctx, sqlf.Sprintf(query, deletedAtClause, types.CloneStatusCloned, types.CloneStatusTiered)))
 }
 
 const purgableReposQuery = `
@@ -177,7 +182,7 @@ FROM repo
 JOIN gitserver_repos gr ON repo.id = gr.repo_id
 WHERE
 	(%s OR repo.blocked IS NOT NULL)
-	AND gr.clone_status = %s
+	AND gr.clone_status IN (%s, %s)
 ORDER BY deleted_at ASC
 `
 
@@ -496,6 +501,24 @@ WHERE
 	%s
 `
 
+func (s *gitserverRepoStore) GetTieredStats(ctx context.Context, shardID string) (count, sizeBytes int64, err error) {
+	row := s.QueryRow(ctx, sqlf.Sprintf(getTieredStatsQueryFmtstr, shardID, types.CloneStatusTiered))
+	err = row.Scan(&count, &sizeBytes)
+	return count, sizeBytes, err
+}
+
+const getTieredStatsQueryFmtstr = `
+SELECT
+	COUNT(*),
+	COALESCE(SUM(gitserver_repos.repo_size_bytes), 0)
+FROM
+	gitserver_repos
+WHERE
+	gitserver_repos.shard_id = %s
+	AND
+	gitserver_repos.clone_status = %s
+`
+
 func (s *gitserverRepoStore) SetRepoSize(ctx context.Context, name api.RepoName, size int64, shardID string) error {
 	err := s.Exec(ctx, sqlf.Sprintf(`
 UPDATE gitserver_repos
@@ -574,10 +597,10 @@ SET
 	corrupted_at = NULL,
 	last_fetched = %s,
 	last_changed = %s,
-	clone_status = %s,
+	clone_status = CASE WHEN clone_status = %s THEN clone_status ELSE %s END,
 	updated_at = NOW()
 WHERE repo_id = (SELECT id FROM repo WHERE name = %s)
-`, data.LastFetched, data.LastChanged, types.CloneStatusCloned, name))
+`, data.LastFetched, data.LastChanged, types.CloneStatusTiered, types.CloneStatusCloned, name))
 	if err != nil {
 		return errors.Wrap(err, "setting last fetched")
 	}
@@ -119,16 +119,20 @@ func TestListPurgeableRepos(t *testing.T) {
 	blockedRepo := &types.Repo{Name: "blocked"}
 	deletedRepo := &types.Repo{Name: "deleted"}
 	notCloned := &types.Repo{Name: "notCloned"}
+	tieredRepo := &types.Repo{Name: "tiered"}
 
 	createTestRepos(ctx, t, db, types.Repos{
 		normalRepo,
 		blockedRepo,
 		deletedRepo,
 		notCloned,
+		tieredRepo,
 	})
 	for _, repo := range []*types.Repo{normalRepo, blockedRepo, deletedRepo} {
 		updateTestGitserverRepos(ctx, t, db, false, types.CloneStatusCloned, repo.ID)
 	}
+	// Tiered repos only live in cold storage, but still need to be purged.
+	updateTestGitserverRepos(ctx, t, db, false, types.CloneStatusTiered, tieredRepo.ID)
 	// Delete & load soft-deleted name of repo
 	if err := db.Repos().Delete(ctx, deletedRepo.ID); err != nil {
 		t.Fatal(err)
@@ -140,8 +144,10 @@ func TestListPurgeableRepos(t *testing.T) {
 	deletedRepoName := api.RepoName(deletedRepoNameStr)
 
 	// Blocking a repo is currently done manually
-	if _, err := db.ExecContext(ctx, `UPDATE repo set blocked = '{}' WHERE id = $1`, blockedRepo.ID); err != nil {
-		t.Fatal(err)
+	for _, repo := range []*types.Repo{blockedRepo, tieredRepo} {
+		if _, err := db.ExecContext(ctx, `UPDATE repo set blocked = '{}' WHERE id = $1`, repo.ID); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	for _, tt := range []struct {
@@ -155,7 +161,7 @@ func TestListPurgeableRepos(t *testing.T) {
 				DeletedBefore: time.Time{},
 				Limit:         0,
 			},
-			wantRepos: []api.RepoName{deletedRepoName, blockedRepo.Name},
+			wantRepos: []api.RepoName{deletedRepoName, blockedRepo.Name, tieredRepo.Name},
 		},
 		{
 			name: "deletedBefore now",
@@ -164,7 +170,7 @@ func TestListPurgeableRepos(t *testing.T) {
 				Limit:         0,
 			},
 
-			wantRepos: []api.RepoName{deletedRepoName, blockedRepo.Name},
+			wantRepos: []api.RepoName{deletedRepoName, blockedRepo.Name, tieredRepo.Name},
 		},
 		{
 			name: "deletedBefore 5 minutes ago",
@@ -172,7 +178,7 @@ func TestListPurgeableRepos(t *testing.T) {
 				DeletedBefore: time.Now().Add(-5 * time.Minute),
 				Limit:         0,
 			},
-			wantRepos: []api.RepoName{blockedRepo.Name},
+			wantRepos: []api.RepoName{blockedRepo.Name, tieredRepo.Name},
 		},
 		{
 			name: "test limit",
@@ -1083,3 +1089,47 @@ func TestGitserverRepos_GetGitserverGitDirSize(t *testing.T) {
 	// only repo2 which is 500 bytes should cont now.
 	assertSize(500)
 }
+
+func TestGitserverRepos_GetTieredStats(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	assertStats := func(shardID string, wantCount, wantSize int64) {
+		t.Helper()
+
+		count, size, err := db.GitserverRepos().GetTieredStats(ctx, shardID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, wantCount, count)
+		require.Equal(t, wantSize, size)
+	}
+
+	// Nothing is tiered when no repos exist yet.
+	assertStats("test-gitserver", 0, 0)
+
+	repo, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/repo")
+	require.NoError(t, db.GitserverRepos().SetRepoSize(ctx, repo.Name, 200, "test-gitserver"))
+	repo2, _ := createTestRepo(ctx, t, db, "github.com/sourcegraph/repo2")
+	require.NoError(t, db.GitserverRepos().SetRepoSize(ctx, repo2.Name, 500, "test-gitserver"))
+
+	// Cloned repos are not tiered.
+	assertStats("test-gitserver", 0, 0)
+
+	// Tiered repos keep their size.
+	require.NoError(t, db.GitserverRepos().SetCloneStatus(ctx, repo.Name, types.CloneStatusTiered, "test-gitserver"))
+	assertStats("test-gitserver", 1, 200)
+
+	// Only repos of the given shard are counted.
+	assertStats("other-gitserver", 0, 0)
+
+	// The tiered repo is no longer counted as taking up space on disk.
+	size, err := db.GitserverRepos().GetGitserverGitDirSize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), size)
+}
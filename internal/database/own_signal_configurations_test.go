@@ -37,6 +37,11 @@ func Test_LoadConfigurations(t *testing.T) {
 			Name:        "analytics",
 			Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 		},
+		{
+			ID:          4,
+			Name:        "coverage-reports",
+			Description: "Periodically records ownership coverage by directory and team to show coverage trends over time.",
+		},
 	}).Equal(t, configurations)
 
 	t.Run("load by name", func(t *testing.T) {
@@ -85,6 +90,11 @@ func Test_LoadConfigurations(t *testing.T) {
 				Name:        "analytics",
 				Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			},
+			{
+				ID:          4,
+				Name:        "coverage-reports",
+				Description: "Periodically records ownership coverage by directory and team to show coverage trends over time.",
+			},
 		}).Equal(t, configurations)
 	})
 }
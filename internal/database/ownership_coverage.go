@@ -0,0 +1,278 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// OwnershipCoverageCounts describes how many files in a file tree are covered by
+// each source of ownership data.
+type OwnershipCoverageCounts struct {
+	// TotalFileCount is the number of files nested within the tree.
+	TotalFileCount int
+	// CodeownedFileCount is the number of files owned via CODEOWNERS.
+	CodeownedFileCount int
+	// AssignedOwnershipFileCount is the number of files owned via assigned
+	// ownership, either by a person or a team.
+	AssignedOwnershipFileCount int
+	// RecentContributorFileCount is the number of files that have recent
+	// contributors.
+	RecentContributorFileCount int
+	// TotalOwnedFileCount is the number of files that have any ownership defined
+	// - either via CODEOWNERS or via assigned ownership.
+	TotalOwnedFileCount int
+}
+
+// OwnershipCoverageDirectory is the ownership coverage of a single directory in
+// a snapshot.
+type OwnershipCoverageDirectory struct {
+	// Path of the directory, without leading /. Empty path "" represents the
+	// repo root.
+	Path string
+	OwnershipCoverageCounts
+}
+
+// OwnershipCoverageTeam is the number of files owned by a team in a snapshot.
+type OwnershipCoverageTeam struct {
+	TeamID         int32
+	OwnedFileCount int
+}
+
+// OwnershipCoverageSnapshot is an ownership coverage report of a repository at
+// a given commit.
+type OwnershipCoverageSnapshot struct {
+	ID        int
+	RepoID    api.RepoID
+	CommitID  api.CommitID
+	CreatedAt time.Time
+
+	// Directories holds the coverage of the directories in the repository. When
+	// listing snapshots, only the requested directory and its direct children
+	// are loaded, in path order.
+	Directories []OwnershipCoverageDirectory
+	// Teams holds the number of files owned by each team, ordered by the number
+	// of files descending.
+	Teams []OwnershipCoverageTeam
+}
+
+// ListOwnershipCoverageSnapshotsOpts locates snapshots of a repository.
+type ListOwnershipCoverageSnapshotsOpts struct {
+	RepoID api.RepoID
+	// Path is the directory to load coverage for, along with its direct
+	// children. Empty path "" represents the repo root.
+	Path string
+	// Since, if set, only returns snapshots created at or after the given time.
+	Since time.Time
+	*LimitOffset
+}
+
+type OwnershipCoverageStore interface {
+	// CreateSnapshot persists the given snapshot with all its directory and team
+	// counts. The ID and CreatedAt fields of the snapshot are set on success.
+	CreateSnapshot(context.Context, *OwnershipCoverageSnapshot) error
+
+	// ListSnapshots returns the snapshots of a repository, most recent first.
+	ListSnapshots(context.Context, ListOwnershipCoverageSnapshotsOpts) ([]*OwnershipCoverageSnapshot, error)
+
+	// DeleteSnapshotsBefore deletes the snapshots of a repository that were
+	// created before the given time, and returns how many were deleted.
+	DeleteSnapshotsBefore(context.Context, api.RepoID, time.Time) (int, error)
+}
+
+var _ OwnershipCoverageStore = &ownershipCoverageStore{}
+
+func OwnershipCoverageWith(other basestore.ShareableStore) OwnershipCoverageStore {
+	return &ownershipCoverageStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+type ownershipCoverageStore struct {
+	*basestore.Store
+}
+
+const createOwnershipCoverageSnapshotFmtstr = `
+	INSERT INTO ownership_coverage_snapshots (repo_id, commit_id)
+	VALUES (%s, %s)
+	RETURNING id, created_at
+`
+
+const insertOwnershipCoverageDirectoryFmtstr = `
+	INSERT INTO ownership_coverage_directory_stats (
+		snapshot_id,
+		path,
+		parent_path,
+		total_files_count,
+		codeowned_files_count,
+		assigned_ownership_files_count,
+		recent_contributor_files_count,
+		any_ownership_files_count)
+	VALUES (%s, %s, %s, %s, %s, %s, %s, %s)
+`
+
+const insertOwnershipCoverageTeamFmtstr = `
+	INSERT INTO ownership_coverage_team_stats (snapshot_id, team_id, owned_files_count)
+	VALUES (%s, %s, %s)
+`
+
+func (s *ownershipCoverageStore) CreateSnapshot(ctx context.Context, snapshot *OwnershipCoverageSnapshot) error {
+	return s.WithTransact(ctx, func(tx *basestore.Store) error {
+		var id int
+		var createdAt time.Time
+		q := sqlf.Sprintf(createOwnershipCoverageSnapshotFmtstr, snapshot.RepoID, snapshot.CommitID)
+		if err := tx.QueryRow(ctx, q).Scan(&id, &createdAt); err != nil {
+			return errors.Wrap(err, "inserting snapshot")
+		}
+		for _, dir := range snapshot.Directories {
+			q := sqlf.Sprintf(
+				insertOwnershipCoverageDirectoryFmtstr,
+				id,
+				dir.Path,
+				parentPath(dir.Path),
+				dir.TotalFileCount,
+				dir.CodeownedFileCount,
+				dir.AssignedOwnershipFileCount,
+				dir.RecentContributorFileCount,
+				dir.TotalOwnedFileCount,
+			)
+			if err := tx.Exec(ctx, q); err != nil {
+				return errors.Wrapf(err, "inserting coverage for path %q", dir.Path)
+			}
+		}
+		for _, team := range snapshot.Teams {
+			q := sqlf.Sprintf(insertOwnershipCoverageTeamFmtstr, id, team.TeamID, team.OwnedFileCount)
+			if err := tx.Exec(ctx, q); err != nil {
+				return errors.Wrapf(err, "inserting coverage for team %d", team.TeamID)
+			}
+		}
+		snapshot.ID = id
+		snapshot.CreatedAt = createdAt
+		return nil
+	})
+}
+
+// parentPath returns the path of the directory containing the given path, or
+// nil for the repo root.
+func parentPath(path string) *string {
+	if path == "" {
+		return nil
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] == '/' {
+			parent := path[:i]
+			return &parent
+		}
+	}
+	root := ""
+	return &root
+}
+
+const listOwnershipCoverageSnapshotsFmtstr = `
+	SELECT id, repo_id, commit_id, created_at
+	FROM ownership_coverage_snapshots
+	WHERE %s
+	ORDER BY created_at DESC, id DESC
+	%s
+`
+
+const listOwnershipCoverageDirectoriesFmtstr = `
+	SELECT
+		snapshot_id,
+		path,
+		total_files_count,
+		codeowned_files_count,
+		assigned_ownership_files_count,
+		recent_contributor_files_count,
+		any_ownership_files_count
+	FROM ownership_coverage_directory_stats
+	WHERE snapshot_id = ANY(%s)
+	AND (path = %s OR parent_path = %s)
+	ORDER BY path
+`
+
+const listOwnershipCoverageTeamsFmtstr = `
+	SELECT snapshot_id, team_id, owned_files_count
+	FROM ownership_coverage_team_stats
+	WHERE snapshot_id = ANY(%s)
+	ORDER BY owned_files_count DESC, team_id
+`
+
+var scanOwnershipCoverageSnapshots = basestore.NewSliceScanner(func(s dbutil.Scanner) (*OwnershipCoverageSnapshot, error) {
+	var snapshot OwnershipCoverageSnapshot
+	err := s.Scan(&snapshot.ID, &snapshot.RepoID, &snapshot.CommitID, &snapshot.CreatedAt)
+	return &snapshot, err
+})
+
+func (s *ownershipCoverageStore) ListSnapshots(ctx context.Context, opts ListOwnershipCoverageSnapshotsOpts) ([]*OwnershipCoverageSnapshot, error) {
+	conds := []*sqlf.Query{sqlf.Sprintf("repo_id = %s", opts.RepoID)}
+	if !opts.Since.IsZero() {
+		conds = append(conds, sqlf.Sprintf("created_at >= %s", opts.Since))
+	}
+	snapshots, err := scanOwnershipCoverageSnapshots(s.Query(ctx, sqlf.Sprintf(
+		listOwnershipCoverageSnapshotsFmtstr,
+		sqlf.Join(conds, "AND"),
+		opts.LimitOffset.SQL(),
+	)))
+	if err != nil || len(snapshots) == 0 {
+		return snapshots, err
+	}
+
+	byID := make(map[int]*OwnershipCoverageSnapshot, len(snapshots))
+	ids := make([]int, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		byID[snapshot.ID] = snapshot
+		ids = append(ids, snapshot.ID)
+	}
+
+	err = basestore.NewCallbackScanner(func(sc dbutil.Scanner) (bool, error) {
+		var snapshotID int
+		var dir OwnershipCoverageDirectory
+		if err := sc.Scan(
+			&snapshotID,
+			&dir.Path,
+			&dir.TotalFileCount,
+			&dir.CodeownedFileCount,
+			&dir.AssignedOwnershipFileCount,
+			&dir.RecentContributorFileCount,
+			&dir.TotalOwnedFileCount,
+		); err != nil {
+			return false, err
+		}
+		byID[snapshotID].Directories = append(byID[snapshotID].Directories, dir)
+		return true, nil
+	})(s.Query(ctx, sqlf.Sprintf(listOwnershipCoverageDirectoriesFmtstr, pq.Array(ids), opts.Path, opts.Path)))
+	if err != nil {
+		return nil, errors.Wrap(err, "listing directory coverage")
+	}
+
+	err = basestore.NewCallbackScanner(func(sc dbutil.Scanner) (bool, error) {
+		var snapshotID int
+		var team OwnershipCoverageTeam
+		if err := sc.Scan(&snapshotID, &team.TeamID, &team.OwnedFileCount); err != nil {
+			return false, err
+		}
+		byID[snapshotID].Teams = append(byID[snapshotID].Teams, team)
+		return true, nil
+	})(s.Query(ctx, sqlf.Sprintf(listOwnershipCoverageTeamsFmtstr, pq.Array(ids))))
+	if err != nil {
+		return nil, errors.Wrap(err, "listing team coverage")
+	}
+
+	return snapshots, nil
+}
+
+func (s *ownershipCoverageStore) DeleteSnapshotsBefore(ctx context.Context, repoID api.RepoID, before time.Time) (int, error) {
+	q := sqlf.Sprintf("DELETE FROM ownership_coverage_snapshots WHERE repo_id = %s AND created_at < %s", repoID, before)
+	res, err := s.ExecResult(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := res.RowsAffected()
+	return int(rows), err
+}
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestOwnershipCoverageSnapshots(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	repo := mustCreate(ctx, t, db, &types.Repo{Name: "a/b"})
+	team, err := db.Teams().CreateTeam(ctx, &types.Team{Name: "team-a"})
+	require.NoError(t, err)
+
+	store := db.OwnershipCoverage()
+	counts := func(total, owned int) OwnershipCoverageCounts {
+		return OwnershipCoverageCounts{
+			TotalFileCount:             total,
+			CodeownedFileCount:         owned,
+			AssignedOwnershipFileCount: 0,
+			RecentContributorFileCount: 1,
+			TotalOwnedFileCount:        owned,
+		}
+	}
+	first := &OwnershipCoverageSnapshot{
+		RepoID:   repo.ID,
+		CommitID: "c1",
+		Directories: []OwnershipCoverageDirectory{
+			{Path: "", OwnershipCoverageCounts: counts(4, 1)},
+			{Path: "src", OwnershipCoverageCounts: counts(3, 1)},
+			{Path: "src/main", OwnershipCoverageCounts: counts(2, 1)},
+			{Path: "docs", OwnershipCoverageCounts: counts(1, 0)},
+		},
+	}
+	require.NoError(t, store.CreateSnapshot(ctx, first))
+	require.NotZero(t, first.ID)
+	second := &OwnershipCoverageSnapshot{
+		RepoID:   repo.ID,
+		CommitID: "c2",
+		Directories: []OwnershipCoverageDirectory{
+			{Path: "", OwnershipCoverageCounts: counts(4, 3)},
+			{Path: "src", OwnershipCoverageCounts: counts(3, 3)},
+			{Path: "src/main", OwnershipCoverageCounts: counts(2, 2)},
+			{Path: "docs", OwnershipCoverageCounts: counts(1, 0)},
+		},
+		Teams: []OwnershipCoverageTeam{{TeamID: team.ID, OwnedFileCount: 3}},
+	}
+	require.NoError(t, store.CreateSnapshot(ctx, second))
+
+	t.Run("repo root", func(t *testing.T) {
+		snapshots, err := store.ListSnapshots(ctx, ListOwnershipCoverageSnapshotsOpts{RepoID: repo.ID})
+		require.NoError(t, err)
+		require.Len(t, snapshots, 2)
+		// Most recent first.
+		assert.Equal(t, second.ID, snapshots[0].ID)
+		assert.Equal(t, []OwnershipCoverageDirectory{
+			{Path: "", OwnershipCoverageCounts: counts(4, 3)},
+			{Path: "docs", OwnershipCoverageCounts: counts(1, 0)},
+			{Path: "src", OwnershipCoverageCounts: counts(3, 3)},
+		}, snapshots[0].Directories)
+		assert.Equal(t, []OwnershipCoverageTeam{{TeamID: team.ID, OwnedFileCount: 3}}, snapshots[0].Teams)
+		assert.Empty(t, snapshots[1].Teams)
+	})
+
+	t.Run("sub-directory", func(t *testing.T) {
+		snapshots, err := store.ListSnapshots(ctx, ListOwnershipCoverageSnapshotsOpts{
+			RepoID:      repo.ID,
+			Path:        "src",
+			LimitOffset: &LimitOffset{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.Equal(t, []OwnershipCoverageDirectory{
+			{Path: "src", OwnershipCoverageCounts: counts(3, 3)},
+			{Path: "src/main", OwnershipCoverageCounts: counts(2, 2)},
+		}, snapshots[0].Directories)
+	})
+
+	t.Run("since", func(t *testing.T) {
+		snapshots, err := store.ListSnapshots(ctx, ListOwnershipCoverageSnapshotsOpts{
+			RepoID: repo.ID,
+			Since:  time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		deleted, err := store.DeleteSnapshotsBefore(ctx, repo.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+		snapshots, err := store.ListSnapshots(ctx, ListOwnershipCoverageSnapshotsOpts{RepoID: repo.ID})
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+}
@@ -15,6 +15,7 @@ import (
 type RecentContributionSignalStore interface {
 	AddCommit(ctx context.Context, commit Commit) error
 	FindRecentAuthors(ctx context.Context, repoID api.RepoID, path string) ([]RecentContributorSummary, error)
+	FindContributedPaths(ctx context.Context, repoID api.RepoID) ([]string, error)
 	ClearSignals(ctx context.Context, repoID api.RepoID) error
 	WithTransact(context.Context, func(store RecentContributionSignalStore) error) error
 }
@@ -205,3 +206,18 @@ func (s *recentContributionSignalStore) FindRecentAuthors(ctx context.Context, r
 	}
 	return contributions, nil
 }
+
+const findContributedPathsFmtstr = `
+	SELECT DISTINCT p.absolute_path
+	FROM own_aggregate_recent_contribution AS g
+	INNER JOIN repo_paths AS p
+	ON p.id = g.changed_file_path_id
+	WHERE p.repo_id = %s
+`
+
+// FindContributedPaths returns all the paths in given `repoID` that have
+// recent contributors. Since the aggregate is kept for files as well as
+// their ancestor directories, the result contains both.
+func (s *recentContributionSignalStore) FindContributedPaths(ctx context.Context, repoID api.RepoID) ([]string, error) {
+	return basestore.ScanStrings(s.Query(ctx, sqlf.Sprintf(findContributedPathsFmtstr, repoID)))
+}
@@ -105,6 +105,26 @@ func TestRecentContributionSignalStore(t *testing.T) {
 			assert.Equal(t, want, got)
 		})
 	}
+
+	t.Run("contributed paths", func(t *testing.T) {
+		got, err := store.FindContributedPaths(ctx, repo.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.ElementsMatch(t, []string{
+			"",
+			"file1.txt",
+			"dir",
+			"dir/file2.txt",
+			"dir/file3.txt",
+			"dir/subdir",
+			"dir/subdir/file.txt",
+			"dir2",
+			"dir2/file2.txt",
+			"dir2/subdir",
+			"dir2/subdir/file.txt",
+		}, got)
+	})
 }
 
 func gitSha(val string) string {
@@ -19,6 +19,7 @@ type RepoStatistics struct {
 	Cloned      int
 	FailedFetch int
 	Corrupted   int
+	Tiered      int
 }
 
 // gitserverRepoStatistics represents the contents of the
@@ -32,6 +33,7 @@ type GitserverReposStatistic struct {
 	Cloned      int
 	FailedFetch int
 	Corrupted   int
+	Tiered      int
 }
 
 type RepoStatisticsStore interface {
@@ -71,7 +73,7 @@ func (s *repoStatisticsStore) WithTransact(ctx context.Context, f func(RepoStati
 func (s *repoStatisticsStore) GetRepoStatistics(ctx context.Context) (RepoStatistics, error) {
 	var rs RepoStatistics
 	row := s.QueryRow(ctx, sqlf.Sprintf(getRepoStatisticsQueryFmtstr))
-	err := row.Scan(&rs.Total, &rs.SoftDeleted, &rs.NotCloned, &rs.Cloning, &rs.Cloned, &rs.FailedFetch, &rs.Corrupted)
+	err := row.Scan(&rs.Total, &rs.SoftDeleted, &rs.NotCloned, &rs.Cloning, &rs.Cloned, &rs.FailedFetch, &rs.Corrupted, &rs.Tiered)
 	if err != nil {
 		return rs, err
 	}
@@ -86,7 +88,8 @@ SELECT
 	SUM(cloning),
 	SUM(cloned),
 	SUM(failed_fetch),
-	SUM(corrupted)
+	SUM(corrupted),
+	SUM(tiered)
 FROM repo_statistics
 `
 
@@ -104,9 +107,10 @@ WITH deleted AS (
 		cloning,
 		cloned,
 		failed_fetch,
-		corrupted
+		corrupted,
+		tiered
 )
-INSERT INTO repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted)
+INSERT INTO repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
 SELECT
 	COALESCE(SUM(total), 0),
 	COALESCE(SUM(soft_deleted), 0),
@@ -114,7 +118,8 @@ SELECT
 	COALESCE(SUM(cloning), 0),
 	COALESCE(SUM(cloned), 0),
 	COALESCE(SUM(failed_fetch), 0),
-	COALESCE(SUM(corrupted), 0)
+	COALESCE(SUM(corrupted), 0),
+	COALESCE(SUM(tiered), 0)
 FROM deleted;
 `
 
@@ -132,9 +137,10 @@ WITH deleted AS (
 		cloning,
 		cloned,
 		failed_fetch,
-		corrupted
+		corrupted,
+		tiered
 )
-INSERT INTO gitserver_repos_statistics (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted)
+INSERT INTO gitserver_repos_statistics (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
 SELECT
 	shard_id,
 	SUM(total),
@@ -142,7 +148,8 @@ SELECT
 	SUM(cloning),
 	SUM(cloned),
 	SUM(failed_fetch),
-	SUM(corrupted)
+	SUM(corrupted),
+	SUM(tiered)
 FROM deleted
 GROUP BY shard_id
 `
@@ -160,7 +167,8 @@ SELECT
 	SUM(cloning) AS cloning,
 	SUM(cloned) AS cloned,
 	SUM(failed_fetch) AS failed_fetch,
-	SUM(corrupted) AS corrupted
+	SUM(corrupted) AS corrupted,
+	SUM(tiered) AS tiered
 FROM gitserver_repos_statistics
 GROUP BY shard_id
 `
@@ -204,7 +212,7 @@ func (s *repoStatisticsStore) DeleteAndRecreateStatistics(ctx context.Context) (
 }
 
 const recreateRepoStatisticsStateQuery = `
-INSERT INTO repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted)
+INSERT INTO repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
 SELECT
   COUNT(*) AS total,
   (SELECT COUNT(*) FROM repo WHERE deleted_at is NOT NULL AND blocked IS NULL) AS soft_deleted,
@@ -212,7 +220,8 @@ SELECT
   COUNT(*) FILTER(WHERE gitserver_repos.clone_status = 'cloning') AS cloning,
   COUNT(*) FILTER(WHERE gitserver_repos.clone_status = 'cloned') AS cloned,
   COUNT(*) FILTER(WHERE gitserver_repos.last_error IS NOT NULL) AS failed_fetch,
-  COUNT(*) FILTER(WHERE gitserver_repos.corrupted_at IS NOT NULL) AS corrupted
+  COUNT(*) FILTER(WHERE gitserver_repos.corrupted_at IS NOT NULL) AS corrupted,
+  COUNT(*) FILTER(WHERE gitserver_repos.clone_status = 'tiered') AS tiered
 FROM repo
 JOIN gitserver_repos ON gitserver_repos.repo_id = repo.id
 WHERE
@@ -221,7 +230,7 @@ WHERE
 
 const recreateGitserverReposStatisticsQuery = `
 INSERT INTO
-  gitserver_repos_statistics (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted)
+  gitserver_repos_statistics (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
 SELECT
   shard_id,
   COUNT(*) AS total,
@@ -229,7 +238,8 @@ SELECT
   COUNT(*) FILTER(WHERE clone_status = 'cloning') AS cloning,
   COUNT(*) FILTER(WHERE clone_status = 'cloned') AS cloned,
   COUNT(*) FILTER(WHERE last_error IS NOT NULL) AS failed_fetch,
-  COUNT(*) FILTER(WHERE corrupted_at IS NOT NULL) AS corrupted
+  COUNT(*) FILTER(WHERE corrupted_at IS NOT NULL) AS corrupted,
+  COUNT(*) FILTER(WHERE clone_status = 'tiered') AS tiered
 FROM
   gitserver_repos
 GROUP BY shard_id
@@ -239,7 +249,7 @@ var scanGitserverReposStatistics = basestore.NewSliceScanner(scanGitserverReposS
 
 func scanGitserverReposStatistic(s dbutil.Scanner) (GitserverReposStatistic, error) {
 	var gs = GitserverReposStatistic{}
-	err := s.Scan(&gs.ShardID, &gs.Total, &gs.NotCloned, &gs.Cloning, &gs.Cloned, &gs.FailedFetch, &gs.Corrupted)
+	err := s.Scan(&gs.ShardID, &gs.Total, &gs.NotCloned, &gs.Cloning, &gs.Cloned, &gs.FailedFetch, &gs.Corrupted, &gs.Tiered)
 	if err != nil {
 		return gs, err
 	}
@@ -256,6 +256,15 @@ func TestRepoStatistics_RecloneAndCorruption(t *testing.T) {
 		{ShardID: "shard-1", Total: 1, Cloned: 1, Corrupted: 0},
 		{ShardID: "shard-2", Total: 1, Cloned: 1, Corrupted: 1},
 	})
+	// Repo 0 is tiered to cold storage
+	setCloneStatus(t, db, repos[0].Name, shards[0], types.CloneStatusTiered)
+	assertRepoStatistics(t, ctx, s, RepoStatistics{
+		Total: 2, Cloned: 1, Tiered: 1, SoftDeleted: 0, Corrupted: 1,
+	}, []GitserverReposStatistic{
+		{ShardID: ""},
+		{ShardID: "shard-1", Total: 1, Tiered: 1, Corrupted: 0},
+		{ShardID: "shard-2", Total: 1, Cloned: 1, Corrupted: 1},
+	})
 }
 
 func TestRepoStatistics_DeleteAndUndelete(t *testing.T) {
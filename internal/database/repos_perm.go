@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 )
 
@@ -20,10 +22,20 @@ type AuthzQueryParameters struct {
 	UsePermissionsUserMapping bool
 	AuthenticatedUserID       int32
 	AuthzEnforceForSiteAdmins bool
+	// AccessTokenRepoIDs restricts the query to these repositories, because the
+	// actor authenticated with an access token that is restricted to them.
+	AccessTokenRepoIDs []api.RepoID
 }
 
 func (p *AuthzQueryParameters) ToAuthzQuery() *sqlf.Query {
-	return authzQuery(p.BypassAuthz, p.AuthenticatedUserID)
+	q := authzQuery(p.BypassAuthz, p.AuthenticatedUserID)
+	if len(p.AccessTokenRepoIDs) > 0 {
+		// 🚨 SECURITY: The restriction applies even if authz is bypassed, a
+		// site admin's token restricted to some repositories must not be able
+		// to access any other repository.
+		q = restrictToReposQuery(q, p.AccessTokenRepoIDs)
+	}
+	return q
 }
 
 func GetAuthzQueryParameters(ctx context.Context, db DB) (params *AuthzQueryParameters, err error) {
@@ -32,6 +44,7 @@ func GetAuthzQueryParameters(ctx context.Context, db DB) (params *AuthzQueryPara
 	params.AuthzEnforceForSiteAdmins = conf.Get().AuthzEnforceForSiteAdmins
 
 	a := actor.FromContext(ctx)
+	params.AccessTokenRepoIDs = a.AccessTokenRepoIDs
 
 	// Authz is bypassed when the request is coming from an internal actor.
 	// Authz can be bypassed by site admins unless conf.AuthEnforceForSiteAdmins
@@ -131,3 +144,11 @@ func authzQuery(bypassAuthz bool, authenticatedUserID int32) *sqlf.Query {
 	// Have to manually wrap the result in parenthesis so that they're evaluated together
 	return sqlf.Sprintf("(%s)", sqlf.Join(conditions, "\nOR\n"))
 }
+
+func restrictToReposQuery(q *sqlf.Query, repoIDs []api.RepoID) *sqlf.Query {
+	ids := make([]int32, 0, len(repoIDs))
+	for _, id := range repoIDs {
+		ids = append(ids, int32(id))
+	}
+	return sqlf.Sprintf("(%s AND repo.id = ANY(%s))", q, pq.Array(ids))
+}
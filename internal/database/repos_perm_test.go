@@ -126,6 +126,14 @@ func TestAuthzQueryConds(t *testing.T) {
 			},
 			wantQuery: authzQuery(false, int32(1)),
 		},
+		{
+			name: "authenticated with an access token restricted to repositories",
+			setup: func(_ *testing.T) (context.Context, DB) {
+				require.NoError(t, db.Users().SetIsSiteAdmin(context.Background(), u.ID, true))
+				return actor.WithActor(context.Background(), &actor.Actor{UID: u.ID, AccessTokenRepoIDs: []api.RepoID{1, 2}}), db
+			},
+			wantQuery: restrictToReposQuery(authzQuery(true, int32(1)), []api.RepoID{1, 2}),
+		},
 	}
 
 	for _, test := range tests {
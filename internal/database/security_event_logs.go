@@ -70,6 +70,9 @@ const (
 	SecurityEventBitbucketCloudAuthSucceeded SecurityEventName = "BitbucketCloudAuthSucceeded"
 	SecurityEventBitbucketCloudAuthFailed    SecurityEventName = "BitbucketCloudAuthFailed"
 
+	SecurityEventGiteaAuthSucceeded SecurityEventName = "GiteaAuthSucceeded"
+	SecurityEventGiteaAuthFailed    SecurityEventName = "GiteaAuthFailed"
+
 	SecurityEventAzureDevOpsAuthSucceeded SecurityEventName = "AzureDevOpsAuthSucceeded"
 	SecurityEventAzureDevOpsAuthFailed    SecurityEventName = "AzureDevOpsAuthFailed"
 
@@ -12,6 +12,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -551,8 +552,17 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		// return errors.Wrap(err, "fetching default reviewers")
 	}
 
-	reviewers := make([]reviewer, 0, len(defaultReviewers))
-	for _, r := range defaultReviewers {
+	// Reviewers set on the pull request are requested in addition to the
+	// default reviewers of the repository.
+	reviewerNames := defaultReviewers
+	for _, r := range pr.Reviewers {
+		if r.User != nil && !slices.Contains(reviewerNames, r.User.Name) {
+			reviewerNames = append(reviewerNames, r.User.Name)
+		}
+	}
+
+	reviewers := make([]reviewer, 0, len(reviewerNames))
+	for _, r := range reviewerNames {
 		reviewers = append(reviewers, reviewer{User: struct {
 			Name string `json:"name"`
 		}{Name: r}})
@@ -0,0 +1,287 @@
+//nolint:bodyclose // Body is closed in Client.do, but the response is still returned to provide access to the headers
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/oauthutil"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// The metric generated here will be named as "src_gitea_requests_total".
+var requestCounter = metrics.NewRequestMeter("gitea", "Total number of requests sent to the Gitea API.")
+
+// apiPath is the path of the Gitea REST API relative to the instance URL.
+// Forgejo serves the same API under the same path.
+const apiPath = "api/v1/"
+
+// defaultPageSize is the number of items requested per page. Gitea caps the
+// page size at the instance's MAX_RESPONSE_ITEMS setting, which defaults to 50.
+const defaultPageSize = 50
+
+// Client accesses a Gitea or Forgejo instance via the REST API.
+type Client interface {
+	GetURL() *url.URL
+	Authenticator() auth.Authenticator
+	WithAuthenticator(a auth.Authenticator) (Client, error)
+
+	GetAuthenticatedUser(ctx context.Context) (*User, error)
+	ListAuthenticatedUserEmails(ctx context.Context) ([]*Email, error)
+
+	GetRepo(ctx context.Context, owner, name string) (*Repo, error)
+	ListAffiliatedRepos(ctx context.Context, page int) (repos []*Repo, hasNextPage bool, err error)
+	ListOrgRepos(ctx context.Context, org string, page int) (repos []*Repo, hasNextPage bool, err error)
+	SearchRepos(ctx context.Context, opts SearchReposOptions, page int) (repos []*Repo, hasNextPage bool, err error)
+	ForkRepo(ctx context.Context, owner, name string, input ForkRepoInput) (*Repo, error)
+
+	GetPullRequest(ctx context.Context, owner, name string, index int64) (*PullRequest, error)
+	GetPullRequestByBranches(ctx context.Context, owner, name, base, head string) (*PullRequest, error)
+	CreatePullRequest(ctx context.Context, owner, name string, input CreatePullRequestInput) (*PullRequest, error)
+	EditPullRequest(ctx context.Context, owner, name string, index int64, input EditPullRequestInput) (*PullRequest, error)
+	MergePullRequest(ctx context.Context, owner, name string, index int64, input MergePullRequestInput) error
+	CancelPullRequestAutoMerge(ctx context.Context, owner, name string, index int64) error
+	ListPullRequestReviews(ctx context.Context, owner, name string, index int64) ([]*PullReview, error)
+	GetCombinedStatus(ctx context.Context, owner, name, ref string) (*CombinedStatus, error)
+	CreateIssueComment(ctx context.Context, owner, name string, index int64, body string) (*Comment, error)
+	EditIssue(ctx context.Context, owner, name string, index int64, input EditIssueInput) error
+	RemoveIssueLabel(ctx context.Context, owner, name string, index, labelID int64) error
+	RequestPullRequestReviewers(ctx context.Context, owner, name string, index int64, input RequestReviewersInput) error
+	RemovePullRequestReviewers(ctx context.Context, owner, name string, index int64, input RequestReviewersInput) error
+	ListRepoLabels(ctx context.Context, owner, name string) ([]*Label, error)
+	ListRepoMilestones(ctx context.Context, owner, name, title string) ([]*Milestone, error)
+}
+
+// client accesses a Gitea or Forgejo instance via the REST API.
+type client struct {
+	// HTTP Client used to communicate with the API.
+	httpClient httpcli.Doer
+
+	// URL is the base URL of the Gitea instance.
+	URL *url.URL
+
+	urn string
+
+	// RateLimit is the self-imposed rate limiter (since Gitea does not have a
+	// concept of rate limiting in HTTP response headers).
+	rateLimit *ratelimit.InstrumentedLimiter
+
+	// Authenticator used to authenticate HTTP requests.
+	auther auth.Authenticator
+}
+
+// NewClient returns an authenticated Gitea API client with the provided
+// configuration. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(urn string, u *url.URL, auther auth.Authenticator, httpClient httpcli.Doer) (Client, error) {
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	httpClient = requestCounter.Doer(httpClient, func(u *url.URL) string {
+		// The third component of the Path (/api/v1/<category>/...) mostly maps
+		// to the type of API request we are making.
+		var category string
+		if parts := strings.SplitN(u.Path, "/", 5); len(parts) > 3 {
+			category = parts[3]
+		}
+		return category
+	})
+
+	return &client{
+		httpClient: httpClient,
+		URL:        extsvc.NormalizeBaseURL(u),
+		urn:        urn,
+		rateLimit:  ratelimit.NewInstrumentedLimiter(urn, ratelimit.NewGlobalRateLimiter(log.Scoped("GiteaClient"), urn)),
+		auther:     auther,
+	}, nil
+}
+
+// WithAuthenticator returns a new Client that uses the same configuration,
+// HTTPClient, and RateLimiter as the current Client, except authenticated with
+// the given authenticator instance.
+//
+// Gitea accepts access tokens both as OAuth bearer tokens and as passwords for
+// basic authentication, so only those authenticators are supported.
+func (c *client) WithAuthenticator(a auth.Authenticator) (Client, error) {
+	switch a.(type) {
+	case *auth.OAuthBearerToken, *auth.OAuthBearerTokenWithSSH, *auth.BasicAuth, *auth.BasicAuthWithSSH:
+		break
+	default:
+		return nil, errors.Errorf("authenticator type unsupported for Gitea clients: %s", a)
+	}
+
+	return &client{
+		httpClient: c.httpClient,
+		URL:        c.URL,
+		urn:        c.urn,
+		rateLimit:  c.rateLimit,
+		auther:     a,
+	}, nil
+}
+
+func (c *client) Authenticator() auth.Authenticator {
+	return c.auther
+}
+
+func (c *client) GetURL() *url.URL {
+	return c.URL
+}
+
+// GetAuthenticatedUser returns the user the client is authenticated as.
+func (c *client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
+	req, err := http.NewRequest("GET", "user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if _, err := c.do(ctx, req, &user); err != nil {
+		if httpErr := (&httpError{}); errors.As(err, &httpErr) && httpErr.Unauthorized() {
+			return nil, errors.New("Invalid access token.")
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// ListAuthenticatedUserEmails returns the email addresses of the currently
+// authenticated user.
+func (c *client) ListAuthenticatedUserEmails(ctx context.Context) ([]*Email, error) {
+	req, err := http.NewRequest("GET", "user/emails", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []*Email
+	if _, err := c.do(ctx, req, &emails); err != nil {
+		return nil, err
+	}
+	return emails, nil
+}
+
+// newJSONRequest returns a request with the given input encoded as JSON body.
+func newJSONRequest(method, path string, input any) (*http.Request, error) {
+	bs, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling request")
+	}
+	return http.NewRequest(method, path, bytes.NewReader(bs))
+}
+
+// pageQuery returns the query parameters selecting the given page.
+func pageQuery(page int) url.Values {
+	if page < 1 {
+		page = 1
+	}
+	return url.Values{
+		"page":  []string{strconv.Itoa(page)},
+		"limit": []string{strconv.Itoa(defaultPageSize)},
+	}
+}
+
+// hasNextPage reports whether there are more results after the given page,
+// based on the X-Total-Count header Gitea sets on paginated responses.
+func hasNextPage(resp *http.Response, page, count int) bool {
+	if page < 1 {
+		page = 1
+	}
+	total, err := strconv.Atoi(resp.Header.Get("X-Total-Count"))
+	if err != nil {
+		// Older versions don't send the header on all endpoints, so we fall
+		// back to checking whether the page was full.
+		return count >= defaultPageSize
+	}
+	return (page-1)*defaultPageSize+count < total
+}
+
+func (c *client) do(ctx context.Context, req *http.Request, result any) (_ *http.Response, err error) {
+	tr, ctx := trace.New(ctx, "Gitea.do")
+	defer tr.EndWithErr(&err)
+
+	req.URL = c.URL.ResolveReference(&url.URL{Path: apiPath}).ResolveReference(req.URL)
+	req.Header.Set("Accept", "application/json")
+	if req.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if err := c.rateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := oauthutil.DoRequest(ctx, log.Scoped("gitea.Client"), c.httpClient, req, c.auther)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, &httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		}
+	}
+
+	if result == nil || len(bs) == 0 {
+		return resp, nil
+	}
+	return resp, json.Unmarshal(bs, result)
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Gitea API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// Conflict reports whether the request conflicted with the state of the
+// resource, such as when a pull request for the same branches already exists.
+func (e *httpError) Conflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+// IsConflict reports whether err is an error caused by a conflict, such as a
+// Gitea API error with status 409.
+func IsConflict(err error) bool {
+	var e interface{ Conflict() bool }
+	return errors.AsInterface(err, &e) && e.Conflict()
+}
+
+// IsNotFound reports whether err is a Gitea API error with status 404.
+func IsNotFound(err error) bool {
+	var e interface{ NotFound() bool }
+	return errors.AsInterface(err, &e) && e.NotFound()
+}
@@ -0,0 +1,122 @@
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestClient_do(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gitea/api/v1/unauthorized":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("Unauthorized"))
+		case "/gitea/api/v1/auth":
+			w.Write([]byte(`{"value":"` + r.Header.Get("Authorization") + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	// Instances can be served from a sub path.
+	u, err := url.Parse(srv.URL + "/gitea")
+	require.NoError(t, err)
+
+	cli, err := NewClient("urn", u, &auth.OAuthBearerToken{Token: "secret"}, httpcli.TestExternalDoer)
+	require.NoError(t, err)
+	c := cli.(*client)
+	c.rateLimit = &ratelimit.InstrumentedLimiter{Limiter: rate.NewLimiter(10, 10)}
+
+	t.Run("authenticates requests", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "auth", nil)
+		require.NoError(t, err)
+
+		var result struct {
+			Value string `json:"value"`
+		}
+		_, err = c.do(context.Background(), req, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer secret", result.Value)
+	})
+
+	t.Run("returns HTTP errors", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "unauthorized", nil)
+		require.NoError(t, err)
+
+		_, err = c.do(context.Background(), req, nil)
+		require.Error(t, err)
+		assert.Equal(t, `Gitea API HTTP error: code=401 url="`+srv.URL+`/gitea/api/v1/unauthorized" body="Unauthorized"`, err.Error())
+	})
+}
+
+func TestClient_WithAuthenticator(t *testing.T) {
+	u, err := url.Parse("https://gitea.sgdev.org")
+	require.NoError(t, err)
+
+	cli, err := NewClient("urn", u, &auth.OAuthBearerToken{Token: "secret"}, nil)
+	require.NoError(t, err)
+
+	for _, a := range []auth.Authenticator{
+		&auth.OAuthBearerToken{Token: "other"},
+		&auth.BasicAuth{Username: "alice", Password: "token"},
+	} {
+		other, err := cli.WithAuthenticator(a)
+		require.NoError(t, err)
+		assert.Equal(t, a, other.Authenticator())
+		assert.Equal(t, cli.GetURL(), other.GetURL())
+	}
+
+	_, err = cli.WithAuthenticator(&auth.OAuthClient{})
+	assert.Error(t, err)
+}
+
+func TestClient_GetAuthenticatedUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		cli, save := NewTestClient(t, "GetAuthenticatedUser", *update)
+		defer save()
+
+		user, err := cli.GetAuthenticatedUser(ctx)
+		require.NoError(t, err)
+		testutil.AssertGolden(t, "testdata/golden/GetAuthenticatedUser.json", *update, user)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		cli, save := NewTestClient(t, "GetAuthenticatedUser-unauthorized", *update)
+		defer save()
+
+		_, err := cli.GetAuthenticatedUser(ctx)
+		require.EqualError(t, err, "Invalid access token.")
+	})
+}
+
+func TestClient_ListAuthenticatedUserEmails(t *testing.T) {
+	cli, save := NewTestClient(t, "ListAuthenticatedUserEmails", *update)
+	defer save()
+
+	emails, err := cli.ListAuthenticatedUserEmails(context.Background())
+	require.NoError(t, err)
+	testutil.AssertGolden(t, "testdata/golden/ListAuthenticatedUserEmails.json", *update, emails)
+}
+
+func TestHTTPError_NotFound(t *testing.T) {
+	err := &httpError{StatusCode: http.StatusNotFound}
+	assert.True(t, errcode.IsNotFound(err))
+	assert.False(t, IsConflict(err))
+	assert.True(t, IsConflict(&httpError{StatusCode: http.StatusConflict}))
+}
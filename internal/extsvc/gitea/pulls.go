@@ -0,0 +1,279 @@
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// wipPrefixes are the title prefixes that mark a pull request as work in
+// progress in the default Gitea configuration.
+var wipPrefixes = []string{"WIP:", "[WIP]"}
+
+// HasWIPPrefix reports whether the given pull request title marks the pull
+// request as work in progress.
+func HasWIPPrefix(title string) bool {
+	upper := strings.ToUpper(title)
+	for _, p := range wipPrefixes {
+		if strings.HasPrefix(upper, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// WIPTitle returns the title with the work in progress prefix added, if it
+// doesn't have one already.
+func WIPTitle(title string) string {
+	if HasWIPPrefix(title) {
+		return title
+	}
+	return wipPrefixes[0] + " " + title
+}
+
+// TrimWIPPrefix returns the title without its work in progress prefix, if it
+// has one.
+func TrimWIPPrefix(title string) string {
+	upper := strings.ToUpper(title)
+	for _, p := range wipPrefixes {
+		if strings.HasPrefix(upper, p) {
+			return strings.TrimLeft(title[len(p):], " ")
+		}
+	}
+	return title
+}
+
+// GetPullRequest returns the pull request with the given index.
+func (c *client) GetPullRequest(ctx context.Context, owner, name string, index int64) (*PullRequest, error) {
+	req, err := http.NewRequest("GET", pullPath(owner, name, index), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// GetPullRequestByBranches returns the open pull request merging head into
+// base.
+func (c *client) GetPullRequestByBranches(ctx context.Context, owner, name, base, head string) (*PullRequest, error) {
+	// The head branch is matched by a wildcard, so it may contain slashes.
+	headPath := (&url.URL{Path: head}).EscapedPath()
+	req, err := http.NewRequest("GET", repoPath(owner, name)+"/pulls/"+url.PathEscape(base)+"/"+headPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// CreatePullRequest opens a new pull request. If a pull request for the same
+// branches already exists, an error for which IsConflict returns true is
+// returned.
+func (c *client) CreatePullRequest(ctx context.Context, owner, name string, input CreatePullRequestInput) (*PullRequest, error) {
+	req, err := newJSONRequest("POST", repoPath(owner, name)+"/pulls", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// EditPullRequest updates the given pull request.
+func (c *client) EditPullRequest(ctx context.Context, owner, name string, index int64, input EditPullRequestInput) (*PullRequest, error) {
+	req, err := newJSONRequest("PATCH", pullPath(owner, name, index), input)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges the given pull request. Gitea doesn't return the
+// merged pull request, so it has to be loaded again by the caller.
+func (c *client) MergePullRequest(ctx context.Context, owner, name string, index int64, input MergePullRequestInput) error {
+	req, err := newJSONRequest("POST", pullPath(owner, name, index)+"/merge", input)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// CancelPullRequestAutoMerge cancels the scheduled merge of the given pull
+// request.
+func (c *client) CancelPullRequestAutoMerge(ctx context.Context, owner, name string, index int64) error {
+	req, err := http.NewRequest("DELETE", pullPath(owner, name, index)+"/merge", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// ListPullRequestReviews returns all reviews of the given pull request.
+func (c *client) ListPullRequestReviews(ctx context.Context, owner, name string, index int64) ([]*PullReview, error) {
+	var all []*PullReview
+	for page := 1; ; page++ {
+		req, err := http.NewRequest("GET", pullPath(owner, name, index)+"/reviews?"+pageQuery(page).Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var reviews []*PullReview
+		resp, err := c.do(ctx, req, &reviews)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, reviews...)
+
+		if !hasNextPage(resp, page, len(reviews)) {
+			return all, nil
+		}
+	}
+}
+
+// GetCombinedStatus returns the combined commit status of the given ref.
+func (c *client) GetCombinedStatus(ctx context.Context, owner, name, ref string) (*CombinedStatus, error) {
+	req, err := http.NewRequest("GET", repoPath(owner, name)+"/commits/"+url.PathEscape(ref)+"/status", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status CombinedStatus
+	if _, err := c.do(ctx, req, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// CreateIssueComment posts a comment on the given issue or pull request.
+func (c *client) CreateIssueComment(ctx context.Context, owner, name string, index int64, body string) (*Comment, error) {
+	req, err := newJSONRequest("POST", issuePath(owner, name, index)+"/comments", struct {
+		Body string `json:"body"`
+	}{Body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if _, err := c.do(ctx, req, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// EditIssue updates the given issue or pull request. Unlike EditPullRequest,
+// it can remove all assignees and the milestone.
+func (c *client) EditIssue(ctx context.Context, owner, name string, index int64, input EditIssueInput) error {
+	req, err := newJSONRequest("PATCH", issuePath(owner, name, index), input)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// RemoveIssueLabel removes the label with the given ID from the given issue or
+// pull request.
+func (c *client) RemoveIssueLabel(ctx context.Context, owner, name string, index, labelID int64) error {
+	req, err := http.NewRequest("DELETE", issuePath(owner, name, index)+"/labels/"+strconv.FormatInt(labelID, 10), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// RequestPullRequestReviewers requests reviews from the given users and teams
+// on the given pull request.
+func (c *client) RequestPullRequestReviewers(ctx context.Context, owner, name string, index int64, input RequestReviewersInput) error {
+	req, err := newJSONRequest("POST", pullPath(owner, name, index)+"/requested_reviewers", input)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// RemovePullRequestReviewers cancels the review requests of the given users
+// and teams on the given pull request.
+func (c *client) RemovePullRequestReviewers(ctx context.Context, owner, name string, index int64, input RequestReviewersInput) error {
+	req, err := newJSONRequest("DELETE", pullPath(owner, name, index)+"/requested_reviewers", input)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// ListRepoLabels returns all labels of the given repository.
+func (c *client) ListRepoLabels(ctx context.Context, owner, name string) ([]*Label, error) {
+	var all []*Label
+	for page := 1; ; page++ {
+		req, err := http.NewRequest("GET", repoPath(owner, name)+"/labels?"+pageQuery(page).Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var labels []*Label
+		resp, err := c.do(ctx, req, &labels)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, labels...)
+
+		if !hasNextPage(resp, page, len(labels)) {
+			return all, nil
+		}
+	}
+}
+
+// ListRepoMilestones returns the open and closed milestones of the given
+// repository with the given title.
+func (c *client) ListRepoMilestones(ctx context.Context, owner, name, title string) ([]*Milestone, error) {
+	q := url.Values{}
+	q.Set("state", "all")
+	q.Set("name", title)
+	req, err := http.NewRequest("GET", repoPath(owner, name)+"/milestones?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var milestones []*Milestone
+	if _, err := c.do(ctx, req, &milestones); err != nil {
+		return nil, err
+	}
+	return milestones, nil
+}
+
+func issuePath(owner, name string, index int64) string {
+	return repoPath(owner, name) + "/issues/" + strconv.FormatInt(index, 10)
+}
+
+func pullPath(owner, name string, index int64) string {
+	return repoPath(owner, name) + "/pulls/" + strconv.FormatInt(index, 10)
+}
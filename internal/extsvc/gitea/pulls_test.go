@@ -0,0 +1,130 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestHasWIPPrefix(t *testing.T) {
+	for title, want := range map[string]bool{
+		"WIP: Add LICENSE":   true,
+		"wip: Add LICENSE":   true,
+		"[WIP] Add LICENSE":  true,
+		"Add LICENSE":        false,
+		"Add LICENSE (WIP:)": false,
+	} {
+		assert.Equal(t, want, HasWIPPrefix(title), title)
+	}
+
+	assert.Equal(t, "WIP: Add LICENSE", WIPTitle("Add LICENSE"))
+	assert.Equal(t, "[WIP] Add LICENSE", WIPTitle("[WIP] Add LICENSE"))
+	assert.Equal(t, "Add LICENSE", TrimWIPPrefix("wip: Add LICENSE"))
+	assert.Equal(t, "Add LICENSE", TrimWIPPrefix("[WIP]Add LICENSE"))
+	assert.Equal(t, "Add LICENSE", TrimWIPPrefix("Add LICENSE"))
+}
+
+func TestClient_GetPullRequest(t *testing.T) {
+	cli, save := NewTestClient(t, "GetPullRequest", *update)
+	defer save()
+
+	pr, err := cli.GetPullRequest(context.Background(), "sourcegraph", "sourcegraph-test", 1)
+	require.NoError(t, err)
+	testutil.AssertGolden(t, "testdata/golden/GetPullRequest.json", *update, pr)
+}
+
+func TestClient_GetPullRequestByBranches(t *testing.T) {
+	cli, save := NewTestClient(t, "GetPullRequestByBranches", *update)
+	defer save()
+
+	pr, err := cli.GetPullRequestByBranches(context.Background(), "sourcegraph", "sourcegraph-test", "main", "batch-changes/test")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pr.Number)
+	assert.Equal(t, "batch-changes/test", pr.Head.Ref)
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		cli, save := NewTestClient(t, "CreatePullRequest", *update)
+		defer save()
+
+		pr, err := cli.CreatePullRequest(ctx, "sourcegraph", "sourcegraph-test", CreatePullRequestInput{
+			Head:  "batch-changes/license",
+			Base:  "main",
+			Title: "WIP: Add LICENSE",
+			Body:  "Adds a license.",
+		})
+		require.NoError(t, err)
+		assert.True(t, pr.IsDraft())
+		testutil.AssertGolden(t, "testdata/golden/CreatePullRequest.json", *update, pr)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		cli, save := NewTestClient(t, "CreatePullRequest-conflict", *update)
+		defer save()
+
+		_, err := cli.CreatePullRequest(ctx, "sourcegraph", "sourcegraph-test", CreatePullRequestInput{
+			Head:  "batch-changes/test",
+			Base:  "main",
+			Title: "Update README",
+		})
+		require.Error(t, err)
+		assert.True(t, IsConflict(err))
+	})
+}
+
+func TestClient_EditPullRequest(t *testing.T) {
+	cli, save := NewTestClient(t, "EditPullRequest", *update)
+	defer save()
+
+	state := PullRequestStateClosed
+	pr, err := cli.EditPullRequest(context.Background(), "sourcegraph", "sourcegraph-test", 2, EditPullRequestInput{State: &state})
+	require.NoError(t, err)
+	assert.Equal(t, PullRequestStateClosed, pr.State)
+	assert.NotNil(t, pr.ClosedAt)
+}
+
+func TestClient_MergePullRequest(t *testing.T) {
+	cli, save := NewTestClient(t, "MergePullRequest", *update)
+	defer save()
+
+	err := cli.MergePullRequest(context.Background(), "sourcegraph", "sourcegraph-test", 1, MergePullRequestInput{
+		Do:                     MergeStyleSquash,
+		DeleteBranchAfterMerge: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestClient_ListPullRequestReviews(t *testing.T) {
+	cli, save := NewTestClient(t, "ListPullRequestReviews", *update)
+	defer save()
+
+	reviews, err := cli.ListPullRequestReviews(context.Background(), "sourcegraph", "sourcegraph-test", 1)
+	require.NoError(t, err)
+	testutil.AssertGolden(t, "testdata/golden/ListPullRequestReviews.json", *update, reviews)
+}
+
+func TestClient_GetCombinedStatus(t *testing.T) {
+	cli, save := NewTestClient(t, "GetCombinedStatus", *update)
+	defer save()
+
+	status, err := cli.GetCombinedStatus(context.Background(), "sourcegraph", "sourcegraph-test", "c5d7a2f0b3e1d4c6a8b9e0f1a2b3c4d5e6f7a8b9")
+	require.NoError(t, err)
+	testutil.AssertGolden(t, "testdata/golden/GetCombinedStatus.json", *update, status)
+}
+
+func TestClient_CreateIssueComment(t *testing.T) {
+	cli, save := NewTestClient(t, "CreateIssueComment", *update)
+	defer save()
+
+	comment, err := cli.CreateIssueComment(context.Background(), "sourcegraph", "sourcegraph-test", 1, "Looks good to me")
+	require.NoError(t, err)
+	assert.Equal(t, int64(301), comment.ID)
+	assert.Equal(t, "Looks good to me", comment.Body)
+}
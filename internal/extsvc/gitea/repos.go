@@ -0,0 +1,105 @@
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// GetRepo returns the repository with the given owner and name.
+func (c *client) GetRepo(ctx context.Context, owner, name string) (*Repo, error) {
+	req, err := http.NewRequest("GET", repoPath(owner, name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repo
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// ListAffiliatedRepos returns a page of the repositories the authenticated user
+// owns or collaborates on.
+func (c *client) ListAffiliatedRepos(ctx context.Context, page int) ([]*Repo, bool, error) {
+	return c.listRepos(ctx, "user/repos", pageQuery(page), page)
+}
+
+// ListOrgRepos returns a page of the repositories of the given organization
+// that are visible to the authenticated user.
+func (c *client) ListOrgRepos(ctx context.Context, org string, page int) ([]*Repo, bool, error) {
+	return c.listRepos(ctx, "orgs/"+url.PathEscape(org)+"/repos", pageQuery(page), page)
+}
+
+func (c *client) listRepos(ctx context.Context, path string, qs url.Values, page int) ([]*Repo, bool, error) {
+	req, err := http.NewRequest("GET", path+"?"+qs.Encode(), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var repos []*Repo
+	resp, err := c.do(ctx, req, &repos)
+	if err != nil {
+		return nil, false, err
+	}
+	return repos, hasNextPage(resp, page, len(repos)), nil
+}
+
+// SearchRepos returns a page of the repositories matching the given options
+// that are visible to the authenticated user.
+func (c *client) SearchRepos(ctx context.Context, opts SearchReposOptions, page int) ([]*Repo, bool, error) {
+	qs := pageQuery(page)
+	if opts.Query != "" {
+		qs.Set("q", opts.Query)
+	}
+	if opts.OnlyPrivate {
+		qs.Set("is_private", "true")
+	}
+
+	req, err := http.NewRequest("GET", "repos/search?"+qs.Encode(), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var result struct {
+		OK   bool    `json:"ok"`
+		Data []*Repo `json:"data"`
+	}
+	resp, err := c.do(ctx, req, &result)
+	if err != nil {
+		return nil, false, err
+	}
+	if !result.OK {
+		return nil, false, errors.New("Gitea repository search failed")
+	}
+	return result.Data, hasNextPage(resp, page, len(result.Data)), nil
+}
+
+// ForkRepo forks the given repository. The fork is created asynchronously, so
+// its contents may not be available right away.
+func (c *client) ForkRepo(ctx context.Context, owner, name string, input ForkRepoInput) (*Repo, error) {
+	req, err := newJSONRequest("POST", repoPath(owner, name)+"/forks", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repo
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// repoPath returns the API path of the given repository.
+func repoPath(owner, name string) string {
+	return "repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+}
+
+// splitFullName splits a "owner/name" repository name into its parts.
+func splitFullName(fullName string) (owner, name string, ok bool) {
+	return strings.Cut(fullName, "/")
+}
@@ -0,0 +1,81 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+)
+
+func TestClient_GetRepo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		cli, save := NewTestClient(t, "GetRepo", *update)
+		defer save()
+
+		repo, err := cli.GetRepo(ctx, "sourcegraph", "sourcegraph-test")
+		require.NoError(t, err)
+		assert.Equal(t, "sourcegraph", repo.Namespace())
+		testutil.AssertGolden(t, "testdata/golden/GetRepo.json", *update, repo)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cli, save := NewTestClient(t, "GetRepo-not-found", *update)
+		defer save()
+
+		_, err := cli.GetRepo(ctx, "sourcegraph", "missing")
+		require.Error(t, err)
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func TestClient_ListAffiliatedRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListAffiliatedRepos", *update)
+	defer save()
+
+	repos, hasNextPage, err := cli.ListAffiliatedRepos(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, hasNextPage)
+	testutil.AssertGolden(t, "testdata/golden/ListAffiliatedRepos.json", *update, repos)
+}
+
+func TestClient_ListOrgRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListOrgRepos", *update)
+	defer save()
+
+	repos, hasNextPage, err := cli.ListOrgRepos(context.Background(), "sourcegraph", 1)
+	require.NoError(t, err)
+	// The organization has more repositories than fit on a single page.
+	assert.True(t, hasNextPage)
+	testutil.AssertGolden(t, "testdata/golden/ListOrgRepos.json", *update, repos)
+}
+
+func TestClient_SearchRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "SearchRepos", *update)
+	defer save()
+
+	repos, hasNextPage, err := cli.SearchRepos(context.Background(), SearchReposOptions{OnlyPrivate: true}, 1)
+	require.NoError(t, err)
+	assert.False(t, hasNextPage)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "sourcegraph/private-test", repos[0].FullName)
+	assert.True(t, repos[0].Private)
+}
+
+func TestClient_ForkRepo(t *testing.T) {
+	cli, save := NewTestClient(t, "ForkRepo", *update)
+	defer save()
+
+	name := "sourcegraph-sourcegraph-test"
+	fork, err := cli.ForkRepo(context.Background(), "sourcegraph", "sourcegraph-test", ForkRepoInput{Name: &name})
+	require.NoError(t, err)
+	assert.True(t, fork.Fork)
+	assert.Equal(t, "alice/sourcegraph-sourcegraph-test", fork.FullName)
+	require.NotNil(t, fork.Parent)
+	assert.Equal(t, int64(10), fork.Parent.ID)
+}
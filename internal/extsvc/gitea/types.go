@@ -0,0 +1,271 @@
+package gitea
+
+import "time"
+
+// User is a Gitea user or organization.
+type User struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+	IsAdmin   bool   `json:"is_admin"`
+}
+
+// Email is an email address of a Gitea user.
+type Email struct {
+	Email    string `json:"email"`
+	Verified bool   `json:"verified"`
+	Primary  bool   `json:"primary"`
+}
+
+// Repo is a Gitea repository.
+type Repo struct {
+	ID            int64            `json:"id"`
+	Owner         *User            `json:"owner"`
+	Name          string           `json:"name"`
+	FullName      string           `json:"full_name"`
+	Description   string           `json:"description"`
+	Empty         bool             `json:"empty"`
+	Private       bool             `json:"private"`
+	Internal      bool             `json:"internal"`
+	Fork          bool             `json:"fork"`
+	Mirror        bool             `json:"mirror"`
+	Archived      bool             `json:"archived"`
+	Parent        *Repo            `json:"parent,omitempty"`
+	HTMLURL       string           `json:"html_url"`
+	SSHURL        string           `json:"ssh_url"`
+	CloneURL      string           `json:"clone_url"`
+	DefaultBranch string           `json:"default_branch"`
+	StarsCount    int              `json:"stars_count"`
+	ForksCount    int              `json:"forks_count"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+	Permissions   *RepoPermissions `json:"permissions,omitempty"`
+}
+
+// Namespace returns the owner of the repository.
+func (r *Repo) Namespace() string {
+	if r.Owner != nil {
+		return r.Owner.Login
+	}
+	owner, _, _ := splitFullName(r.FullName)
+	return owner
+}
+
+// RepoPermissions are the permissions of the authenticated user on a
+// repository.
+type RepoPermissions struct {
+	Admin bool `json:"admin"`
+	Push  bool `json:"push"`
+	Pull  bool `json:"pull"`
+}
+
+// SearchReposOptions are the options for SearchRepos.
+type SearchReposOptions struct {
+	// Query is the keyword to search for. If empty, all repositories visible
+	// to the authenticated user are returned.
+	Query string
+	// OnlyPrivate limits the results to private repositories.
+	OnlyPrivate bool
+}
+
+// ForkRepoInput is the input for forking a repository.
+type ForkRepoInput struct {
+	// Organization is the organization to fork into. If empty, the fork is
+	// created in the namespace of the authenticated user.
+	Organization *string `json:"organization,omitempty"`
+	// Name is the name of the fork. If empty, the name of the forked
+	// repository is used.
+	Name *string `json:"name,omitempty"`
+}
+
+// PullRequest states.
+const (
+	PullRequestStateOpen   = "open"
+	PullRequestStateClosed = "closed"
+)
+
+// PullRequest is a Gitea pull request.
+type PullRequest struct {
+	ID                 int64         `json:"id"`
+	Number             int64         `json:"number"`
+	User               *User         `json:"user"`
+	Title              string        `json:"title"`
+	Body               string        `json:"body"`
+	Labels             []*Label      `json:"labels"`
+	State              string        `json:"state"`
+	HTMLURL            string        `json:"html_url"`
+	Mergeable          bool          `json:"mergeable"`
+	HasMerged          bool          `json:"merged"`
+	MergedAt           *time.Time    `json:"merged_at"`
+	MergedCommitID     *string       `json:"merge_commit_sha"`
+	MergedBy           *User         `json:"merged_by"`
+	Base               *PRBranchInfo `json:"base"`
+	Head               *PRBranchInfo `json:"head"`
+	MergeBase          string        `json:"merge_base"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+	ClosedAt           *time.Time    `json:"closed_at"`
+	RequestedReviewers []*User       `json:"requested_reviewers"`
+	Assignees          []*User       `json:"assignees"`
+	Milestone          *Milestone    `json:"milestone"`
+}
+
+// IsDraft reports whether the pull request is marked as work in progress.
+// Gitea has no draft pull requests, but treats pull requests with a title
+// starting with one of the configured WIP prefixes as work in progress.
+func (pr *PullRequest) IsDraft() bool {
+	return HasWIPPrefix(pr.Title)
+}
+
+// PRBranchInfo is the base or head branch of a pull request.
+type PRBranchInfo struct {
+	Name       string `json:"label"`
+	Ref        string `json:"ref"`
+	Sha        string `json:"sha"`
+	RepoID     int64  `json:"repo_id"`
+	Repository *Repo  `json:"repo"`
+}
+
+// Label is a label of an issue or pull request.
+type Label struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// Milestone is a milestone of a repository.
+type Milestone struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	State string `json:"state"`
+}
+
+// CreatePullRequestInput is the input for creating a pull request.
+type CreatePullRequestInput struct {
+	// Head is the branch to merge. Branches in forks are given as
+	// "owner:branch".
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+
+	Assignees []string `json:"assignees,omitempty"`
+	Labels    []int64  `json:"labels,omitempty"`
+	Milestone int64    `json:"milestone,omitempty"`
+}
+
+// EditPullRequestInput is the input for editing a pull request. Fields that
+// are nil are left unchanged.
+type EditPullRequestInput struct {
+	Title *string `json:"title,omitempty"`
+	Body  *string `json:"body,omitempty"`
+	Base  *string `json:"base,omitempty"`
+	State *string `json:"state,omitempty"`
+
+	// Assignees and Labels replace the assignees and labels of the pull
+	// request when non-nil.
+	Assignees []string `json:"assignees,omitempty"`
+	Labels    []int64  `json:"labels,omitempty"`
+	Milestone *int64   `json:"milestone,omitempty"`
+}
+
+// EditIssueInput is the input for editing an issue or pull request via the
+// issues API. An empty, non-nil Assignees removes all assignees and a
+// Milestone of 0 removes the milestone.
+type EditIssueInput struct {
+	Assignees *[]string `json:"assignees,omitempty"`
+	Milestone *int64    `json:"milestone,omitempty"`
+}
+
+// RequestReviewersInput is the input for requesting reviews on a pull
+// request.
+type RequestReviewersInput struct {
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+// MergeStyle is the strategy used to merge a pull request.
+type MergeStyle string
+
+const (
+	MergeStyleMerge  MergeStyle = "merge"
+	MergeStyleSquash MergeStyle = "squash"
+)
+
+// MergePullRequestInput is the input for merging a pull request.
+type MergePullRequestInput struct {
+	Do                     MergeStyle `json:"Do"`
+	DeleteBranchAfterMerge bool       `json:"delete_branch_after_merge,omitempty"`
+	// MergeWhenChecksSucceed schedules the merge for when all required
+	// status checks have passed instead of merging right away.
+	MergeWhenChecksSucceed bool `json:"merge_when_checks_succeed,omitempty"`
+}
+
+// ReviewState is the state of a pull request review.
+type ReviewState string
+
+const (
+	ReviewStateApproved       ReviewState = "APPROVED"
+	ReviewStatePending        ReviewState = "PENDING"
+	ReviewStateComment        ReviewState = "COMMENT"
+	ReviewStateRequestChanges ReviewState = "REQUEST_CHANGES"
+	ReviewStateRequestReview  ReviewState = "REQUEST_REVIEW"
+)
+
+// PullReview is a review of a pull request.
+type PullReview struct {
+	ID          int64       `json:"id"`
+	User        *User       `json:"user"`
+	Body        string      `json:"body"`
+	CommitID    string      `json:"commit_id"`
+	State       ReviewState `json:"state"`
+	Stale       bool        `json:"stale"`
+	Official    bool        `json:"official"`
+	Dismissed   bool        `json:"dismissed"`
+	SubmittedAt time.Time   `json:"submitted_at"`
+	HTMLURL     string      `json:"html_url"`
+}
+
+// CommitStatusState is the state of a commit status.
+type CommitStatusState string
+
+const (
+	CommitStatusPending CommitStatusState = "pending"
+	CommitStatusSuccess CommitStatusState = "success"
+	CommitStatusError   CommitStatusState = "error"
+	CommitStatusFailure CommitStatusState = "failure"
+	CommitStatusWarning CommitStatusState = "warning"
+)
+
+// CommitStatus is a status reported for a commit, usually by CI.
+type CommitStatus struct {
+	ID          int64             `json:"id"`
+	State       CommitStatusState `json:"status"`
+	TargetURL   string            `json:"target_url"`
+	Description string            `json:"description"`
+	Context     string            `json:"context"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// CombinedStatus is the combined status of a commit, with the latest status
+// of every context.
+type CombinedStatus struct {
+	State      CommitStatusState `json:"state"`
+	SHA        string            `json:"sha"`
+	TotalCount int               `json:"total_count"`
+	Statuses   []*CommitStatus   `json:"statuses"`
+}
+
+// Comment is a comment on an issue or pull request.
+type Comment struct {
+	ID        int64     `json:"id"`
+	HTMLURL   string    `json:"html_url"`
+	User      *User     `json:"user"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
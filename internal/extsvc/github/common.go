@@ -1125,6 +1125,56 @@ func (c *V4Client) MergePullRequest(ctx context.Context, pr *PullRequest, squash
 	return nil
 }
 
+const enablePullRequestAutoMergeMutation = `
+mutation EnablePullRequestAutoMerge($input: EnablePullRequestAutoMergeInput!) {
+  enablePullRequestAutoMerge(input: $input) {
+    clientMutationId
+  }
+}
+`
+
+// EnablePullRequestAutoMerge enables auto-merge for the PullRequest on GitHub,
+// so that it is merged once all of its requirements are met.
+func (c *V4Client) EnablePullRequestAutoMerge(ctx context.Context, pr *PullRequest) error {
+	var result struct {
+		EnablePullRequestAutoMerge struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+
+	input := map[string]any{"input": struct {
+		PullRequestID string `json:"pullRequestId"`
+	}{
+		PullRequestID: pr.ID,
+	}}
+	return c.requestGraphQL(ctx, enablePullRequestAutoMergeMutation, input, &result)
+}
+
+const disablePullRequestAutoMergeMutation = `
+mutation DisablePullRequestAutoMerge($input: DisablePullRequestAutoMergeInput!) {
+  disablePullRequestAutoMerge(input: $input) {
+    clientMutationId
+  }
+}
+`
+
+// DisablePullRequestAutoMerge disables auto-merge for the PullRequest on
+// GitHub.
+func (c *V4Client) DisablePullRequestAutoMerge(ctx context.Context, pr *PullRequest) error {
+	var result struct {
+		DisablePullRequestAutoMerge struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"disablePullRequestAutoMerge"`
+	}
+
+	input := map[string]any{"input": struct {
+		PullRequestID string `json:"pullRequestId"`
+	}{
+		PullRequestID: pr.ID,
+	}}
+	return c.requestGraphQL(ctx, disablePullRequestAutoMergeMutation, input, &result)
+}
+
 func (c *V4Client) loadRemainingTimelineItems(ctx context.Context, prID string, pageInfo PageInfo) (items []TimelineItem, err error) {
 	version := c.determineGitHubVersion(ctx)
 	timelineItemTypes, err := timelineItemTypes(version)
@@ -1910,6 +1960,11 @@ type Repository struct {
 	// empirically it's in kibibytes (meaning: multiples of 1024 bytes, not
 	// 1000).
 	DiskUsageKibibytes int `json:"DiskUsage,omitempty"`
+
+	// Parent is the repository this repository was forked from. The REST
+	// endpoints that list many repositories don't return it, so it can be nil
+	// even for forks.
+	Parent *ParentRepository `json:",omitempty"`
 }
 
 // PublicRepository is a reduced set of fields from a GitHub repository
@@ -1973,6 +2028,13 @@ type restRepository struct {
 	// docs, in kilobytes, but empirically it's in kibibytes (meaning:
 	// multiples of 1024 bytes, not 1000).
 	DiskUsageKibibytes int `json:"size"`
+	// Parent is only returned by the endpoints that return a single repository.
+	Parent *restParentRepository `json:"parent"`
+}
+
+type restParentRepository struct {
+	FullName string `json:"full_name"`
+	Fork     bool   `json:"fork"`
 }
 
 // restPublicRepository is a reduced set of fields from a GitHub repository
@@ -2031,6 +2093,13 @@ func convertRestRepo(restRepo restRepository) *Repository {
 		DiskUsageKibibytes: restRepo.DiskUsageKibibytes,
 	}
 
+	if restRepo.Parent != nil {
+		repo.Parent = &ParentRepository{
+			NameWithOwner: restRepo.Parent.FullName,
+			IsFork:        restRepo.Parent.Fork,
+		}
+	}
+
 	return &repo
 }
 
@@ -204,6 +204,25 @@ func (c *V3Client) delete(ctx context.Context, requestURI string) (*httpResponse
 	return c.request(ctx, req, struct{}{})
 }
 
+func (c *V3Client) deleteWithPayload(ctx context.Context, requestURI string, payload, result any) (*httpResponseState, error) {
+	var body []byte
+	if payload != nil {
+		var err error
+		if body, err = json.Marshal(payload); err != nil {
+			return nil, errors.Wrap(err, "marshalling payload")
+		}
+	}
+
+	req, err := http.NewRequest("DELETE", requestURI, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+
+	return c.request(ctx, req, result)
+}
+
 func (c *V3Client) request(ctx context.Context, req *http.Request, result any) (*httpResponseState, error) {
 	// Include node_id (GraphQL ID) in response. See
 	// https://developer.github.com/changes/2017-12-19-graphql-node-id/.
@@ -901,6 +920,172 @@ func (c *V3Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return &updatedRef, nil
 }
 
+// AddLabelsToIssue adds the labels with the given names to the issue or pull
+// request with the given number. Labels that don't exist yet are created.
+func (c *V3Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/labels", owner, repo, number)
+	_, err := c.post(ctx, path, struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels}, &[]Label{})
+	return err
+}
+
+// AddAssigneesToIssue assigns the users with the given logins to the issue or
+// pull request with the given number.
+func (c *V3Client) AddAssigneesToIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, number)
+	_, err := c.post(ctx, path, struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees}, &struct{}{})
+	return err
+}
+
+// RequestPullRequestReviewers requests a review of the pull request with the
+// given number from the users with the given logins and the teams with the
+// given slugs.
+func (c *V3Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
+	_, err := c.post(ctx, path, struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers}, &struct{}{})
+	return err
+}
+
+// Milestone is a milestone of a GitHub repository.
+type Milestone struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+}
+
+// ListMilestones lists the open milestones of the repository.
+//
+// The page is the page of results to return, and is 1-indexed (so the first call should
+// be for page 1).
+func (c *V3Client) ListMilestones(ctx context.Context, owner, repo string, page int) (milestones []*Milestone, hasNextPage bool, _ error) {
+	path := fmt.Sprintf("repos/%s/%s/milestones?state=open&page=%d&per_page=100", owner, repo, page)
+	respState, err := c.get(ctx, path, &milestones)
+	if err != nil {
+		return nil, false, err
+	}
+	return milestones, respState.hasNextPage(), nil
+}
+
+// SetIssueMilestone sets the milestone of the issue or pull request with the
+// given number.
+func (c *V3Client) SetIssueMilestone(ctx context.Context, owner, repo string, number int64, milestone int) error {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number)
+	_, err := c.patch(ctx, path, struct {
+		Milestone int `json:"milestone"`
+	}{Milestone: milestone}, &struct{}{})
+	return err
+}
+
+// ClearIssueMilestone removes the milestone from the issue or pull request
+// with the given number.
+func (c *V3Client) ClearIssueMilestone(ctx context.Context, owner, repo string, number int64) error {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number)
+	_, err := c.patch(ctx, path, struct {
+		Milestone *int `json:"milestone"`
+	}{}, &struct{}{})
+	return err
+}
+
+// RemoveLabelFromIssue removes the label with the given name from the issue or
+// pull request with the given number.
+func (c *V3Client) RemoveLabelFromIssue(ctx context.Context, owner, repo string, number int64, label string) error {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/labels/%s", owner, repo, number, url.PathEscape(label))
+	_, err := c.deleteWithPayload(ctx, path, nil, &[]Label{})
+	return err
+}
+
+// RemoveAssigneesFromIssue unassigns the users with the given logins from the
+// issue or pull request with the given number.
+func (c *V3Client) RemoveAssigneesFromIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, number)
+	_, err := c.deleteWithPayload(ctx, path, struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees}, &struct{}{})
+	return err
+}
+
+// RemovePullRequestReviewers removes the review requests of the pull request
+// with the given number from the users with the given logins and the teams
+// with the given slugs.
+func (c *V3Client) RemovePullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)
+	_, err := c.deleteWithPayload(ctx, path, struct {
+		Reviewers     []string `json:"reviewers"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers}, &struct{}{})
+	return err
+}
+
+// PullRequestMetadata is the metadata of a pull request that can be set by
+// changeset specs, as returned by the REST API.
+type PullRequestMetadata struct {
+	Labels             []struct{ Name string }  `json:"labels"`
+	Assignees          []struct{ Login string } `json:"assignees"`
+	RequestedReviewers []struct{ Login string } `json:"requested_reviewers"`
+	RequestedTeams     []struct{ Slug string }  `json:"requested_teams"`
+	Milestone          *Milestone               `json:"milestone"`
+	AutoMerge          *struct{}                `json:"auto_merge"`
+}
+
+// HasLabel returns true if the pull request has the label with the given name.
+func (m *PullRequestMetadata) HasLabel(name string) bool {
+	for _, l := range m.Labels {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAssignee returns true if the user with the given login is assigned to
+// the pull request.
+func (m *PullRequestMetadata) HasAssignee(login string) bool {
+	for _, a := range m.Assignees {
+		if strings.EqualFold(a.Login, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRequestedReviewer returns true if a review of the pull request is
+// requested from the user with the given login.
+func (m *PullRequestMetadata) HasRequestedReviewer(login string) bool {
+	for _, r := range m.RequestedReviewers {
+		if strings.EqualFold(r.Login, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRequestedTeam returns true if a review of the pull request is requested
+// from the team with the given slug.
+func (m *PullRequestMetadata) HasRequestedTeam(slug string) bool {
+	for _, t := range m.RequestedTeams {
+		if strings.EqualFold(t.Slug, slug) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPullRequestMetadata returns the labels, assignees, requested reviewers,
+// milestone and auto-merge state of the pull request with the given number.
+func (c *V3Client) GetPullRequestMetadata(ctx context.Context, owner, repo string, number int64) (*PullRequestMetadata, error) {
+	var meta PullRequestMetadata
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d", owner, repo, number)
+	if _, err := c.get(ctx, path, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
 // GetAppInstallation gets information of a GitHub App installation.
 //
 // API docs: https://docs.github.com/en/rest/reference/apps#get-an-installation-for-the-authenticated-app
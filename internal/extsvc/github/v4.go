@@ -572,7 +572,7 @@ func (c *V4Client) buildGetReposBatchQuery(ctx context.Context, namesWithOwners
 			return "", err
 		}
 		fmt.Fprintf(&b, "repo%d: repository(owner: %q, name: %q) { ", i, owner, name)
-		b.WriteString("... on Repository { ...RepositoryFields parent { nameWithOwner, isFork } } }\n")
+		b.WriteString("... on Repository { ...RepositoryFields } }\n")
 	}
 
 	b.WriteString("}")
@@ -601,6 +601,10 @@ fragment RepositoryFields on Repository {
 	visibility
 	forkCount
 	diskUsage
+	parent {
+		nameWithOwner
+		isFork
+	}
 	repositoryTopics(first:100) {
 		nodes {
 			topic {
@@ -639,6 +643,10 @@ fragment RepositoryFields on Repository {
 	isDisabled
 	forkCount
 	diskUsage
+	parent {
+		nameWithOwner
+		isFork
+	}
 	repositoryTopics(first:100) {
 		nodes {
 			topic {
@@ -703,6 +711,85 @@ func (c *V4Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).UpdateRef(ctx, owner, repo, ref, commit)
 }
 
+// AddLabelsToIssue adds the labels with the given names to the issue or pull
+// request with the given number. Labels that don't exist yet are created.
+func (c *V4Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	logger := c.log.Scoped("AddLabelsToIssue")
+	// The GraphQL API requires label IDs and doesn't create missing labels, so we
+	// use the REST API, which accepts label names.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddLabelsToIssue(ctx, owner, repo, number, labels)
+}
+
+// AddAssigneesToIssue assigns the users with the given logins to the issue or
+// pull request with the given number.
+func (c *V4Client) AddAssigneesToIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	logger := c.log.Scoped("AddAssigneesToIssue")
+	// The GraphQL API requires user node IDs, so we use the REST API, which
+	// accepts logins.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddAssigneesToIssue(ctx, owner, repo, number, assignees)
+}
+
+// RequestPullRequestReviewers requests a review of the pull request with the
+// given number from the users with the given logins and the teams with the
+// given slugs.
+func (c *V4Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	logger := c.log.Scoped("RequestPullRequestReviewers")
+	// The GraphQL API requires user and team node IDs, so we use the REST API,
+	// which accepts logins and slugs.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RequestPullRequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+}
+
+// ListMilestones lists the open milestones of the repository.
+func (c *V4Client) ListMilestones(ctx context.Context, owner, repo string, page int) ([]*Milestone, bool, error) {
+	logger := c.log.Scoped("ListMilestones")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).ListMilestones(ctx, owner, repo, page)
+}
+
+// SetIssueMilestone sets the milestone of the issue or pull request with the
+// given number.
+func (c *V4Client) SetIssueMilestone(ctx context.Context, owner, repo string, number int64, milestone int) error {
+	logger := c.log.Scoped("SetIssueMilestone")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).SetIssueMilestone(ctx, owner, repo, number, milestone)
+}
+
+// ClearIssueMilestone removes the milestone from the issue or pull request
+// with the given number.
+func (c *V4Client) ClearIssueMilestone(ctx context.Context, owner, repo string, number int64) error {
+	logger := c.log.Scoped("ClearIssueMilestone")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).ClearIssueMilestone(ctx, owner, repo, number)
+}
+
+// RemoveLabelFromIssue removes the label with the given name from the issue or
+// pull request with the given number.
+func (c *V4Client) RemoveLabelFromIssue(ctx context.Context, owner, repo string, number int64, label string) error {
+	logger := c.log.Scoped("RemoveLabelFromIssue")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RemoveLabelFromIssue(ctx, owner, repo, number, label)
+}
+
+// RemoveAssigneesFromIssue unassigns the users with the given logins from the
+// issue or pull request with the given number.
+func (c *V4Client) RemoveAssigneesFromIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	logger := c.log.Scoped("RemoveAssigneesFromIssue")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RemoveAssigneesFromIssue(ctx, owner, repo, number, assignees)
+}
+
+// RemovePullRequestReviewers removes the review requests of the pull request
+// with the given number from the users with the given logins and the teams
+// with the given slugs.
+func (c *V4Client) RemovePullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	logger := c.log.Scoped("RemovePullRequestReviewers")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RemovePullRequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+}
+
+// GetPullRequestMetadata returns the labels, assignees, requested reviewers,
+// milestone and auto-merge state of the pull request with the given number.
+func (c *V4Client) GetPullRequestMetadata(ctx context.Context, owner, repo string, number int64) (*PullRequestMetadata, error) {
+	logger := c.log.Scoped("GetPullRequestMetadata")
+	// The GraphQL fragment we load pull requests with doesn't include these
+	// fields, so we use the REST API.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).GetPullRequestMetadata(ctx, owner, repo, number)
+}
+
 type RecentCommittersParams struct {
 	// Repository name
 	Name string
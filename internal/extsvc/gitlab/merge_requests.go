@@ -27,32 +27,37 @@ const (
 )
 
 type MergeRequest struct {
-	ID                      ID `json:"id"`
-	IID                     ID `json:"iid"`
-	ProjectID               ID `json:"project_id"`
-	SourceProjectID         ID `json:"source_project_id"`
-	SourceProjectNamespace  string
-	SourceProjectName       string
-	Title                   string            `json:"title"`
-	Description             string            `json:"description"`
-	State                   MergeRequestState `json:"state"`
-	CreatedAt               Time              `json:"created_at"`
-	UpdatedAt               Time              `json:"updated_at"`
-	MergedAt                *Time             `json:"merged_at"`
-	ClosedAt                *Time             `json:"closed_at"`
-	HeadPipeline            *Pipeline         `json:"head_pipeline"`
-	Labels                  []string          `json:"labels"`
-	SourceBranch            string            `json:"source_branch"`
-	TargetBranch            string            `json:"target_branch"`
-	WebURL                  string            `json:"web_url"`
-	WorkInProgress          bool              `json:"work_in_progress"`
-	Draft                   bool              `json:"draft"`
-	ForceRemoveSourceBranch bool              `json:"force_remove_source_branch"`
+	ID                        ID `json:"id"`
+	IID                       ID `json:"iid"`
+	ProjectID                 ID `json:"project_id"`
+	SourceProjectID           ID `json:"source_project_id"`
+	SourceProjectNamespace    string
+	SourceProjectName         string
+	Title                     string            `json:"title"`
+	Description               string            `json:"description"`
+	State                     MergeRequestState `json:"state"`
+	CreatedAt                 Time              `json:"created_at"`
+	UpdatedAt                 Time              `json:"updated_at"`
+	MergedAt                  *Time             `json:"merged_at"`
+	ClosedAt                  *Time             `json:"closed_at"`
+	HeadPipeline              *Pipeline         `json:"head_pipeline"`
+	Labels                    []string          `json:"labels"`
+	SourceBranch              string            `json:"source_branch"`
+	TargetBranch              string            `json:"target_branch"`
+	WebURL                    string            `json:"web_url"`
+	WorkInProgress            bool              `json:"work_in_progress"`
+	Draft                     bool              `json:"draft"`
+	ForceRemoveSourceBranch   bool              `json:"force_remove_source_branch"`
+	MergeWhenPipelineSucceeds bool              `json:"merge_when_pipeline_succeeds"`
 	// We only get a partial User object back from the REST API. For example, it lacks
 	// `Email` and `Identities`. If we need more, we need to issue an additional API
 	// request. Otherwise, we should use a different type here.
 	Author User `json:"author"`
 
+	Assignees []User     `json:"assignees"`
+	Reviewers []User     `json:"reviewers"`
+	Milestone *Milestone `json:"milestone,omitempty"`
+
 	DiffRefs DiffRefs `json:"diff_refs"`
 
 	// The fields below are computed from other REST API requests when getting a
@@ -114,6 +119,11 @@ type CreateMergeRequestOpts struct {
 	Title              string `json:"title"`
 	Description        string `json:"description,omitempty"`
 	RemoveSourceBranch bool   `json:"remove_source_branch,omitempty"`
+	// Labels is a comma-separated list of label names.
+	Labels      string  `json:"labels,omitempty"`
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
+	MilestoneID ID      `json:"milestone_id,omitempty"`
 	// TODO: other fields at
 	// https://docs.gitlab.com/ee/api/merge_requests.html#create-mr as needed.
 }
@@ -223,6 +233,19 @@ type UpdateMergeRequestOpts struct {
 	Description        string                       `json:"description,omitempty"`
 	StateEvent         UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
 	RemoveSourceBranch bool                         `json:"remove_source_branch,omitempty"`
+	// AddLabels is a comma-separated list of label names to add to the labels
+	// the merge request already has.
+	AddLabels string `json:"add_labels,omitempty"`
+	// RemoveLabels is a comma-separated list of label names to remove from the
+	// merge request.
+	RemoveLabels string `json:"remove_labels,omitempty"`
+	// AssigneeIDs and ReviewerIDs replace the assignees and reviewers of the
+	// merge request. A single ID of 0 removes all of them.
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
+	// MilestoneID sets the milestone of the merge request. A pointer to 0
+	// removes the milestone.
+	MilestoneID *ID `json:"milestone_id,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -306,6 +329,59 @@ func (c *Client) MergeMergeRequest(ctx context.Context, project *Project, mr *Me
 	return resp, nil
 }
 
+// EnableMergeRequestAutoMerge sets the merge request to be merged once its
+// pipeline succeeds.
+func (c *Client) EnableMergeRequestAutoMerge(ctx context.Context, project *Project, mr *MergeRequest) (*MergeRequest, error) {
+	if MockEnableMergeRequestAutoMerge != nil {
+		return MockEnableMergeRequestAutoMerge(c, ctx, project, mr)
+	}
+
+	data, err := json.Marshal(struct {
+		MergeWhenPipelineSucceeds bool `json:"merge_when_pipeline_succeeds"`
+	}{
+		MergeWhenPipelineSucceeds: true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling options")
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("projects/%d/merge_requests/%d/merge", project.ID, mr.IID), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to enable auto-merge on a merge request")
+	}
+
+	resp := &MergeRequest{}
+	if _, _, err := c.do(ctx, req, resp); err != nil {
+		var e HTTPError
+		if errors.As(err, &e) && e.Code() == http.StatusMethodNotAllowed {
+			return nil, errors.Wrap(ErrNotMergeable, err.Error())
+		}
+		return nil, errors.Wrap(err, "sending request to enable auto-merge on a merge request")
+	}
+
+	return resp, nil
+}
+
+// CancelMergeRequestAutoMerge cancels merging the merge request once its
+// pipeline succeeds.
+func (c *Client) CancelMergeRequestAutoMerge(ctx context.Context, project *Project, mr *MergeRequest) (*MergeRequest, error) {
+	if MockCancelMergeRequestAutoMerge != nil {
+		return MockCancelMergeRequestAutoMerge(c, ctx, project, mr)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("projects/%d/merge_requests/%d/cancel_merge_when_pipeline_succeeds", project.ID, mr.IID), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to cancel auto-merge of a merge request")
+	}
+
+	resp := &MergeRequest{}
+	if _, _, err := c.do(ctx, req, resp); err != nil {
+		return nil, errors.Wrap(err, "sending request to cancel auto-merge of a merge request")
+	}
+
+	return resp, nil
+}
+
 func (c *Client) CreateMergeRequestNote(ctx context.Context, project *Project, mr *MergeRequest, body string) error {
 	if MockCreateMergeRequestNote != nil {
 		return MockCreateMergeRequestNote(c, ctx, project, mr, body)
@@ -0,0 +1,37 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type Milestone struct {
+	ID    ID     `json:"id"`
+	IID   ID     `json:"iid"`
+	Title string `json:"title"`
+	State string `json:"state"`
+}
+
+// ListProjectMilestones returns the milestones of the project with the given
+// title.
+func (c *Client) ListProjectMilestones(ctx context.Context, project *Project, title string) ([]*Milestone, error) {
+	if MockListProjectMilestones != nil {
+		return MockListProjectMilestones(c, ctx, project, title)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/milestones?title=%s", project.ID, url.QueryEscape(title)), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to list milestones")
+	}
+
+	var milestones []*Milestone
+	if _, _, err := c.do(ctx, req, &milestones); err != nil {
+		return nil, errors.Wrap(err, "sending request to list milestones")
+	}
+
+	return milestones, nil
+}
@@ -49,6 +49,18 @@ var MockUpdateMergeRequest func(c *Client, ctx context.Context, project *Project
 // Client.MergeMergeRequest
 var MockMergeMergeRequest func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, squash bool) (*MergeRequest, error)
 
+// MockEnableMergeRequestAutoMerge, if non-nil, will be called instead of
+// Client.EnableMergeRequestAutoMerge
+var MockEnableMergeRequestAutoMerge func(c *Client, ctx context.Context, project *Project, mr *MergeRequest) (*MergeRequest, error)
+
+// MockCancelMergeRequestAutoMerge, if non-nil, will be called instead of
+// Client.CancelMergeRequestAutoMerge
+var MockCancelMergeRequestAutoMerge func(c *Client, ctx context.Context, project *Project, mr *MergeRequest) (*MergeRequest, error)
+
+// MockListProjectMilestones, if non-nil, will be called instead of
+// Client.ListProjectMilestones
+var MockListProjectMilestones func(c *Client, ctx context.Context, project *Project, title string) ([]*Milestone, error)
+
 // MockCreateMergeRequestNote, if non-nil, will be called instead of
 // Client.CreateMergeRequestNote
 var MockCreateMergeRequestNote func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, body string) error
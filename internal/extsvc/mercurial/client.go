@@ -0,0 +1,171 @@
+package mercurial
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// Repo is the metadata of a Mercurial repository served by hgweb.
+type Repo struct {
+	// Name is the path of the repository relative to the URL of the hgweb server.
+	Name string `json:"name"`
+
+	// Description is the description configured in the repository's hgrc.
+	Description string `json:"description,omitempty"`
+
+	// Contact is the contact configured in the repository's hgrc.
+	Contact string `json:"contact,omitempty"`
+
+	// URL is the URL the repository is served at. It does not contain
+	// credentials.
+	URL string `json:"url"`
+}
+
+// Client talks to an hgweb server.
+type Client struct {
+	// URL is the base URL of the hgweb server.
+	URL *url.URL
+
+	username   string
+	password   string
+	httpClient httpcli.Doer
+}
+
+// NewClient returns a client for the hgweb server configured in config. If a
+// nil httpClient is provided, httpcli.ExternalDoer will be used.
+func NewClient(config *schema.MercurialConnection, httpClient httpcli.Doer) (*Client, error) {
+	u, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, err
+	}
+	// Relative repository paths are resolved against the base URL, which
+	// only works as expected if it ends in a slash.
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	return &Client{
+		URL:        u,
+		username:   config.Username,
+		password:   config.Password,
+		httpClient: httpClient,
+	}, nil
+}
+
+// RepoURL returns the URL of the repository at the given path relative to the
+// URL of the hgweb server.
+func (c *Client) RepoURL(name string) string {
+	return c.URL.ResolveReference(&url.URL{Path: strings.Trim(name, "/")}).String()
+}
+
+// indexEntry is an entry of the index page of hgweb when rendered with the
+// json style.
+type indexEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Contact     string `json:"contact"`
+}
+
+// ListRepos returns all repositories listed on the index page of the hgweb
+// server.
+func (c *Client) ListRepos(ctx context.Context) ([]*Repo, error) {
+	u := c.URL.ResolveReference(&url.URL{RawQuery: "style=json"})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var index struct {
+		Entries []indexEntry `json:"entries"`
+	}
+	if err := c.do(req, &index); err != nil {
+		return nil, errors.Wrap(err, "listing repositories")
+	}
+
+	repos := make([]*Repo, 0, len(index.Entries))
+	for _, e := range index.Entries {
+		name := strings.Trim(e.Name, "/")
+		if name == "" {
+			continue
+		}
+		repos = append(repos, &Repo{
+			Name:        name,
+			Description: e.Description,
+			Contact:     e.Contact,
+			URL:         c.RepoURL(name),
+		})
+	}
+
+	return repos, nil
+}
+
+// Ping checks that the hgweb server is reachable and that the configured
+// credentials are accepted.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL.String(), nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, nil)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return errors.WithStack(&httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(bs, result)
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("hgweb HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL.Redacted(), e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
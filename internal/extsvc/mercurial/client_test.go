@@ -0,0 +1,55 @@
+package mercurial
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient_ListRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "alice" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/hg/" || r.URL.Query().Get("style") != "json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"entries": [
+			{"name": "project/repo", "description": "A repository", "contact": "alice", "lastchange": [1700000000, 0], "labels": []},
+			{"name": "other/", "description": "unknown", "contact": "", "lastchange": [1700000000, 0], "labels": []},
+			{"name": "/", "description": "", "contact": "", "lastchange": [1700000000, 0], "labels": []}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		cli, err := NewClient(&schema.MercurialConnection{Url: srv.URL + "/hg", Username: "alice", Password: "secret"}, srv.Client())
+		require.NoError(t, err)
+
+		repos, err := cli.ListRepos(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []*Repo{
+			{Name: "project/repo", Description: "A repository", Contact: "alice", URL: srv.URL + "/hg/project/repo"},
+			{Name: "other", Description: "unknown", URL: srv.URL + "/hg/other"},
+		}, repos)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		cli, err := NewClient(&schema.MercurialConnection{Url: srv.URL + "/hg/"}, srv.Client())
+		require.NoError(t, err)
+
+		_, err = cli.ListRepos(ctx)
+		require.Error(t, err)
+		require.True(t, errcode.IsUnauthorized(err))
+	})
+}
@@ -37,6 +37,9 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantGerrit.ConfigPrototype().(*schema.GerritConnection); !ok {
 		t.Errorf("wrong type for Gerrit configuration prototype: %T", y)
 	}
+	if y, ok := VariantGitea.ConfigPrototype().(*schema.GiteaConnection); !ok {
+		t.Errorf("wrong type for Gitea configuration prototype: %T", y)
+	}
 	if y, ok := VariantGitHub.ConfigPrototype().(*schema.GitHubConnection); !ok {
 		t.Errorf("wrong type for GitHub configuration prototype: %T", y)
 	}
@@ -52,6 +55,9 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantJVMPackages.ConfigPrototype().(*schema.JVMPackagesConnection); !ok {
 		t.Errorf("wrong type for JVM Packages configuration prototype: %T", y)
 	}
+	if y, ok := VariantMercurial.ConfigPrototype().(*schema.MercurialConnection); !ok {
+		t.Errorf("wrong type for Mercurial configuration prototype: %T", y)
+	}
 	if y, ok := VariantNpmPackages.ConfigPrototype().(*schema.NpmPackagesConnection); !ok {
 		t.Errorf("wrong type for NPM Packages configuration prototype: %T", y)
 	}
@@ -378,6 +384,11 @@ func TestUniqueCodeHostIdentifier(t *testing.T) {
 			config: `{"url": "https://example.com"}`,
 			want:   "https://example.com/",
 		},
+		{
+			kind:   KindGitea,
+			config: `{"url": "https://Gitea.example.com/", "token": "abc"}`,
+			want:   "https://gitea.example.com/",
+		},
 		{
 			kind:   KindBitbucketServer,
 			config: `{"url": "https://bitbucket.sgdev.org/"}`,
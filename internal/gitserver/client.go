@@ -215,12 +215,32 @@ type BlameOptions struct {
 	NewestCommit     api.CommitID `json:",omitempty" url:",omitempty"`
 	IgnoreWhitespace bool         `json:",omitempty" url:",omitempty"`
 	Range            *BlameRange  `json:",omitempty" url:",omitempty"`
+
+	// OldestCommit is an optional lower bound for the blame. Lines that have
+	// not changed since OldestCommit are attributed to it.
+	OldestCommit api.CommitID `json:",omitempty" url:",omitempty"`
+	// IgnoreRevs are revisions whose changes are skipped when attributing
+	// lines, for example bulk reformatting commits.
+	IgnoreRevs []string `json:",omitempty" url:",omitempty"`
+	// UseIgnoreRevsFile additionally skips the revisions listed in the
+	// repository's .git-blame-ignore-revs file.
+	UseIgnoreRevsFile bool `json:",omitempty" url:",omitempty"`
+	// DetectMoves detects lines moved or copied within the file (git blame -M).
+	DetectMoves bool `json:",omitempty" url:",omitempty"`
+	// DetectCopies detects lines moved or copied from other files modified in
+	// the same commit (git blame -C).
+	DetectCopies bool `json:",omitempty" url:",omitempty"`
 }
 
 func (o *BlameOptions) Attrs() []attribute.KeyValue {
 	kvs := []attribute.KeyValue{
 		attribute.String("newestCommit", string(o.NewestCommit)),
 		attribute.Bool("ignoreWhitespace", o.IgnoreWhitespace),
+		attribute.String("oldestCommit", string(o.OldestCommit)),
+		attribute.StringSlice("ignoreRevs", o.IgnoreRevs),
+		attribute.Bool("useIgnoreRevsFile", o.UseIgnoreRevsFile),
+		attribute.Bool("detectMoves", o.DetectMoves),
+		attribute.Bool("detectCopies", o.DetectCopies),
 	}
 	if o.Range != nil {
 		kvs = append(kvs, o.Range.Attrs()...)
@@ -266,6 +286,12 @@ type ArchiveOptions struct {
 	// on Linux can be arbitrary byte sequences. Users should take care to validate / sanitize
 	// paths if necessary.
 	Paths []string
+	// LFSPointers leaves Git LFS pointer files in the archive as they are
+	// stored in the repository. By default, gitserver replaces them with the
+	// content of the LFS objects they point to if LFS object resolution is
+	// enabled. Archives used for indexing or search should set this, so they
+	// don't fetch large binaries.
+	LFSPointers bool
 }
 
 func (a *ArchiveOptions) Attrs() []attribute.KeyValue {
@@ -277,6 +303,7 @@ func (a *ArchiveOptions) Attrs() []attribute.KeyValue {
 		attribute.String("treeish", a.Treeish),
 		attribute.String("format", string(a.Format)),
 		attribute.StringSlice("paths", pathAttrs),
+		attribute.Bool("lfsPointers", a.LFSPointers),
 	}
 }
 
@@ -289,9 +316,10 @@ func (o *ArchiveOptions) FromProto(x *proto.ArchiveRequest) {
 	}
 
 	*o = ArchiveOptions{
-		Treeish: x.GetTreeish(),
-		Format:  ArchiveFormatFromProto(x.GetFormat()),
-		Paths:   paths,
+		Treeish:     x.GetTreeish(),
+		Format:      ArchiveFormatFromProto(x.GetFormat()),
+		Paths:       paths,
+		LFSPointers: x.GetLfsPointer(),
 	}
 }
 
@@ -302,10 +330,11 @@ func (o *ArchiveOptions) ToProto(repo string) *proto.ArchiveRequest {
 	}
 
 	return &proto.ArchiveRequest{
-		Repo:    repo,
-		Treeish: o.Treeish,
-		Format:  o.Format.ToProto(),
-		Paths:   paths,
+		Repo:       repo,
+		Treeish:    o.Treeish,
+		Format:     o.Format.ToProto(),
+		Paths:      paths,
+		LfsPointer: o.LFSPointers,
 	}
 }
 
@@ -438,6 +467,12 @@ type Client interface {
 	// If the specified commit does not exist, a RevisionNotFoundError is returned.
 	NewFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (io.ReadCloser, error)
 
+	// NewLFSPointerReader is like NewFileReader, but returns Git LFS pointer
+	// files as they are stored in the repository, instead of the content of the
+	// LFS objects they point to. Files that are not pointers are returned as
+	// with NewFileReader.
+	NewLFSPointerReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (io.ReadCloser, error)
+
 	// ChangedFiles returns the list of files that have been added, modified, or
 	// deleted in the entire repository between the two given <tree-ish> identifiers (e.g., commit, branch, tag).
 	//
@@ -449,6 +484,15 @@ type Client interface {
 	// Commits returns all commits matching the options.
 	Commits(ctx context.Context, repo api.RepoName, opt CommitsOptions) ([]*gitdomain.Commit, error)
 
+	// CommitGraph returns the commit graph of all commits matching the options.
+	// For every commit, only its parents and committer date are returned, which
+	// makes this a lot cheaper than Commits for reconstructing the ancestry of
+	// large ranges of history.
+	//
+	// As the graph can't be filtered by sub-repo permissions, an error is
+	// returned for non-internal actors if the repo has sub-repo permissions.
+	CommitGraph(ctx context.Context, repo api.RepoName, opt CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)
+
 	// FirstEverCommit returns the first commit ever made to the repository.
 	FirstEverCommit(ctx context.Context, repo api.RepoName) (*gitdomain.Commit, error)
 
@@ -522,10 +566,12 @@ func (c *clientImplementor) SystemsInfo(ctx context.Context) (_ []protocol.Syste
 				return protocol.SystemInfo{}, err
 			}
 			return protocol.SystemInfo{
-				Address:     addr.Address(),
-				FreeSpace:   response.GetFreeSpace(),
-				TotalSpace:  response.GetTotalSpace(),
-				PercentUsed: response.GetPercentUsed(),
+				Address:         addr.Address(),
+				FreeSpace:       response.GetFreeSpace(),
+				TotalSpace:      response.GetTotalSpace(),
+				PercentUsed:     response.GetPercentUsed(),
+				TieredRepos:     response.GetTieredRepos(),
+				TieredSizeBytes: response.GetTieredSizeBytes(),
 			}, nil
 		})
 	}
@@ -553,9 +599,11 @@ func (c *clientImplementor) SystemInfo(ctx context.Context, addr string) (_ prot
 	}
 
 	return protocol.SystemInfo{
-		Address:    ac.Address(),
-		FreeSpace:  response.FreeSpace,
-		TotalSpace: response.TotalSpace,
+		Address:         ac.Address(),
+		FreeSpace:       response.FreeSpace,
+		TotalSpace:      response.TotalSpace,
+		TieredRepos:     response.TieredRepos,
+		TieredSizeBytes: response.TieredSizeBytes,
 	}, nil
 }
 
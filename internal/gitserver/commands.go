@@ -494,10 +494,17 @@ func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoNa
 	}
 
 	req := &proto.BlameRequest{
-		RepoName:         string(repo),
-		Commit:           string(opt.NewestCommit),
-		Path:             []byte(path), // The file path might not be utf-8 encoded.
-		IgnoreWhitespace: opt.IgnoreWhitespace,
+		RepoName:          string(repo),
+		Commit:            string(opt.NewestCommit),
+		Path:              []byte(path), // The file path might not be utf-8 encoded.
+		IgnoreWhitespace:  opt.IgnoreWhitespace,
+		IgnoreRevs:        opt.IgnoreRevs,
+		UseIgnoreRevsFile: opt.UseIgnoreRevsFile,
+		DetectMoves:       opt.DetectMoves,
+		DetectCopies:      opt.DetectCopies,
+	}
+	if opt.OldestCommit != "" {
+		req.OldestCommit = pointers.Ptr(string(opt.OldestCommit))
 	}
 	if opt.Range != nil {
 		req.Range = &proto.BlameRange{
@@ -770,7 +777,15 @@ func (c *clientImplementor) BehindAhead(ctx context.Context, repo api.RepoName,
 }
 
 func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (_ io.ReadCloser, err error) {
-	ctx, _, endObservation := c.operations.newFileReader.With(ctx, &err, observation.Args{
+	return c.newFileReader(ctx, c.operations.newFileReader, repo, commit, name, false)
+}
+
+func (c *clientImplementor) NewLFSPointerReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (_ io.ReadCloser, err error) {
+	return c.newFileReader(ctx, c.operations.newLFSPointerReader, repo, commit, name, true)
+}
+
+func (c *clientImplementor) newFileReader(ctx context.Context, op *observation.Operation, repo api.RepoName, commit api.CommitID, name string, lfsPointer bool) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := op.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
 		Attrs: []attribute.KeyValue{
 			repo.Attr(),
@@ -794,9 +809,10 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 	}
 
 	req := &proto.ReadFileRequest{
-		RepoName: string(repo),
-		Commit:   string(commit),
-		Path:     []byte(rel(name)),
+		RepoName:   string(repo),
+		Commit:     string(commit),
+		Path:       []byte(rel(name)),
+		LfsPointer: lfsPointer,
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -1233,6 +1249,105 @@ type wrappedCommit struct {
 	files []string
 }
 
+// CommitGraphOptions specifies options for CommitGraph.
+type CommitGraphOptions struct {
+	AllRefs bool // if true, the commits reachable from all refs are returned. When set, Ranges should not be specified.
+	// commit ranges to inspect (revspec, "A..B", "A...B", etc.).
+	Ranges []string
+
+	After time.Time // include only commits after this date
+
+	Order CommitsOrder
+}
+
+func (opt CommitGraphOptions) ToProto(repoName api.RepoName) (*proto.CommitGraphRequest, error) {
+	if len(opt.Ranges) > 0 && opt.AllRefs {
+		return nil, errors.New("cannot specify both a range and AllRefs")
+	}
+	if len(opt.Ranges) == 0 && !opt.AllRefs {
+		return nil, errors.New("must specify a range or AllRefs")
+	}
+
+	p := &proto.CommitGraphRequest{
+		RepoName: string(repoName),
+		AllRefs:  opt.AllRefs,
+		Ranges:   stringsToByteSlices(opt.Ranges),
+	}
+	if !opt.After.IsZero() {
+		p.After = timestamppb.New(opt.After)
+	}
+
+	switch opt.Order {
+	case CommitsOrderCommitDate:
+		p.Order = proto.CommitGraphRequest_COMMIT_GRAPH_ORDER_COMMIT_DATE
+	case CommitsOrderTopoDate:
+		p.Order = proto.CommitGraphRequest_COMMIT_GRAPH_ORDER_TOPO_DATE
+	case CommitsOrderDefault:
+		p.Order = proto.CommitGraphRequest_COMMIT_GRAPH_ORDER_UNSPECIFIED
+	default:
+		return nil, errors.Newf("invalid ordering %d", opt.Order)
+	}
+
+	return p, nil
+}
+
+func (c *clientImplementor) CommitGraph(ctx context.Context, repo api.RepoName, opt CommitGraphOptions) (_ []*gitdomain.CommitGraphNode, err error) {
+	ctx, _, endObservation := c.operations.commitGraph.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.StringSlice("ranges", opt.Ranges),
+			attribute.Bool("allRefs", opt.AllRefs),
+			attribute.Stringer("after", opt.After),
+			attribute.Int("order", int(opt.Order)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if authz.SubRepoEnabled(c.subRepoPermsChecker) && !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := authz.SubRepoEnabledForRepo(ctx, c.subRepoPermsChecker, repo); err != nil {
+			return nil, errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return nil, errors.New("commitGraph invoked for a repo with sub-repo permissions")
+		}
+	}
+
+	req, err := opt.ToProto(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.CommitGraph(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []*gitdomain.CommitGraphNode{}
+	for {
+		chunk, err := cc.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		for _, n := range chunk.GetCommits() {
+			node, err := gitdomain.CommitGraphNodeFromProto(n)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes, nil
+}
+
 // FirstEverCommit returns the first commit ever made to the repository.
 func (c *clientImplementor) FirstEverCommit(ctx context.Context, repo api.RepoName) (commit *gitdomain.Commit, err error) {
 	ctx, _, endObservation := c.operations.firstEverCommit.With(ctx, &err, observation.Args{
@@ -362,6 +362,23 @@ func (r *errorTranslatingCommitLogClient) Recv() (*proto.CommitLogResponse, erro
 	return res, convertGRPCErrorToGitDomainError(err)
 }
 
+func (r *errorTranslatingClient) CommitGraph(ctx context.Context, in *proto.CommitGraphRequest, opts ...grpc.CallOption) (proto.GitserverService_CommitGraphClient, error) {
+	cc, err := r.base.CommitGraph(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingCommitGraphClient{cc}, nil
+}
+
+type errorTranslatingCommitGraphClient struct {
+	proto.GitserverService_CommitGraphClient
+}
+
+func (r *errorTranslatingCommitGraphClient) Recv() (*proto.CommitGraphResponse, error) {
+	res, err := r.GitserverService_CommitGraphClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
 func (r *errorTranslatingClient) MergeBaseOctopus(ctx context.Context, in *proto.MergeBaseOctopusRequest, opts ...grpc.CallOption) (*proto.MergeBaseOctopusResponse, error) {
 	res, err := r.base.MergeBaseOctopus(ctx, in, opts...)
 	return res, convertGRPCErrorToGitDomainError(err)
@@ -56,6 +56,12 @@ type Submodule struct {
 	// CommitID is the pinned commit ID of the submodule (in the
 	// submodule repository's commit ID space).
 	CommitID api.CommitID
+
+	// RepoName is the name of the repository that URL maps to on this
+	// instance, as resolved by gitserver with repos.SubmoduleRepoName. It is
+	// empty if no code host matches URL, and the repository doesn't
+	// necessarily exist or is visible to the current user.
+	RepoName api.RepoName
 }
 
 // ObjectInfo holds information about a Git object and is returned in (fs.FileInfo).Sys for blobs
@@ -212,6 +218,59 @@ func CommitFromProto(p *proto.GitCommit) *Commit {
 	}
 }
 
+// CommitGraphNode is a commit in the commit graph of a repository. It only holds
+// the information required to reason about the ancestry of commits.
+type CommitGraphNode struct {
+	ID OID
+	// Parents are the IDs of this commit's parent commits, in order.
+	Parents []OID
+	// CommitterDate is the committer date of the commit, with second precision.
+	CommitterDate time.Time
+}
+
+func (n *CommitGraphNode) ToProto() *proto.CommitGraphNode {
+	parents := make([][]byte, len(n.Parents))
+	for i, p := range n.Parents {
+		parents[i] = p[:]
+	}
+
+	return &proto.CommitGraphNode{
+		Oid:           n.ID[:],
+		Parents:       parents,
+		CommitterTime: n.CommitterDate.Unix(),
+	}
+}
+
+func CommitGraphNodeFromProto(p *proto.CommitGraphNode) (*CommitGraphNode, error) {
+	id, err := oidFromBytes(p.GetOid())
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make([]OID, len(p.GetParents()))
+	for i, raw := range p.GetParents() {
+		parents[i], err = oidFromBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &CommitGraphNode{
+		ID:            id,
+		Parents:       parents,
+		CommitterDate: time.Unix(p.GetCommitterTime(), 0).UTC(),
+	}, nil
+}
+
+func oidFromBytes(b []byte) (OID, error) {
+	var oid OID
+	if len(b) != len(oid) {
+		return oid, errors.Errorf("invalid object ID length %d", len(b))
+	}
+	copy(oid[:], b)
+	return oid, nil
+}
+
 // Message represents a git commit message
 type Message string
 
@@ -599,6 +658,7 @@ func FSFileInfoToProto(fi fs.FileInfo) *v1.FileInfo {
 			Url:       s.URL,
 			CommitSha: string(s.CommitID),
 			Path:      []byte(s.Path),
+			RepoName:  string(s.RepoName),
 		}
 	case ObjectInfo:
 		p.BlobOid = s.OID().String()
@@ -613,6 +673,7 @@ func ProtoFileInfoToFS(fi *v1.FileInfo) fs.FileInfo {
 			URL:      sm.GetUrl(),
 			Path:     string(sm.GetPath()),
 			CommitID: api.CommitID(sm.GetCommitSha()),
+			RepoName: api.RepoName(sm.GetRepoName()),
 		}
 	} else {
 		oid, _ := decodeOID(fi.GetBlobOid())
@@ -227,6 +227,38 @@ func TestRoundTripCommit(t *testing.T) {
 	}
 }
 
+func TestRoundTripCommitGraphNode(t *testing.T) {
+	diff := ""
+
+	err := quick.Check(func(id OID, parents []OID, committerDate fuzzTime) bool {
+		original := &CommitGraphNode{
+			ID:            id,
+			Parents:       parents,
+			CommitterDate: time.Time(committerDate).Truncate(time.Second),
+		}
+		if original.Parents == nil {
+			original.Parents = []OID{}
+		}
+
+		converted, err := CommitGraphNodeFromProto(original.ToProto())
+		if err != nil {
+			t.Fatalf("unexpected error converting commit graph node: %v", err)
+		}
+		if diff = cmp.Diff(original, converted); diff != "" {
+			return false
+		}
+
+		return true
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
+	}
+
+	if _, err := CommitGraphNodeFromProto(&proto.CommitGraphNode{Oid: []byte("short")}); err == nil {
+		t.Fatal("expected an error for an invalid object ID")
+	}
+}
+
 type fuzzTime time.Time
 
 func (fuzzTime) Generate(rand *rand.Rand, _ int) reflect.Value {
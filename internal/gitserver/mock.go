@@ -35,6 +35,9 @@ type MockGitserverServiceClient struct {
 	// CheckPerforceCredentialsFunc is an instance of a mock function object
 	// controlling the behavior of the method CheckPerforceCredentials.
 	CheckPerforceCredentialsFunc *GitserverServiceClientCheckPerforceCredentialsFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *GitserverServiceClientCommitGraphFunc
 	// CommitLogFunc is an instance of a mock function object controlling
 	// the behavior of the method CommitLog.
 	CommitLogFunc *GitserverServiceClientCommitLogFunc
@@ -152,6 +155,11 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		CommitGraphFunc: &GitserverServiceClientCommitGraphFunc{
+			defaultHook: func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (r0 v1.GitserverService_CommitGraphClient, r1 error) {
+				return
+			},
+		},
 		CommitLogFunc: &GitserverServiceClientCommitLogFunc{
 			defaultHook: func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (r0 v1.GitserverService_CommitLogClient, r1 error) {
 				return
@@ -325,6 +333,11 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.CheckPerforceCredentials")
 			},
 		},
+		CommitGraphFunc: &GitserverServiceClientCommitGraphFunc{
+			defaultHook: func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.CommitGraph")
+			},
+		},
 		CommitLogFunc: &GitserverServiceClientCommitLogFunc{
 			defaultHook: func(context.Context, *v1.CommitLogRequest, ...grpc.CallOption) (v1.GitserverService_CommitLogClient, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.CommitLog")
@@ -488,6 +501,9 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: i.CheckPerforceCredentials,
 		},
+		CommitGraphFunc: &GitserverServiceClientCommitGraphFunc{
+			defaultHook: i.CommitGraph,
+		},
 		CommitLogFunc: &GitserverServiceClientCommitLogFunc{
 			defaultHook: i.CommitLog,
 		},
@@ -1176,6 +1192,127 @@ func (c GitserverServiceClientCheckPerforceCredentialsFuncCall) Results() []inte
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverServiceClientCommitGraphFunc describes the behavior when the
+// CommitGraph method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientCommitGraphFunc struct {
+	defaultHook func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error)
+	hooks       []func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error)
+	history     []GitserverServiceClientCommitGraphFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) CommitGraph(v0 context.Context, v1 *v1.CommitGraphRequest, v2 ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2...)
+	m.CommitGraphFunc.appendCall(GitserverServiceClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientCommitGraphFunc) PushHook(hook func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientCommitGraphFunc) SetDefaultReturn(r0 v1.GitserverService_CommitGraphClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientCommitGraphFunc) PushReturn(r0 v1.GitserverService_CommitGraphClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientCommitGraphFunc) nextHook() func(context.Context, *v1.CommitGraphRequest, ...grpc.CallOption) (v1.GitserverService_CommitGraphClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientCommitGraphFunc) appendCall(r0 GitserverServiceClientCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientCommitGraphFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientCommitGraphFunc) History() []GitserverServiceClientCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientCommitGraphFuncCall is an object that describes an
+// invocation of method CommitGraph on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientCommitGraphFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.CommitGraphRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_CommitGraphClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientCommitGraphFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverServiceClientCommitLogFunc describes the behavior when the
 // CommitLog method of the parent MockGitserverServiceClient instance is
 // invoked.
@@ -9815,6 +9952,1761 @@ func (c GitserverService_ChangedFilesServerSetTrailerFuncCall) Results() []inter
 	return []interface{}{}
 }
 
+// MockGitserverService_CommitGraphClient is a mock implementation of the
+// GitserverService_CommitGraphClient interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/gitserver/v1) used for unit
+// testing.
+type MockGitserverService_CommitGraphClient struct {
+	// CloseSendFunc is an instance of a mock function object controlling
+	// the behavior of the method CloseSend.
+	CloseSendFunc *GitserverService_CommitGraphClientCloseSendFunc
+	// ContextFunc is an instance of a mock function object controlling the
+	// behavior of the method Context.
+	ContextFunc *GitserverService_CommitGraphClientContextFunc
+	// HeaderFunc is an instance of a mock function object controlling the
+	// behavior of the method Header.
+	HeaderFunc *GitserverService_CommitGraphClientHeaderFunc
+	// RecvFunc is an instance of a mock function object controlling the
+	// behavior of the method Recv.
+	RecvFunc *GitserverService_CommitGraphClientRecvFunc
+	// RecvMsgFunc is an instance of a mock function object controlling the
+	// behavior of the method RecvMsg.
+	RecvMsgFunc *GitserverService_CommitGraphClientRecvMsgFunc
+	// SendMsgFunc is an instance of a mock function object controlling the
+	// behavior of the method SendMsg.
+	SendMsgFunc *GitserverService_CommitGraphClientSendMsgFunc
+	// TrailerFunc is an instance of a mock function object controlling the
+	// behavior of the method Trailer.
+	TrailerFunc *GitserverService_CommitGraphClientTrailerFunc
+}
+
+// NewMockGitserverService_CommitGraphClient creates a new mock of the
+// GitserverService_CommitGraphClient interface. All methods return zero
+// values for all results, unless overwritten.
+func NewMockGitserverService_CommitGraphClient() *MockGitserverService_CommitGraphClient {
+	return &MockGitserverService_CommitGraphClient{
+		CloseSendFunc: &GitserverService_CommitGraphClientCloseSendFunc{
+			defaultHook: func() (r0 error) {
+				return
+			},
+		},
+		ContextFunc: &GitserverService_CommitGraphClientContextFunc{
+			defaultHook: func() (r0 context.Context) {
+				return
+			},
+		},
+		HeaderFunc: &GitserverService_CommitGraphClientHeaderFunc{
+			defaultHook: func() (r0 metadata.MD, r1 error) {
+				return
+			},
+		},
+		RecvFunc: &GitserverService_CommitGraphClientRecvFunc{
+			defaultHook: func() (r0 *v1.CommitGraphResponse, r1 error) {
+				return
+			},
+		},
+		RecvMsgFunc: &GitserverService_CommitGraphClientRecvMsgFunc{
+			defaultHook: func(interface{}) (r0 error) {
+				return
+			},
+		},
+		SendMsgFunc: &GitserverService_CommitGraphClientSendMsgFunc{
+			defaultHook: func(interface{}) (r0 error) {
+				return
+			},
+		},
+		TrailerFunc: &GitserverService_CommitGraphClientTrailerFunc{
+			defaultHook: func() (r0 metadata.MD) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGitserverService_CommitGraphClient creates a new mock of the
+// GitserverService_CommitGraphClient interface. All methods panic on
+// invocation, unless overwritten.
+func NewStrictMockGitserverService_CommitGraphClient() *MockGitserverService_CommitGraphClient {
+	return &MockGitserverService_CommitGraphClient{
+		CloseSendFunc: &GitserverService_CommitGraphClientCloseSendFunc{
+			defaultHook: func() error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.CloseSend")
+			},
+		},
+		ContextFunc: &GitserverService_CommitGraphClientContextFunc{
+			defaultHook: func() context.Context {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.Context")
+			},
+		},
+		HeaderFunc: &GitserverService_CommitGraphClientHeaderFunc{
+			defaultHook: func() (metadata.MD, error) {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.Header")
+			},
+		},
+		RecvFunc: &GitserverService_CommitGraphClientRecvFunc{
+			defaultHook: func() (*v1.CommitGraphResponse, error) {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.Recv")
+			},
+		},
+		RecvMsgFunc: &GitserverService_CommitGraphClientRecvMsgFunc{
+			defaultHook: func(interface{}) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.RecvMsg")
+			},
+		},
+		SendMsgFunc: &GitserverService_CommitGraphClientSendMsgFunc{
+			defaultHook: func(interface{}) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.SendMsg")
+			},
+		},
+		TrailerFunc: &GitserverService_CommitGraphClientTrailerFunc{
+			defaultHook: func() metadata.MD {
+				panic("unexpected invocation of MockGitserverService_CommitGraphClient.Trailer")
+			},
+		},
+	}
+}
+
+// NewMockGitserverService_CommitGraphClientFrom creates a new mock of the
+// MockGitserverService_CommitGraphClient interface. All methods delegate to
+// the given implementation, unless overwritten.
+func NewMockGitserverService_CommitGraphClientFrom(i v1.GitserverService_CommitGraphClient) *MockGitserverService_CommitGraphClient {
+	return &MockGitserverService_CommitGraphClient{
+		CloseSendFunc: &GitserverService_CommitGraphClientCloseSendFunc{
+			defaultHook: i.CloseSend,
+		},
+		ContextFunc: &GitserverService_CommitGraphClientContextFunc{
+			defaultHook: i.Context,
+		},
+		HeaderFunc: &GitserverService_CommitGraphClientHeaderFunc{
+			defaultHook: i.Header,
+		},
+		RecvFunc: &GitserverService_CommitGraphClientRecvFunc{
+			defaultHook: i.Recv,
+		},
+		RecvMsgFunc: &GitserverService_CommitGraphClientRecvMsgFunc{
+			defaultHook: i.RecvMsg,
+		},
+		SendMsgFunc: &GitserverService_CommitGraphClientSendMsgFunc{
+			defaultHook: i.SendMsg,
+		},
+		TrailerFunc: &GitserverService_CommitGraphClientTrailerFunc{
+			defaultHook: i.Trailer,
+		},
+	}
+}
+
+// GitserverService_CommitGraphClientCloseSendFunc describes the behavior
+// when the CloseSend method of the parent
+// MockGitserverService_CommitGraphClient instance is invoked.
+type GitserverService_CommitGraphClientCloseSendFunc struct {
+	defaultHook func() error
+	hooks       []func() error
+	history     []GitserverService_CommitGraphClientCloseSendFuncCall
+	mutex       sync.Mutex
+}
+
+// CloseSend delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) CloseSend() error {
+	r0 := m.CloseSendFunc.nextHook()()
+	m.CloseSendFunc.appendCall(GitserverService_CommitGraphClientCloseSendFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CloseSend method of
+// the parent MockGitserverService_CommitGraphClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphClientCloseSendFunc) SetDefaultHook(hook func() error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CloseSend method of the parent MockGitserverService_CommitGraphClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphClientCloseSendFunc) PushHook(hook func() error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientCloseSendFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func() error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientCloseSendFunc) PushReturn(r0 error) {
+	f.PushHook(func() error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphClientCloseSendFunc) nextHook() func() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientCloseSendFunc) appendCall(r0 GitserverService_CommitGraphClientCloseSendFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientCloseSendFuncCall objects describing
+// the invocations of this function.
+func (f *GitserverService_CommitGraphClientCloseSendFunc) History() []GitserverService_CommitGraphClientCloseSendFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientCloseSendFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientCloseSendFuncCall is an object that
+// describes an invocation of method CloseSend on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientCloseSendFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientCloseSendFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientCloseSendFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphClientContextFunc describes the behavior when
+// the Context method of the parent MockGitserverService_CommitGraphClient
+// instance is invoked.
+type GitserverService_CommitGraphClientContextFunc struct {
+	defaultHook func() context.Context
+	hooks       []func() context.Context
+	history     []GitserverService_CommitGraphClientContextFuncCall
+	mutex       sync.Mutex
+}
+
+// Context delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) Context() context.Context {
+	r0 := m.ContextFunc.nextHook()()
+	m.ContextFunc.appendCall(GitserverService_CommitGraphClientContextFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Context method of
+// the parent MockGitserverService_CommitGraphClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphClientContextFunc) SetDefaultHook(hook func() context.Context) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Context method of the parent MockGitserverService_CommitGraphClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphClientContextFunc) PushHook(hook func() context.Context) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientContextFunc) SetDefaultReturn(r0 context.Context) {
+	f.SetDefaultHook(func() context.Context {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientContextFunc) PushReturn(r0 context.Context) {
+	f.PushHook(func() context.Context {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphClientContextFunc) nextHook() func() context.Context {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientContextFunc) appendCall(r0 GitserverService_CommitGraphClientContextFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientContextFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphClientContextFunc) History() []GitserverService_CommitGraphClientContextFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientContextFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientContextFuncCall is an object that
+// describes an invocation of method Context on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientContextFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 context.Context
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientContextFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphClientHeaderFunc describes the behavior when
+// the Header method of the parent MockGitserverService_CommitGraphClient
+// instance is invoked.
+type GitserverService_CommitGraphClientHeaderFunc struct {
+	defaultHook func() (metadata.MD, error)
+	hooks       []func() (metadata.MD, error)
+	history     []GitserverService_CommitGraphClientHeaderFuncCall
+	mutex       sync.Mutex
+}
+
+// Header delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) Header() (metadata.MD, error) {
+	r0, r1 := m.HeaderFunc.nextHook()()
+	m.HeaderFunc.appendCall(GitserverService_CommitGraphClientHeaderFuncCall{r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Header method of the
+// parent MockGitserverService_CommitGraphClient instance is invoked and the
+// hook queue is empty.
+func (f *GitserverService_CommitGraphClientHeaderFunc) SetDefaultHook(hook func() (metadata.MD, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Header method of the parent MockGitserverService_CommitGraphClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphClientHeaderFunc) PushHook(hook func() (metadata.MD, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientHeaderFunc) SetDefaultReturn(r0 metadata.MD, r1 error) {
+	f.SetDefaultHook(func() (metadata.MD, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientHeaderFunc) PushReturn(r0 metadata.MD, r1 error) {
+	f.PushHook(func() (metadata.MD, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverService_CommitGraphClientHeaderFunc) nextHook() func() (metadata.MD, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientHeaderFunc) appendCall(r0 GitserverService_CommitGraphClientHeaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientHeaderFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphClientHeaderFunc) History() []GitserverService_CommitGraphClientHeaderFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientHeaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientHeaderFuncCall is an object that
+// describes an invocation of method Header on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientHeaderFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 metadata.MD
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientHeaderFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientHeaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverService_CommitGraphClientRecvFunc describes the behavior when
+// the Recv method of the parent MockGitserverService_CommitGraphClient
+// instance is invoked.
+type GitserverService_CommitGraphClientRecvFunc struct {
+	defaultHook func() (*v1.CommitGraphResponse, error)
+	hooks       []func() (*v1.CommitGraphResponse, error)
+	history     []GitserverService_CommitGraphClientRecvFuncCall
+	mutex       sync.Mutex
+}
+
+// Recv delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) Recv() (*v1.CommitGraphResponse, error) {
+	r0, r1 := m.RecvFunc.nextHook()()
+	m.RecvFunc.appendCall(GitserverService_CommitGraphClientRecvFuncCall{r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Recv method of the
+// parent MockGitserverService_CommitGraphClient instance is invoked and the
+// hook queue is empty.
+func (f *GitserverService_CommitGraphClientRecvFunc) SetDefaultHook(hook func() (*v1.CommitGraphResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Recv method of the parent MockGitserverService_CommitGraphClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverService_CommitGraphClientRecvFunc) PushHook(hook func() (*v1.CommitGraphResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientRecvFunc) SetDefaultReturn(r0 *v1.CommitGraphResponse, r1 error) {
+	f.SetDefaultHook(func() (*v1.CommitGraphResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientRecvFunc) PushReturn(r0 *v1.CommitGraphResponse, r1 error) {
+	f.PushHook(func() (*v1.CommitGraphResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverService_CommitGraphClientRecvFunc) nextHook() func() (*v1.CommitGraphResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientRecvFunc) appendCall(r0 GitserverService_CommitGraphClientRecvFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientRecvFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphClientRecvFunc) History() []GitserverService_CommitGraphClientRecvFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientRecvFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientRecvFuncCall is an object that
+// describes an invocation of method Recv on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientRecvFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *v1.CommitGraphResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientRecvFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientRecvFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverService_CommitGraphClientRecvMsgFunc describes the behavior when
+// the RecvMsg method of the parent MockGitserverService_CommitGraphClient
+// instance is invoked.
+type GitserverService_CommitGraphClientRecvMsgFunc struct {
+	defaultHook func(interface{}) error
+	hooks       []func(interface{}) error
+	history     []GitserverService_CommitGraphClientRecvMsgFuncCall
+	mutex       sync.Mutex
+}
+
+// RecvMsg delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) RecvMsg(v0 interface{}) error {
+	r0 := m.RecvMsgFunc.nextHook()(v0)
+	m.RecvMsgFunc.appendCall(GitserverService_CommitGraphClientRecvMsgFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RecvMsg method of
+// the parent MockGitserverService_CommitGraphClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) SetDefaultHook(hook func(interface{}) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RecvMsg method of the parent MockGitserverService_CommitGraphClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) PushHook(hook func(interface{}) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(interface{}) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) PushReturn(r0 error) {
+	f.PushHook(func(interface{}) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) nextHook() func(interface{}) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) appendCall(r0 GitserverService_CommitGraphClientRecvMsgFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientRecvMsgFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphClientRecvMsgFunc) History() []GitserverService_CommitGraphClientRecvMsgFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientRecvMsgFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientRecvMsgFuncCall is an object that
+// describes an invocation of method RecvMsg on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientRecvMsgFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 interface{}
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientRecvMsgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientRecvMsgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphClientSendMsgFunc describes the behavior when
+// the SendMsg method of the parent MockGitserverService_CommitGraphClient
+// instance is invoked.
+type GitserverService_CommitGraphClientSendMsgFunc struct {
+	defaultHook func(interface{}) error
+	hooks       []func(interface{}) error
+	history     []GitserverService_CommitGraphClientSendMsgFuncCall
+	mutex       sync.Mutex
+}
+
+// SendMsg delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) SendMsg(v0 interface{}) error {
+	r0 := m.SendMsgFunc.nextHook()(v0)
+	m.SendMsgFunc.appendCall(GitserverService_CommitGraphClientSendMsgFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SendMsg method of
+// the parent MockGitserverService_CommitGraphClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphClientSendMsgFunc) SetDefaultHook(hook func(interface{}) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SendMsg method of the parent MockGitserverService_CommitGraphClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphClientSendMsgFunc) PushHook(hook func(interface{}) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientSendMsgFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(interface{}) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientSendMsgFunc) PushReturn(r0 error) {
+	f.PushHook(func(interface{}) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphClientSendMsgFunc) nextHook() func(interface{}) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientSendMsgFunc) appendCall(r0 GitserverService_CommitGraphClientSendMsgFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientSendMsgFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphClientSendMsgFunc) History() []GitserverService_CommitGraphClientSendMsgFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientSendMsgFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientSendMsgFuncCall is an object that
+// describes an invocation of method SendMsg on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientSendMsgFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 interface{}
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientSendMsgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientSendMsgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphClientTrailerFunc describes the behavior when
+// the Trailer method of the parent MockGitserverService_CommitGraphClient
+// instance is invoked.
+type GitserverService_CommitGraphClientTrailerFunc struct {
+	defaultHook func() metadata.MD
+	hooks       []func() metadata.MD
+	history     []GitserverService_CommitGraphClientTrailerFuncCall
+	mutex       sync.Mutex
+}
+
+// Trailer delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphClient) Trailer() metadata.MD {
+	r0 := m.TrailerFunc.nextHook()()
+	m.TrailerFunc.appendCall(GitserverService_CommitGraphClientTrailerFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Trailer method of
+// the parent MockGitserverService_CommitGraphClient instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphClientTrailerFunc) SetDefaultHook(hook func() metadata.MD) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Trailer method of the parent MockGitserverService_CommitGraphClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphClientTrailerFunc) PushHook(hook func() metadata.MD) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphClientTrailerFunc) SetDefaultReturn(r0 metadata.MD) {
+	f.SetDefaultHook(func() metadata.MD {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphClientTrailerFunc) PushReturn(r0 metadata.MD) {
+	f.PushHook(func() metadata.MD {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphClientTrailerFunc) nextHook() func() metadata.MD {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphClientTrailerFunc) appendCall(r0 GitserverService_CommitGraphClientTrailerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphClientTrailerFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphClientTrailerFunc) History() []GitserverService_CommitGraphClientTrailerFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphClientTrailerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphClientTrailerFuncCall is an object that
+// describes an invocation of method Trailer on an instance of
+// MockGitserverService_CommitGraphClient.
+type GitserverService_CommitGraphClientTrailerFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 metadata.MD
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphClientTrailerFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphClientTrailerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockGitserverService_CommitGraphServer is a mock implementation of the
+// GitserverService_CommitGraphServer interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/gitserver/v1) used for unit
+// testing.
+type MockGitserverService_CommitGraphServer struct {
+	// ContextFunc is an instance of a mock function object controlling the
+	// behavior of the method Context.
+	ContextFunc *GitserverService_CommitGraphServerContextFunc
+	// RecvMsgFunc is an instance of a mock function object controlling the
+	// behavior of the method RecvMsg.
+	RecvMsgFunc *GitserverService_CommitGraphServerRecvMsgFunc
+	// SendFunc is an instance of a mock function object controlling the
+	// behavior of the method Send.
+	SendFunc *GitserverService_CommitGraphServerSendFunc
+	// SendHeaderFunc is an instance of a mock function object controlling
+	// the behavior of the method SendHeader.
+	SendHeaderFunc *GitserverService_CommitGraphServerSendHeaderFunc
+	// SendMsgFunc is an instance of a mock function object controlling the
+	// behavior of the method SendMsg.
+	SendMsgFunc *GitserverService_CommitGraphServerSendMsgFunc
+	// SetHeaderFunc is an instance of a mock function object controlling
+	// the behavior of the method SetHeader.
+	SetHeaderFunc *GitserverService_CommitGraphServerSetHeaderFunc
+	// SetTrailerFunc is an instance of a mock function object controlling
+	// the behavior of the method SetTrailer.
+	SetTrailerFunc *GitserverService_CommitGraphServerSetTrailerFunc
+}
+
+// NewMockGitserverService_CommitGraphServer creates a new mock of the
+// GitserverService_CommitGraphServer interface. All methods return zero
+// values for all results, unless overwritten.
+func NewMockGitserverService_CommitGraphServer() *MockGitserverService_CommitGraphServer {
+	return &MockGitserverService_CommitGraphServer{
+		ContextFunc: &GitserverService_CommitGraphServerContextFunc{
+			defaultHook: func() (r0 context.Context) {
+				return
+			},
+		},
+		RecvMsgFunc: &GitserverService_CommitGraphServerRecvMsgFunc{
+			defaultHook: func(interface{}) (r0 error) {
+				return
+			},
+		},
+		SendFunc: &GitserverService_CommitGraphServerSendFunc{
+			defaultHook: func(*v1.CommitGraphResponse) (r0 error) {
+				return
+			},
+		},
+		SendHeaderFunc: &GitserverService_CommitGraphServerSendHeaderFunc{
+			defaultHook: func(metadata.MD) (r0 error) {
+				return
+			},
+		},
+		SendMsgFunc: &GitserverService_CommitGraphServerSendMsgFunc{
+			defaultHook: func(interface{}) (r0 error) {
+				return
+			},
+		},
+		SetHeaderFunc: &GitserverService_CommitGraphServerSetHeaderFunc{
+			defaultHook: func(metadata.MD) (r0 error) {
+				return
+			},
+		},
+		SetTrailerFunc: &GitserverService_CommitGraphServerSetTrailerFunc{
+			defaultHook: func(metadata.MD) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGitserverService_CommitGraphServer creates a new mock of the
+// GitserverService_CommitGraphServer interface. All methods panic on
+// invocation, unless overwritten.
+func NewStrictMockGitserverService_CommitGraphServer() *MockGitserverService_CommitGraphServer {
+	return &MockGitserverService_CommitGraphServer{
+		ContextFunc: &GitserverService_CommitGraphServerContextFunc{
+			defaultHook: func() context.Context {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.Context")
+			},
+		},
+		RecvMsgFunc: &GitserverService_CommitGraphServerRecvMsgFunc{
+			defaultHook: func(interface{}) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.RecvMsg")
+			},
+		},
+		SendFunc: &GitserverService_CommitGraphServerSendFunc{
+			defaultHook: func(*v1.CommitGraphResponse) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.Send")
+			},
+		},
+		SendHeaderFunc: &GitserverService_CommitGraphServerSendHeaderFunc{
+			defaultHook: func(metadata.MD) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.SendHeader")
+			},
+		},
+		SendMsgFunc: &GitserverService_CommitGraphServerSendMsgFunc{
+			defaultHook: func(interface{}) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.SendMsg")
+			},
+		},
+		SetHeaderFunc: &GitserverService_CommitGraphServerSetHeaderFunc{
+			defaultHook: func(metadata.MD) error {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.SetHeader")
+			},
+		},
+		SetTrailerFunc: &GitserverService_CommitGraphServerSetTrailerFunc{
+			defaultHook: func(metadata.MD) {
+				panic("unexpected invocation of MockGitserverService_CommitGraphServer.SetTrailer")
+			},
+		},
+	}
+}
+
+// NewMockGitserverService_CommitGraphServerFrom creates a new mock of the
+// MockGitserverService_CommitGraphServer interface. All methods delegate to
+// the given implementation, unless overwritten.
+func NewMockGitserverService_CommitGraphServerFrom(i v1.GitserverService_CommitGraphServer) *MockGitserverService_CommitGraphServer {
+	return &MockGitserverService_CommitGraphServer{
+		ContextFunc: &GitserverService_CommitGraphServerContextFunc{
+			defaultHook: i.Context,
+		},
+		RecvMsgFunc: &GitserverService_CommitGraphServerRecvMsgFunc{
+			defaultHook: i.RecvMsg,
+		},
+		SendFunc: &GitserverService_CommitGraphServerSendFunc{
+			defaultHook: i.Send,
+		},
+		SendHeaderFunc: &GitserverService_CommitGraphServerSendHeaderFunc{
+			defaultHook: i.SendHeader,
+		},
+		SendMsgFunc: &GitserverService_CommitGraphServerSendMsgFunc{
+			defaultHook: i.SendMsg,
+		},
+		SetHeaderFunc: &GitserverService_CommitGraphServerSetHeaderFunc{
+			defaultHook: i.SetHeader,
+		},
+		SetTrailerFunc: &GitserverService_CommitGraphServerSetTrailerFunc{
+			defaultHook: i.SetTrailer,
+		},
+	}
+}
+
+// GitserverService_CommitGraphServerContextFunc describes the behavior when
+// the Context method of the parent MockGitserverService_CommitGraphServer
+// instance is invoked.
+type GitserverService_CommitGraphServerContextFunc struct {
+	defaultHook func() context.Context
+	hooks       []func() context.Context
+	history     []GitserverService_CommitGraphServerContextFuncCall
+	mutex       sync.Mutex
+}
+
+// Context delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) Context() context.Context {
+	r0 := m.ContextFunc.nextHook()()
+	m.ContextFunc.appendCall(GitserverService_CommitGraphServerContextFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Context method of
+// the parent MockGitserverService_CommitGraphServer instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphServerContextFunc) SetDefaultHook(hook func() context.Context) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Context method of the parent MockGitserverService_CommitGraphServer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphServerContextFunc) PushHook(hook func() context.Context) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerContextFunc) SetDefaultReturn(r0 context.Context) {
+	f.SetDefaultHook(func() context.Context {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerContextFunc) PushReturn(r0 context.Context) {
+	f.PushHook(func() context.Context {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphServerContextFunc) nextHook() func() context.Context {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerContextFunc) appendCall(r0 GitserverService_CommitGraphServerContextFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerContextFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphServerContextFunc) History() []GitserverService_CommitGraphServerContextFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerContextFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerContextFuncCall is an object that
+// describes an invocation of method Context on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerContextFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 context.Context
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerContextFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphServerRecvMsgFunc describes the behavior when
+// the RecvMsg method of the parent MockGitserverService_CommitGraphServer
+// instance is invoked.
+type GitserverService_CommitGraphServerRecvMsgFunc struct {
+	defaultHook func(interface{}) error
+	hooks       []func(interface{}) error
+	history     []GitserverService_CommitGraphServerRecvMsgFuncCall
+	mutex       sync.Mutex
+}
+
+// RecvMsg delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) RecvMsg(v0 interface{}) error {
+	r0 := m.RecvMsgFunc.nextHook()(v0)
+	m.RecvMsgFunc.appendCall(GitserverService_CommitGraphServerRecvMsgFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RecvMsg method of
+// the parent MockGitserverService_CommitGraphServer instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) SetDefaultHook(hook func(interface{}) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RecvMsg method of the parent MockGitserverService_CommitGraphServer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) PushHook(hook func(interface{}) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(interface{}) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) PushReturn(r0 error) {
+	f.PushHook(func(interface{}) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) nextHook() func(interface{}) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) appendCall(r0 GitserverService_CommitGraphServerRecvMsgFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerRecvMsgFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphServerRecvMsgFunc) History() []GitserverService_CommitGraphServerRecvMsgFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerRecvMsgFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerRecvMsgFuncCall is an object that
+// describes an invocation of method RecvMsg on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerRecvMsgFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 interface{}
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerRecvMsgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerRecvMsgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphServerSendFunc describes the behavior when
+// the Send method of the parent MockGitserverService_CommitGraphServer
+// instance is invoked.
+type GitserverService_CommitGraphServerSendFunc struct {
+	defaultHook func(*v1.CommitGraphResponse) error
+	hooks       []func(*v1.CommitGraphResponse) error
+	history     []GitserverService_CommitGraphServerSendFuncCall
+	mutex       sync.Mutex
+}
+
+// Send delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) Send(v0 *v1.CommitGraphResponse) error {
+	r0 := m.SendFunc.nextHook()(v0)
+	m.SendFunc.appendCall(GitserverService_CommitGraphServerSendFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Send method of the
+// parent MockGitserverService_CommitGraphServer instance is invoked and the
+// hook queue is empty.
+func (f *GitserverService_CommitGraphServerSendFunc) SetDefaultHook(hook func(*v1.CommitGraphResponse) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Send method of the parent MockGitserverService_CommitGraphServer instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverService_CommitGraphServerSendFunc) PushHook(hook func(*v1.CommitGraphResponse) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerSendFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(*v1.CommitGraphResponse) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerSendFunc) PushReturn(r0 error) {
+	f.PushHook(func(*v1.CommitGraphResponse) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphServerSendFunc) nextHook() func(*v1.CommitGraphResponse) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerSendFunc) appendCall(r0 GitserverService_CommitGraphServerSendFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerSendFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphServerSendFunc) History() []GitserverService_CommitGraphServerSendFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerSendFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerSendFuncCall is an object that
+// describes an invocation of method Send on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerSendFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 *v1.CommitGraphResponse
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerSendFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerSendFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphServerSendHeaderFunc describes the behavior
+// when the SendHeader method of the parent
+// MockGitserverService_CommitGraphServer instance is invoked.
+type GitserverService_CommitGraphServerSendHeaderFunc struct {
+	defaultHook func(metadata.MD) error
+	hooks       []func(metadata.MD) error
+	history     []GitserverService_CommitGraphServerSendHeaderFuncCall
+	mutex       sync.Mutex
+}
+
+// SendHeader delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) SendHeader(v0 metadata.MD) error {
+	r0 := m.SendHeaderFunc.nextHook()(v0)
+	m.SendHeaderFunc.appendCall(GitserverService_CommitGraphServerSendHeaderFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SendHeader method of
+// the parent MockGitserverService_CommitGraphServer instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) SetDefaultHook(hook func(metadata.MD) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SendHeader method of the parent MockGitserverService_CommitGraphServer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) PushHook(hook func(metadata.MD) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(metadata.MD) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) PushReturn(r0 error) {
+	f.PushHook(func(metadata.MD) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) nextHook() func(metadata.MD) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) appendCall(r0 GitserverService_CommitGraphServerSendHeaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerSendHeaderFuncCall objects describing
+// the invocations of this function.
+func (f *GitserverService_CommitGraphServerSendHeaderFunc) History() []GitserverService_CommitGraphServerSendHeaderFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerSendHeaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerSendHeaderFuncCall is an object that
+// describes an invocation of method SendHeader on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerSendHeaderFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 metadata.MD
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerSendHeaderFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerSendHeaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphServerSendMsgFunc describes the behavior when
+// the SendMsg method of the parent MockGitserverService_CommitGraphServer
+// instance is invoked.
+type GitserverService_CommitGraphServerSendMsgFunc struct {
+	defaultHook func(interface{}) error
+	hooks       []func(interface{}) error
+	history     []GitserverService_CommitGraphServerSendMsgFuncCall
+	mutex       sync.Mutex
+}
+
+// SendMsg delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) SendMsg(v0 interface{}) error {
+	r0 := m.SendMsgFunc.nextHook()(v0)
+	m.SendMsgFunc.appendCall(GitserverService_CommitGraphServerSendMsgFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SendMsg method of
+// the parent MockGitserverService_CommitGraphServer instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphServerSendMsgFunc) SetDefaultHook(hook func(interface{}) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SendMsg method of the parent MockGitserverService_CommitGraphServer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphServerSendMsgFunc) PushHook(hook func(interface{}) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerSendMsgFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(interface{}) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerSendMsgFunc) PushReturn(r0 error) {
+	f.PushHook(func(interface{}) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphServerSendMsgFunc) nextHook() func(interface{}) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerSendMsgFunc) appendCall(r0 GitserverService_CommitGraphServerSendMsgFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerSendMsgFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverService_CommitGraphServerSendMsgFunc) History() []GitserverService_CommitGraphServerSendMsgFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerSendMsgFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerSendMsgFuncCall is an object that
+// describes an invocation of method SendMsg on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerSendMsgFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 interface{}
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerSendMsgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerSendMsgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphServerSetHeaderFunc describes the behavior
+// when the SetHeader method of the parent
+// MockGitserverService_CommitGraphServer instance is invoked.
+type GitserverService_CommitGraphServerSetHeaderFunc struct {
+	defaultHook func(metadata.MD) error
+	hooks       []func(metadata.MD) error
+	history     []GitserverService_CommitGraphServerSetHeaderFuncCall
+	mutex       sync.Mutex
+}
+
+// SetHeader delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) SetHeader(v0 metadata.MD) error {
+	r0 := m.SetHeaderFunc.nextHook()(v0)
+	m.SetHeaderFunc.appendCall(GitserverService_CommitGraphServerSetHeaderFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetHeader method of
+// the parent MockGitserverService_CommitGraphServer instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) SetDefaultHook(hook func(metadata.MD) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetHeader method of the parent MockGitserverService_CommitGraphServer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) PushHook(hook func(metadata.MD) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(metadata.MD) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) PushReturn(r0 error) {
+	f.PushHook(func(metadata.MD) error {
+		return r0
+	})
+}
+
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) nextHook() func(metadata.MD) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) appendCall(r0 GitserverService_CommitGraphServerSetHeaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerSetHeaderFuncCall objects describing
+// the invocations of this function.
+func (f *GitserverService_CommitGraphServerSetHeaderFunc) History() []GitserverService_CommitGraphServerSetHeaderFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerSetHeaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerSetHeaderFuncCall is an object that
+// describes an invocation of method SetHeader on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerSetHeaderFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 metadata.MD
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerSetHeaderFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerSetHeaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitserverService_CommitGraphServerSetTrailerFunc describes the behavior
+// when the SetTrailer method of the parent
+// MockGitserverService_CommitGraphServer instance is invoked.
+type GitserverService_CommitGraphServerSetTrailerFunc struct {
+	defaultHook func(metadata.MD)
+	hooks       []func(metadata.MD)
+	history     []GitserverService_CommitGraphServerSetTrailerFuncCall
+	mutex       sync.Mutex
+}
+
+// SetTrailer delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverService_CommitGraphServer) SetTrailer(v0 metadata.MD) {
+	m.SetTrailerFunc.nextHook()(v0)
+	m.SetTrailerFunc.appendCall(GitserverService_CommitGraphServerSetTrailerFuncCall{v0})
+	return
+}
+
+// SetDefaultHook sets function that is called when the SetTrailer method of
+// the parent MockGitserverService_CommitGraphServer instance is invoked and
+// the hook queue is empty.
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) SetDefaultHook(hook func(metadata.MD)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetTrailer method of the parent MockGitserverService_CommitGraphServer
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) PushHook(hook func(metadata.MD)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(metadata.MD) {
+		return
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) PushReturn() {
+	f.PushHook(func(metadata.MD) {
+		return
+	})
+}
+
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) nextHook() func(metadata.MD) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) appendCall(r0 GitserverService_CommitGraphServerSetTrailerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverService_CommitGraphServerSetTrailerFuncCall objects describing
+// the invocations of this function.
+func (f *GitserverService_CommitGraphServerSetTrailerFunc) History() []GitserverService_CommitGraphServerSetTrailerFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverService_CommitGraphServerSetTrailerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverService_CommitGraphServerSetTrailerFuncCall is an object that
+// describes an invocation of method SetTrailer on an instance of
+// MockGitserverService_CommitGraphServer.
+type GitserverService_CommitGraphServerSetTrailerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 metadata.MD
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverService_CommitGraphServerSetTrailerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverService_CommitGraphServerSetTrailerFuncCall) Results() []interface{} {
+	return []interface{}{}
+}
+
 // MockGitserverService_CommitLogClient is a mock implementation of the
 // GitserverService_CommitLogClient interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/gitserver/v1) used for unit
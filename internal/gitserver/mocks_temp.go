@@ -39,6 +39,9 @@ type MockClient struct {
 	// CheckPerforceCredentialsFunc is an instance of a mock function object
 	// controlling the behavior of the method CheckPerforceCredentials.
 	CheckPerforceCredentialsFunc *ClientCheckPerforceCredentialsFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *ClientCommitGraphFunc
 	// CommitsFunc is an instance of a mock function object controlling the
 	// behavior of the method Commits.
 	CommitsFunc *ClientCommitsFunc
@@ -87,6 +90,9 @@ type MockClient struct {
 	// NewFileReaderFunc is an instance of a mock function object
 	// controlling the behavior of the method NewFileReader.
 	NewFileReaderFunc *ClientNewFileReaderFunc
+	// NewLFSPointerReaderFunc is an instance of a mock function object
+	// controlling the behavior of the method NewLFSPointerReader.
+	NewLFSPointerReaderFunc *ClientNewLFSPointerReaderFunc
 	// PerforceGetChangelistFunc is an instance of a mock function object
 	// controlling the behavior of the method PerforceGetChangelist.
 	PerforceGetChangelistFunc *ClientPerforceGetChangelistFunc
@@ -163,6 +169,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		CommitGraphFunc: &ClientCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitGraphOptions) (r0 []*gitdomain.CommitGraphNode, r1 error) {
+				return
+			},
+		},
 		CommitsFunc: &ClientCommitsFunc{
 			defaultHook: func(context.Context, api.RepoName, CommitsOptions) (r0 []*gitdomain.Commit, r1 error) {
 				return
@@ -243,6 +254,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		NewLFSPointerReaderFunc: &ClientNewLFSPointerReaderFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
 		PerforceGetChangelistFunc: &ClientPerforceGetChangelistFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails, string) (r0 *perforce.Changelist, r1 error) {
 				return
@@ -350,6 +366,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.CheckPerforceCredentials")
 			},
 		},
+		CommitGraphFunc: &ClientCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+				panic("unexpected invocation of MockClient.CommitGraph")
+			},
+		},
 		CommitsFunc: &ClientCommitsFunc{
 			defaultHook: func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
 				panic("unexpected invocation of MockClient.Commits")
@@ -430,6 +451,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.NewFileReader")
 			},
 		},
+		NewLFSPointerReaderFunc: &ClientNewLFSPointerReaderFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockClient.NewLFSPointerReader")
+			},
+		},
 		PerforceGetChangelistFunc: &ClientPerforceGetChangelistFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
 				panic("unexpected invocation of MockClient.PerforceGetChangelist")
@@ -527,6 +553,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		CheckPerforceCredentialsFunc: &ClientCheckPerforceCredentialsFunc{
 			defaultHook: i.CheckPerforceCredentials,
 		},
+		CommitGraphFunc: &ClientCommitGraphFunc{
+			defaultHook: i.CommitGraph,
+		},
 		CommitsFunc: &ClientCommitsFunc{
 			defaultHook: i.Commits,
 		},
@@ -575,6 +604,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		NewFileReaderFunc: &ClientNewFileReaderFunc{
 			defaultHook: i.NewFileReader,
 		},
+		NewLFSPointerReaderFunc: &ClientNewLFSPointerReaderFunc{
+			defaultHook: i.NewLFSPointerReader,
+		},
 		PerforceGetChangelistFunc: &ClientPerforceGetChangelistFunc{
 			defaultHook: i.PerforceGetChangelist,
 		},
@@ -1170,6 +1202,116 @@ func (c ClientCheckPerforceCredentialsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// ClientCommitGraphFunc describes the behavior when the CommitGraph method
+// of the parent MockClient instance is invoked.
+type ClientCommitGraphFunc struct {
+	defaultHook func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)
+	hooks       []func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)
+	history     []ClientCommitGraphFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CommitGraph(v0 context.Context, v1 api.RepoName, v2 CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
+	m.CommitGraphFunc.appendCall(ClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientCommitGraphFunc) SetDefaultReturn(r0 []*gitdomain.CommitGraphNode, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientCommitGraphFunc) PushReturn(r0 []*gitdomain.CommitGraphNode, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientCommitGraphFunc) nextHook() func(context.Context, api.RepoName, CommitGraphOptions) ([]*gitdomain.CommitGraphNode, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientCommitGraphFunc) appendCall(r0 ClientCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *ClientCommitGraphFunc) History() []ClientCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientCommitGraphFuncCall is an object that describes an invocation of
+// method CommitGraph on an instance of MockClient.
+type ClientCommitGraphFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 CommitGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitdomain.CommitGraphNode
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientCommitsFunc describes the behavior when the Commits method of the
 // parent MockClient instance is invoked.
 type ClientCommitsFunc struct {
@@ -2934,6 +3076,120 @@ func (c ClientNewFileReaderFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientNewLFSPointerReaderFunc describes the behavior when the
+// NewLFSPointerReader method of the parent MockClient instance is invoked.
+type ClientNewLFSPointerReaderFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
+	history     []ClientNewLFSPointerReaderFuncCall
+	mutex       sync.Mutex
+}
+
+// NewLFSPointerReader delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) NewLFSPointerReader(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (io.ReadCloser, error) {
+	r0, r1 := m.NewLFSPointerReaderFunc.nextHook()(v0, v1, v2, v3)
+	m.NewLFSPointerReaderFunc.appendCall(ClientNewLFSPointerReaderFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the NewLFSPointerReader
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientNewLFSPointerReaderFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// NewLFSPointerReader method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientNewLFSPointerReaderFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientNewLFSPointerReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientNewLFSPointerReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientNewLFSPointerReaderFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientNewLFSPointerReaderFunc) appendCall(r0 ClientNewLFSPointerReaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientNewLFSPointerReaderFuncCall objects
+// describing the invocations of this function.
+func (f *ClientNewLFSPointerReaderFunc) History() []ClientNewLFSPointerReaderFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientNewLFSPointerReaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientNewLFSPointerReaderFuncCall is an object that describes an
+// invocation of method NewLFSPointerReader on an instance of MockClient.
+type ClientNewLFSPointerReaderFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientNewLFSPointerReaderFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientNewLFSPointerReaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientPerforceGetChangelistFunc describes the behavior when the
 // PerforceGetChangelist method of the parent MockClient instance is
 // invoked.
@@ -15,6 +15,7 @@ import (
 type operations struct {
 	archiveReader            *observation.Operation
 	commits                  *observation.Operation
+	commitGraph              *observation.Operation
 	contributorCount         *observation.Operation
 	firstEverCommit          *observation.Operation
 	behindAhead              *observation.Operation
@@ -23,6 +24,7 @@ type operations struct {
 	lstat                    *observation.Operation
 	mergeBase                *observation.Operation
 	newFileReader            *observation.Operation
+	newLFSPointerReader      *observation.Operation
 	readDir                  *observation.Operation
 	resolveRevision          *observation.Operation
 	revAtTime                *observation.Operation
@@ -97,6 +99,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 	return &operations{
 		archiveReader:            op("ArchiveReader"),
 		commits:                  op("Commits"),
+		commitGraph:              op("CommitGraph"),
 		contributorCount:         op("ContributorCount"),
 		firstEverCommit:          op("FirstEverCommit"),
 		behindAhead:              op("BehindAhead"),
@@ -105,6 +108,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		lstat:                    subOp("lStat"),
 		mergeBase:                op("MergeBase"),
 		newFileReader:            op("NewFileReader"),
+		newLFSPointerReader:      op("NewLFSPointerReader"),
 		readDir:                  op("ReadDir"),
 		resolveRevision:          resolveRevisionOperation,
 		revAtTime:                op("RevAtTime"),
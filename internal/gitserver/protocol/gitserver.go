@@ -101,6 +101,9 @@ type CommitMatch struct {
 	Message       result.MatchedString `json:",omitempty"`
 	Diff          result.MatchedString `json:",omitempty"`
 	ModifiedFiles []string             `json:",omitempty"`
+	// CopiedFiles are the new paths of the files in Diff that git detected as
+	// copies, which Diff doesn't tell apart from renames.
+	CopiedFiles []string `json:",omitempty"`
 }
 
 func (cm *CommitMatch) ToProto() *proto.CommitMatch {
@@ -118,6 +121,7 @@ func (cm *CommitMatch) ToProto() *proto.CommitMatch {
 		Message:       matchedStringToProto(cm.Message),
 		Diff:          matchedStringToProto(cm.Diff),
 		ModifiedFiles: cm.ModifiedFiles,
+		CopiedFiles:   cm.CopiedFiles,
 	}
 }
 
@@ -136,6 +140,7 @@ func CommitMatchFromProto(p *proto.CommitMatch) CommitMatch {
 		Message:       matchedStringFromProto(p.GetMessage()),
 		Diff:          matchedStringFromProto(p.GetDiff()),
 		ModifiedFiles: p.GetModifiedFiles(),
+		CopiedFiles:   p.GetCopiedFiles(),
 	}
 }
 
@@ -582,6 +587,12 @@ type SystemInfo struct {
 	FreeSpace   uint64
 	TotalSpace  uint64
 	PercentUsed float32
+	// TieredRepos is the number of repositories that have been offloaded to
+	// cold storage.
+	TieredRepos uint64
+	// TieredSizeBytes is the size of the repositories that have been offloaded
+	// to cold storage.
+	TieredSizeBytes uint64
 }
 
 type PerforceUsersRequest struct {
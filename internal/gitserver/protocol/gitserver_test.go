@@ -76,6 +76,8 @@ func TestCommitMatchProtoRoundtrip(t *testing.T) {
 				End:   result.Location{Offset: 111, Line: 222, Column: 333},
 			}},
 		},
+		ModifiedFiles: []string{"a.go", "b.go"},
+		CopiedFiles:   []string{"b.go"},
 	}
 
 	protoReq := req.ToProto()
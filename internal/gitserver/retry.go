@@ -185,6 +185,11 @@ func (r *automaticRetryClient) CommitLog(ctx context.Context, in *proto.CommitLo
 	return r.base.CommitLog(ctx, in, opts...)
 }
 
+func (r *automaticRetryClient) CommitGraph(ctx context.Context, in *proto.CommitGraphRequest, opts ...grpc.CallOption) (proto.GitserverService_CommitGraphClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CommitGraph(ctx, in, opts...)
+}
+
 func (r *automaticRetryClient) MergeBaseOctopus(ctx context.Context, in *proto.MergeBaseOctopusRequest, opts ...grpc.CallOption) (*proto.MergeBaseOctopusResponse, error) {
 	opts = append(defaults.RetryPolicy, opts...)
 	return r.base.MergeBaseOctopus(ctx, in, opts...)
@@ -226,6 +226,7 @@ const (
 	GitserverService_Stat_FullMethodName                        = "/gitserver.v1.GitserverService/Stat"
 	GitserverService_ReadDir_FullMethodName                     = "/gitserver.v1.GitserverService/ReadDir"
 	GitserverService_CommitLog_FullMethodName                   = "/gitserver.v1.GitserverService/CommitLog"
+	GitserverService_CommitGraph_FullMethodName                 = "/gitserver.v1.GitserverService/CommitGraph"
 	GitserverService_MergeBaseOctopus_FullMethodName            = "/gitserver.v1.GitserverService/MergeBaseOctopus"
 )
 
@@ -440,6 +441,17 @@ type GitserverServiceClient interface {
 	// If one of the given ranges doesn't exist, an error with a ReversionNotFoundPayload
 	// is returned.
 	CommitLog(ctx context.Context, in *CommitLogRequest, opts ...grpc.CallOption) (GitserverService_CommitLogClient, error)
+	// CommitGraph streams the parent graph of all commits matching the options.
+	// Unlike CommitLog, only the commit IDs, their parents and the committer date
+	// are returned, in a compact binary form, so that the ancestry of very large
+	// repositories can be transferred cheaply. The commits are gathered with
+	// `git rev-list`.
+	// If one of the given ranges doesn't exist, an error with a RevisionNotFoundPayload
+	// is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	CommitGraph(ctx context.Context, in *CommitGraphRequest, opts ...grpc.CallOption) (GitserverService_CommitGraphClient, error)
 	// MergeBaseOctopus returns the octopus merge base commit sha for the specified
 	// revspecs.
 	// If no common merge base exists, an empty string is returned.
@@ -1000,6 +1012,38 @@ func (x *gitserverServiceCommitLogClient) Recv() (*CommitLogResponse, error) {
 	return m, nil
 }
 
+func (c *gitserverServiceClient) CommitGraph(ctx context.Context, in *CommitGraphRequest, opts ...grpc.CallOption) (GitserverService_CommitGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[10], GitserverService_CommitGraph_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceCommitGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_CommitGraphClient interface {
+	Recv() (*CommitGraphResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceCommitGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceCommitGraphClient) Recv() (*CommitGraphResponse, error) {
+	m := new(CommitGraphResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gitserverServiceClient) MergeBaseOctopus(ctx context.Context, in *MergeBaseOctopusRequest, opts ...grpc.CallOption) (*MergeBaseOctopusResponse, error) {
 	out := new(MergeBaseOctopusResponse)
 	err := c.cc.Invoke(ctx, GitserverService_MergeBaseOctopus_FullMethodName, in, out, opts...)
@@ -1220,6 +1264,17 @@ type GitserverServiceServer interface {
 	// If one of the given ranges doesn't exist, an error with a ReversionNotFoundPayload
 	// is returned.
 	CommitLog(*CommitLogRequest, GitserverService_CommitLogServer) error
+	// CommitGraph streams the parent graph of all commits matching the options.
+	// Unlike CommitLog, only the commit IDs, their parents and the committer date
+	// are returned, in a compact binary form, so that the ancestry of very large
+	// repositories can be transferred cheaply. The commits are gathered with
+	// `git rev-list`.
+	// If one of the given ranges doesn't exist, an error with a RevisionNotFoundPayload
+	// is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	CommitGraph(*CommitGraphRequest, GitserverService_CommitGraphServer) error
 	// MergeBaseOctopus returns the octopus merge base commit sha for the specified
 	// revspecs.
 	// If no common merge base exists, an empty string is returned.
@@ -1353,6 +1408,9 @@ func (UnimplementedGitserverServiceServer) ReadDir(*ReadDirRequest, GitserverSer
 func (UnimplementedGitserverServiceServer) CommitLog(*CommitLogRequest, GitserverService_CommitLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method CommitLog not implemented")
 }
+func (UnimplementedGitserverServiceServer) CommitGraph(*CommitGraphRequest, GitserverService_CommitGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method CommitGraph not implemented")
+}
 func (UnimplementedGitserverServiceServer) MergeBaseOctopus(context.Context, *MergeBaseOctopusRequest) (*MergeBaseOctopusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MergeBaseOctopus not implemented")
 }
@@ -1980,6 +2038,27 @@ func (x *gitserverServiceCommitLogServer) Send(m *CommitLogResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GitserverService_CommitGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CommitGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).CommitGraph(m, &gitserverServiceCommitGraphServer{stream})
+}
+
+type GitserverService_CommitGraphServer interface {
+	Send(*CommitGraphResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceCommitGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceCommitGraphServer) Send(m *CommitGraphResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GitserverService_MergeBaseOctopus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MergeBaseOctopusRequest)
 	if err := dec(in); err != nil {
@@ -2149,6 +2228,11 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GitserverService_CommitLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CommitGraph",
+			Handler:       _GitserverService_CommitGraph_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gitserver.proto",
 }
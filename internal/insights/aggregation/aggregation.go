@@ -2,6 +2,8 @@ package aggregation
 
 import (
 	"context"
+	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,9 +20,20 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/client"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	sTypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/languages"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+const (
+	// DefaultDirectoryDepth is the number of leading directories used to group
+	// results when aggregating by directory and no depth is requested.
+	DefaultDirectoryDepth = 1
+
+	// CommitMonthFormat is the layout of the groups produced when aggregating
+	// by commit month.
+	CommitMonthFormat = "2006-01"
+)
+
 type AggregationMatchResult struct {
 	Key   MatchKey
 	Count int
@@ -167,12 +180,129 @@ func countRepoMetadata(r result.Match, repo *sTypes.Repo) (map[MatchKey]int, err
 	return matches, nil
 }
 
-func GetCountFuncForMode(query, patternType string, mode types.SearchAggregationMode) (AggregationCountFunc, error) {
+func countLanguage(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	var language string
+	switch match := r.(type) {
+	case *result.FileMatch:
+		// Detecting the language from the file contents would require a round
+		// trip to gitserver per result, so only the path is considered. For
+		// ambiguous paths the first candidate is used.
+		langs, _ := languages.GetLanguages(match.Path, nil)
+		if len(langs) > 0 {
+			language = langs[0]
+		}
+	default:
+	}
+	if language != "" {
+		return map[MatchKey]int{{
+			RepoID: int32(r.RepoName().ID),
+			Repo:   string(r.RepoName().Name),
+			Group:  language,
+		}: r.ResultCount()}, nil
+	}
+	return nil, nil
+}
+
+func countFileExtension(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	var filePath string
+	switch match := r.(type) {
+	case *result.FileMatch:
+		filePath = match.Path
+	default:
+	}
+	if filePath != "" {
+		return map[MatchKey]int{{
+			RepoID: int32(r.RepoName().ID),
+			Repo:   string(r.RepoName().Name),
+			Group:  fileExtension(filePath),
+		}: r.ResultCount()}, nil
+	}
+	return nil, nil
+}
+
+// fileExtension returns the lowercased extension of the file, including the
+// leading dot. Dotfiles such as .gitignore are considered to have no extension.
+func fileExtension(filePath string) string {
+	base := path.Base(filePath)
+	ext := path.Ext(base)
+	if ext == "" || ext == base {
+		return types.NO_FILE_EXTENSION_TEXT
+	}
+	return strings.ToLower(ext)
+}
+
+func countDirectoryFunc(depth int) AggregationCountFunc {
+	if depth <= 0 {
+		depth = DefaultDirectoryDepth
+	}
+	return func(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+		var filePath string
+		switch match := r.(type) {
+		case *result.FileMatch:
+			filePath = match.Path
+		default:
+		}
+		if filePath != "" {
+			return map[MatchKey]int{{
+				RepoID: int32(r.RepoName().ID),
+				Repo:   string(r.RepoName().Name),
+				Group:  directoryPrefix(filePath, depth),
+			}: r.ResultCount()}, nil
+		}
+		return nil, nil
+	}
+}
+
+// directoryPrefix returns the first depth directories of the file's path with
+// a trailing slash, for example "cmd/frontend/" for cmd/frontend/main.go at a
+// depth of 2. Files at the root of the repository are grouped together.
+func directoryPrefix(filePath string, depth int) string {
+	dir := path.Dir(strings.TrimPrefix(filePath, "/"))
+	if dir == "." {
+		return types.ROOT_DIRECTORY_TEXT
+	}
+	segments := strings.Split(dir, "/")
+	if len(segments) > depth {
+		segments = segments[:depth]
+	}
+	return strings.Join(segments, "/") + "/"
+}
+
+func countCommitMonth(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	var month string
+	switch match := r.(type) {
+	case *result.CommitMatch:
+		// The after: and before: filters used for drilldowns operate on the
+		// committer date, so we prefer it over the author date.
+		date := match.Commit.Author.Date
+		if match.Commit.Committer != nil && !match.Commit.Committer.Date.IsZero() {
+			date = match.Commit.Committer.Date
+		}
+		if !date.IsZero() {
+			month = date.UTC().Format(CommitMonthFormat)
+		}
+	default:
+	}
+	if month != "" {
+		return map[MatchKey]int{{
+			RepoID: int32(r.RepoName().ID),
+			Repo:   string(r.RepoName().Name),
+			Group:  month,
+		}: r.ResultCount()}, nil
+	}
+	return nil, nil
+}
+
+func GetCountFuncForMode(query, patternType string, mode types.SearchAggregationMode, directoryDepth int) (AggregationCountFunc, error) {
 	modeCountTypes := map[types.SearchAggregationMode]AggregationCountFunc{
-		types.REPO_AGGREGATION_MODE:          countRepo,
-		types.PATH_AGGREGATION_MODE:          countPath,
-		types.AUTHOR_AGGREGATION_MODE:        countAuthor,
-		types.REPO_METADATA_AGGREGATION_MODE: countRepoMetadata,
+		types.REPO_AGGREGATION_MODE:            countRepo,
+		types.PATH_AGGREGATION_MODE:            countPath,
+		types.AUTHOR_AGGREGATION_MODE:          countAuthor,
+		types.REPO_METADATA_AGGREGATION_MODE:   countRepoMetadata,
+		types.LANGUAGE_AGGREGATION_MODE:        countLanguage,
+		types.FILE_EXTENSION_AGGREGATION_MODE:  countFileExtension,
+		types.DIRECTORY_DEPTH_AGGREGATION_MODE: countDirectoryFunc(directoryDepth),
+		types.COMMIT_MONTH_AGGREGATION_MODE:    countCommitMonth,
 	}
 
 	if mode == types.CAPTURE_GROUP_AGGREGATION_MODE {
@@ -102,7 +102,7 @@ func commitMatch(repo, author string, date time.Time, repoID, numRanges int32, c
 
 	return &result.CommitMatch{
 		Commit: gitdomain.Commit{
-			Author:    gitdomain.Signature{Name: author},
+			Author:    gitdomain.Signature{Name: author, Date: date},
 			Committer: &gitdomain.Signature{},
 			Message:   gitdomain.Message(content),
 		},
@@ -260,7 +260,7 @@ func TestRepoAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -330,7 +330,7 @@ func TestAuthorAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -438,7 +438,7 @@ func TestPathAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -649,7 +649,7 @@ func TestCaptureGroupAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode, 0)
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
@@ -703,7 +703,7 @@ func TestRepoMetadataAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, db)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -711,6 +711,207 @@ func TestRepoMetadataAggregation(t *testing.T) {
 	}
 }
 
+func TestLanguageAggregation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mode        types.SearchAggregationMode
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"No results",
+			types.LANGUAGE_AGGREGATION_MODE, streaming.SearchEvent{}, autogold.Expect(map[string]int{})},
+		{
+			"no language for commit",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					commitMatch("repoA", "Author A", sampleDate, 1, 2, "a"),
+				},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"no language for unknown extension",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					pathMatch("myRepo", "file.notalanguage", 1),
+				},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"Count languages on multiple match types",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					repoMatch("myRepo", 1),
+					pathMatch("myRepo", "main.go", 1),
+					symbolMatch("myRepo", "cmd/main.go", 1, "c", "d"),
+					contentMatch("myRepo2", "client/index.ts", 2, "a", "b"),
+					contentMatch("myRepo2", "Dockerfile", 2, "a"),
+				},
+			},
+			autogold.Expect(map[string]int{"Dockerfile": 1, "Go": 3, "TypeScript": 2}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
+func TestFileExtensionAggregation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mode        types.SearchAggregationMode
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"No results",
+			types.FILE_EXTENSION_AGGREGATION_MODE, streaming.SearchEvent{}, autogold.Expect(map[string]int{})},
+		{
+			"no extension for commit",
+			types.FILE_EXTENSION_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					commitMatch("repoA", "Author A", sampleDate, 1, 2, "a"),
+				},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"Count extensions on multiple match types",
+			types.FILE_EXTENSION_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					repoMatch("myRepo", 1),
+					pathMatch("myRepo", "main.go", 1),
+					symbolMatch("myRepo", "cmd/main.go", 1, "c", "d"),
+					contentMatch("myRepo2", "docs/README.MD", 2, "a", "b"),
+					contentMatch("myRepo2", "Makefile", 2, "a"),
+					contentMatch("myRepo2", "dir.d/.gitignore", 2, "a"),
+				},
+			},
+			autogold.Expect(map[string]int{".go": 3, ".md": 2, "No extension": 2}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
+func TestDirectoryDepthAggregation(t *testing.T) {
+	results := []result.Match{
+		repoMatch("myRepo", 1),
+		commitMatch("repoA", "Author A", sampleDate, 1, 2, "a"),
+		pathMatch("myRepo", "README.md", 1),
+		pathMatch("myRepo", "cmd/frontend/main.go", 1),
+		symbolMatch("myRepo", "cmd/gitserver/server/server.go", 1, "c", "d"),
+		contentMatch("myRepo2", "internal/search/search.go", 2, "a", "b"),
+	}
+	testCases := []struct {
+		name  string
+		depth int
+		want  autogold.Value
+	}{
+		{
+			"default depth",
+			0,
+			autogold.Expect(map[string]int{"/": 1, "cmd/": 3, "internal/": 2}),
+		},
+		{
+			"depth of two",
+			2,
+			autogold.Expect(map[string]int{
+				"/": 1, "cmd/frontend/": 1, "cmd/gitserver/": 2,
+				"internal/search/": 2,
+			}),
+		},
+		{
+			"depth deeper than paths",
+			10,
+			autogold.Expect(map[string]int{
+				"/": 1, "cmd/frontend/": 1, "cmd/gitserver/server/": 2,
+				"internal/search/": 2,
+			}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mode := types.DIRECTORY_DEPTH_AGGREGATION_MODE
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode("", "", mode, tc.depth)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, mode, nil)
+			sra.Send(streaming.SearchEvent{Results: results})
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
+func TestCommitMonthAggregation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mode        types.SearchAggregationMode
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"No results",
+			types.COMMIT_MONTH_AGGREGATION_MODE, streaming.SearchEvent{}, autogold.Expect(map[string]int{})},
+		{
+			"No month for content match",
+			types.COMMIT_MONTH_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{contentMatch("myRepo", "file.go", 1, "a", "b")},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"No month for commit without date",
+			types.COMMIT_MONTH_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{diffMatch("myRepo", "author-a", 1)},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"Count months on commit matches",
+			types.COMMIT_MONTH_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					commitMatch("repoA", "Author A", sampleDate, 1, 2, "a"),
+					commitMatch("repoA", "Author B", sampleDate.AddDate(0, 0, 10), 1, 2, "a"),
+					commitMatch("repoB", "Author A", sampleDate.AddDate(0, -1, 0), 2, 2, "a"),
+				},
+			},
+			autogold.Expect(map[string]int{"2022-03": 2, "2022-04": 4}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode("", "", tc.mode, 0)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
 func TestAggregationCancelation(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -736,7 +937,7 @@ func TestAggregationCancelation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode, 0)
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
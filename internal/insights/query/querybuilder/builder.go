@@ -495,6 +495,68 @@ func AddRepoMetadataFilter(query BasicQuery, repoMeta string) (BasicQuery, error
 	return BasicQuery(searchquery.StringHuman(mutatedQuery.ToQ())), nil
 }
 
+// AddLanguageFilter adds a lang: filter for the given language name to the query.
+func AddLanguageFilter(query BasicQuery, language string) (BasicQuery, error) {
+	parameter := searchquery.Parameter{Field: searchquery.FieldLang, Value: language}
+	if strings.Contains(language, " ") {
+		parameter.Annotation.Labels = searchquery.Quoted
+	}
+	return addParameters(query, parameter)
+}
+
+// AddFileExtensionFilter adds a file: filter matching files with the given
+// extension, including the leading dot, to the query.
+func AddFileExtensionFilter(query BasicQuery, extension string) (BasicQuery, error) {
+	if extension == types.NO_FILE_EXTENSION_TEXT {
+		return query, errors.New("Can't search for no file extension")
+	}
+	return addParameters(query, searchquery.Parameter{
+		Field: searchquery.FieldFile,
+		Value: regexp.QuoteMeta(extension) + "$",
+	})
+}
+
+// AddDirectoryFilter adds a file: filter matching files in the given directory
+// to the query. The root directory matches files that are not in a directory.
+func AddDirectoryFilter(query BasicQuery, directory string) (BasicQuery, error) {
+	if directory == types.ROOT_DIRECTORY_TEXT {
+		return addParameters(query, searchquery.Parameter{Field: searchquery.FieldFile, Value: "/", Negated: true})
+	}
+	value := "^" + regexp.QuoteMeta(directory)
+	if strings.Contains(directory, " ") {
+		value = "(" + value + ")"
+	}
+	return addParameters(query, searchquery.Parameter{Field: searchquery.FieldFile, Value: value})
+}
+
+// AddCommitMonthFilter adds after: and before: filters to the query that
+// restrict it to commits made in the given month, formatted as "2006-01".
+func AddCommitMonthFilter(query BasicQuery, month string) (BasicQuery, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing month")
+	}
+	return addParameters(query,
+		searchquery.Parameter{Field: searchquery.FieldAfter, Value: start.Format(time.DateOnly)},
+		searchquery.Parameter{Field: searchquery.FieldBefore, Value: start.AddDate(0, 1, 0).Format(time.DateOnly)},
+	)
+}
+
+func addParameters(query BasicQuery, parameters ...searchquery.Parameter) (BasicQuery, error) {
+	plan, err := searchquery.Pipeline(searchquery.Init(string(query), searchquery.SearchTypeLiteral))
+	if err != nil {
+		return "", err
+	}
+
+	mutatedQuery := searchquery.MapPlan(plan, func(basic searchquery.Basic) searchquery.Basic {
+		modified := make([]searchquery.Parameter, 0, len(basic.Parameters)+len(parameters))
+		modified = append(modified, basic.Parameters...)
+		modified = append(modified, parameters...)
+		return basic.MapParameters(modified)
+	})
+	return BasicQuery(searchquery.StringHuman(mutatedQuery.ToQ())), nil
+}
+
 func buildFilterText(raw string) string {
 	quoted := regexp.QuoteMeta(raw)
 	if strings.Contains(raw, " ") {
@@ -620,6 +620,152 @@ func Test_addFileFilter(t *testing.T) {
 	}
 }
 
+func Test_addLanguageFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		language string
+		want     autogold.Value
+	}{
+		{
+			name:     "single word language",
+			input:    "myquery repo:supergreat",
+			language: "Go",
+			want:     autogold.Expect(BasicQuery("repo:supergreat lang:Go myquery")),
+		},
+		{
+			name:     "language with spaces",
+			input:    "myquery",
+			language: "Visual Basic .NET",
+			want:     autogold.Expect(BasicQuery(`lang:"Visual Basic .NET" myquery`)),
+		},
+		{
+			name:     "compound query adding language",
+			input:    "(myquery repo:supergreat) or (big repo:asdf)",
+			language: "Go",
+			want:     autogold.Expect(BasicQuery("(repo:supergreat lang:Go myquery OR repo:asdf lang:Go big)")),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AddLanguageFilter(BasicQuery(test.input), test.language)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
+func Test_addFileExtensionFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		extension string
+		want      autogold.Value
+	}{
+		{
+			name:      "extension",
+			input:     "myquery repo:supergreat",
+			extension: ".go",
+			want:      autogold.Expect(BasicQuery("repo:supergreat file:\\.go$ myquery")),
+		},
+		{
+			name:      "no extension",
+			input:     "myquery",
+			extension: "No extension",
+			want:      autogold.Expect("Can't search for no file extension"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AddFileExtensionFilter(BasicQuery(test.input), test.extension)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
+func Test_addDirectoryFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		directory string
+		want      autogold.Value
+	}{
+		{
+			name:      "directory",
+			input:     "myquery repo:supergreat",
+			directory: "cmd/frontend.d/",
+			want:      autogold.Expect(BasicQuery("repo:supergreat file:^cmd/frontend\\.d/ myquery")),
+		},
+		{
+			name:      "directory with spaces",
+			input:     "myquery",
+			directory: "my docs/",
+			want:      autogold.Expect(BasicQuery("file:(^my docs/) myquery")),
+		},
+		{
+			name:      "root directory",
+			input:     "myquery",
+			directory: "/",
+			want:      autogold.Expect(BasicQuery("-file:/ myquery")),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AddDirectoryFilter(BasicQuery(test.input), test.directory)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
+func Test_addCommitMonthFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		month string
+		want  autogold.Value
+	}{
+		{
+			name:  "month",
+			input: "myquery type:commit",
+			month: "2022-04",
+			want:  autogold.Expect(BasicQuery("type:commit after:2022-04-01 before:2022-05-01 myquery")),
+		},
+		{
+			name:  "end of year",
+			input: "myquery type:diff",
+			month: "2022-12",
+			want:  autogold.Expect(BasicQuery("type:diff after:2022-12-01 before:2023-01-01 myquery")),
+		},
+		{
+			name:  "invalid month",
+			input: "myquery type:diff",
+			month: "April",
+			want:  autogold.Expect(`parsing month: parsing time "April" as "2006-01": cannot parse "April" as "2006"`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AddCommitMonthFilter(BasicQuery(test.input), test.month)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
 func Test_addRepoMetadataFilter(t *testing.T) {
 	tests := []struct {
 		name         string
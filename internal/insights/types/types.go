@@ -209,14 +209,18 @@ type RecordingTime struct {
 type SearchAggregationMode string
 
 const (
-	REPO_AGGREGATION_MODE          SearchAggregationMode = "REPO"
-	PATH_AGGREGATION_MODE          SearchAggregationMode = "PATH"
-	AUTHOR_AGGREGATION_MODE        SearchAggregationMode = "AUTHOR"
-	CAPTURE_GROUP_AGGREGATION_MODE SearchAggregationMode = "CAPTURE_GROUP"
-	REPO_METADATA_AGGREGATION_MODE SearchAggregationMode = "REPO_METADATA"
+	REPO_AGGREGATION_MODE            SearchAggregationMode = "REPO"
+	PATH_AGGREGATION_MODE            SearchAggregationMode = "PATH"
+	AUTHOR_AGGREGATION_MODE          SearchAggregationMode = "AUTHOR"
+	CAPTURE_GROUP_AGGREGATION_MODE   SearchAggregationMode = "CAPTURE_GROUP"
+	REPO_METADATA_AGGREGATION_MODE   SearchAggregationMode = "REPO_METADATA"
+	LANGUAGE_AGGREGATION_MODE        SearchAggregationMode = "LANGUAGE"
+	FILE_EXTENSION_AGGREGATION_MODE  SearchAggregationMode = "FILE_EXTENSION"
+	DIRECTORY_DEPTH_AGGREGATION_MODE SearchAggregationMode = "DIRECTORY_DEPTH"
+	COMMIT_MONTH_AGGREGATION_MODE    SearchAggregationMode = "COMMIT_MONTH"
 )
 
-var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE}
+var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE, LANGUAGE_AGGREGATION_MODE, FILE_EXTENSION_AGGREGATION_MODE, DIRECTORY_DEPTH_AGGREGATION_MODE, COMMIT_MONTH_AGGREGATION_MODE}
 
 type AggregationNotAvailableReasonType string
 
@@ -229,5 +233,7 @@ const (
 )
 
 const (
-	NO_REPO_METADATA_TEXT = "No metadata"
+	NO_REPO_METADATA_TEXT  = "No metadata"
+	NO_FILE_EXTENSION_TEXT = "No extension"
+	ROOT_DIRECTORY_TEXT    = "/"
 )
@@ -0,0 +1,101 @@
+package object
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+)
+
+type azureAPI interface {
+	CreateContainer(ctx context.Context, container string) error
+	ListBlobs(container, prefix string) azureBlobPager
+	DownloadStream(ctx context.Context, container, name string) (io.ReadCloser, error)
+	UploadStream(ctx context.Context, container, name string, r io.Reader) error
+	DeleteBlob(ctx context.Context, container, name string) error
+}
+
+type azureBlobPager interface {
+	More() bool
+	NextPage(ctx context.Context) ([]azureBlob, error)
+}
+
+// azureBlob is the subset of blob properties we care about.
+type azureBlob struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+type azureAPIShim struct{ client *azblob.Client }
+type azureBlobPagerShim struct {
+	pager *runtime.Pager[azblob.ListBlobsFlatResponse]
+}
+
+var _ azureAPI = &azureAPIShim{}
+var _ azureBlobPager = &azureBlobPagerShim{}
+
+// CreateContainer creates the given container. It is not an error for the container
+// to already exist.
+func (s *azureAPIShim) CreateContainer(ctx context.Context, container string) error {
+	if _, err := s.client.CreateContainer(ctx, container, nil); err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		return err
+	}
+	return nil
+}
+
+func (s *azureAPIShim) ListBlobs(container, prefix string) azureBlobPager {
+	options := &azblob.ListBlobsFlatOptions{}
+	if prefix != "" {
+		options.Prefix = &prefix
+	}
+	return &azureBlobPagerShim{pager: s.client.NewListBlobsFlatPager(container, options)}
+}
+
+func (s *azureAPIShim) DownloadStream(ctx context.Context, container, name string) (io.ReadCloser, error) {
+	resp, err := s.client.DownloadStream(ctx, container, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *azureAPIShim) UploadStream(ctx context.Context, container, name string, r io.Reader) error {
+	_, err := s.client.UploadStream(ctx, container, name, r, nil)
+	return err
+}
+
+// DeleteBlob deletes the given blob. It is not an error for the blob to not exist.
+func (s *azureAPIShim) DeleteBlob(ctx context.Context, container, name string) error {
+	if _, err := s.client.DeleteBlob(ctx, container, name, nil); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *azureBlobPagerShim) More() bool {
+	return s.pager.More()
+}
+
+func (s *azureBlobPagerShim) NextPage(ctx context.Context) ([]azureBlob, error) {
+	page, err := s.pager.NextPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := make([]azureBlob, 0, len(page.Segment.BlobItems))
+	for _, item := range page.Segment.BlobItems {
+		if item.Name == nil {
+			continue
+		}
+		blob := azureBlob{Name: *item.Name}
+		if item.Properties != nil && item.Properties.CreationTime != nil {
+			blob.CreatedAt = *item.Properties.CreationTime
+		}
+		blobs = append(blobs, blob)
+	}
+
+	return blobs, nil
+}
@@ -0,0 +1,256 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	sglog "github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+type azureStore struct {
+	container    string
+	manageBucket bool
+	client       azureAPI
+	operations   *Operations
+}
+
+var _ Storage = &azureStore{}
+
+type AzureConfig struct {
+	AccountName string
+	// AccountKey is the shared key of the storage account. If empty, the default Azure
+	// credential chain (environment, workload identity, managed identity) is used.
+	AccountKey string
+	// Endpoint overrides the blob service URL, which defaults to
+	// https://<AccountName>.blob.core.windows.net/. This is useful for Azurite.
+	Endpoint string
+}
+
+// newAzureFromConfig creates a new store backed by Azure Blob Storage. The configured
+// bucket is used as the container name.
+func newAzureFromConfig(ctx context.Context, config StorageConfig, operations *Operations) (Storage, error) {
+	client, err := azureClient(config.Azure)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAzureWithClient(&azureAPIShim{client}, config.Bucket, config.ManageBucket, operations), nil
+}
+
+func newAzureWithClient(client azureAPI, container string, manageBucket bool, operations *Operations) *azureStore {
+	return &azureStore{
+		container:    container,
+		manageBucket: manageBucket,
+		client:       client,
+		operations:   operations,
+	}
+}
+
+func (s *azureStore) Init(ctx context.Context) error {
+	if !s.manageBucket {
+		return nil
+	}
+
+	if err := s.client.CreateContainer(ctx, s.container); err != nil {
+		return errors.Wrap(err, "failed to create container")
+	}
+
+	return nil
+}
+
+func (s *azureStore) List(ctx context.Context, prefix string) (_ *iterator.Iterator[string], err error) {
+	ctx, _, endObservation := s.operations.List.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("prefix", prefix),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	pager := s.client.ListBlobs(s.container, prefix)
+
+	next := func() ([]string, error) {
+		// Skip over empty pages, an empty result ends the iteration.
+		for pager.More() {
+			blobs, err := pager.NextPage(ctx)
+			if err != nil {
+				s.operations.List.Logger.Error("Failed to list blobs in Azure container", sglog.Error(err))
+				return nil, err
+			}
+			if len(blobs) == 0 {
+				continue
+			}
+
+			keys := make([]string, 0, len(blobs))
+			for _, blob := range blobs {
+				keys = append(keys, blob.Name)
+			}
+			return keys, nil
+		}
+
+		return nil, nil
+	}
+
+	return iterator.New[string](next), nil
+}
+
+func (s *azureStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := s.operations.Get.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("key", key),
+	}})
+	done := func() { endObservation(1, observation.Args{}) }
+
+	rc, err := s.client.DownloadStream(ctx, s.container, key)
+	if err != nil {
+		done()
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return newExtraCloser(rc, done), nil
+}
+
+func (s *azureStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Upload.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	cr := &countingReader{r: r}
+	if err := s.client.UploadStream(ctx, s.container, key, cr); err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return int64(cr.n), nil
+}
+
+// Compose streams the source objects, in order, into the destination object. Azure
+// has no server-side equivalent of a GCS compose or an S3 multipart copy that works
+// across blobs without a SAS token, so the data passes through this process.
+func (s *azureStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Compose.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("destination", destination),
+		attribute.StringSlice("sources", sources),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(ctx, sources); err != nil {
+				s.operations.Compose.Logger.Error("Failed to delete source objects", sglog.Error(err))
+			}
+		}
+	}()
+
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(s.readSourcesInto(ctx, pw, sources))
+	}()
+	// Unblock the writer if the upload stops consuming early.
+	defer pr.Close()
+
+	cr := &countingReader{r: pr}
+	if err := s.client.UploadStream(ctx, s.container, destination, cr); err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return int64(cr.n), nil
+}
+
+func (s *azureStore) Delete(ctx context.Context, key string) (err error) {
+	ctx, _, endObservation := s.operations.Delete.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return errors.Wrap(s.client.DeleteBlob(ctx, s.container, key), "failed to delete object")
+}
+
+func (s *azureStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	ctx, _, endObservation := s.operations.ExpireObjects.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("prefix", prefix),
+		attribute.Stringer("maxAge", maxAge),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	pager := s.client.ListBlobs(s.container, prefix)
+	for pager.More() {
+		blobs, err := pager.NextPage(ctx)
+		if err != nil {
+			s.operations.ExpireObjects.Logger.Error("Failed to iterate Azure container", sglog.Error(err))
+			break // we'll try again later
+		}
+
+		for _, blob := range blobs {
+			if time.Since(blob.CreatedAt) < maxAge {
+				continue
+			}
+
+			if err := s.client.DeleteBlob(ctx, s.container, blob.Name); err != nil {
+				s.operations.ExpireObjects.Logger.Error("Failed to delete expired Azure blob",
+					sglog.Error(err),
+					sglog.String("container", s.container),
+					sglog.String("blob", blob.Name))
+				continue
+			}
+		}
+	}
+	return nil
+}
+
+func (s *azureStore) readSourcesInto(ctx context.Context, w io.Writer, sources []string) error {
+	for _, source := range sources {
+		rc, err := s.client.DownloadStream(ctx, s.container, source)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get source object %q", source)
+		}
+
+		_, err = io.Copy(w, rc)
+		rc.Close()
+		if err != nil {
+			return errors.Wrapf(err, "failed to read source object %q", source)
+		}
+	}
+
+	return nil
+}
+
+func (s *azureStore) deleteSources(ctx context.Context, sources []string) error {
+	return forEachString(sources, func(index int, source string) error {
+		if err := s.client.DeleteBlob(ctx, s.container, source); err != nil {
+			return errors.Wrap(err, "failed to delete source object")
+		}
+
+		return nil
+	})
+}
+
+func azureClient(config AzureConfig) (*azblob.Client, error) {
+	serviceURL := config.Endpoint
+	if serviceURL == "" {
+		if config.AccountName == "" {
+			return nil, errors.New("an Azure account name or endpoint is required")
+		}
+		serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net/", config.AccountName)
+	}
+
+	if config.AccountKey != "" {
+		cred, err := azblob.NewSharedKeyCredential(config.AccountName, config.AccountKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid Azure shared key credential")
+		}
+		return azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default Azure credential")
+	}
+	return azblob.NewClient(serviceURL, cred, nil)
+}
@@ -0,0 +1,138 @@
+package object
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestAzureStorageConformance(t *testing.T) {
+	testStorageConformance(t, func(t *testing.T) Storage {
+		store := rawAzureClient(newFakeAzureAPI(), true)
+		if err := store.Init(context.Background()); err != nil {
+			t.Fatalf("unexpected error initializing store: %s", err)
+		}
+		return store
+	})
+}
+
+func TestAzureInit(t *testing.T) {
+	client := newFakeAzureAPI()
+	store := rawAzureClient(client, true)
+	if err := store.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing store: %s", err)
+	}
+	if _, ok := client.containers["test-container"]; !ok {
+		t.Errorf("expected container to be created")
+	}
+}
+
+func TestAzureInitNoManageBucket(t *testing.T) {
+	client := newFakeAzureAPI()
+	store := rawAzureClient(client, false)
+	if err := store.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing store: %s", err)
+	}
+	if len(client.containers) != 0 {
+		t.Errorf("unexpected container creation")
+	}
+}
+
+func rawAzureClient(client azureAPI, manageBucket bool) *azureStore {
+	return newAzureWithClient(client, "test-container", manageBucket, NewOperations(&observation.TestContext, "test", "azurestore"))
+}
+
+// fakeAzureAPI is an in-memory azureAPI. Pages are deliberately small to exercise
+// pagination.
+type fakeAzureAPI struct {
+	mu         sync.Mutex
+	containers map[string]map[string]fakeAzureBlob
+}
+
+type fakeAzureBlob struct {
+	content   []byte
+	createdAt time.Time
+}
+
+var _ azureAPI = &fakeAzureAPI{}
+
+func newFakeAzureAPI() *fakeAzureAPI {
+	return &fakeAzureAPI{containers: map[string]map[string]fakeAzureBlob{}}
+}
+
+func (f *fakeAzureAPI) CreateContainer(_ context.Context, container string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.containers[container]; !ok {
+		f.containers[container] = map[string]fakeAzureBlob{}
+	}
+	return nil
+}
+
+func (f *fakeAzureAPI) ListBlobs(container, prefix string) azureBlobPager {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var blobs []azureBlob
+	for name, blob := range f.containers[container] {
+		if strings.HasPrefix(name, prefix) {
+			blobs = append(blobs, azureBlob{Name: name, CreatedAt: blob.createdAt})
+		}
+	}
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].Name < blobs[j].Name })
+	return &fakeAzureBlobPager{blobs: blobs}
+}
+
+func (f *fakeAzureAPI) DownloadStream(_ context.Context, container, name string) (io.ReadCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	blob, ok := f.containers[container][name]
+	if !ok {
+		return nil, errors.Newf("blob %q not found", name)
+	}
+	return io.NopCloser(strings.NewReader(string(blob.content))), nil
+}
+
+func (f *fakeAzureAPI) UploadStream(_ context.Context, container, name string, r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	blobs, ok := f.containers[container]
+	if !ok {
+		return errors.Newf("container %q not found", container)
+	}
+	blobs[name] = fakeAzureBlob{content: content, createdAt: time.Now()}
+	return nil
+}
+
+func (f *fakeAzureAPI) DeleteBlob(_ context.Context, container, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.containers[container], name)
+	return nil
+}
+
+type fakeAzureBlobPager struct {
+	blobs   []azureBlob
+	fetched bool
+}
+
+func (p *fakeAzureBlobPager) More() bool {
+	return !p.fetched || len(p.blobs) > 0
+}
+
+func (p *fakeAzureBlobPager) NextPage(_ context.Context) ([]azureBlob, error) {
+	p.fetched = true
+	page := p.blobs[:min(len(p.blobs), 10)]
+	p.blobs = p.blobs[len(page):]
+	return page, nil
+}
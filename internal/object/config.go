@@ -12,6 +12,8 @@ type StorageConfig struct {
 	Bucket       string
 	S3           S3Config
 	GCS          GCSConfig
+	Azure        AzureConfig
+	Local        LocalConfig
 }
 
 func normalizeConfig(t StorageConfig) StorageConfig {
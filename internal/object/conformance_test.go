@@ -0,0 +1,239 @@
+package object
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testStorageConformance runs the behavior shared by all Storage implementations
+// against stores returned by newStore, which must be empty and initialized.
+func testStorageConformance(t *testing.T, newStore func(t *testing.T) Storage) {
+	ctx := context.Background()
+
+	upload := func(t *testing.T, store Storage, key, content string) {
+		t.Helper()
+		n, err := store.Upload(ctx, key, bytes.NewReader([]byte(content)))
+		if err != nil {
+			t.Fatalf("unexpected error uploading %q: %s", key, err)
+		}
+		if n != int64(len(content)) {
+			t.Errorf("unexpected upload size for %q. want=%d have=%d", key, len(content), n)
+		}
+	}
+
+	get := func(t *testing.T, store Storage, key string) string {
+		t.Helper()
+		rc, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("unexpected error getting %q: %s", key, err)
+		}
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("unexpected error reading %q: %s", key, err)
+		}
+		return string(content)
+	}
+
+	list := func(t *testing.T, store Storage, prefix string) []string {
+		t.Helper()
+		it, err := store.List(ctx, prefix)
+		if err != nil {
+			t.Fatalf("unexpected error listing %q: %s", prefix, err)
+		}
+		keys := []string{}
+		for it.Next() {
+			keys = append(keys, it.Current())
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("unexpected error iterating %q: %s", prefix, err)
+		}
+		return keys
+	}
+
+	t.Run("upload and get", func(t *testing.T) {
+		store := newStore(t)
+		upload(t, store, "foo/bar.txt", "hello")
+		if content := get(t, store, "foo/bar.txt"); content != "hello" {
+			t.Errorf("unexpected content. want=%q have=%q", "hello", content)
+		}
+	})
+
+	t.Run("upload overwrites", func(t *testing.T) {
+		store := newStore(t)
+		upload(t, store, "key", "first")
+		upload(t, store, "key", "second")
+		if content := get(t, store, "key"); content != "second" {
+			t.Errorf("unexpected content. want=%q have=%q", "second", content)
+		}
+	})
+
+	t.Run("get missing object", func(t *testing.T) {
+		store := newStore(t)
+		// Some stores stream lazily and only report the missing object on read.
+		rc, err := store.Get(ctx, "missing")
+		if err == nil {
+			_, err = io.ReadAll(rc)
+			rc.Close()
+		}
+		if err == nil {
+			t.Fatal("expected an error getting a missing object")
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		store := newStore(t)
+		for _, key := range []string{"b/2", "a/1", "a-1", "a/2/3", "c"} {
+			upload(t, store, key, key)
+		}
+
+		if diff := cmp.Diff([]string{"a-1", "a/1", "a/2/3", "b/2", "c"}, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"a/1", "a/2/3"}, list(t, store, "a/")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"a-1", "a/1", "a/2/3"}, list(t, store, "a")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{}, list(t, store, "d")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("list many", func(t *testing.T) {
+		store := newStore(t)
+		var want []string
+		for i := 0; i < 25; i++ {
+			key := fmt.Sprintf("many/%02d", i)
+			upload(t, store, key, key)
+			want = append(want, key)
+		}
+
+		if diff := cmp.Diff(want, list(t, store, "many/")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		store := newStore(t)
+		upload(t, store, "dir/key", "content")
+		upload(t, store, "other", "content")
+
+		if err := store.Delete(ctx, "dir/key"); err != nil {
+			t.Fatalf("unexpected error deleting object: %s", err)
+		}
+		if rc, err := store.Get(ctx, "dir/key"); err == nil {
+			_, err = io.ReadAll(rc)
+			rc.Close()
+			if err == nil {
+				t.Fatal("expected an error getting a deleted object")
+			}
+		}
+		if diff := cmp.Diff([]string{"other"}, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+
+		if err := store.Delete(ctx, "dir/key"); err != nil {
+			t.Fatalf("unexpected error deleting a missing object: %s", err)
+		}
+	})
+
+	t.Run("concurrent upload and delete", func(t *testing.T) {
+		store := newStore(t)
+		const n = 50
+		for i := 0; i < n; i++ {
+			upload(t, store, fmt.Sprintf("race/%d/old", i), "content")
+		}
+
+		// Deleting the only object in a directory while uploading another
+		// one to it must not fail the upload.
+		var wg sync.WaitGroup
+		var want []string
+		for i := 0; i < n; i++ {
+			oldKey, newKey := fmt.Sprintf("race/%d/old", i), fmt.Sprintf("race/%d/new", i)
+			want = append(want, newKey)
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				if err := store.Delete(ctx, oldKey); err != nil {
+					t.Errorf("unexpected error deleting %q: %s", oldKey, err)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				if _, err := store.Upload(ctx, newKey, bytes.NewReader([]byte("content"))); err != nil {
+					t.Errorf("unexpected error uploading %q: %s", newKey, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		sort.Strings(want)
+		if diff := cmp.Diff(want, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("compose", func(t *testing.T) {
+		store := newStore(t)
+		upload(t, store, "parts/1", "foo")
+		upload(t, store, "parts/2", "bar")
+		upload(t, store, "parts/3", "baz")
+
+		n, err := store.Compose(ctx, "composed", "parts/1", "parts/2", "parts/3")
+		if err != nil {
+			t.Fatalf("unexpected error composing objects: %s", err)
+		}
+		if n != 9 {
+			t.Errorf("unexpected composed size. want=%d have=%d", 9, n)
+		}
+		if content := get(t, store, "composed"); content != "foobarbaz" {
+			t.Errorf("unexpected content. want=%q have=%q", "foobarbaz", content)
+		}
+		if diff := cmp.Diff([]string{"composed"}, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys after compose (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("compose missing source", func(t *testing.T) {
+		store := newStore(t)
+		upload(t, store, "parts/1", "foo")
+
+		if _, err := store.Compose(ctx, "composed", "parts/1", "parts/missing"); err == nil {
+			t.Fatal("expected an error composing a missing object")
+		}
+		if diff := cmp.Diff([]string{"parts/1"}, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys after failed compose (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("expire objects", func(t *testing.T) {
+		store := newStore(t)
+		upload(t, store, "expire/1", "content")
+		upload(t, store, "expire/2", "content")
+		upload(t, store, "keep/1", "content")
+
+		if err := store.ExpireObjects(ctx, "expire/", time.Hour); err != nil {
+			t.Fatalf("unexpected error expiring objects: %s", err)
+		}
+		if diff := cmp.Diff([]string{"expire/1", "expire/2", "keep/1"}, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys after expiring recent objects (-want +have):\n%s", diff)
+		}
+
+		if err := store.ExpireObjects(ctx, "expire/", 0); err != nil {
+			t.Fatalf("unexpected error expiring objects: %s", err)
+		}
+		if diff := cmp.Diff([]string{"keep/1"}, list(t, store, "")); diff != "" {
+			t.Errorf("unexpected keys after expiring all objects (-want +have):\n%s", diff)
+		}
+	})
+}
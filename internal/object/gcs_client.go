@@ -187,7 +187,12 @@ func (s *gcsStore) Delete(ctx context.Context, key string) (err error) {
 	}})
 	defer endObservation(1, observation.Args{})
 
-	return errors.Wrap(s.client.Bucket(s.bucket).Object(key).Delete(ctx), "failed to delete object")
+	// Deleting a missing object is not an error, matching the other stores.
+	if err := s.client.Bucket(s.bucket).Object(key).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return nil
 }
 
 func (s *gcsStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
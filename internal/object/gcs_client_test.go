@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
@@ -14,6 +17,16 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
+func TestGCSStorageConformance(t *testing.T) {
+	testStorageConformance(t, func(t *testing.T) Storage {
+		store := rawGCSClient(newFakeGCSAPI(), true)
+		if err := store.Init(context.Background()); err != nil {
+			t.Fatalf("unexpected error initializing store: %s", err)
+		}
+		return store
+	})
+}
+
 func TestGCSInit(t *testing.T) {
 	gcsClient := NewMockGcsAPI()
 	bucketHandle := NewMockGcsBucketHandle()
@@ -317,3 +330,163 @@ type nopCloser struct {
 func (nopCloser) Close() error {
 	return nil
 }
+
+// fakeGCSAPI is an in-memory gcsAPI. Like GCS, deleting or reading a missing object
+// returns storage.ErrObjectNotExist.
+type fakeGCSAPI struct {
+	mu      sync.Mutex
+	buckets map[string]map[string]fakeGCSObject
+}
+
+type fakeGCSObject struct {
+	content []byte
+	created time.Time
+}
+
+type fakeGCSBucketHandle struct {
+	api  *fakeGCSAPI
+	name string
+}
+
+type fakeGCSObjectHandle struct {
+	bucket *fakeGCSBucketHandle
+	name   string
+}
+
+type fakeGCSWriter struct {
+	bytes.Buffer
+	object *fakeGCSObjectHandle
+}
+
+type fakeGCSObjectIterator struct {
+	objects []*storage.ObjectAttrs
+}
+
+type fakeGCSComposer struct {
+	destination *fakeGCSObjectHandle
+	sources     []gcsObjectHandle
+}
+
+var (
+	_ gcsAPI            = &fakeGCSAPI{}
+	_ gcsBucketHandle   = &fakeGCSBucketHandle{}
+	_ gcsObjectHandle   = &fakeGCSObjectHandle{}
+	_ gcsObjectIterator = &fakeGCSObjectIterator{}
+	_ gcsComposer       = &fakeGCSComposer{}
+)
+
+func newFakeGCSAPI() *fakeGCSAPI {
+	return &fakeGCSAPI{buckets: map[string]map[string]fakeGCSObject{}}
+}
+
+func (f *fakeGCSAPI) Bucket(name string) gcsBucketHandle {
+	return &fakeGCSBucketHandle{api: f, name: name}
+}
+
+func (b *fakeGCSBucketHandle) Attrs(_ context.Context) (*storage.BucketAttrs, error) {
+	b.api.mu.Lock()
+	defer b.api.mu.Unlock()
+	if _, ok := b.api.buckets[b.name]; !ok {
+		return nil, storage.ErrBucketNotExist
+	}
+	return &storage.BucketAttrs{Name: b.name}, nil
+}
+
+func (b *fakeGCSBucketHandle) Create(_ context.Context, _ string, _ *storage.BucketAttrs) error {
+	b.api.mu.Lock()
+	defer b.api.mu.Unlock()
+	b.api.buckets[b.name] = map[string]fakeGCSObject{}
+	return nil
+}
+
+func (b *fakeGCSBucketHandle) Object(name string) gcsObjectHandle {
+	return &fakeGCSObjectHandle{bucket: b, name: name}
+}
+
+func (b *fakeGCSBucketHandle) Objects(_ context.Context, q *storage.Query) gcsObjectIterator {
+	b.api.mu.Lock()
+	defer b.api.mu.Unlock()
+	var objects []*storage.ObjectAttrs
+	for name, obj := range b.api.buckets[b.name] {
+		if strings.HasPrefix(name, q.Prefix) {
+			objects = append(objects, &storage.ObjectAttrs{Name: name, Size: int64(len(obj.content)), Created: obj.created})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+	return &fakeGCSObjectIterator{objects: objects}
+}
+
+func (o *fakeGCSObjectHandle) Delete(_ context.Context) error {
+	o.bucket.api.mu.Lock()
+	defer o.bucket.api.mu.Unlock()
+	objects := o.bucket.api.buckets[o.bucket.name]
+	if _, ok := objects[o.name]; !ok {
+		return storage.ErrObjectNotExist
+	}
+	delete(objects, o.name)
+	return nil
+}
+
+func (o *fakeGCSObjectHandle) NewRangeReader(_ context.Context, offset, length int64) (io.ReadCloser, error) {
+	o.bucket.api.mu.Lock()
+	defer o.bucket.api.mu.Unlock()
+	obj, ok := o.bucket.api.buckets[o.bucket.name][o.name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	content := obj.content[min(offset, int64(len(obj.content))):]
+	if length >= 0 {
+		content = content[:min(length, int64(len(content)))]
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (o *fakeGCSObjectHandle) NewWriter(_ context.Context) io.WriteCloser {
+	return &fakeGCSWriter{object: o}
+}
+
+func (o *fakeGCSObjectHandle) ComposerFrom(sources ...gcsObjectHandle) gcsComposer {
+	return &fakeGCSComposer{destination: o, sources: sources}
+}
+
+func (w *fakeGCSWriter) Close() error {
+	w.object.put(w.Bytes())
+	return nil
+}
+
+func (o *fakeGCSObjectHandle) put(content []byte) {
+	o.bucket.api.mu.Lock()
+	defer o.bucket.api.mu.Unlock()
+	o.bucket.api.buckets[o.bucket.name][o.name] = fakeGCSObject{content: content, created: time.Now()}
+}
+
+func (it *fakeGCSObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if len(it.objects) == 0 {
+		return nil, iterator.Done
+	}
+	attrs := it.objects[0]
+	it.objects = it.objects[1:]
+	return attrs, nil
+}
+
+func (it *fakeGCSObjectIterator) PageInfo() *iterator.PageInfo {
+	return nil
+}
+
+func (c *fakeGCSComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	var content []byte
+	for _, source := range c.sources {
+		rc, err := source.NewRangeReader(ctx, 0, -1)
+		if err != nil {
+			return nil, err
+		}
+		part, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, part...)
+	}
+	c.destination.put(content)
+	return &storage.ObjectAttrs{Name: c.destination.name, Size: int64(len(content))}, nil
+}
@@ -0,0 +1,366 @@
+package object
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	sglog "github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+// localStore is a store backed by a directory on the local filesystem. It is meant
+// for single-node deployments that don't want to run blobstore.
+//
+// Each object is a file at the path given by its key below the bucket directory. As
+// a consequence a key can't be both an object and a prefix of another object followed
+// by a slash, e.g. "a" and "a/b".
+type localStore struct {
+	root         string
+	tmpDir       string
+	manageBucket bool
+	operations   *Operations
+}
+
+var _ Storage = &localStore{}
+
+type LocalConfig struct {
+	// Dir is the directory that contains one directory per bucket.
+	Dir string
+}
+
+// newLocalFromConfig creates a new store backed by the local filesystem.
+func newLocalFromConfig(_ context.Context, config StorageConfig, operations *Operations) (Storage, error) {
+	if config.Local.Dir == "" {
+		return nil, errors.New("a directory is required for the local upload store backend")
+	}
+
+	return newLocalWithDir(config.Local.Dir, config.Bucket, config.ManageBucket, operations), nil
+}
+
+func newLocalWithDir(dir, bucket string, manageBucket bool, operations *Operations) *localStore {
+	return &localStore{
+		root: filepath.Join(dir, bucket),
+		// Uploads are staged outside of the bucket so that partially written objects
+		// are never visible, and are moved in place with an atomic rename.
+		tmpDir:       filepath.Join(dir, ".tmp"),
+		manageBucket: manageBucket,
+		operations:   operations,
+	}
+}
+
+func (s *localStore) Init(ctx context.Context) error {
+	if !s.manageBucket {
+		if _, err := os.Stat(s.root); err != nil {
+			return errors.Wrap(err, "failed to stat bucket directory")
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(s.root, 0o750); err != nil {
+		return errors.Wrap(err, "failed to create bucket directory")
+	}
+
+	return nil
+}
+
+func (s *localStore) List(ctx context.Context, prefix string) (_ *iterator.Iterator[string], err error) {
+	ctx, _, endObservation := s.operations.List.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("prefix", prefix),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var keys []string
+	if err := s.walk(prefix, func(key string, _ fs.FileInfo) error {
+		keys = append(keys, key)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list objects")
+	}
+	// Match the lexical key order of the other backends, which differs from the walk
+	// order for keys like "a/b" and "a-b".
+	sort.Strings(keys)
+
+	next := func() ([]string, error) {
+		page := keys[:min(len(keys), maxKeys)]
+		keys = keys[len(page):]
+		return page, nil
+	}
+
+	return iterator.New[string](next), nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	_, _, endObservation := s.operations.Get.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("key", key),
+	}})
+	done := func() { endObservation(1, observation.Args{}) }
+
+	path, err := s.path(key)
+	if err != nil {
+		done()
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		done()
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return newExtraCloser(f, done), nil
+}
+
+func (s *localStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	_, _, endObservation := s.operations.Upload.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	n, err := s.writeAtomically(key, func(w io.Writer) (int64, error) {
+		return io.Copy(w, r)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Compose.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("destination", destination),
+		attribute.StringSlice("sources", sources),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(ctx, sources); err != nil {
+				s.operations.Compose.Logger.Error("Failed to delete source objects", sglog.Error(err))
+			}
+		}
+	}()
+
+	n, err := s.writeAtomically(destination, func(w io.Writer) (int64, error) {
+		var total int64
+		for _, source := range sources {
+			n, err := s.copyObject(w, source)
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+		return total, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) (err error) {
+	_, _, endObservation := s.operations.Delete.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return errors.Wrap(s.remove(key), "failed to delete object")
+}
+
+func (s *localStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	_, _, endObservation := s.operations.ExpireObjects.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("prefix", prefix),
+		attribute.Stringer("maxAge", maxAge),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var expired []string
+	if err := s.walk(prefix, func(key string, info fs.FileInfo) error {
+		// Objects are never modified in place, so the modification time is the
+		// time the object was created.
+		if time.Since(info.ModTime()) >= maxAge {
+			expired = append(expired, key)
+		}
+		return nil
+	}); err != nil {
+		s.operations.ExpireObjects.Logger.Error("Failed to iterate bucket directory", sglog.Error(err))
+		return nil // we'll try again later
+	}
+
+	for _, key := range expired {
+		if err := s.remove(key); err != nil {
+			s.operations.ExpireObjects.Logger.Error("Failed to delete expired object",
+				sglog.Error(err),
+				sglog.String("root", s.root),
+				sglog.String("object", key))
+			continue
+		}
+	}
+	return nil
+}
+
+// path returns the file path of the object with the given key. Keys that would
+// escape the bucket directory are rejected.
+func (s *localStore) path(key string) (string, error) {
+	if key == "" || strings.HasSuffix(key, "/") || !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// writeAtomically stages the content written by fn in a temporary file, which is
+// renamed to the object's path once fn succeeded.
+func (s *localStore) writeAtomically(key string, fn func(w io.Writer) (int64, error)) (_ int64, err error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(s.tmpDir, 0o750); err != nil {
+		return 0, err
+	}
+	f, err := os.CreateTemp(s.tmpDir, "upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	n, err := fn(f)
+	if err != nil {
+		return 0, err
+	}
+	if err := f.Sync(); err != nil {
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+
+	err = moveInPlace(f.Name(), path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// A concurrent remove may have deleted the directory we created for
+		// the object because it was empty, so create it again once.
+		err = moveInPlace(f.Name(), path)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// moveInPlace renames the file at tmp to path, creating the parent directories
+// of path if needed.
+func moveInPlace(tmp, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *localStore) copyObject(w io.Writer, key string) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get source object %q", key)
+	}
+	defer f.Close()
+
+	return io.Copy(w, f)
+}
+
+// remove deletes the object with the given key, along with any directories that
+// became empty as a result. It is not an error for the object to not exist.
+func (s *localStore) remove(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for dir := filepath.Dir(path); dir != s.root && strings.HasPrefix(dir, s.root); dir = filepath.Dir(dir) {
+		// Fails if the directory isn't empty, which ends the cleanup.
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *localStore) deleteSources(ctx context.Context, sources []string) error {
+	return forEachString(sources, func(index int, source string) error {
+		if err := s.remove(source); err != nil {
+			return errors.Wrap(err, "failed to delete source object")
+		}
+
+		return nil
+	})
+}
+
+// walk invokes fn for every object whose key starts with the given prefix.
+func (s *localStore) walk(prefix string, fn func(key string, info fs.FileInfo) error) error {
+	return filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Nothing was uploaded yet, or deleted concurrently.
+				return nil
+			}
+			return err
+		}
+		if path == s.root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			// Only descend into directories that can contain matching keys.
+			if dirPrefix := key + "/"; !strings.HasPrefix(dirPrefix, prefix) && !strings.HasPrefix(prefix, dirPrefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Deleted concurrently
+				return nil
+			}
+			return err
+		}
+		return fn(key, info)
+	})
+}
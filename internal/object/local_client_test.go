@@ -0,0 +1,84 @@
+package object
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestLocalStorageConformance(t *testing.T) {
+	testStorageConformance(t, func(t *testing.T) Storage {
+		store := rawLocalClient(t.TempDir(), true)
+		if err := store.Init(context.Background()); err != nil {
+			t.Fatalf("unexpected error initializing store: %s", err)
+		}
+		return store
+	})
+}
+
+func TestLocalInitNoManageBucket(t *testing.T) {
+	dir := t.TempDir()
+	if err := rawLocalClient(dir, false).Init(context.Background()); err == nil {
+		t.Fatal("expected an error for a missing bucket directory")
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "test-bucket"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := rawLocalClient(dir, false).Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing store: %s", err)
+	}
+}
+
+func TestLocalInvalidKeys(t *testing.T) {
+	store := rawLocalClient(t.TempDir(), true)
+
+	for _, key := range []string{"", "../escape", "a/../../escape", "/absolute", "dir/"} {
+		if _, err := store.Upload(context.Background(), key, strings.NewReader("content")); err == nil {
+			t.Errorf("expected an error uploading key %q", key)
+		}
+	}
+}
+
+func TestLocalUploadIsAtomic(t *testing.T) {
+	dir := t.TempDir()
+	store := rawLocalClient(dir, true)
+	ctx := context.Background()
+
+	if _, err := store.Upload(ctx, "key", strings.NewReader("original")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	failingReader := io.MultiReader(strings.NewReader("partial"), errReader{errors.New("oops")})
+	if _, err := store.Upload(ctx, "key", failingReader); err == nil {
+		t.Fatal("expected an error uploading from a failing reader")
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "test-bucket", "key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "original" {
+		t.Errorf("unexpected content. want=%q have=%q", "original", content)
+	}
+
+	if entries, err := os.ReadDir(filepath.Join(dir, ".tmp")); err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 0 {
+		t.Errorf("unexpected leftover temporary files: %v", entries)
+	}
+}
+
+func rawLocalClient(dir string, manageBucket bool) *localStore {
+	return newLocalWithDir(dir, "test-bucket", manageBucket, NewOperations(&observation.TestContext, "test", "localstore"))
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read(p []byte) (int, error) { return 0, r.err }
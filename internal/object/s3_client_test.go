@@ -7,7 +7,10 @@ import (
 	"io"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -19,6 +22,17 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+func TestS3StorageConformance(t *testing.T) {
+	testStorageConformance(t, func(t *testing.T) Storage {
+		client := newFakeS3API()
+		store := rawS3Client(client, client)
+		if err := store.Init(context.Background()); err != nil {
+			t.Fatalf("unexpected error initializing store: %s", err)
+		}
+		return store
+	})
+}
+
 func TestS3Init(t *testing.T) {
 	s3Client := NewMockS3API()
 	client := testS3Client(s3Client, nil)
@@ -100,6 +114,7 @@ var bytesPattern = regexp.MustCompile(`bytes=(\d+)-`)
 
 func TestS3GetTransientErrors(t *testing.T) {
 	// read 50 bytes then return a connection reset error
+	t.Cleanup(func() { ioCopyHook = io.Copy })
 	ioCopyHook = func(w io.Writer, r io.Reader) (int64, error) {
 		var buf bytes.Buffer
 		_, readErr := io.CopyN(&buf, r, 50)
@@ -145,6 +160,7 @@ func TestS3GetTransientErrors(t *testing.T) {
 
 func TestS3GetReadNothingLoop(t *testing.T) {
 	// read nothing then return a connection reset error
+	t.Cleanup(func() { ioCopyHook = io.Copy })
 	ioCopyHook = func(_ io.Writer, _ io.Reader) (int64, error) {
 		return 0, errors.New("read: connection reset by peer")
 	}
@@ -370,3 +386,178 @@ func testS3Client(client s3API, uploader s3Uploader) Storage {
 func rawS3Client(client s3API, uploader s3Uploader) *s3Store {
 	return newS3WithClients(client, uploader, "test-bucket", true, NewOperations(&observation.TestContext, "test", "brittleStore"))
 }
+
+// fakeS3API is an in-memory s3API and s3Uploader. Pages are deliberately small to
+// exercise pagination.
+type fakeS3API struct {
+	mu       sync.Mutex
+	buckets  map[string]map[string]fakeS3Object
+	uploads  map[string]map[int32][]byte
+	uploadID int
+}
+
+type fakeS3Object struct {
+	content      []byte
+	lastModified time.Time
+}
+
+var (
+	_ s3API                     = &fakeS3API{}
+	_ s3Uploader                = &fakeS3API{}
+	_ s3.ListObjectsV2APIClient = &fakeS3API{}
+)
+
+func newFakeS3API() *fakeS3API {
+	return &fakeS3API{
+		buckets: map[string]map[string]fakeS3Object{},
+		uploads: map[string]map[int32][]byte{},
+	}
+}
+
+func (f *fakeS3API) CreateBucket(_ context.Context, input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.buckets[*input.Bucket]; ok {
+		return nil, &s3types.BucketAlreadyOwnedByYou{}
+	}
+	f.buckets[*input.Bucket] = map[string]fakeS3Object{}
+	return &s3.CreateBucketOutput{}, nil
+}
+
+func (f *fakeS3API) HeadObject(_ context.Context, input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	obj, ok := f.buckets[*input.Bucket][*input.Key]
+	if !ok {
+		return nil, &s3types.NotFound{}
+	}
+	return &s3.HeadObjectOutput{ContentLength: int64(len(obj.content))}, nil
+}
+
+func (f *fakeS3API) GetObject(_ context.Context, input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	obj, ok := f.buckets[*input.Bucket][*input.Key]
+	if !ok {
+		return nil, &s3types.NoSuchKey{}
+	}
+	content := obj.content
+	if input.Range != nil {
+		var offset int
+		if _, err := fmt.Sscanf(*input.Range, "bytes=%d-", &offset); err != nil {
+			return nil, err
+		}
+		content = content[min(offset, len(content)):]
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(content))}, nil
+}
+
+func (f *fakeS3API) Upload(_ context.Context, input *s3.PutObjectInput) error {
+	content, err := io.ReadAll(input.Body)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	objects, ok := f.buckets[*input.Bucket]
+	if !ok {
+		return &s3types.NoSuchBucket{}
+	}
+	objects[*input.Key] = fakeS3Object{content: content, lastModified: time.Now()}
+	return nil
+}
+
+func (f *fakeS3API) DeleteObject(_ context.Context, input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.buckets[*input.Bucket], *input.Key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (f *fakeS3API) DeleteObjects(_ context.Context, input *s3.DeleteObjectsInput, _ ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, obj := range input.Delete.Objects {
+		delete(f.buckets[*input.Bucket], *obj.Key)
+	}
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func (f *fakeS3API) CreateMultipartUpload(_ context.Context, input *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.uploadID++
+	uploadID := strconv.Itoa(f.uploadID)
+	f.uploads[uploadID] = map[int32][]byte{}
+	return &s3.CreateMultipartUploadOutput{Bucket: input.Bucket, Key: input.Key, UploadId: aws.String(uploadID)}, nil
+}
+
+func (f *fakeS3API) UploadPartCopy(_ context.Context, input *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bucket, key, _ := strings.Cut(*input.CopySource, "/")
+	obj, ok := f.buckets[bucket][key]
+	if !ok {
+		return nil, &s3types.NoSuchKey{}
+	}
+	parts, ok := f.uploads[*input.UploadId]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	parts[input.PartNumber] = obj.content
+	etag := fmt.Sprintf("etag-%d", input.PartNumber)
+	return &s3.UploadPartCopyOutput{CopyPartResult: &s3types.CopyPartResult{ETag: &etag}}, nil
+}
+
+func (f *fakeS3API) CompleteMultipartUpload(_ context.Context, input *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	parts, ok := f.uploads[*input.UploadId]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	var content []byte
+	for _, part := range input.MultipartUpload.Parts {
+		content = append(content, parts[part.PartNumber]...)
+	}
+	delete(f.uploads, *input.UploadId)
+	f.buckets[*input.Bucket][*input.Key] = fakeS3Object{content: content, lastModified: time.Now()}
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3API) AbortMultipartUpload(_ context.Context, input *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.uploads, *input.UploadId)
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3API) NewListObjectsV2Paginator(input *s3.ListObjectsV2Input) *s3.ListObjectsV2Paginator {
+	return s3.NewListObjectsV2Paginator(f, input)
+}
+
+func (f *fakeS3API) ListObjectsV2(_ context.Context, input *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var keys []string
+	for key := range f.buckets[*input.Bucket] {
+		if strings.HasPrefix(key, aws.ToString(input.Prefix)) && key > aws.ToString(input.ContinuationToken) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	output := &s3.ListObjectsV2Output{}
+	if len(keys) > 10 {
+		keys = keys[:10]
+		output.IsTruncated = true
+		output.NextContinuationToken = aws.String(keys[len(keys)-1])
+	}
+	for _, key := range keys {
+		output.Contents = append(output.Contents, s3types.Object{
+			Key:          aws.String(key),
+			LastModified: aws.Time(f.buckets[*input.Bucket][key].lastModified),
+		})
+	}
+	return output, nil
+}
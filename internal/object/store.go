@@ -1,5 +1,5 @@
 // Package object provides an interface to object storage that abstracts over
-// S3, GCS and blobstore.
+// S3, GCS, Azure Blob Storage, blobstore and the local filesystem.
 package object
 
 import (
@@ -46,6 +46,8 @@ var storeConstructors = map[string]func(ctx context.Context, config StorageConfi
 	"s3":        newS3FromConfig,
 	"blobstore": newS3FromConfig,
 	"gcs":       newGCSFromConfig,
+	"azure":     newAzureFromConfig,
+	"local":     newLocalFromConfig,
 }
 
 // CreateLazyStorage initialize a new store from the given configuration that is initialized
@@ -185,6 +185,8 @@ func (h *handler) Handle(ctx context.Context, lgr log.Logger, record *Job) error
 		delegate = handleRecentContributors
 	case types.Analytics:
 		delegate = handleAnalytics
+	case types.CoverageReports:
+		delegate = handleCoverageReport
 	default:
 		return errcode.MakeNonRetryable(errors.New("unsupported own index job type"))
 	}
@@ -0,0 +1,279 @@
+package background
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxCoverageDirectoryDepth is the depth of the deepest directories coverage is
+// recorded for. Files nested deeper still count towards their ancestors.
+const maxCoverageDirectoryDepth = 3
+
+// coverageSnapshotRetention is how long coverage snapshots are kept around to
+// show trends.
+const coverageSnapshotRetention = time.Hour * 24 * 180
+
+func handleCoverageReport(ctx context.Context, lgr log.Logger, repoId api.RepoID, db database.DB) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user,
+	// and needs to see all repos and files.
+	internalCtx := actor.WithInternalActor(ctx)
+	indexer := newCoverageIndexer(gitserver.NewClient("own.coverageindexer"), db, lgr)
+	err := indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+	if err != nil {
+		lgr.Error("own coverage report failure", log.String("msg", err.Error()))
+	}
+	return err
+}
+
+type coverageIndexer struct {
+	client gitserver.Client
+	db     database.DB
+	logger log.Logger
+}
+
+func newCoverageIndexer(client gitserver.Client, db database.DB, lgr log.Logger) *coverageIndexer {
+	return &coverageIndexer{client: client, db: db, logger: lgr}
+}
+
+var ownCoverageFilesCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_coverage_files_indexed_total",
+})
+
+// indexRepo computes the ownership coverage of the repo at HEAD and stores it as
+// a new snapshot.
+func (r *coverageIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// If the repo has sub-repo perms enabled, skip indexing
+	isSubRepoPermsRepo, err := authz.SubRepoEnabledForRepoID(ctx, checker, repoId)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own coverage report due to the repo having subrepo perms enabled", log.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoId)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+	commitID, err := r.client.ResolveRevision(ctx, repo.Name, "HEAD", gitserver.ResolveRevisionOptions{EnsureRevision: false})
+	if err != nil {
+		return errcode.MakeNonRetryable(errors.Wrapf(err, "cannot resolve HEAD"))
+	}
+
+	ownService := own.NewService(r.client, r.db)
+	ruleset, err := ownService.RulesetForRepo(ctx, repo.Name, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "RulesetForRepo")
+	}
+	assignedOwners, err := ownService.AssignedOwnership(ctx, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "AssignedOwnership")
+	}
+	assignedTeams, err := ownService.AssignedTeams(ctx, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "AssignedTeams")
+	}
+	contributedPaths, err := r.db.RecentContributionSignals().FindContributedPaths(ctx, repo.ID)
+	if err != nil {
+		return errors.Wrap(err, "FindContributedPaths")
+	}
+	contributed := make(map[string]struct{}, len(contributedPaths))
+	for _, path := range contributedPaths {
+		contributed[path] = struct{}{}
+	}
+	teams := &teamResolver{store: r.db.Teams(), ids: map[string]int32{}}
+
+	it, err := r.client.ReadDir(ctx, repo.Name, commitID, "", true)
+	if err != nil {
+		return errors.Wrap(err, "ls-tree")
+	}
+	defer it.Close()
+
+	report := newCoverageReport()
+	for {
+		f, err := it.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if f.IsDir() {
+			continue
+		}
+		path := f.Name()
+		var fc fileCoverage
+		if ruleset != nil {
+			for _, rule := range ruleset.FindOwners(path) {
+				for _, owner := range rule.GetOwner() {
+					fc.codeowned = true
+					teamID, err := teams.find(ctx, owner.GetHandle())
+					if err != nil {
+						return err
+					}
+					if teamID != 0 {
+						fc.teamIDs = append(fc.teamIDs, teamID)
+					}
+				}
+			}
+		}
+		for _, summary := range assignedTeams.Match(path) {
+			fc.assigned = true
+			fc.teamIDs = append(fc.teamIDs, summary.OwnerTeamID)
+		}
+		if len(assignedOwners.Match(path)) > 0 {
+			fc.assigned = true
+		}
+		_, fc.recentContributors = contributed[path]
+		report.add(path, fc)
+	}
+
+	snapshot := report.snapshot()
+	snapshot.RepoID = repo.ID
+	snapshot.CommitID = commitID
+	store := r.db.OwnershipCoverage()
+	if err := store.CreateSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "CreateSnapshot")
+	}
+	if _, err := store.DeleteSnapshotsBefore(ctx, repo.ID, time.Now().Add(-coverageSnapshotRetention)); err != nil {
+		return errors.Wrap(err, "DeleteSnapshotsBefore")
+	}
+	ownCoverageFilesCounter.Add(float64(report.directories[""].TotalFileCount))
+	return nil
+}
+
+// teamResolver resolves CODEOWNERS handles to Sourcegraph teams by name. Results
+// are cached, since the same handles own many files.
+type teamResolver struct {
+	store database.TeamStore
+	ids   map[string]int32
+}
+
+// find returns the ID of the team with the given handle, or 0 if the handle does
+// not refer to a team.
+func (t *teamResolver) find(ctx context.Context, handle string) (int32, error) {
+	name := strings.TrimPrefix(handle, "@")
+	if name == "" {
+		return 0, nil
+	}
+	if id, ok := t.ids[name]; ok {
+		return id, nil
+	}
+	team, err := t.store.GetTeamByName(ctx, name)
+	if err != nil && !errcode.IsNotFound(err) {
+		return 0, errors.Wrap(err, "Teams.GetTeamByName")
+	}
+	var id int32
+	if team != nil {
+		id = team.ID
+	}
+	t.ids[name] = id
+	return id, nil
+}
+
+// fileCoverage describes the ownership data found for a single file.
+type fileCoverage struct {
+	codeowned          bool
+	assigned           bool
+	recentContributors bool
+	// teamIDs are the teams owning the file, possibly with duplicates.
+	teamIDs []int32
+}
+
+// coverageReport aggregates the coverage of files by directory and by team.
+type coverageReport struct {
+	directories map[string]*database.OwnershipCoverageCounts
+	teams       map[int32]int
+}
+
+func newCoverageReport() *coverageReport {
+	return &coverageReport{
+		directories: map[string]*database.OwnershipCoverageCounts{"": {}},
+		teams:       map[int32]int{},
+	}
+}
+
+func (c *coverageReport) add(path string, f fileCoverage) {
+	for _, dir := range coverageDirectories(path) {
+		counts, ok := c.directories[dir]
+		if !ok {
+			counts = &database.OwnershipCoverageCounts{}
+			c.directories[dir] = counts
+		}
+		counts.TotalFileCount++
+		if f.codeowned {
+			counts.CodeownedFileCount++
+		}
+		if f.assigned {
+			counts.AssignedOwnershipFileCount++
+		}
+		if f.recentContributors {
+			counts.RecentContributorFileCount++
+		}
+		if f.codeowned || f.assigned {
+			counts.TotalOwnedFileCount++
+		}
+	}
+	seen := make(map[int32]struct{}, len(f.teamIDs))
+	for _, id := range f.teamIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		c.teams[id]++
+	}
+}
+
+// snapshot returns the report as a snapshot, with directories ordered by path
+// and teams by the number of owned files descending.
+func (c *coverageReport) snapshot() *database.OwnershipCoverageSnapshot {
+	var snapshot database.OwnershipCoverageSnapshot
+	for path, counts := range c.directories {
+		snapshot.Directories = append(snapshot.Directories, database.OwnershipCoverageDirectory{
+			Path:                    path,
+			OwnershipCoverageCounts: *counts,
+		})
+	}
+	sort.Slice(snapshot.Directories, func(i, j int) bool {
+		return snapshot.Directories[i].Path < snapshot.Directories[j].Path
+	})
+	for id, count := range c.teams {
+		snapshot.Teams = append(snapshot.Teams, database.OwnershipCoverageTeam{TeamID: id, OwnedFileCount: count})
+	}
+	sort.Slice(snapshot.Teams, func(i, j int) bool {
+		if snapshot.Teams[i].OwnedFileCount != snapshot.Teams[j].OwnedFileCount {
+			return snapshot.Teams[i].OwnedFileCount > snapshot.Teams[j].OwnedFileCount
+		}
+		return snapshot.Teams[i].TeamID < snapshot.Teams[j].TeamID
+	})
+	return &snapshot
+}
+
+// coverageDirectories returns the repo root followed by the ancestor
+// directories of the given file path, up to maxCoverageDirectoryDepth.
+func coverageDirectories(path string) []string {
+	dirs := []string{""}
+	for i := 0; i < len(path) && len(dirs) <= maxCoverageDirectoryDepth; i++ {
+		if path[i] == '/' {
+			dirs = append(dirs, path[:i])
+		}
+	}
+	return dirs
+}
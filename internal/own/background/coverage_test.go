@@ -0,0 +1,119 @@
+package background
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestCoverageDirectories(t *testing.T) {
+	assert.Equal(t, []string{""}, coverageDirectories("file.go"))
+	assert.Equal(t, []string{"", "a", "a/b"}, coverageDirectories("a/b/file.go"))
+	assert.Equal(t, []string{"", "a", "a/b", "a/b/c"}, coverageDirectories("a/b/c/d/e/file.go"))
+}
+
+func TestCoverageReport(t *testing.T) {
+	report := newCoverageReport()
+	report.add("README.md", fileCoverage{})
+	report.add("src/main.go", fileCoverage{codeowned: true, recentContributors: true, teamIDs: []int32{1, 2, 1}})
+	report.add("src/util/util.go", fileCoverage{assigned: true, teamIDs: []int32{2}})
+
+	counts := func(total, codeowned, assigned, contributors, owned int) database.OwnershipCoverageCounts {
+		return database.OwnershipCoverageCounts{
+			TotalFileCount:             total,
+			CodeownedFileCount:         codeowned,
+			AssignedOwnershipFileCount: assigned,
+			RecentContributorFileCount: contributors,
+			TotalOwnedFileCount:        owned,
+		}
+	}
+	snapshot := report.snapshot()
+	assert.Equal(t, []database.OwnershipCoverageDirectory{
+		{Path: "", OwnershipCoverageCounts: counts(3, 1, 1, 1, 2)},
+		{Path: "src", OwnershipCoverageCounts: counts(2, 1, 1, 1, 2)},
+		{Path: "src/util", OwnershipCoverageCounts: counts(1, 0, 1, 0, 1)},
+	}, snapshot.Directories)
+	// Files owned by the same team more than once are counted once.
+	assert.Equal(t, []database.OwnershipCoverageTeam{
+		{TeamID: 2, OwnedFileCount: 2},
+		{TeamID: 1, OwnedFileCount: 1},
+	}, snapshot.Teams)
+}
+
+func TestCoverageIndexer(t *testing.T) {
+	rcache.SetupForTest(t)
+	obsCtx := observation.TestContextTB(t)
+	logger := obsCtx.Logger
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	user, err := db.Users().Create(ctx, database.NewUser{Username: "test"})
+	require.NoError(t, err)
+	team, err := db.Teams().CreateTeam(ctx, &types.Team{Name: "owners"})
+	require.NoError(t, err)
+	var repoID api.RepoID = 1
+	require.NoError(t, db.Repos().Create(ctx, &types.Repo{Name: "repo", ID: repoID}))
+	client := fakeGitServer{
+		files: []string{
+			"notOwned.go",
+			"owned/file1.go",
+			"owned/file2.go",
+			"assigned/deep/er/than/max.go",
+		},
+		fileContents: map[string]string{
+			"CODEOWNERS": "/owned/* @owners",
+		},
+	}
+	require.NoError(t, db.AssignedOwners().Insert(ctx, user.ID, repoID, "assigned", user.ID))
+	require.NoError(t, db.RecentContributionSignals().AddCommit(ctx, database.Commit{
+		RepoID:       repoID,
+		AuthorName:   "alice",
+		AuthorEmail:  "alice@example.com",
+		CommitSHA:    "d3adb33f",
+		FilesChanged: []string{"notOwned.go"},
+	}))
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(false, nil)
+	require.NoError(t, newCoverageIndexer(client, db, logger).indexRepo(ctx, repoID, checker))
+
+	snapshots, err := db.OwnershipCoverage().ListSnapshots(ctx, database.ListOwnershipCoverageSnapshotsOpts{RepoID: repoID})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, []database.OwnershipCoverageDirectory{
+		{Path: "", OwnershipCoverageCounts: database.OwnershipCoverageCounts{
+			TotalFileCount:             4,
+			CodeownedFileCount:         2,
+			AssignedOwnershipFileCount: 1,
+			RecentContributorFileCount: 1,
+			TotalOwnedFileCount:        3,
+		}},
+		{Path: "assigned", OwnershipCoverageCounts: database.OwnershipCoverageCounts{
+			TotalFileCount:             1,
+			AssignedOwnershipFileCount: 1,
+			TotalOwnedFileCount:        1,
+		}},
+		{Path: "owned", OwnershipCoverageCounts: database.OwnershipCoverageCounts{
+			TotalFileCount:      2,
+			CodeownedFileCount:  2,
+			TotalOwnedFileCount: 2,
+		}},
+	}, snapshots[0].Directories)
+	assert.Equal(t, []database.OwnershipCoverageTeam{{TeamID: team.ID, OwnedFileCount: 2}}, snapshots[0].Teams)
+
+	snapshots, err = db.OwnershipCoverage().ListSnapshots(ctx, database.ListOwnershipCoverageSnapshotsOpts{RepoID: repoID, Path: "assigned/deep/er"})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	// Directories deeper than the maximum depth are not recorded.
+	assert.Equal(t, "assigned/deep/er", snapshots[0].Directories[0].Path)
+	assert.Len(t, snapshots[0].Directories, 1)
+}
@@ -44,6 +44,7 @@ func TestOwnRepoIndexSchedulerJob_JobsAutoIndex(t *testing.T) {
 	wantJobCountByName := map[string]int{
 		types.SignalRecentContributors: 3,
 		types.Analytics:                0, // Turned off by default
+		types.CoverageReports:          0, // Turned off by default
 	}
 
 	for _, jobType := range QueuePerRepoIndexJobs {
@@ -83,6 +83,40 @@ func (x *Ruleset) Match(path string) *codeownerspb.Rule {
 	return nil
 }
 
+// FindOwners returns the rules matching the given path in every section of this
+// CODEOWNERS ruleset, as sections are evaluated independently of each other.
+// Rules that do not belong to any section form a section of their own. Within
+// a section, the matching rule furthest down the input file applies. The rules
+// are returned in the order their sections first appear in the file.
+func (x *Ruleset) FindOwners(path string) []*codeownerspb.Rule {
+	if path[0] != '/' {
+		path = "/" + path
+	}
+	matched := make(map[string]*codeownerspb.Rule)
+	for i := len(x.rules) - 1; i >= 0; i-- {
+		rule := x.rules[i]
+		section := rule.proto.GetSectionName()
+		if _, ok := matched[section]; ok {
+			continue
+		}
+		if rule.match(path) {
+			matched[section] = rule.proto
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+	rules := make([]*codeownerspb.Rule, 0, len(matched))
+	for _, r := range x.rules {
+		section := r.proto.GetSectionName()
+		if rule, ok := matched[section]; ok {
+			rules = append(rules, rule)
+			delete(matched, section)
+		}
+	}
+	return rules
+}
+
 type CompiledRule struct {
 	proto       *codeownerspb.Rule
 	glob        *paths.GlobPattern
@@ -255,6 +255,40 @@ func TestFileOwnersOrder(t *testing.T) {
 	assert.Equal(t, wantOwner, got.GetOwner())
 }
 
+func TestFindOwnersSections(t *testing.T) {
+	rs := codeowners.NewRuleset(
+		codeowners.IngestedRulesetSource{},
+		&codeownerspb.File{
+			Rule: []*codeownerspb.Rule{
+				{Pattern: "*", Owner: []*codeownerspb.Owner{{Handle: "default"}}},
+				{Pattern: "docs/", Owner: []*codeownerspb.Owner{{Handle: "docs-pm"}}, SectionName: "pm"},
+				{Pattern: "*.md", Owner: []*codeownerspb.Owner{{Handle: "eng"}}, SectionName: "eng"},
+				{Pattern: "*.go", Owner: []*codeownerspb.Owner{{Handle: "go-eng"}}, SectionName: "eng"},
+				{Pattern: "docs/*.md", Owner: []*codeownerspb.Owner{{Handle: "docs-writer"}}, SectionName: "pm"},
+			},
+		})
+
+	owners := func(rules []*codeownerspb.Rule) (handles []string) {
+		for _, r := range rules {
+			for _, o := range r.GetOwner() {
+				handles = append(handles, o.GetHandle())
+			}
+		}
+		return handles
+	}
+
+	// The last matching rule of every section applies, in order of appearance
+	// of the sections.
+	assert.Equal(t, []string{"default", "docs-writer", "eng"}, owners(rs.FindOwners("docs/index.md")))
+	assert.Equal(t, []string{"default", "docs-pm"}, owners(rs.FindOwners("/docs/main.c")))
+	assert.Equal(t, []string{"default", "go-eng"}, owners(rs.FindOwners("main.go")))
+	// Match still only returns the last matching rule.
+	assert.Equal(t, []string{"docs-writer"}, owners([]*codeownerspb.Rule{rs.Match("docs/index.md")}))
+
+	empty := codeowners.NewRuleset(codeowners.IngestedRulesetSource{}, &codeownerspb.File{})
+	assert.Empty(t, empty.FindOwners("main.go"))
+}
+
 func BenchmarkOwnersMatchLiteral(b *testing.B) {
 	pattern := "/main/src/foo/bar/README.md"
 	paths := []string{
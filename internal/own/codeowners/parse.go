@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"io"
 	"net/mail"
+	"strconv"
 	"strings"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -14,10 +16,25 @@ import (
 // Parse parses CODEOWNERS file given as a Reader and returns the proto
 // representation of all rules within. The rules are in the same order
 // as in the file, since this matters for evaluation.
+//
+// A line like `[Section] @owner` is parsed as a rule for the pattern
+// `[Section]`. Use ParseForCodeHost to parse it as a section header with
+// default owners in files of code hosts that support them.
 func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
+	return parse(codeownersFile, false)
+}
+
+// ParseForCodeHost is like Parse, but follows the CODEOWNERS flavour of the
+// given code host type. Only GitLab supports default owners on section
+// headers, everywhere else `[abc] @owner` is a rule for the files a, b and c.
+func ParseForCodeHost(codeownersFile io.Reader, codeHostType string) (*codeownerspb.File, error) {
+	return parse(codeownersFile, codeHostType == extsvc.TypeGitLab)
+}
+
+func parse(codeownersFile io.Reader, sectionOwners bool) (*codeownerspb.File, error) {
 	scanner := bufio.NewScanner(codeownersFile)
 	var rs []*codeownerspb.Rule
-	p := new(parsing)
+	p := &parsing{allowSectionOwners: sectionOwners}
 	lineNumber := int32(0)
 	for scanner.Scan() {
 		p.nextLine(scanner.Text())
@@ -32,13 +49,20 @@ func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 		if !ok {
 			return nil, errors.Errorf("failed to match rule: %s", p.line)
 		}
+		// Rules without owners within a section that lists default owners
+		// are owned by the default owners.
+		if len(owners) == 0 {
+			owners = p.sectionOwners
+		}
 		// Need to handle this error once, codeownerspb.File supports
 		// error metadata.
 		r := codeownerspb.Rule{
 			Pattern: unescape(pattern),
 			// Section names are case-insensitive, so we lowercase it.
-			SectionName: strings.TrimSpace(strings.ToLower(p.section)),
-			LineNumber:  lineNumber,
+			SectionName:              strings.TrimSpace(strings.ToLower(p.section)),
+			LineNumber:               lineNumber,
+			SectionOptional:          p.sectionOptional,
+			SectionApprovalsRequired: p.sectionApprovals,
 		}
 		for _, ownerText := range owners {
 			o := ParseOwner(ownerText)
@@ -73,6 +97,16 @@ type parsing struct {
 	line string
 	// The most recently defined section, or "" if none.
 	section string
+	// Whether the most recently defined section is optional.
+	sectionOptional bool
+	// The number of approvals required by the most recently defined section,
+	// or 0 if it does not specify one.
+	sectionApprovals int32
+	// The default owners listed on the most recently defined section header.
+	sectionOwners []string
+	// Whether section headers can list default owners. Otherwise a header
+	// followed by owners is a rule.
+	allowSectionOwners bool
 }
 
 // nextLine advances parsing to focus on the next line.
@@ -113,17 +147,33 @@ func (p *parsing) matchRule() (string, []string, bool) {
 	return filePattern, owners, true
 }
 
-var sectionPattern = lazyregexp.New(`^\s*\^?\s*\[([^\]]+)\]\s*(?:\[[0-9]+\])?\s*$`)
+var sectionPattern = lazyregexp.New(`^\s*(\^?)\s*\[([^\]]+)\]\s*(?:\[([0-9]+)\])?((?:\s+\S+)*)\s*$`)
 
 // matchSection tries to extract a section which looks like `[section name]`.
 // A section can also be defined as `^[Section]`, meaning it is optional for approval.
 // It can also be `[Section][2]`, meaning two approvals are required.
+// If allowSectionOwners is set, the section header can be followed by
+// default owners for the section, like `[Section] @owner`.
 func (p *parsing) matchSection() bool {
 	match := sectionPattern.FindStringSubmatch(p.lineWithoutComments())
-	if len(match) != 2 {
+	if len(match) != 5 {
+		return false
+	}
+	if match[4] != "" && !p.allowSectionOwners {
 		return false
 	}
-	p.section = match[1]
+	p.section = match[2]
+	p.sectionOptional = match[1] == "^"
+	p.sectionApprovals = 0
+	if match[3] != "" {
+		// The pattern guarantees a number, only out of range values fail.
+		approvals, err := strconv.ParseInt(match[3], 10, 32)
+		if err != nil {
+			return false
+		}
+		p.sectionApprovals = int32(approvals)
+	}
+	p.sectionOwners = strings.Fields(match[4])
 	return true
 }
 
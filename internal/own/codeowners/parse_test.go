@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/internal/own/codeowners/v1"
 )
@@ -464,7 +465,8 @@ own/codeowners/* @own-pms
 			Owner: []*codeownerspb.Owner{
 				{Handle: "own-engs"},
 			},
-			LineNumber: 6,
+			LineNumber:      6,
+			SectionOptional: true,
 		},
 		{
 			Pattern:     "own/codeowners/*",
@@ -472,7 +474,8 @@ own/codeowners/* @own-pms
 			Owner: []*codeownerspb.Owner{
 				{Handle: "own-engs"},
 			},
-			LineNumber: 10,
+			LineNumber:               10,
+			SectionApprovalsRequired: 2,
 		},
 		{
 			Pattern:     "own/codeowners/*",
@@ -485,6 +488,78 @@ own/codeowners/* @own-pms
 	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
 }
 
+func TestParseSectionDefaultOwners(t *testing.T) {
+	got, err := codeowners.ParseForCodeHost(strings.NewReader(
+		`[Docs] @docs-team docs@example.com
+docs/
+README.md @readme-owner
+
+^[Eng][2] @eng-team
+*.go
+
+[Other]
+*.txt
+`), extsvc.TypeGitLab)
+	require.NoError(t, err)
+	want := []*codeownerspb.Rule{
+		{
+			Pattern:     "docs/",
+			SectionName: "docs",
+			Owner: []*codeownerspb.Owner{
+				{Handle: "docs-team"},
+				{Email: "docs@example.com"},
+			},
+			LineNumber: 2,
+		},
+		{
+			Pattern:     "README.md",
+			SectionName: "docs",
+			Owner: []*codeownerspb.Owner{
+				{Handle: "readme-owner"},
+			},
+			LineNumber: 3,
+		},
+		{
+			Pattern:     "*.go",
+			SectionName: "eng",
+			Owner: []*codeownerspb.Owner{
+				{Handle: "eng-team"},
+			},
+			LineNumber:               6,
+			SectionOptional:          true,
+			SectionApprovalsRequired: 2,
+		},
+		{
+			Pattern:     "*.txt",
+			SectionName: "other",
+			LineNumber:  9,
+		},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+}
+
+func TestParseSectionOwnersOutsideGitLab(t *testing.T) {
+	// Outside of GitLab, `[abc]` is a pattern matching the files a, b and c.
+	file := "[abc] @owner\n"
+	for _, codeHostType := range []string{"", extsvc.TypeGitHub} {
+		got, err := codeowners.ParseForCodeHost(strings.NewReader(file), codeHostType)
+		require.NoError(t, err)
+		want := []*codeownerspb.Rule{
+			{
+				Pattern:    "[abc]",
+				Owner:      []*codeownerspb.Owner{{Handle: "owner"}},
+				LineNumber: 1,
+			},
+		}
+		assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	}
+
+	got, err := codeowners.Parse(strings.NewReader(file))
+	require.NoError(t, err)
+	require.Len(t, got.Rule, 1)
+	assert.Equal(t, "[abc]", got.Rule[0].Pattern)
+}
+
 func TestParseManySections(t *testing.T) {
 	got, err := codeowners.Parse(strings.NewReader(
 		`own/codeowners/* @own-eng
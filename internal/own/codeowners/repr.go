@@ -15,7 +15,14 @@ func (f *Ruleset) Repr() string {
 	var lastSeenSection string
 	for _, r := range f.proto.GetRule() {
 		if s := r.SectionName; s != lastSeenSection {
-			fmt.Fprintf(w, "[%s]\n", s)
+			if r.SectionOptional {
+				fmt.Fprint(w, "^")
+			}
+			fmt.Fprintf(w, "[%s]", s)
+			if n := r.SectionApprovalsRequired; n > 0 {
+				fmt.Fprintf(w, "[%d]", n)
+			}
+			fmt.Fprintln(w)
 			lastSeenSection = s
 		}
 		fmt.Fprint(w, r.Pattern)
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
@@ -443,6 +444,8 @@ func fetchCodeHostHandles(ctx context.Context, accounts []*extsvc.Account) []str
 			accountInfo, err = bitbucketcloud.GetPublicExternalAccountData(ctx, &account.AccountData)
 		case extsvc.TypeAzureDevOps:
 			accountInfo, err = azuredevops.GetPublicExternalAccountData(ctx, &account.AccountData)
+		case extsvc.TypeGitea:
+			accountInfo, err = gitea.GetPublicExternalAccountData(ctx, &account.AccountData)
 		}
 		if err != nil {
 			continue
@@ -137,26 +137,36 @@ type repoOwnershipData struct {
 }
 
 func (o repoOwnershipData) Match(path string) fileOwnershipData {
-	var rule *codeownerspb.Rule
+	var rules []*codeownerspb.Rule
 	if o.codeowners != nil {
-		rule = o.codeowners.Match(path)
+		rules = o.codeowners.FindOwners(path)
 	}
 	return fileOwnershipData{
-		rule:           rule,
+		rules:          rules,
 		assignedOwners: o.assigned.Match(path),
 		assignedTeams:  o.assignedTeams.Match(path),
 	}
 }
 
 type fileOwnershipData struct {
-	rule           *codeownerspb.Rule
+	// rules are the matching CODEOWNERS rules, one for every section.
+	rules          []*codeownerspb.Rule
 	assignedOwners []database.AssignedOwnerSummary
 	assignedTeams  []database.AssignedTeamSummary
 }
 
+// codeownersOwners returns the owners of all matching CODEOWNERS rules.
+func (d fileOwnershipData) codeownersOwners() []*codeownerspb.Owner {
+	var owners []*codeownerspb.Owner
+	for _, r := range d.rules {
+		owners = append(owners, r.GetOwner()...)
+	}
+	return owners
+}
+
 func (d fileOwnershipData) References() []own.Reference {
 	var rs []own.Reference
-	for _, o := range d.rule.GetOwner() {
+	for _, o := range d.codeownersOwners() {
 		rs = append(rs, own.Reference{Handle: o.Handle, Email: o.Email})
 	}
 	for _, o := range d.assignedOwners {
@@ -173,7 +183,7 @@ func (d fileOwnershipData) Empty() bool {
 }
 
 func (d fileOwnershipData) NonEmpty() bool {
-	if d.rule != nil && len(d.rule.Owner) > 0 {
+	if len(d.codeownersOwners()) > 0 {
 		return true
 	}
 	if len(d.assignedOwners) > 0 {
@@ -186,7 +196,7 @@ func (d fileOwnershipData) NonEmpty() bool {
 }
 
 func (d fileOwnershipData) IsWithin(bag own.Bag) bool {
-	for _, o := range d.rule.GetOwner() {
+	for _, o := range d.codeownersOwners() {
 		if bag.Contains(own.Reference{
 			Handle: o.Handle,
 			Email:  o.Email,
@@ -209,7 +219,7 @@ func (d fileOwnershipData) IsWithin(bag own.Bag) bool {
 
 func (d fileOwnershipData) String() string {
 	var references []string
-	for _, o := range d.rule.GetOwner() {
+	for _, o := range d.codeownersOwners() {
 		if h := o.GetHandle(); h != "" {
 			references = append(references, h)
 		}
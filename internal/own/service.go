@@ -99,6 +99,14 @@ var codeownersLocations = []string{
 // RulesetForRepo makes a best effort attempt to return a CODEOWNERS file ruleset
 // from one of the possible codeownersLocations, or the ingested codeowners files. It returns nil if no match is found.
 func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
+	// The code host determines the CODEOWNERS flavour the file is parsed with.
+	repo, err := s.db.Repos().Get(ctx, repoID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
 	ingestedCodeowners, err := s.db.Codeowners().GetCodeownersForRepo(ctx, repoID)
 	if err != nil && !errcode.IsNotFound(err) {
 		return nil, err
@@ -121,7 +129,7 @@ func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, rep
 				return nil, err
 			}
 
-			pbfile, err := codeowners.Parse(r)
+			pbfile, err := codeowners.ParseForCodeHost(r, repo.ExternalRepo.ServiceType)
 			r.Close()
 			if err != nil {
 				return nil, err
@@ -134,12 +142,6 @@ func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, rep
 	if rs == nil {
 		return nil, nil
 	}
-	repo, err := s.db.Repos().Get(ctx, repoID)
-	if err != nil && !errcode.IsNotFound(err) {
-		return nil, err
-	} else if errcode.IsNotFound(err) {
-		return nil, nil
-	}
 	rs.SetCodeHostType(repo.ExternalRepo.ServiceType)
 	return rs, nil
 }
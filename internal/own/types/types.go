@@ -21,4 +21,5 @@ const (
 	SignalRecentContributors = "recent-contributors"
 	SignalRecentViews        = "recent-views"
 	Analytics                = "analytics"
+	CoverageReports          = "coverage-reports"
 )
@@ -1,8 +1,9 @@
-package cloneurls
+package repos
 
 import (
 	"context"
 	neturl "net/url"
+	"path"
 	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -17,14 +18,14 @@ import (
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
-// RepoSourceCloneURLToRepoName maps a Git clone URL (format documented here:
+// CloneURLToRepoName maps a Git clone URL (format documented here:
 // https://git-scm.com/docs/git-clone#_git_urls_a_id_urls_a) to the corresponding repo name if there
 // exists a code host configuration that matches the clone URL. Implicitly, it includes a code host
 // configuration for github.com, even if one is not explicitly specified. Returns the empty string and nil
 // error if a matching code host could not be found. This function does not actually check the code
 // host to see if the repository actually exists.
-func RepoSourceCloneURLToRepoName(ctx context.Context, db database.DB, cloneURL string) (repoName api.RepoName, err error) {
-	tr, ctx := trace.New(ctx, "RepoSourceCloneURLToRepoName", attribute.String("cloneURL", cloneURL))
+func CloneURLToRepoName(ctx context.Context, db database.DB, cloneURL string) (repoName api.RepoName, err error) {
+	tr, ctx := trace.New(ctx, "CloneURLToRepoName", attribute.String("cloneURL", cloneURL))
 	defer tr.EndWithErr(&err)
 
 	if repoName := reposource.CustomCloneURLToRepoName(cloneURL); repoName != "" {
@@ -49,6 +50,8 @@ func RepoSourceCloneURLToRepoName(ctx context.Context, db database.DB, cloneURL
 			extsvc.KindAWSCodeCommit,
 			extsvc.KindGitolite,
 			extsvc.KindPhabricator,
+			extsvc.KindMercurial,
+			extsvc.KindGitea,
 			extsvc.KindOther,
 		},
 		LimitOffset: &database.LimitOffset{
@@ -90,6 +93,19 @@ func RepoSourceCloneURLToRepoName(ctx context.Context, db database.DB, cloneURL
 	return rs.CloneURLToRepoName(cloneURL)
 }
 
+// SubmoduleRepoName maps the URL of a submodule of the repository parent, as
+// found in gitdomain.Submodule, to the corresponding repo name. Relative URLs
+// like "../other" are resolved against the name of parent, which mirrors how
+// git resolves them against the clone URL of parent. Like CloneURLToRepoName,
+// it returns the empty string and nil error if no code host matches, and does
+// not check whether the repository exists or is visible to the current user.
+func SubmoduleRepoName(ctx context.Context, db database.DB, parent api.RepoName, submoduleURL string) (api.RepoName, error) {
+	if strings.HasPrefix(submoduleURL, "../") || strings.HasPrefix(submoduleURL, "./") {
+		submoduleURL = path.Join(string(parent), submoduleURL)
+	}
+	return CloneURLToRepoName(ctx, db, submoduleURL)
+}
+
 func getRepoNameFromService(ctx context.Context, cloneURL string, svc *types.ExternalService) (_ api.RepoName, err error) {
 	tr, ctx := trace.New(ctx, "getRepoNameFromService",
 		attribute.Int64("externalService.ID", svc.ID),
@@ -131,6 +147,12 @@ func getRepoNameFromService(ctx context.Context, cloneURL string, svc *types.Ext
 		// If this repository is hosted by Phabricator, it should be handled by
 		// an OtherExternalServiceConnection.
 		return "", nil
+	case *schema.MercurialConnection:
+		rs = reposource.Mercurial{MercurialConnection: c}
+		host = c.Url
+	case *schema.GiteaConnection:
+		rs = reposource.Gitea{GiteaConnection: c}
+		host = c.Url
 	case *schema.OtherExternalServiceConnection:
 		rs = reposource.Other{OtherExternalServiceConnection: c}
 		host = c.Url
@@ -1,4 +1,4 @@
-package cloneurls
+package repos
 
 import (
 	"context"
@@ -12,7 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
-func TestReposourceCloneURLToRepoName(t *testing.T) {
+func TestCloneURLToRepoName(t *testing.T) {
 	ctx := context.Background()
 
 	externalServices := dbmocks.NewMockExternalServiceStore()
@@ -70,7 +70,7 @@ func TestReposourceCloneURLToRepoName(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			repoName, err := RepoSourceCloneURLToRepoName(ctx, db, test.cloneURL)
+			repoName, err := CloneURLToRepoName(ctx, db, test.cloneURL)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -81,3 +81,36 @@ func TestReposourceCloneURLToRepoName(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmoduleRepoName(t *testing.T) {
+	ctx := context.Background()
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetFirstRepoNameByCloneURLFunc.SetDefaultHook(func(_ context.Context, cloneURL string) (api.RepoName, error) {
+		if cloneURL == "github.com/sourcegraph/zoekt" || cloneURL == "https://github.com/sourcegraph/zoekt.git" {
+			return "github.com/sourcegraph/zoekt", nil
+		}
+		return "", nil
+	})
+	db := dbmocks.NewMockDB()
+	db.ExternalServicesFunc.SetDefaultReturn(dbmocks.NewMockExternalServiceStore())
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	for _, tc := range []struct {
+		url  string
+		want api.RepoName
+	}{
+		{url: "https://github.com/sourcegraph/zoekt.git", want: "github.com/sourcegraph/zoekt"},
+		{url: "../zoekt", want: "github.com/sourcegraph/zoekt"},
+		{url: "./../zoekt", want: "github.com/sourcegraph/zoekt"},
+		{url: "https://example.com/unknown.git", want: ""},
+	} {
+		got, err := SubmoduleRepoName(ctx, db, "github.com/sourcegraph/sourcegraph", tc.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("SubmoduleRepoName(%q) = %q, want %q", tc.url, got, tc.want)
+		}
+	}
+}
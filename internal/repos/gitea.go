@@ -0,0 +1,272 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GiteaSource yields repositories from a single Gitea or Forgejo connection
+// configured in Sourcegraph via the external services configuration.
+type GiteaSource struct {
+	svc       *types.ExternalService
+	conn      *schema.GiteaConnection
+	cli       gitea.Client
+	serviceID string
+	excluder  repoExcluder
+	logger    log.Logger
+}
+
+var (
+	_ Source     = &GiteaSource{}
+	_ RepoGetter = &GiteaSource{}
+)
+
+// NewGiteaSource returns a new GiteaSource from the given external service.
+func NewGiteaSource(ctx context.Context, logger log.Logger, svc *types.ExternalService, cf *httpcli.Factory) (*GiteaSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GiteaConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := gitea.NewClient(svc.URN(), u, &auth.OAuthBearerToken{Token: c.Token}, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	var ex repoExcluder
+	for _, r := range c.Exclude {
+		rule := NewRule().
+			Exact(r.Name).
+			Pattern(r.Pattern)
+
+		if r.Id != 0 {
+			rule.Exact(strconv.Itoa(r.Id))
+		}
+
+		if r.Forks {
+			rule.Generic(func(input any) bool {
+				if repo, ok := input.(gitea.Repo); ok {
+					return repo.Fork
+				}
+				return false
+			})
+		}
+
+		if r.Archived {
+			rule.Generic(func(input any) bool {
+				if repo, ok := input.(gitea.Repo); ok {
+					return repo.Archived
+				}
+				return false
+			})
+		}
+
+		ex.AddRule(rule)
+	}
+	if err := ex.RuleErrors(); err != nil {
+		return nil, err
+	}
+
+	return &GiteaSource{
+		svc:       svc,
+		conn:      &c,
+		cli:       cli,
+		serviceID: extsvc.NormalizeBaseURL(cli.GetURL()).String(),
+		excluder:  ex,
+		logger:    logger,
+	}, nil
+}
+
+// CheckConnection checks that the Gitea instance is reachable with the
+// configured token.
+func (s *GiteaSource) CheckConnection(ctx context.Context) error {
+	if _, err := s.cli.GetAuthenticatedUser(ctx); err != nil {
+		return errors.Wrap(err, "connection check failed. could not fetch authenticated user")
+	}
+	return nil
+}
+
+// ListRepos returns all Gitea repositories configured with this GiteaSource's
+// config.
+func (s *GiteaSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[int64]struct{})
+	yield := func(r *gitea.Repo) {
+		if _, ok := seen[r.ID]; ok || s.excludes(r) {
+			return
+		}
+		seen[r.ID] = struct{}{}
+		results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+	}
+
+	for _, nameWithOwner := range s.conn.Repos {
+		owner, name, _ := strings.Cut(nameWithOwner, "/")
+		r, err := s.cli.GetRepo(ctx, owner, name)
+		if err != nil {
+			if errcode.IsNotFound(err) {
+				s.logger.Warn("skipping missing gitea.repos entry", log.String("name", nameWithOwner))
+				continue
+			}
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "getting repository %q", nameWithOwner)}
+			continue
+		}
+		yield(r)
+	}
+
+	for _, org := range s.conn.Orgs {
+		err := paginateGitea(func(page int) ([]*gitea.Repo, bool, error) {
+			return s.cli.ListOrgRepos(ctx, org, page)
+		}, yield)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "listing repositories of organization %q", org)}
+		}
+	}
+
+	for _, query := range s.repositoryQueries() {
+		var list func(page int) ([]*gitea.Repo, bool, error)
+		switch query {
+		case "affiliated":
+			list = func(page int) ([]*gitea.Repo, bool, error) {
+				return s.cli.ListAffiliatedRepos(ctx, page)
+			}
+		case "all":
+			list = func(page int) ([]*gitea.Repo, bool, error) {
+				return s.cli.SearchRepos(ctx, gitea.SearchReposOptions{}, page)
+			}
+		default:
+			continue
+		}
+		if err := paginateGitea(list, yield); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "listing repositories for repositoryQuery %q", query)}
+		}
+	}
+}
+
+// repositoryQueries returns the configured repository queries, applying the
+// defaults documented in the schema.
+func (s *GiteaSource) repositoryQueries() []string {
+	if len(s.conn.RepositoryQuery) > 0 {
+		return s.conn.RepositoryQuery
+	}
+	if len(s.conn.Repos) > 0 || len(s.conn.Orgs) > 0 {
+		return []string{"none"}
+	}
+	return []string{"affiliated"}
+}
+
+// paginateGitea calls list for consecutive pages, passing all repositories to
+// yield, until there are no more pages.
+func paginateGitea(list func(page int) ([]*gitea.Repo, bool, error), yield func(*gitea.Repo)) error {
+	for page := 1; ; page++ {
+		repos, hasNextPage, err := list(page)
+		if err != nil {
+			return err
+		}
+		for _, r := range repos {
+			yield(r)
+		}
+		if !hasNextPage {
+			return nil
+		}
+	}
+}
+
+// GetRepo returns the Gitea repository with the given "owner/name".
+func (s *GiteaSource) GetRepo(ctx context.Context, nameWithOwner string) (*types.Repo, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok {
+		return nil, errors.Errorf("invalid Gitea repository name %q", nameWithOwner)
+	}
+
+	r, err := s.cli.GetRepo(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.makeRepo(r), nil
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GiteaSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *GiteaSource) excludes(r *gitea.Repo) bool {
+	return s.excluder.ShouldExclude(r.FullName) ||
+		s.excluder.ShouldExclude(strconv.FormatInt(r.ID, 10)) ||
+		s.excluder.ShouldExclude(*r)
+}
+
+func (s *GiteaSource) makeRepo(r *gitea.Repo) *types.Repo {
+	urn := s.svc.URN()
+	host := s.cli.GetURL().Hostname()
+
+	cloneURL := r.CloneURL
+	if s.conn.GitURLType == "ssh" {
+		cloneURL = r.SSHURL
+	}
+
+	return &types.Repo{
+		Name: reposource.GiteaRepoName(
+			s.conn.RepositoryPathPattern,
+			host,
+			r.FullName,
+		),
+		URI: string(reposource.GiteaRepoName(
+			"",
+			host,
+			r.FullName,
+		)),
+		Description: r.Description,
+		Fork:        r.Fork,
+		Archived:    r.Archived,
+		Stars:       r.StarsCount,
+		// Repositories of organizations with limited visibility are only
+		// visible to signed in users.
+		Private: r.Private || r.Internal,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          strconv.FormatInt(r.ID, 10),
+			ServiceType: extsvc.TypeGitea,
+			ServiceID:   s.serviceID,
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL,
+			},
+		},
+		Metadata: r,
+	}
+}
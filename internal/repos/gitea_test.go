@@ -0,0 +1,172 @@
+package repos
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types/typestest"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGiteaSource_ListRepos(t *testing.T) {
+	newRepo := func(id int64, fullName string) *gitea.Repo {
+		return &gitea.Repo{
+			ID:       id,
+			FullName: fullName,
+			CloneURL: "https://gitea.example.com/" + fullName + ".git",
+			SSHURL:   "git@gitea.example.com:" + fullName + ".git",
+		}
+	}
+	dotfiles := newRepo(1, "alice/dotfiles")
+	fork := newRepo(2, "alice/fork")
+	fork.Fork = true
+	app := newRepo(3, "myorg/app")
+	app.Private = true
+	archived := newRepo(4, "myorg/old")
+	archived.Archived = true
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		var result any
+		switch r.URL.Path {
+		case "/api/v1/user/repos":
+			result = []*gitea.Repo{dotfiles, fork}
+		case "/api/v1/orgs/myorg/repos":
+			// Return the organization's repositories on two pages.
+			w.Header().Set("X-Total-Count", "51")
+			if page == 1 {
+				repos := []*gitea.Repo{app}
+				for i := 0; i < 49; i++ {
+					repos = append(repos, app)
+				}
+				result = repos
+			} else {
+				result = []*gitea.Repo{archived}
+			}
+		case "/api/v1/repos/search":
+			result = map[string]any{"ok": true, "data": []*gitea.Repo{dotfiles, app}}
+		case "/api/v1/repos/myorg/app":
+			result = app
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+
+	list := func(t *testing.T, conn *schema.GiteaConnection) []string {
+		t.Helper()
+		requests = nil
+
+		conn.Url = srv.URL
+		conn.Token = "secret"
+		svc := typestest.MakeExternalService(t, extsvc.VariantGitea, conn)
+
+		src, err := NewGiteaSource(ctx, logtest.Scoped(t), svc, httpcli.TestExternalClientFactory)
+		require.NoError(t, err)
+
+		repos, err := ListAll(ctx, src)
+		require.NoError(t, err)
+
+		var names []string
+		for _, r := range repos {
+			names = append(names, string(r.Name))
+		}
+		return names
+	}
+
+	t.Run("affiliated by default", func(t *testing.T) {
+		names := list(t, &schema.GiteaConnection{})
+		require.Equal(t, []string{"127.0.0.1/alice/dotfiles", "127.0.0.1/alice/fork"}, names)
+		require.Equal(t, []string{"/api/v1/user/repos"}, requests)
+	})
+
+	t.Run("repos and orgs", func(t *testing.T) {
+		names := list(t, &schema.GiteaConnection{
+			Repos:                 []string{"myorg/app", "myorg/missing"},
+			Orgs:                  []string{"myorg"},
+			RepositoryPathPattern: "forge/{nameWithOwner}",
+		})
+		require.Equal(t, []string{"forge/myorg/app", "forge/myorg/old"}, names)
+		require.Equal(t, []string{
+			"/api/v1/repos/myorg/app",
+			"/api/v1/repos/myorg/missing",
+			"/api/v1/orgs/myorg/repos",
+			"/api/v1/orgs/myorg/repos",
+		}, requests)
+	})
+
+	t.Run("exclude", func(t *testing.T) {
+		names := list(t, &schema.GiteaConnection{
+			Orgs:            []string{"myorg"},
+			RepositoryQuery: []string{"all"},
+			Exclude: []*schema.ExcludedGiteaRepo{
+				{Forks: true},
+				{Archived: true},
+				{Id: 3},
+			},
+		})
+		require.Equal(t, []string{"127.0.0.1/alice/dotfiles"}, names)
+	})
+}
+
+func TestGiteaSource_makeRepo(t *testing.T) {
+	repo := &gitea.Repo{
+		ID:          42,
+		FullName:    "myorg/app",
+		Description: "An app",
+		Private:     true,
+		StarsCount:  3,
+		CloneURL:    "https://gitea.example.com/myorg/app.git",
+		SSHURL:      "git@gitea.example.com:myorg/app.git",
+	}
+
+	for _, gitURLType := range []string{"http", "ssh"} {
+		t.Run(gitURLType, func(t *testing.T) {
+			svc := typestest.MakeExternalService(t, extsvc.VariantGitea, &schema.GiteaConnection{
+				Url:        "https://gitea.example.com",
+				Token:      "secret",
+				GitURLType: gitURLType,
+			})
+
+			src, err := NewGiteaSource(context.Background(), logtest.Scoped(t), svc, httpcli.TestExternalClientFactory)
+			require.NoError(t, err)
+
+			cloneURL := repo.CloneURL
+			if gitURLType == "ssh" {
+				cloneURL = repo.SSHURL
+			}
+
+			got := src.makeRepo(repo)
+			require.Equal(t, api.RepoName("gitea.example.com/myorg/app"), got.Name)
+			require.Equal(t, "gitea.example.com/myorg/app", got.URI)
+			require.Equal(t, "An app", got.Description)
+			require.True(t, got.Private)
+			require.Equal(t, 3, got.Stars)
+			require.Equal(t, api.ExternalRepoSpec{
+				ID:          "42",
+				ServiceType: extsvc.TypeGitea,
+				ServiceID:   "https://gitea.example.com/",
+			}, got.ExternalRepo)
+			require.Equal(t, cloneURL, got.Sources[svc.URN()].CloneURL)
+			require.Same(t, repo, got.Metadata)
+		})
+	}
+}
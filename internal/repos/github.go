@@ -271,6 +271,7 @@ func (s *GitHubSource) ListRepos(ctx context.Context, results chan SourceResult)
 	}()
 
 	seen := make(map[int64]bool)
+	var forks []*github.Repository
 	for res := range unfiltered {
 		if res.err != nil {
 			results <- SourceResult{Source: s, Err: res.err}
@@ -279,9 +280,56 @@ func (s *GitHubSource) ListRepos(ctx context.Context, results chan SourceResult)
 
 		s.logger.Debug("unfiltered", log.String("repo", res.repo.NameWithOwner))
 		if !seen[res.repo.DatabaseID] && !s.excludes(res.repo) {
+			seen[res.repo.DatabaseID] = true
+
+			// The REST endpoints listing repositories don't return the parent of
+			// forks, so we look those up once listing is done.
+			if res.repo.IsFork && res.repo.Parent == nil {
+				forks = append(forks, res.repo)
+				continue
+			}
+
 			results <- SourceResult{Source: s, Repo: s.makeRepo(res.repo)}
 			s.logger.Debug("sent to result", log.String("repo", res.repo.NameWithOwner))
-			seen[res.repo.DatabaseID] = true
+		}
+	}
+
+	s.addForkParents(ctx, forks)
+	for _, r := range forks {
+		results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+		s.logger.Debug("sent to result", log.String("repo", r.NameWithOwner))
+	}
+}
+
+// addForkParents sets the parent of the given forks by fetching them in batches
+// through the GraphQL API. Failures are only logged, forks are synced without a
+// parent in that case.
+func (s *GitHubSource) addForkParents(ctx context.Context, forks []*github.Repository) {
+	const batchSize = 30
+
+	for start := 0; start < len(forks); start += batchSize {
+		if ctx.Err() != nil {
+			return
+		}
+
+		batch := forks[start:min(start+batchSize, len(forks))]
+		names := make([]string, 0, len(batch))
+		for _, r := range batch {
+			names = append(names, r.NameWithOwner)
+		}
+
+		repos, err := s.v4Client.GetReposByNameWithOwner(ctx, names...)
+		if err != nil {
+			s.logger.Warn("github sync: failed to fetch parents of forks", log.Strings("repos", names), log.Error(err))
+			continue
+		}
+
+		parents := make(map[int64]*github.ParentRepository, len(repos))
+		for _, r := range repos {
+			parents[r.DatabaseID] = r.Parent
+		}
+		for _, r := range batch {
+			r.Parent = parents[r.DatabaseID]
 		}
 	}
 }
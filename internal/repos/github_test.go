@@ -806,6 +806,65 @@ func githubGraphQLFailureMiddleware(cli httpcli.Doer) httpcli.Doer {
 	})
 }
 
+func TestGithubSource_ListRepos_ForkParents(t *testing.T) {
+	// The GitHubSource uses the github.Client under the hood, which
+	// uses rcache, a caching layer that uses Redis.
+	// We need to clear the cache before we run the tests
+	rcache.SetupForTest(t)
+	ratelimit.SetupForTest(t)
+
+	// The REST endpoint listing the repositories of an organization doesn't
+	// return the parent of forks, only the GraphQL API does.
+	var graphQLQueries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v3/orgs/acme/repos":
+			fmt.Fprint(w, `[
+				{"node_id": "R_1", "id": 1, "full_name": "acme/app", "html_url": "https://ghe.example.com/acme/app"},
+				{"node_id": "R_2", "id": 2, "full_name": "acme/fork", "html_url": "https://ghe.example.com/acme/fork", "fork": true}
+			]`)
+		case "/api/graphql":
+			var body struct{ Query string }
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			graphQLQueries = append(graphQLQueries, body.Query)
+			fmt.Fprint(w, `{"data": {"repo0": {
+				"id": "R_2", "databaseId": 2, "nameWithOwner": "acme/fork", "isFork": true,
+				"parent": {"nameWithOwner": "upstream/project", "isFork": false}
+			}}}`)
+		default:
+			w.Header().Set("X-GitHub-Enterprise-Version", "3.10.0")
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer srv.Close()
+
+	svc := typestest.MakeExternalService(t, extsvc.VariantGitHub, &schema.GitHubConnection{
+		Url:   srv.URL,
+		Token: "secret",
+		Orgs:  []string{"acme"},
+	})
+
+	ctx := context.Background()
+	githubSrc, err := NewGitHubSource(ctx, logtest.Scoped(t), dbmocks.NewMockDB(), svc, httpcli.TestExternalClientFactory)
+	require.NoError(t, err)
+
+	repos, err := ListAll(ctx, githubSrc)
+	require.NoError(t, err)
+
+	parents := map[string]*github.ParentRepository{}
+	for _, r := range repos {
+		parents[r.ExternalRepo.ID] = r.Metadata.(*github.Repository).Parent
+	}
+	assert.Equal(t, map[string]*github.ParentRepository{
+		"R_1": nil,
+		"R_2": {NameWithOwner: "upstream/project"},
+	}, parents)
+
+	require.Len(t, graphQLQueries, 1)
+	assert.Contains(t, graphQLQueries[0], `repository(owner: "acme", name: "fork")`)
+}
+
 func TestGithubSource_WithAuthenticator(t *testing.T) {
 	// The GitHubSource uses the github.Client under the hood, which
 	// uses rcache, a caching layer that uses Redis.
@@ -0,0 +1,141 @@
+package repos
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/mercurial"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A MercurialSource yields repositories from a single hgweb server configured
+// in Sourcegraph via the external services configuration.
+type MercurialSource struct {
+	svc       *types.ExternalService
+	conn      *schema.MercurialConnection
+	cli       *mercurial.Client
+	serviceID string
+	excluder  repoExcluder
+}
+
+// NewMercurialSource returns a new MercurialSource from the given external service.
+func NewMercurialSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*MercurialSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.MercurialConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := mercurial.NewClient(&c, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	var ex repoExcluder
+	for _, r := range c.Exclude {
+		ex.AddRule(NewRule().
+			Exact(r.Name).
+			Pattern(r.Pattern))
+	}
+	if err := ex.RuleErrors(); err != nil {
+		return nil, err
+	}
+
+	return &MercurialSource{
+		svc:       svc,
+		conn:      &c,
+		cli:       cli,
+		serviceID: extsvc.NormalizeBaseURL(cli.URL).String(),
+		excluder:  ex,
+	}, nil
+}
+
+// CheckConnection checks that the hgweb server is reachable with the
+// configured credentials.
+func (s *MercurialSource) CheckConnection(ctx context.Context) error {
+	if err := s.cli.Ping(ctx); err != nil {
+		return errors.Wrap(err, "connection check failed. could not reach hgweb server")
+	}
+	return nil
+}
+
+// ListRepos returns all Mercurial repositories configured with this
+// MercurialSource's config. If no repositories are configured explicitly, all
+// repositories listed on the index page of the hgweb server are returned.
+func (s *MercurialSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	var repos []*mercurial.Repo
+	if len(s.conn.Repos) > 0 {
+		for _, name := range s.conn.Repos {
+			name = strings.Trim(name, "/")
+			repos = append(repos, &mercurial.Repo{Name: name, URL: s.cli.RepoURL(name)})
+		}
+	} else {
+		var err error
+		if repos, err = s.cli.ListRepos(ctx); err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			return
+		}
+	}
+
+	for _, r := range repos {
+		if s.excluder.ShouldExclude(r.Name) {
+			continue
+		}
+		results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *MercurialSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *MercurialSource) makeRepo(r *mercurial.Repo) *types.Repo {
+	urn := s.svc.URN()
+
+	return &types.Repo{
+		Name: reposource.MercurialRepoName(
+			s.conn.RepositoryPathPattern,
+			s.cli.URL.Hostname(),
+			r.Name,
+		),
+		URI: string(reposource.MercurialRepoName(
+			"",
+			s.cli.URL.Hostname(),
+			r.Name,
+		)),
+		Description: r.Description,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          r.Name,
+			ServiceType: extsvc.TypeMercurial,
+			ServiceID:   s.serviceID,
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: r.URL,
+			},
+		},
+		Metadata: r,
+		Private:  !s.svc.Unrestricted,
+	}
+}
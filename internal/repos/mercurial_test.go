@@ -0,0 +1,109 @@
+package repos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/mercurial"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/types/typestest"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMercurialSource_ListRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("style") != "json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"entries": [
+			{"name": "project/repo", "description": "A repository", "contact": "", "lastchange": [1700000000, 0], "labels": []},
+			{"name": "project/secret", "description": "", "contact": "", "lastchange": [1700000000, 0], "labels": []},
+			{"name": "other", "description": "", "contact": "", "lastchange": [1700000000, 0], "labels": []}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	host := u.Hostname()
+
+	makeRepo := func(svc *types.ExternalService, name, description string) *types.Repo {
+		cloneURL := srv.URL + "/" + name
+		return &types.Repo{
+			Name:        api.RepoName(host + "/" + name),
+			URI:         host + "/" + name,
+			Description: description,
+			ExternalRepo: api.ExternalRepoSpec{
+				ID:          name,
+				ServiceType: extsvc.TypeMercurial,
+				ServiceID:   srv.URL + "/",
+			},
+			Sources: map[string]*types.SourceInfo{
+				svc.URN(): {ID: svc.URN(), CloneURL: cloneURL},
+			},
+			Metadata: &mercurial.Repo{Name: name, Description: description, URL: cloneURL},
+			Private:  true,
+		}
+	}
+
+	ctx := context.Background()
+
+	t.Run("index", func(t *testing.T) {
+		svc := typestest.MakeExternalService(t, extsvc.VariantMercurial, &schema.MercurialConnection{
+			Url:     srv.URL,
+			Exclude: []*schema.ExcludedMercurialRepo{{Pattern: "secret$"}},
+		})
+
+		src, err := NewMercurialSource(ctx, svc, httpcli.TestExternalClientFactory)
+		require.NoError(t, err)
+
+		repos, err := ListAll(ctx, src)
+		require.NoError(t, err)
+		require.Equal(t, []*types.Repo{
+			makeRepo(svc, "project/repo", "A repository"),
+			makeRepo(svc, "other", ""),
+		}, repos)
+	})
+
+	t.Run("explicit repos", func(t *testing.T) {
+		svc := typestest.MakeExternalService(t, extsvc.VariantMercurial, &schema.MercurialConnection{
+			Url:   srv.URL,
+			Repos: []string{"/project/repo/"},
+		})
+
+		src, err := NewMercurialSource(ctx, svc, httpcli.TestExternalClientFactory)
+		require.NoError(t, err)
+
+		repos, err := ListAll(ctx, src)
+		require.NoError(t, err)
+		require.Equal(t, []*types.Repo{
+			makeRepo(svc, "project/repo", ""),
+		}, repos)
+	})
+
+	t.Run("repositoryPathPattern", func(t *testing.T) {
+		svc := typestest.MakeExternalService(t, extsvc.VariantMercurial, &schema.MercurialConnection{
+			Url:                   srv.URL,
+			Repos:                 []string{"other"},
+			RepositoryPathPattern: "hg/{name}",
+		})
+
+		src, err := NewMercurialSource(ctx, svc, httpcli.TestExternalClientFactory)
+		require.NoError(t, err)
+
+		repos, err := ListAll(ctx, src)
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+		require.Equal(t, api.RepoName("hg/other"), repos[0].Name)
+		require.Equal(t, host+"/other", repos[0].URI)
+	})
+}
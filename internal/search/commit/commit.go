@@ -325,6 +325,7 @@ func protocolMatchToCommitMatch(repo types.MinimalRepo, diff bool, in protocol.C
 	if diff {
 		diffPreview = &in.Diff
 		structuredDiff, _ = result.ParseDiffString(in.Diff.Content)
+		result.MarkCopiedFiles(structuredDiff, in.CopiedFiles)
 	} else {
 		messagePreview = &in.Message
 	}
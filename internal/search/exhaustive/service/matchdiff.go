@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/object"
+)
+
+// matchKey identifies a result across runs of a search job schedule. Line
+// numbers are ignored, so that a match moving within a file isn't reported
+// as a change. Revisions are ignored for file matches, since the revision
+// searched usually moves between runs. For commit and repository matches the
+// revision is what identifies the match, so it is kept.
+func matchKey(row matchRow) string {
+	revision := ""
+	if row.Path == "" {
+		revision = row.Revision
+	}
+	return strings.Join([]string{row.Repository, revision, row.Path, strings.TrimSpace(row.Preview)}, "\x00")
+}
+
+// resultsDiff is the result of diffSearchJobResults.
+type resultsDiff struct {
+	// Count is the number of results of the head job.
+	Count int32
+	// Added is the number of results of the head job that aren't results of
+	// the base job.
+	Added int32
+	// Removed is the number of results of the base job that aren't results
+	// of the head job.
+	Removed int32
+}
+
+// diffSearchJobResults compares the results of the search jobs baseID and
+// headID. If baseID is 0, all results of headID are considered added.
+//
+// onAdded and onRemoved, if non-nil, are called for every added and removed
+// result. Results are compared as a multiset of their matchKey, which is the
+// only state held in memory, and only for the results of the base job.
+func diffSearchJobResults(ctx context.Context, uploadStore object.Storage, baseID, headID int64, onAdded, onRemoved func(matchRow) error) (diff resultsDiff, err error) {
+	forEachRow := func(id int64, f func(matchRow) error) error {
+		if id == 0 {
+			return nil
+		}
+		iter, err := uploadStore.List(ctx, getPrefix(id))
+		if err != nil {
+			return err
+		}
+		return forEachMatchRow(ctx, iter, uploadStore, f)
+	}
+
+	base := make(map[string]int32)
+	err = forEachRow(baseID, func(row matchRow) error {
+		base[matchKey(row)]++
+		return nil
+	})
+	if err != nil {
+		return diff, err
+	}
+
+	err = forEachRow(headID, func(row matchRow) error {
+		diff.Count++
+		key := matchKey(row)
+		if base[key] > 0 {
+			base[key]--
+			return nil
+		}
+		diff.Added++
+		if onAdded != nil {
+			return onAdded(row)
+		}
+		return nil
+	})
+	if err != nil {
+		return diff, err
+	}
+
+	// Whatever is left in base was removed.
+	for _, n := range base {
+		diff.Removed += n
+	}
+	if onRemoved == nil || diff.Removed == 0 {
+		return diff, nil
+	}
+
+	// We only kept the keys of the base results in memory, so we read them
+	// again to report the removed rows.
+	return diff, forEachRow(baseID, func(row matchRow) error {
+		key := matchKey(row)
+		if base[key] == 0 {
+			return nil
+		}
+		base[key]--
+		return onRemoved(row)
+	})
+}
+
+// writeSearchJobResultsDiffCSV writes the results added and removed between
+// the search jobs baseID and headID as CSV.
+func writeSearchJobResultsDiffCSV(ctx context.Context, uploadStore object.Storage, baseID, headID int64, w io.Writer) (int64, error) {
+	writeCounter := &writeCounter{w: w}
+	cw := csv.NewWriter(writeCounter)
+
+	if err := cw.Write(append([]string{"change"}, matchRowHeader...)); err != nil {
+		return writeCounter.n, err
+	}
+
+	write := func(change string) func(matchRow) error {
+		return func(row matchRow) error {
+			line := ""
+			if row.Line > 0 {
+				line = strconv.Itoa(row.Line)
+			}
+			return cw.Write([]string{change, row.Repository, row.Revision, row.Path, line, row.Preview})
+		}
+	}
+
+	_, err := diffSearchJobResults(ctx, uploadStore, baseID, headID, write("added"), write("removed"))
+	if err != nil {
+		return writeCounter.n, err
+	}
+
+	// Flush data before checking for any final write errors.
+	cw.Flush()
+	return writeCounter.n, cw.Error()
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/object/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+func mockRunsStore(runs map[int64]string) *mocks.MockStorage {
+	blobstore := mocks.NewMockStorage()
+	blobstore.ListFunc.SetDefaultHook(func(ctx context.Context, prefix string) (*iterator.Iterator[string], error) {
+		var keys []string
+		for id := range runs {
+			if getPrefix(id) == prefix {
+				keys = append(keys, prefix+"1")
+			}
+		}
+		return iterator.From(keys), nil
+	})
+	blobstore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		for id, blob := range runs {
+			if strings.HasPrefix(key, getPrefix(id)) {
+				return io.NopCloser(strings.NewReader(blob)), nil
+			}
+		}
+		return io.NopCloser(strings.NewReader("")), nil
+	})
+	return blobstore
+}
+
+func TestDiffSearchJobResults(t *testing.T) {
+	runs := map[int64]string{
+		// deprecatedCall appears twice in main.go and once in util.go.
+		1: `{"type":"content","path":"main.go","repository":"github.com/sourcegraph/foo","commit":"aaaaaaaa","chunkMatches":[{"content":"deprecatedCall()\nx := deprecatedCall()","contentStart":{"offset":0,"line":2,"column":0},"ranges":[{"start":{"offset":0,"line":2,"column":0},"end":{"offset":14,"line":2,"column":14}},{"start":{"offset":22,"line":3,"column":5},"end":{"offset":36,"line":3,"column":19}}]}]}
+{"type":"content","path":"util.go","repository":"github.com/sourcegraph/foo","commit":"aaaaaaaa","chunkMatches":[{"content":"\tdeprecatedCall()","contentStart":{"offset":0,"line":9,"column":0},"ranges":[{"start":{"offset":1,"line":9,"column":1},"end":{"offset":15,"line":9,"column":15}}]}]}
+`,
+		// A new commit removed the call in util.go, moved the remaining
+		// lines in main.go and added one in new.go.
+		2: `{"type":"content","path":"main.go","repository":"github.com/sourcegraph/foo","commit":"bbbbbbbb","chunkMatches":[{"content":"deprecatedCall()\nx := deprecatedCall()","contentStart":{"offset":0,"line":12,"column":0},"ranges":[{"start":{"offset":0,"line":12,"column":0},"end":{"offset":14,"line":12,"column":14}},{"start":{"offset":22,"line":13,"column":5},"end":{"offset":36,"line":13,"column":19}}]}]}
+{"type":"content","path":"new.go","repository":"github.com/sourcegraph/foo","commit":"bbbbbbbb","chunkMatches":[{"content":"y := deprecatedCall()","contentStart":{"offset":0,"line":0,"column":0},"ranges":[{"start":{"offset":5,"line":0,"column":5},"end":{"offset":19,"line":0,"column":19}}]}]}
+`,
+	}
+	blobstore := mockRunsStore(runs)
+	ctx := context.Background()
+
+	t.Run("counts", func(t *testing.T) {
+		diff, err := diffSearchJobResults(ctx, blobstore, 1, 2, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, resultsDiff{Count: 3, Added: 1, Removed: 1}, diff)
+	})
+
+	t.Run("no base", func(t *testing.T) {
+		diff, err := diffSearchJobResults(ctx, blobstore, 0, 1, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, resultsDiff{Count: 3, Added: 3}, diff)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		n, err := writeSearchJobResultsDiffCSV(ctx, blobstore, 1, 2, w)
+		require.NoError(t, err)
+		require.Equal(t, int64(w.Len()), n)
+
+		want := `change,repository,revision,path,line,preview
+added,github.com/sourcegraph/foo,bbbbbbbb,new.go,1,y := deprecatedCall()
+removed,github.com/sourcegraph/foo,aaaaaaaa,util.go,10,"	deprecatedCall()"
+`
+		require.Equal(t, want, w.String())
+	})
+}
+
+func TestParseSchedule(t *testing.T) {
+	now := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, schedule := range []string{"@weekly", "@daily", "0 9 * * MON", "0 * * * *"} {
+		_, err := parseSchedule(schedule, now)
+		require.NoError(t, err, schedule)
+	}
+
+	for _, schedule := range []string{"", "not a schedule", "*/5 * * * *"} {
+		_, err := parseSchedule(schedule, now)
+		require.Error(t, err, schedule)
+	}
+}
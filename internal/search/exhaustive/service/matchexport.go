@@ -0,0 +1,317 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/compress"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+
+	"github.com/sourcegraph/sourcegraph/internal/object"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+// ResultsFormat is the format in which the results of a search job are
+// downloaded. Results are always stored as newline-delimited JSON, the other
+// formats are converted on the fly.
+type ResultsFormat string
+
+const (
+	ResultsFormatJSON    ResultsFormat = "jsonl"
+	ResultsFormatCSV     ResultsFormat = "csv"
+	ResultsFormatParquet ResultsFormat = "parquet"
+)
+
+// ParseResultsFormat parses the format of a search job download. An empty
+// string is parsed as ResultsFormatJSON.
+func ParseResultsFormat(s string) (ResultsFormat, error) {
+	switch f := ResultsFormat(strings.ToLower(s)); f {
+	case "", ResultsFormatJSON:
+		return ResultsFormatJSON, nil
+	case ResultsFormatCSV, ResultsFormatParquet:
+		return f, nil
+	default:
+		return "", errors.Errorf("unsupported search job results format %q", s)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f ResultsFormat) ContentType() string {
+	switch f {
+	case ResultsFormatCSV:
+		return "text/csv"
+	case ResultsFormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "application/jsonlines"
+	}
+}
+
+// Extension returns the file extension of the format, including the dot.
+func (f ResultsFormat) Extension() string {
+	return "." + string(f)
+}
+
+// matchRow is a flattened search result: one row per matched line for
+// content matches, one row per symbol for symbol matches, and one row per
+// match for all other match types.
+type matchRow struct {
+	Repository string
+	Revision   string
+	Path       string
+	// Line is the 1-based line number of the match, or 0 if the match isn't
+	// associated with a line.
+	Line    int
+	Preview string
+}
+
+var matchRowHeader = []string{"repository", "revision", "path", "line", "preview"}
+
+// matchRows returns the rows for the JSON encoded event match.
+func matchRows(data []byte) ([]matchRow, error) {
+	var typ struct {
+		Type streamhttp.MatchType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typ); err != nil {
+		return nil, err
+	}
+
+	switch typ.Type {
+	case streamhttp.ContentMatchType:
+		var m streamhttp.EventContentMatch
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		var rows []matchRow
+		for _, cm := range m.ChunkMatches {
+			lines := strings.Split(cm.Content, "\n")
+			seen := make(map[int]struct{}, len(cm.Ranges))
+			for _, rr := range cm.Ranges {
+				if _, ok := seen[rr.Start.Line]; ok {
+					continue
+				}
+				seen[rr.Start.Line] = struct{}{}
+
+				preview := ""
+				if i := rr.Start.Line - cm.ContentStart.Line; i >= 0 && i < len(lines) {
+					preview = lines[i]
+				}
+				rows = append(rows, matchRow{
+					Repository: m.Repository,
+					Revision:   m.Commit,
+					Path:       m.Path,
+					Line:       rr.Start.Line + 1,
+					Preview:    preview,
+				})
+			}
+		}
+		return rows, nil
+
+	case streamhttp.PathMatchType:
+		var m streamhttp.EventPathMatch
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		return []matchRow{{Repository: m.Repository, Revision: m.Commit, Path: m.Path}}, nil
+
+	case streamhttp.SymbolMatchType:
+		var m streamhttp.EventSymbolMatch
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		rows := make([]matchRow, 0, len(m.Symbols))
+		for _, s := range m.Symbols {
+			rows = append(rows, matchRow{
+				Repository: m.Repository,
+				Revision:   m.Commit,
+				Path:       m.Path,
+				Line:       int(s.Line),
+				Preview:    s.Name,
+			})
+		}
+		return rows, nil
+
+	case streamhttp.CommitMatchType:
+		var m streamhttp.EventCommitMatch
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		subject, _, _ := strings.Cut(m.Message, "\n")
+		return []matchRow{{Repository: m.Repository, Revision: m.OID, Preview: subject}}, nil
+
+	case streamhttp.RepoMatchType:
+		var m streamhttp.EventRepoMatch
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		revision := ""
+		if len(m.Branches) > 0 {
+			revision = m.Branches[0]
+		}
+		return []matchRow{{Repository: m.Repository, Revision: revision}}, nil
+
+	default:
+		// Person and team matches are not produced by search jobs.
+		return nil, nil
+	}
+}
+
+// forEachMatchRow calls f for every row of every match stored in the blobs
+// returned by iter. The blobs are read one at a time, line by line, so the
+// result set is never held in memory in its entirety.
+func forEachMatchRow(ctx context.Context, iter *iterator.Iterator[string], uploadStore object.Storage, f func(matchRow) error) error {
+	// keep a single bufio.Reader so we can reuse its buffer.
+	var br bufio.Reader
+
+	readKey := func(key string) error {
+		rc, err := uploadStore.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		br.Reset(rc)
+
+		for {
+			line, err := br.ReadBytes('\n')
+			if len(line) > 0 {
+				rows, err := matchRows(line)
+				if err != nil {
+					return err
+				}
+				for _, row := range rows {
+					if err := f(row); err != nil {
+						return err
+					}
+				}
+			}
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for iter.Next() {
+		key := iter.Current()
+		if err := readKey(key); err != nil {
+			return errors.Wrapf(err, "converting results for key %q", key)
+		}
+	}
+
+	return iter.Err()
+}
+
+func writeSearchJobCSV(ctx context.Context, iter *iterator.Iterator[string], uploadStore object.Storage, w io.Writer) (int64, error) {
+	writeCounter := &writeCounter{w: w}
+	cw := csv.NewWriter(writeCounter)
+
+	if err := cw.Write(matchRowHeader); err != nil {
+		return writeCounter.n, err
+	}
+
+	err := forEachMatchRow(ctx, iter, uploadStore, func(row matchRow) error {
+		line := ""
+		if row.Line > 0 {
+			line = strconv.Itoa(row.Line)
+		}
+		return cw.Write([]string{row.Repository, row.Revision, row.Path, line, row.Preview})
+	})
+	if err != nil {
+		return writeCounter.n, err
+	}
+
+	// Flush data before checking for any final write errors.
+	cw.Flush()
+	return writeCounter.n, cw.Error()
+}
+
+// parquetBatchSize is the number of rows written to each Parquet row group.
+// It bounds the number of rows we hold in memory while converting results.
+const parquetBatchSize = 10_000
+
+var parquetSchema = arrow.NewSchema([]arrow.Field{
+	{Name: matchRowHeader[0], Type: arrow.BinaryTypes.String},
+	{Name: matchRowHeader[1], Type: arrow.BinaryTypes.String},
+	{Name: matchRowHeader[2], Type: arrow.BinaryTypes.String},
+	{Name: matchRowHeader[3], Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: matchRowHeader[4], Type: arrow.BinaryTypes.String},
+}, nil)
+
+func writeSearchJobParquet(ctx context.Context, iter *iterator.Iterator[string], uploadStore object.Storage, w io.Writer) (_ int64, err error) {
+	writeCounter := &writeCounter{w: w}
+
+	fw, err := pqarrow.NewFileWriter(
+		parquetSchema,
+		writeCounter,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+		pqarrow.DefaultWriterProps(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, parquetSchema)
+	defer b.Release()
+
+	var (
+		repository = b.Field(0).(*array.StringBuilder)
+		revision   = b.Field(1).(*array.StringBuilder)
+		path       = b.Field(2).(*array.StringBuilder)
+		line       = b.Field(3).(*array.Int32Builder)
+		preview    = b.Field(4).(*array.StringBuilder)
+		rows       int
+	)
+
+	flush := func() error {
+		if rows == 0 {
+			return nil
+		}
+		rec := b.NewRecord()
+		defer rec.Release()
+		rows = 0
+		return fw.Write(rec)
+	}
+
+	err = forEachMatchRow(ctx, iter, uploadStore, func(row matchRow) error {
+		repository.Append(row.Repository)
+		revision.Append(row.Revision)
+		path.Append(row.Path)
+		if row.Line > 0 {
+			line.Append(int32(row.Line))
+		} else {
+			line.AppendNull()
+		}
+		preview.Append(row.Preview)
+
+		rows++
+		if rows >= parquetBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+
+	// Close writes the file footer, without which the file is unreadable.
+	// We only care about its error if everything else succeeded.
+	if closeErr := fw.Close(); err == nil {
+		err = closeErr
+	}
+
+	return writeCounter.n, err
+}
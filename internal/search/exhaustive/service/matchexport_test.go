@@ -0,0 +1,97 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/object/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/iterator"
+)
+
+var testResultBlobs = map[string]string{
+	"a": `{"type":"content","path":"main.go","repositoryID":1,"repository":"github.com/sourcegraph/foo","commit":"deadbeef","hunks":null,"chunkMatches":[{"content":"func main() {\n\tfmt.Println(\"hello, world\")","contentStart":{"offset":0,"line":2,"column":0},"ranges":[{"start":{"offset":0,"line":2,"column":0},"end":{"offset":4,"line":2,"column":4}},{"start":{"offset":15,"line":3,"column":1},"end":{"offset":18,"line":3,"column":4}},{"start":{"offset":19,"line":3,"column":5},"end":{"offset":26,"line":3,"column":12}}]}]}
+{"type":"path","path":"README.md","repositoryID":1,"repository":"github.com/sourcegraph/foo","commit":"deadbeef"}
+`,
+	"b": `{"type":"commit","label":"","url":"","detail":"","repositoryID":2,"repository":"github.com/sourcegraph/bar","oid":"cafebabe","message":"Fix bug\n\nLong description.","authorName":"","authorDate":"0001-01-01T00:00:00Z","committerName":"","committerDate":"0001-01-01T00:00:00Z","content":"","ranges":null}
+`,
+}
+
+func mockResultsStore() *mocks.MockStorage {
+	blobstore := mocks.NewMockStorage()
+	blobstore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader([]byte(testResultBlobs[key]))), nil
+	})
+	return blobstore
+}
+
+func TestParseResultsFormat(t *testing.T) {
+	for in, want := range map[string]ResultsFormat{
+		"":        ResultsFormatJSON,
+		"jsonl":   ResultsFormatJSON,
+		"CSV":     ResultsFormatCSV,
+		"parquet": ResultsFormatParquet,
+	} {
+		got, err := ParseResultsFormat(in)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	_, err := ParseResultsFormat("xlsx")
+	require.Error(t, err)
+}
+
+func TestWriteSearchJobCSV(t *testing.T) {
+	w := &bytes.Buffer{}
+
+	n, err := writeSearchJobCSV(context.Background(), iterator.From([]string{"a", "b"}), mockResultsStore(), w)
+	require.NoError(t, err)
+
+	want := `repository,revision,path,line,preview
+github.com/sourcegraph/foo,deadbeef,main.go,3,func main() {
+github.com/sourcegraph/foo,deadbeef,main.go,4,"	fmt.Println(""hello, world"")"
+github.com/sourcegraph/foo,deadbeef,README.md,,
+github.com/sourcegraph/bar,cafebabe,,,Fix bug
+`
+	require.Equal(t, want, w.String())
+	require.Equal(t, int64(w.Len()), n)
+}
+
+func TestWriteSearchJobParquet(t *testing.T) {
+	w := &bytes.Buffer{}
+
+	n, err := writeSearchJobParquet(context.Background(), iterator.From([]string{"a", "b"}), mockResultsStore(), w)
+	require.NoError(t, err)
+	require.Equal(t, int64(w.Len()), n)
+
+	pf, err := file.NewParquetReader(bytes.NewReader(w.Bytes()))
+	require.NoError(t, err)
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	require.Equal(t, int64(4), table.NumRows())
+
+	column := func(i int) []string {
+		var values []string
+		for _, chunk := range table.Column(i).Data().Chunks() {
+			for j := 0; j < chunk.Len(); j++ {
+				values = append(values, chunk.ValueStr(j))
+			}
+		}
+		return values
+	}
+
+	require.Equal(t, []string{"main.go", "main.go", "README.md", ""}, column(2))
+	require.Equal(t, []string{"3", "4", array.NullValueStr, array.NullValueStr}, column(3))
+	require.Equal(t, []string{"func main() {", "\tfmt.Println(\"hello, world\")", "", "Fix bug"}, column(4))
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// DefaultRetainRuns is the number of runs kept for a schedule if the
+	// user doesn't specify it.
+	DefaultRetainRuns = 10
+
+	// maxRetainRuns bounds the storage used by a single schedule.
+	maxRetainRuns = 100
+
+	// minScheduleInterval is the minimum time between two runs of a
+	// schedule. Search jobs are expensive, so we don't want to run them more
+	// often than this.
+	minScheduleInterval = time.Hour
+)
+
+// parseSchedule parses a cron expression and validates that it doesn't run
+// more often than minScheduleInterval.
+func parseSchedule(schedule string, now time.Time) (*cronexpr.Expression, error) {
+	expr, err := cronexpr.Parse(schedule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid schedule %q", schedule)
+	}
+
+	next := expr.NextN(now, 2)
+	if len(next) < 2 {
+		return nil, errors.Errorf("schedule %q never runs", schedule)
+	}
+	if next[1].Sub(next[0]) < minScheduleInterval {
+		return nil, errors.Errorf("schedule %q runs more often than every %s", schedule, minScheduleInterval)
+	}
+
+	return expr, nil
+}
+
+// CreateSearchJobSchedule creates a schedule which periodically creates a
+// search job for query. schedule is a cron expression. The most recent
+// retainRuns search jobs of the schedule are kept, older ones are deleted. If
+// retainRuns is 0, DefaultRetainRuns is used.
+//
+// The first search job is created on the next run of the worker, rather than
+// at the first time matching schedule, so that users get results right away.
+func (s *Service) CreateSearchJobSchedule(ctx context.Context, query, schedule string, retainRuns int32) (_ *types.ExhaustiveSearchJobSchedule, err error) {
+	ctx, _, endObservation := s.operations.createSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.String("query", query),
+		attribute.String("schedule", schedule),
+	))
+	defer endObservation(1, observation.Args{})
+
+	actor := actor.FromContext(ctx)
+	if !actor.IsAuthenticated() {
+		return nil, errors.New("search job schedules can only be created by an authenticated user")
+	}
+
+	if retainRuns == 0 {
+		retainRuns = DefaultRetainRuns
+	}
+	if retainRuns < 1 || retainRuns > maxRetainRuns {
+		return nil, errors.Errorf("the number of retained runs must be between 1 and %d", maxRetainRuns)
+	}
+
+	now := time.Now()
+	if _, err := parseSchedule(schedule, now); err != nil {
+		return nil, err
+	}
+
+	// Validate query
+	err = s.ValidateSearchJob(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	id, err := tx.CreateSearchJobSchedule(ctx, types.ExhaustiveSearchJobSchedule{
+		InitiatorID: actor.UID,
+		Query:       query,
+		Schedule:    schedule,
+		RetainRuns:  retainRuns,
+		NextRunAt:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.GetSearchJobSchedule(ctx, id)
+}
+
+func (s *Service) GetSearchJobSchedule(ctx context.Context, id int64) (_ *types.ExhaustiveSearchJobSchedule, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	return s.store.GetSearchJobSchedule(ctx, id)
+}
+
+// ListSearchJobSchedules returns the schedules of the current user. Site
+// admins may list the schedules of other users by passing userIDs.
+func (s *Service) ListSearchJobSchedules(ctx context.Context, userIDs []int32) (schedules []*types.ExhaustiveSearchJobSchedule, err error) {
+	ctx, _, endObservation := s.operations.listSearchJobSchedules.With(ctx, &err, observation.Args{})
+	defer func() {
+		endObservation(1, opAttrs(
+			attribute.Int("len", len(schedules)),
+		))
+	}()
+
+	return s.store.ListSearchJobSchedules(ctx, userIDs)
+}
+
+// ListSearchJobScheduleRuns returns the search jobs created by the schedule
+// id, most recent first.
+func (s *Service) ListSearchJobScheduleRuns(ctx context.Context, id int64) (jobs []*types.ExhaustiveSearchJob, err error) {
+	ctx, _, endObservation := s.operations.listSearchJobScheduleRuns.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer func() {
+		endObservation(1, opAttrs(
+			attribute.Int("len", len(jobs)),
+		))
+	}()
+
+	return s.store.ListSearchJobScheduleRuns(ctx, id)
+}
+
+// DeleteSearchJobSchedule deletes the schedule id. Search jobs created by the
+// schedule are not deleted, they become one-off jobs.
+func (s *Service) DeleteSearchJobSchedule(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	return s.store.DeleteSearchJobSchedule(ctx, id)
+}
+
+// RunDueSearchJobSchedules creates a search job for every schedule that is
+// due at now, on behalf of the user who created the schedule. It must be
+// called with an internal actor.
+func (s *Service) RunDueSearchJobSchedules(ctx context.Context, now time.Time) (err error) {
+	ctx, _, endObservation := s.operations.runDueSearchJobSchedules.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	schedules, errs, err := s.claimDueSearchJobSchedules(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		if err := s.runSearchJobSchedule(ctx, schedule); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "running search job schedule %d", schedule.ID))
+		}
+	}
+
+	return errs
+}
+
+// claimDueSearchJobSchedules advances the next run of every schedule that is
+// due at now, and returns the schedules it advanced. Listing and advancing
+// happen in one transaction that skips schedules locked by other workers, so
+// that every run is claimed by exactly one worker.
+//
+// Schedules whose next run can't be computed are not claimed, their errors
+// are returned in invalid.
+func (s *Service) claimDueSearchJobSchedules(ctx context.Context, now time.Time) (claimed []*types.ExhaustiveSearchJobSchedule, invalid error, err error) {
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	schedules, err := tx.ListDueSearchJobSchedules(ctx, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, schedule := range schedules {
+		expr, err := parseSchedule(schedule.Schedule, now)
+		if err != nil {
+			invalid = errors.Append(invalid, errors.Wrapf(err, "running search job schedule %d", schedule.ID))
+			continue
+		}
+
+		// Advance the schedule before creating the job. If creating the job
+		// fails, for example because the query is no longer valid, we'd rather
+		// skip a run than retry it in a hot loop.
+		if err := tx.SetSearchJobScheduleNextRunAt(ctx, schedule.ID, expr.Next(now)); err != nil {
+			return nil, nil, err
+		}
+		claimed = append(claimed, schedule)
+	}
+
+	return claimed, invalid, nil
+}
+
+func (s *Service) runSearchJobSchedule(ctx context.Context, schedule *types.ExhaustiveSearchJobSchedule) error {
+	// Use the initiator as the actor, so the search only sees what the user
+	// can see.
+	userCtx := actor.WithActor(ctx, actor.FromUser(schedule.InitiatorID))
+
+	if err := s.ValidateSearchJob(userCtx, schedule.Query); err != nil {
+		return err
+	}
+
+	_, err := s.createSearchJob(userCtx, schedule.Query, schedule.ID)
+	return err
+}
+
+// UpdateScheduledSearchJobResults counts the results of the scheduled search
+// job id, and computes how they differ from the results of the previous
+// completed run of its schedule. Afterwards it deletes the runs of the
+// schedule exceeding its retention.
+//
+// It is called by the janitor once the job is aggregated. It is a no-op for
+// one-off jobs.
+func (s *Service) UpdateScheduledSearchJobResults(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.updateScheduledJobResults.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return err
+	}
+	if job.ScheduleID == 0 {
+		return nil
+	}
+
+	schedule, err := s.store.GetSearchJobSchedule(ctx, job.ScheduleID)
+	if err != nil {
+		return err
+	}
+
+	// runs are sorted by ID, most recent first.
+	runs, err := s.store.ListSearchJobScheduleRuns(ctx, schedule.ID)
+	if err != nil {
+		return err
+	}
+
+	// Results of failed or canceled runs are incomplete, so we neither count
+	// them nor compare against them.
+	if job.State == types.JobStateCompleted {
+		var base *types.ExhaustiveSearchJob
+		for _, run := range runs {
+			if run.ID < job.ID && run.IsAggregated && run.State == types.JobStateCompleted {
+				base = run
+				break
+			}
+		}
+
+		var baseID int64
+		if base != nil {
+			baseID = base.ID
+		}
+
+		diff, err := diffSearchJobResults(ctx, s.uploadStore, baseID, job.ID, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var resultsDiff *types.ResultsDiff
+		if base != nil {
+			resultsDiff = &types.ResultsDiff{
+				BaseJobID: base.ID,
+				Added:     diff.Added,
+				Removed:   diff.Removed,
+			}
+		}
+		if err := s.store.SetSearchJobResults(ctx, job.ID, diff.Count, resultsDiff); err != nil {
+			return err
+		}
+	}
+
+	// Apply the retention. Runs that aren't aggregated yet are kept, so we
+	// don't delete data that is still being written.
+	var errs error
+	for i, run := range runs {
+		if i < int(schedule.RetainRuns) || !run.IsAggregated {
+			continue
+		}
+		if err := s.DeleteSearchJob(ctx, run.ID); err != nil {
+			errs = errors.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// GetSearchJobResultsDiffWriterTo returns a WriterTo which can be called once
+// to write the results added and removed by the scheduled search job id,
+// compared to the previous run of its schedule, as CSV.
+// Note: ctx is used by WriterTo.
+func (s *Service) GetSearchJobResultsDiffWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobResultsDiffWriterTo.get.With(parentCtx, &err, opAttrs(
+		attribute.Int64("id", id)))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: GetExhaustiveSearchJob checks that the actor has access
+	// to the job.
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.ResultsDiff == nil {
+		return nil, errors.Wrapf(store.ErrNoResults, "search job %d has no results diff", id)
+	}
+
+	baseID := job.ResultsDiff.BaseJobID
+	// 🚨 SECURITY: the base job is usually created by the same schedule, but
+	// we double check that the actor has access to it.
+	if err := s.store.UserHasAccess(ctx, baseID); err != nil {
+		return nil, errors.Wrapf(err, "previous run %d of search job %d", baseID, id)
+	}
+
+	return writerToFunc(func(w io.Writer) (n int64, err error) {
+		ctx, _, endObservation := s.operations.getSearchJobResultsDiffWriterTo.writerTo.With(parentCtx, &err, opAttrs(
+			attribute.Int64("id", id)))
+		defer func() {
+			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
+		}()
+
+		return writeSearchJobResultsDiffCSV(ctx, s.uploadStore, baseID, id, w)
+	}), nil
+}
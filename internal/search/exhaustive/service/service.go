@@ -62,8 +62,17 @@ type operations struct {
 	cancelSearchJob          *observation.Operation
 	getAggregateRepoRevState *observation.Operation
 
-	getSearchJobResultsWriterTo operationWithWriterTo
-	getSearchJobLogsWriterTo    operationWithWriterTo
+	createSearchJobSchedule   *observation.Operation
+	getSearchJobSchedule      *observation.Operation
+	listSearchJobSchedules    *observation.Operation
+	listSearchJobScheduleRuns *observation.Operation
+	deleteSearchJobSchedule   *observation.Operation
+	runDueSearchJobSchedules  *observation.Operation
+	updateScheduledJobResults *observation.Operation
+
+	getSearchJobResultsWriterTo     operationWithWriterTo
+	getSearchJobLogsWriterTo        operationWithWriterTo
+	getSearchJobResultsDiffWriterTo operationWithWriterTo
 }
 
 // operationWithWriterTo encodes our pattern around our CSV WriterTo were we
@@ -108,6 +117,14 @@ func newOperations(observationCtx *observation.Context) *operations {
 			cancelSearchJob:          op("CancelSearchJob"),
 			getAggregateRepoRevState: op("GetAggregateRepoRevState"),
 
+			createSearchJobSchedule:   op("CreateSearchJobSchedule"),
+			getSearchJobSchedule:      op("GetSearchJobSchedule"),
+			listSearchJobSchedules:    op("ListSearchJobSchedules"),
+			listSearchJobScheduleRuns: op("ListSearchJobScheduleRuns"),
+			deleteSearchJobSchedule:   op("DeleteSearchJobSchedule"),
+			runDueSearchJobSchedules:  op("RunDueSearchJobSchedules"),
+			updateScheduledJobResults: op("UpdateScheduledSearchJobResults"),
+
 			getSearchJobResultsWriterTo: operationWithWriterTo{
 				get:      op("GetSearchJobResultsWriterTo"),
 				writerTo: op("GetSearchJobResultsWriterTo.WriteTo"),
@@ -116,6 +133,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 				get:      op("GetSearchJobLogsWriterTo"),
 				writerTo: op("GetSearchJobLogsWriterTo.WriteTo"),
 			},
+			getSearchJobResultsDiffWriterTo: operationWithWriterTo{
+				get:      op("GetSearchJobResultsDiffWriterTo"),
+				writerTo: op("GetSearchJobResultsDiffWriterTo.WriteTo"),
+			},
 		}
 	})
 	return singletonOperations
@@ -147,6 +168,12 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 		return nil, err
 	}
 
+	return s.createSearchJob(ctx, query, 0)
+}
+
+// createSearchJob creates a search job for the current actor. scheduleID is
+// the schedule creating the job, or 0 for one-off jobs.
+func (s *Service) createSearchJob(ctx context.Context, query string, scheduleID int64) (_ *types.ExhaustiveSearchJob, err error) {
 	tx, err := s.store.Transact(ctx)
 	if err != nil {
 		return nil, err
@@ -155,10 +182,11 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 
 	// XXX(keegancsmith) this API for creating seems easy to mess up since the
 	// ExhaustiveSearchJob type has lots of fields, but reading the store
-	// implementation only two fields are read.
+	// implementation only three fields are read.
 	jobID, err := tx.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
-		InitiatorID: actor.UID,
+		InitiatorID: actor.FromContext(ctx).UID,
 		Query:       query,
+		ScheduleID:  scheduleID,
 	})
 	if err != nil {
 		return nil, err
@@ -348,17 +376,31 @@ func (s *Service) DeleteSearchJob(ctx context.Context, id int64) (err error) {
 }
 
 // GetSearchJobResultsWriterTo returns a WriterTo which can be called once to
-// write all CSVs associated with a search job to the given writer for job id.
+// write all results associated with a search job to the given writer for job
+// id, in the given format.
 // Note: ctx is used by WriterTo.
 //
 // io.WriterTo is a specialization of an io.Reader. We expect callers of this
 // function to want to write a http response, so we avoid an io.Pipe and
 // instead pass a more direct use.
-func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, err error) {
+func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int64, format ResultsFormat) (_ io.WriterTo, err error) {
 	ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.get.With(parentCtx, &err, opAttrs(
-		attribute.Int64("id", id)))
+		attribute.Int64("id", id),
+		attribute.String("format", string(format))))
 	defer endObservation(1, observation.Args{})
 
+	var write func(context.Context, *iterator.Iterator[string], object.Storage, io.Writer) (int64, error)
+	switch format {
+	case ResultsFormatJSON:
+		write = writeSearchJobJSON
+	case ResultsFormatCSV:
+		write = writeSearchJobCSV
+	case ResultsFormatParquet:
+		write = writeSearchJobParquet
+	default:
+		return nil, errors.Errorf("unsupported search job results format %q", format)
+	}
+
 	// 🚨 SECURITY: only someone with access to the job may copy the blobs
 	if err := s.store.UserHasAccess(ctx, id); err != nil {
 		return nil, err
@@ -376,7 +418,7 @@ func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int6
 			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
 		}()
 
-		return writeSearchJobJSON(ctx, iter, s.uploadStore, w)
+		return write(ctx, iter, s.uploadStore, w)
 	}), nil
 }
 
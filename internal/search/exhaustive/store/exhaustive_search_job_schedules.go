@@ -0,0 +1,272 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MissingScheduleErr is returned when a schedule is missing from a
+// types.ExhaustiveSearchJobSchedule.
+var MissingScheduleErr = errors.New("missing schedule")
+
+var searchJobScheduleColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("initiator_id"),
+	sqlf.Sprintf("query"),
+	sqlf.Sprintf("schedule"),
+	sqlf.Sprintf("retain_runs"),
+	sqlf.Sprintf("next_run_at"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+func (s *Store) CreateSearchJobSchedule(ctx context.Context, schedule types.ExhaustiveSearchJobSchedule) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.createSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.String("query", schedule.Query),
+		attribute.String("schedule", schedule.Schedule),
+		attribute.Int("initiator_id", int(schedule.InitiatorID)),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if schedule.Query == "" {
+		return 0, MissingQueryErr
+	}
+	if schedule.Schedule == "" {
+		return 0, MissingScheduleErr
+	}
+	if schedule.InitiatorID <= 0 {
+		return 0, MissingInitiatorIDErr
+	}
+
+	// 🚨 SECURITY: InitiatorID has to match the actor or can be overridden by SiteAdmin.
+	if err := auth.CheckSiteAdminOrSameUser(ctx, s.db, schedule.InitiatorID); err != nil {
+		return 0, err
+	}
+
+	return basestore.ScanAny[int64](s.Store.QueryRow(
+		ctx,
+		sqlf.Sprintf(
+			createSearchJobScheduleQueryFmtStr,
+			schedule.Query,
+			schedule.InitiatorID,
+			schedule.Schedule,
+			schedule.RetainRuns,
+			schedule.NextRunAt,
+		),
+	))
+}
+
+const createSearchJobScheduleQueryFmtStr = `
+INSERT INTO exhaustive_search_job_schedules (query, initiator_id, schedule, retain_runs, next_run_at)
+VALUES (%s, %s, %s, %s, %s)
+RETURNING id
+`
+
+func (s *Store) GetSearchJobSchedule(ctx context.Context, id int64) (_ *types.ExhaustiveSearchJobSchedule, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listSearchJobSchedulesQueryFmtStr,
+		sqlf.Join(searchJobScheduleColumns, ", "),
+		sqlf.Sprintf("WHERE id = %s", id),
+	)
+
+	schedule, err := scanSearchJobSchedule(s.Store.QueryRow(ctx, q))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(ErrNoResults, "failed to scan schedule with id %d: %s", id, err.Error())
+		}
+		return nil, err
+	}
+
+	// 🚨 SECURITY: only the initiator, internal or site admins may view a schedule
+	if err := auth.CheckSiteAdminOrSameUser(ctx, s.db, schedule.InitiatorID); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// ListSearchJobSchedules returns the schedules created by the current user,
+// or by any of userIDs if the current user is a site admin.
+func (s *Store) ListSearchJobSchedules(ctx context.Context, userIDs []int32) (schedules []*types.ExhaustiveSearchJobSchedule, err error) {
+	ctx, _, endObservation := s.operations.listSearchJobSchedules.With(ctx, &err, observation.Args{})
+	defer func() {
+		endObservation(1, opAttrs(attribute.Int("length", len(schedules))))
+	}()
+
+	a := actor.FromContext(ctx)
+
+	// 🚨 SECURITY: Only authenticated users can list schedules.
+	if !a.IsAuthenticated() {
+		return nil, errors.New("can only list schedules for an authenticated user")
+	}
+
+	// 🚨 SECURITY: Site admins see any schedule and may filter based on
+	// userIDs. Other users only see their own schedules.
+	var where *sqlf.Query
+	if auth.CheckUserIsSiteAdmin(ctx, s.db, a.UID) == nil {
+		if len(userIDs) > 0 {
+			ids := make([]*sqlf.Query, len(userIDs))
+			for i, id := range userIDs {
+				ids[i] = sqlf.Sprintf("%d", id)
+			}
+			where = sqlf.Sprintf("WHERE initiator_id IN (%s)", sqlf.Join(ids, ","))
+		} else {
+			where = sqlf.Sprintf("")
+		}
+	} else {
+		if len(userIDs) > 0 {
+			return nil, errors.New("cannot filter by user id if not a site admin")
+		}
+		where = sqlf.Sprintf("WHERE initiator_id = %d", a.UID)
+	}
+
+	q := sqlf.Sprintf(
+		listSearchJobSchedulesQueryFmtStr+"ORDER BY id ASC",
+		sqlf.Join(searchJobScheduleColumns, ", "),
+		where,
+	)
+
+	return scanSearchJobSchedules(s.Store.Query(ctx, q))
+}
+
+// ListDueSearchJobSchedules returns all schedules whose next run is at or
+// before now. It may only be called by internal actors.
+//
+// The returned schedules are locked until the end of the transaction, and
+// schedules locked by another transaction are skipped. Callers should advance
+// the next run of the schedules in the same transaction, so that a run is
+// only claimed once across all workers.
+func (s *Store) ListDueSearchJobSchedules(ctx context.Context, now time.Time) (schedules []*types.ExhaustiveSearchJobSchedule, err error) {
+	ctx, _, endObservation := s.operations.listDueSearchJobSchedules.With(ctx, &err, observation.Args{})
+	defer func() {
+		endObservation(1, opAttrs(attribute.Int("length", len(schedules))))
+	}()
+
+	// 🚨 SECURITY: this lists the schedules of all users.
+	if !actor.FromContext(ctx).IsInternal() {
+		return nil, errors.New("only internal actors can list due schedules")
+	}
+
+	q := sqlf.Sprintf(
+		listSearchJobSchedulesQueryFmtStr+"ORDER BY next_run_at ASC FOR UPDATE SKIP LOCKED",
+		sqlf.Join(searchJobScheduleColumns, ", "),
+		sqlf.Sprintf("WHERE next_run_at <= %s", now),
+	)
+
+	return scanSearchJobSchedules(s.Store.Query(ctx, q))
+}
+
+const listSearchJobSchedulesQueryFmtStr = `
+SELECT %s FROM exhaustive_search_job_schedules
+%s -- whereClause
+`
+
+// ListSearchJobScheduleRuns returns the search jobs created by the schedule
+// id, most recent first.
+func (s *Store) ListSearchJobScheduleRuns(ctx context.Context, id int64) (jobs []*types.ExhaustiveSearchJob, err error) {
+	ctx, _, endObservation := s.operations.listSearchJobScheduleRuns.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer func() {
+		endObservation(1, opAttrs(attribute.Int("length", len(jobs))))
+	}()
+
+	// 🚨 SECURITY: only someone with access to the schedule may list its runs
+	if err := s.userHasScheduleAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		"%s ORDER BY id DESC",
+		listSearchJobQuery(sqlf.Sprintf("WHERE schedule_id = %s", id)),
+	)
+
+	return scanExhaustiveSearchJobsList(s.Store.Query(ctx, q))
+}
+
+// SetSearchJobScheduleNextRunAt sets the time at which the schedule id
+// creates its next search job.
+func (s *Store) SetSearchJobScheduleNextRunAt(ctx context.Context, id int64, nextRunAt time.Time) (err error) {
+	ctx, _, endObservation := s.operations.setSearchJobScheduleNextRunAt.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the schedule may update it
+	if err := s.userHasScheduleAccess(ctx, id); err != nil {
+		return err
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		"UPDATE exhaustive_search_job_schedules SET next_run_at = %s, updated_at = NOW() WHERE id = %s",
+		nextRunAt,
+		id,
+	))
+}
+
+// DeleteSearchJobSchedule deletes the schedule id. The search jobs created by
+// the schedule are kept as one-off jobs.
+func (s *Store) DeleteSearchJobSchedule(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteSearchJobSchedule.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the schedule may delete it
+	if err := s.userHasScheduleAccess(ctx, id); err != nil {
+		return err
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM exhaustive_search_job_schedules WHERE id = %s", id))
+}
+
+// userHasScheduleAccess is the equivalent of UserHasAccess for schedules.
+func (s *Store) userHasScheduleAccess(ctx context.Context, id int64) error {
+	q := sqlf.Sprintf("SELECT initiator_id FROM exhaustive_search_job_schedules WHERE id = %s", id)
+
+	var initiatorID int32
+	err := s.Store.QueryRow(ctx, q).Scan(&initiatorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrapf(ErrNoResults, "failed to scan schedule with id %d: %s", id, err.Error())
+		}
+		return err
+	}
+
+	// 🚨 SECURITY: only the initiator, internal or site admins may access a schedule.
+	return auth.CheckSiteAdminOrSameUser(ctx, s.db, initiatorID)
+}
+
+func scanSearchJobSchedule(sc dbutil.Scanner) (*types.ExhaustiveSearchJobSchedule, error) {
+	var schedule types.ExhaustiveSearchJobSchedule
+
+	return &schedule, sc.Scan(
+		&schedule.ID,
+		&schedule.InitiatorID,
+		&schedule.Query,
+		&schedule.Schedule,
+		&schedule.RetainRuns,
+		&schedule.NextRunAt,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+}
+
+var scanSearchJobSchedules = basestore.NewSliceScanner(scanSearchJobSchedule)
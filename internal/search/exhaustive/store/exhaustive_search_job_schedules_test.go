@@ -0,0 +1,180 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store/storetest"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+func TestStore_SearchJobSchedules(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	bs := basestore.NewWithHandle(db.Handle())
+
+	userID, err := storetest.CreateUser(bs, "alice")
+	require.NoError(t, err)
+	malloryID, err := storetest.CreateUser(bs, "mallory")
+	require.NoError(t, err)
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(userID))
+	malloryCtx := actor.WithActor(context.Background(), actor.FromUser(malloryID))
+	internalCtx := actor.WithInternalActor(context.Background())
+
+	s := store.New(db, observation.TestContextTB(t))
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	dueID, err := s.CreateSearchJobSchedule(ctx, types.ExhaustiveSearchJobSchedule{
+		InitiatorID: userID,
+		Query:       "deprecatedCall",
+		Schedule:    "@weekly",
+		RetainRuns:  2,
+		NextRunAt:   now.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	laterID, err := s.CreateSearchJobSchedule(ctx, types.ExhaustiveSearchJobSchedule{
+		InitiatorID: userID,
+		Query:       "otherCall",
+		Schedule:    "@daily",
+		RetainRuns:  10,
+		NextRunAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	t.Run("create validates input", func(t *testing.T) {
+		_, err := s.CreateSearchJobSchedule(ctx, types.ExhaustiveSearchJobSchedule{InitiatorID: userID, Query: "foo"})
+		require.ErrorIs(t, err, store.MissingScheduleErr)
+
+		// 🚨 SECURITY: users cannot create schedules on behalf of others.
+		_, err = s.CreateSearchJobSchedule(malloryCtx, types.ExhaustiveSearchJobSchedule{InitiatorID: userID, Query: "foo", Schedule: "@daily"})
+		require.Error(t, err)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		schedule, err := s.GetSearchJobSchedule(ctx, dueID)
+		require.NoError(t, err)
+		assert.Equal(t, "deprecatedCall", schedule.Query)
+		assert.Equal(t, "@weekly", schedule.Schedule)
+		assert.Equal(t, int32(2), schedule.RetainRuns)
+		assert.True(t, schedule.NextRunAt.Equal(now.Add(-time.Minute)))
+
+		// 🚨 SECURITY: other users cannot see the schedule.
+		_, err = s.GetSearchJobSchedule(malloryCtx, dueID)
+		require.Error(t, err)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		schedules, err := s.ListSearchJobSchedules(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, schedules, 2)
+
+		schedules, err = s.ListSearchJobSchedules(malloryCtx, nil)
+		require.NoError(t, err)
+		require.Empty(t, schedules)
+	})
+
+	t.Run("list due", func(t *testing.T) {
+		// 🚨 SECURITY: only internal actors can list the schedules of all
+		// users.
+		_, err := s.ListDueSearchJobSchedules(ctx, now)
+		require.Error(t, err)
+
+		schedules, err := s.ListDueSearchJobSchedules(internalCtx, now)
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		require.Equal(t, dueID, schedules[0].ID)
+
+		require.NoError(t, s.SetSearchJobScheduleNextRunAt(internalCtx, dueID, now.Add(time.Hour)))
+		schedules, err = s.ListDueSearchJobSchedules(internalCtx, now)
+		require.NoError(t, err)
+		require.Empty(t, schedules)
+	})
+
+	t.Run("list due skips schedules claimed by another transaction", func(t *testing.T) {
+		require.NoError(t, s.SetSearchJobScheduleNextRunAt(internalCtx, dueID, now.Add(-time.Minute)))
+
+		tx, err := s.Transact(internalCtx)
+		require.NoError(t, err)
+		schedules, err := tx.ListDueSearchJobSchedules(internalCtx, now)
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+
+		// Another worker doesn't see the schedule while it is being claimed.
+		schedules, err = s.ListDueSearchJobSchedules(internalCtx, now)
+		require.NoError(t, err)
+		require.Empty(t, schedules)
+
+		require.NoError(t, tx.SetSearchJobScheduleNextRunAt(internalCtx, dueID, now.Add(time.Hour)))
+		require.NoError(t, tx.Done(nil))
+
+		// Nor once it has been claimed.
+		schedules, err = s.ListDueSearchJobSchedules(internalCtx, now)
+		require.NoError(t, err)
+		require.Empty(t, schedules)
+	})
+
+	t.Run("runs", func(t *testing.T) {
+		var runIDs []int64
+		for range 2 {
+			id, err := s.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
+				InitiatorID: userID,
+				Query:       "deprecatedCall",
+				ScheduleID:  dueID,
+			})
+			require.NoError(t, err)
+			runIDs = append(runIDs, id)
+		}
+
+		diff := &types.ResultsDiff{BaseJobID: runIDs[0], Added: 3, Removed: 1}
+		require.NoError(t, s.SetSearchJobResults(ctx, runIDs[1], 7, diff))
+
+		runs, err := s.ListSearchJobScheduleRuns(ctx, dueID)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+
+		// Most recent first
+		require.Equal(t, runIDs[1], runs[0].ID)
+		require.Equal(t, dueID, runs[0].ScheduleID)
+		require.Equal(t, int32(7), *runs[0].ResultCount)
+		require.Equal(t, diff, runs[0].ResultsDiff)
+		require.Nil(t, runs[1].ResultCount)
+		require.Nil(t, runs[1].ResultsDiff)
+
+		_, err = s.ListSearchJobScheduleRuns(malloryCtx, dueID)
+		require.Error(t, err)
+	})
+
+	t.Run("delete keeps runs", func(t *testing.T) {
+		// 🚨 SECURITY: other users cannot delete the schedule.
+		require.Error(t, s.DeleteSearchJobSchedule(malloryCtx, laterID))
+
+		require.NoError(t, s.DeleteSearchJobSchedule(ctx, dueID))
+		_, err := s.GetSearchJobSchedule(ctx, dueID)
+		require.ErrorIs(t, err, store.ErrNoResults)
+
+		jobs, err := s.ListExhaustiveSearchJobs(ctx, store.ListArgs{Query: "deprecatedCall"})
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		for _, job := range jobs {
+			require.Zero(t, job.ScheduleID)
+		}
+	})
+}
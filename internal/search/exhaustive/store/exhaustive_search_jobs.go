@@ -61,6 +61,11 @@ var exhaustiveSearchJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("created_at"),
 	sqlf.Sprintf("updated_at"),
 	sqlf.Sprintf("is_aggregated"),
+	sqlf.Sprintf("schedule_id"),
+	sqlf.Sprintf("result_count"),
+	sqlf.Sprintf("diff_base_job_id"),
+	sqlf.Sprintf("results_added"),
+	sqlf.Sprintf("results_removed"),
 }
 
 func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.ExhaustiveSearchJob) (_ int64, err error) {
@@ -84,7 +89,7 @@ func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.Exhaust
 
 	return basestore.ScanAny[int64](s.Store.QueryRow(
 		ctx,
-		sqlf.Sprintf(createExhaustiveSearchJobQueryFmtr, job.Query, job.InitiatorID),
+		sqlf.Sprintf(createExhaustiveSearchJobQueryFmtr, job.Query, job.InitiatorID, dbutil.NullInt64Column(job.ScheduleID)),
 	))
 }
 
@@ -95,8 +100,8 @@ var MissingQueryErr = errors.New("missing query")
 var MissingInitiatorIDErr = errors.New("missing initiator ID")
 
 const createExhaustiveSearchJobQueryFmtr = `
-INSERT INTO exhaustive_search_jobs (query, initiator_id)
-VALUES (%s, %s)
+INSERT INTO exhaustive_search_jobs (query, initiator_id, schedule_id)
+VALUES (%s, %s, %s)
 RETURNING id
 `
 
@@ -529,7 +534,26 @@ func (s *Store) GetJobLogs(ctx context.Context, id int64, opts *GetJobLogsOpts)
 	return jobs, nil
 }
 
-func defaultScanTargets(job *types.ExhaustiveSearchJob) []any {
+// resultsDiffColumns holds the nullable columns of a types.ResultsDiff while
+// scanning a job.
+type resultsDiffColumns struct {
+	baseJobID *int64
+	added     *int32
+	removed   *int32
+}
+
+func (c *resultsDiffColumns) get() *types.ResultsDiff {
+	if c.baseJobID == nil || c.added == nil || c.removed == nil {
+		return nil
+	}
+	return &types.ResultsDiff{
+		BaseJobID: *c.baseJobID,
+		Added:     *c.added,
+		Removed:   *c.removed,
+	}
+}
+
+func defaultScanTargets(job *types.ExhaustiveSearchJob, diff *resultsDiffColumns) []any {
 	// required field for the sync worker, but
 	// the value is thrown out here
 	var executionLogs *[]any
@@ -551,26 +575,66 @@ func defaultScanTargets(job *types.ExhaustiveSearchJob) []any {
 		&job.CreatedAt,
 		&job.UpdatedAt,
 		&job.IsAggregated,
+		&dbutil.NullInt64{N: &job.ScheduleID},
+		&job.ResultCount,
+		&diff.baseJobID,
+		&diff.added,
+		&diff.removed,
 	}
 }
 
 func scanExhaustiveSearchJob(sc dbutil.Scanner) (*types.ExhaustiveSearchJob, error) {
 	var job types.ExhaustiveSearchJob
+	var diff resultsDiffColumns
 
-	return &job, sc.Scan(
-		defaultScanTargets(&job)...,
+	err := sc.Scan(
+		defaultScanTargets(&job, &diff)...,
 	)
+	job.ResultsDiff = diff.get()
+	return &job, err
 }
 
 func scanExhaustiveSearchJobList(sc dbutil.Scanner) (*types.ExhaustiveSearchJob, error) {
 	var job types.ExhaustiveSearchJob
+	var diff resultsDiffColumns
 
-	return &job, sc.Scan(
+	err := sc.Scan(
 		append(
-			defaultScanTargets(&job),
+			defaultScanTargets(&job, &diff),
 			&job.AggState,
 		)...,
 	)
+	job.ResultsDiff = diff.get()
+	return &job, err
 }
 
 var scanExhaustiveSearchJobsList = basestore.NewSliceScanner(scanExhaustiveSearchJobList)
+
+// SetSearchJobResults records the number of results of a scheduled job, and
+// how they differ from the previous run of the schedule. diff may be nil if
+// there is no previous run to compare to.
+func (s *Store) SetSearchJobResults(ctx context.Context, id int64, resultCount int32, diff *types.ResultsDiff) (err error) {
+	ctx, _, endObservation := s.operations.setSearchJobResults.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the job may update the job
+	if err := s.UserHasAccess(ctx, id); err != nil {
+		return err
+	}
+
+	var baseJobID *int64
+	var added, removed *int32
+	if diff != nil {
+		baseJobID, added, removed = &diff.BaseJobID, &diff.Added, &diff.Removed
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(setSearchJobResultsFmtStr, resultCount, baseJobID, added, removed, id))
+}
+
+const setSearchJobResultsFmtStr = `
+UPDATE exhaustive_search_jobs
+SET result_count = %s, diff_base_job_id = %s, results_added = %s, results_removed = %s
+WHERE id = %s
+`
@@ -65,6 +65,15 @@ type operations struct {
 	userHasAccess             *observation.Operation
 	listExhaustiveSearchJobs  *observation.Operation
 	deleteExhaustiveSearchJob *observation.Operation
+	setSearchJobResults       *observation.Operation
+
+	createSearchJobSchedule       *observation.Operation
+	getSearchJobSchedule          *observation.Operation
+	listSearchJobSchedules        *observation.Operation
+	listDueSearchJobSchedules     *observation.Operation
+	listSearchJobScheduleRuns     *observation.Operation
+	setSearchJobScheduleNextRunAt *observation.Operation
+	deleteSearchJobSchedule       *observation.Operation
 
 	createExhaustiveSearchRepoJob         *observation.Operation
 	createExhaustiveSearchRepoRevisionJob *observation.Operation
@@ -98,6 +107,15 @@ func newOperations(observationCtx *observation.Context) *operations {
 		userHasAccess:             op("UserHasAccess"),
 		listExhaustiveSearchJobs:  op("ListExhaustiveSearchJobs"),
 		deleteExhaustiveSearchJob: op("DeleteExhaustiveSearchJob"),
+		setSearchJobResults:       op("SetSearchJobResults"),
+
+		createSearchJobSchedule:       op("CreateSearchJobSchedule"),
+		getSearchJobSchedule:          op("GetSearchJobSchedule"),
+		listSearchJobSchedules:        op("ListSearchJobSchedules"),
+		listDueSearchJobSchedules:     op("ListDueSearchJobSchedules"),
+		listSearchJobScheduleRuns:     op("ListSearchJobScheduleRuns"),
+		setSearchJobScheduleNextRunAt: op("SetSearchJobScheduleNextRunAt"),
+		deleteSearchJobSchedule:       op("DeleteSearchJobSchedule"),
 
 		createExhaustiveSearchRepoJob:         op("CreateExhaustiveSearchRepoJob"),
 		createExhaustiveSearchRepoRevisionJob: op("CreateExhaustiveSearchRepoRevisionJob"),
@@ -29,6 +29,36 @@ type ExhaustiveSearchJob struct {
 	// Set to true by the janitor job if it has processed the job. This is used to
 	// avoid aggregating the same job multiple times.
 	IsAggregated bool
+
+	// ScheduleID is the ID of the ExhaustiveSearchJobSchedule that created
+	// this job, or 0 for one-off jobs.
+	ScheduleID int64
+
+	// ResultCount is the number of results of a scheduled job. It is computed
+	// by the janitor job once the job is aggregated, and nil before that or
+	// for one-off jobs.
+	ResultCount *int32
+
+	// ResultsDiff is the difference between the results of this job and the
+	// previous run of the same schedule. It is nil if the job is not
+	// scheduled, not aggregated yet, or the first run of its schedule.
+	ResultsDiff *ResultsDiff
+}
+
+// ResultsDiff is the difference between the results of two runs of a search
+// job schedule.
+type ResultsDiff struct {
+	// BaseJobID is the ID of the earlier run the results are compared to. The
+	// job might have been deleted since by the retention policy of the
+	// schedule.
+	BaseJobID int64
+
+	// Added is the number of results that were not part of the earlier run.
+	Added int32
+
+	// Removed is the number of results of the earlier run that are no longer
+	// present.
+	Removed int32
 }
 
 func (j *ExhaustiveSearchJob) RecordID() int {
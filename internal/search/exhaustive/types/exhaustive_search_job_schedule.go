@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+)
+
+// ExhaustiveSearchJobSchedule periodically creates an ExhaustiveSearchJob for
+// a query. Maps to the `exhaustive_search_job_schedules` database table.
+type ExhaustiveSearchJobSchedule struct {
+	ID int64
+
+	// InitiatorID is the user ID of the user who created the schedule. The
+	// search jobs created by the schedule run on behalf of this user.
+	InitiatorID int32
+
+	Query string
+
+	// Schedule is a cron expression, for example "0 9 * * MON" or "@weekly",
+	// which determines when a new search job is created.
+	Schedule string
+
+	// RetainRuns is the number of most recent search jobs that are kept for
+	// the schedule. Older jobs and their results are deleted.
+	RetainRuns int32
+
+	// NextRunAt is the time at which the next search job will be created.
+	NextRunAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
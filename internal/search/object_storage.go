@@ -30,15 +30,21 @@ type ObjectStorageConfig struct {
 	GCSProjectID               string
 	GCSCredentialsFile         string
 	GCSCredentialsFileContents string
+
+	AzureAccountName string
+	AzureAccountKey  string
+	AzureEndpoint    string
+
+	LocalDir string
 }
 
 func (c *ObjectStorageConfig) Load() {
-	c.Backend = strings.ToLower(c.Get("SEARCH_JOBS_UPLOAD_BACKEND", "blobstore", "The target file service for search jobs. S3, GCS, and Blobstore are supported."))
+	c.Backend = strings.ToLower(c.Get("SEARCH_JOBS_UPLOAD_BACKEND", "blobstore", "The target file service for search jobs. S3, GCS, Azure, Local, and Blobstore are supported."))
 	c.ManageBucket = c.GetBool("SEARCH_JOBS_UPLOAD_MANAGE_BUCKET", "false", "Whether or not the client should manage the target bucket configuration.")
 	c.Bucket = c.Get("SEARCH_JOBS_UPLOAD_BUCKET", "search-jobs", "The name of the bucket to store search job results in.")
 
-	if c.Backend != "blobstore" && c.Backend != "s3" && c.Backend != "gcs" {
-		c.AddError(errors.Errorf("invalid backend %q for SEARCH_JOBS_UPLOAD_BACKEND: must be S3, GCS, or Blobstore", c.Backend))
+	if c.Backend != "blobstore" && c.Backend != "s3" && c.Backend != "gcs" && c.Backend != "azure" && c.Backend != "local" {
+		c.AddError(errors.Errorf("invalid backend %q for SEARCH_JOBS_UPLOAD_BACKEND: must be S3, GCS, Azure, Local, or Blobstore", c.Backend))
 	}
 
 	if c.Backend == "blobstore" || c.Backend == "s3" {
@@ -56,6 +62,16 @@ func (c *ObjectStorageConfig) Load() {
 		c.GCSProjectID = c.Get("SEARCH_JOBS_UPLOAD_GCP_PROJECT_ID", "", "The project containing the GCS bucket.")
 		c.GCSCredentialsFile = c.GetOptional("SEARCH_JOBS_UPLOAD_GOOGLE_APPLICATION_CREDENTIALS_FILE", "The path to a service account key file with access to GCS.")
 		c.GCSCredentialsFileContents = c.GetOptional("SEARCH_JOBS_UPLOAD_GOOGLE_APPLICATION_CREDENTIALS_FILE_CONTENT", "The contents of a service account key file with access to GCS.")
+	} else if c.Backend == "azure" {
+		c.AzureAccountName = c.GetOptional("SEARCH_JOBS_UPLOAD_AZURE_ACCOUNT_NAME", "The Azure storage account containing the container named by the bucket.")
+		c.AzureAccountKey = c.GetOptional("SEARCH_JOBS_UPLOAD_AZURE_ACCOUNT_KEY", "An optional shared key of the Azure storage account. If unset, the default Azure credential chain is used.")
+		c.AzureEndpoint = c.GetOptional("SEARCH_JOBS_UPLOAD_AZURE_ENDPOINT", "An optional URL of the Azure blob service, e.g. for Azurite.")
+
+		if c.AzureAccountName == "" && c.AzureEndpoint == "" {
+			c.AddError(errors.New("SEARCH_JOBS_UPLOAD_AZURE_ACCOUNT_NAME or SEARCH_JOBS_UPLOAD_AZURE_ENDPOINT must be set for the Azure backend"))
+		}
+	} else if c.Backend == "local" {
+		c.LocalDir = c.Get("SEARCH_JOBS_UPLOAD_LOCAL_DIR", "", "The directory to store search job results in, which contains a directory per bucket.")
 	}
 }
 
@@ -79,6 +95,14 @@ func NewObjectStorage(ctx context.Context, observationCtx *observation.Context,
 			CredentialsFile:         conf.GCSCredentialsFile,
 			CredentialsFileContents: conf.GCSCredentialsFileContents,
 		},
+		Azure: object.AzureConfig{
+			AccountName: conf.AzureAccountName,
+			AccountKey:  conf.AzureAccountKey,
+			Endpoint:    conf.AzureEndpoint,
+		},
+		Local: object.LocalConfig{
+			Dir: conf.LocalDir,
+		},
 	}
 	return object.CreateLazyStorage(ctx, c, object.NewOperations(observationCtx, "search_jobs", "uploadstore"))
 }
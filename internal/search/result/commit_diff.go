@@ -2,6 +2,7 @@ package result
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -45,6 +46,13 @@ func (cm *CommitDiffMatch) PathStatus() PathStatus {
 		return Deleted
 	}
 
+	if cm.OrigName != cm.NewName {
+		if cm.Copied {
+			return Copied
+		}
+		return Renamed
+	}
+
 	return Modified
 }
 
@@ -170,6 +178,13 @@ func ParseDiffString(diff string) (res []DiffFile, err error) {
 			currentDiff.OrigName, currentDiff.NewName, err = splitDiffFiles(line)
 			state = IN_DIFF
 		case IN_DIFF:
+			if !strings.HasPrefix(line, "@@") {
+				// A file diff without hunks, such as a pure rename, is
+				// directly followed by the next file diff.
+				finishDiff()
+				currentDiff.OrigName, currentDiff.NewName, err = splitDiffFiles(line)
+				break
+			}
 			currentHunk.OldStart, currentHunk.OldCount, currentHunk.NewStart, currentHunk.NewCount, currentHunk.Header, err = parseHunkHeader(line)
 			state = IN_HUNK
 		case IN_HUNK:
@@ -191,7 +206,9 @@ func ParseDiffString(diff string) (res []DiffFile, err error) {
 			return nil, err
 		}
 	}
-	finishHunk()
+	if state == IN_HUNK {
+		finishHunk()
+	}
 	finishDiff()
 
 	return res, nil
@@ -237,6 +254,34 @@ func parseHunkHeader(headerLine string) (oldStart, oldCount, newStart, newCount
 type DiffFile struct {
 	OrigName, NewName string
 	Hunks             []Hunk
+
+	// Copied is true if git detected NewName as a copy of OrigName. The
+	// formatted diff doesn't tell copies apart from renames, so it is set
+	// separately with MarkCopiedFiles.
+	Copied bool
+}
+
+// MarkCopiedFiles sets Copied for the files whose new name is in copied.
+func MarkCopiedFiles(files []DiffFile, copied []string) {
+	if len(copied) == 0 {
+		return
+	}
+	for i := range files {
+		if slices.Contains(copied, files[i].NewName) {
+			files[i].Copied = true
+		}
+	}
+}
+
+// CopiedFiles inverts MarkCopiedFiles.
+func CopiedFiles(files []DiffFile) []string {
+	var copied []string
+	for _, file := range files {
+		if file.Copied {
+			copied = append(copied, file.NewName)
+		}
+	}
+	return copied
 }
 
 type Hunk struct {
@@ -252,4 +297,6 @@ const (
 	Modified PathStatus = iota
 	Added
 	Deleted
+	Renamed
+	Copied
 )
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 )
 
 const input = `client/web/src/enterprise/codeintel/badge/components/IndexerSummary.module.scss client/web/src/enterprise/codeintel/badge/components/IndexerSummary.module.scss
@@ -60,3 +62,53 @@ func TestCommitDiffMatch(t *testing.T) {
 	autogold.Expect("client/web/src/enterprise/codeintel/badge/components/IndexerSummary.module.scss").
 		Equal(t, commitDiff.Path())
 }
+
+func TestParseDiffString_Renames(t *testing.T) {
+	input := `old.go new.go
+moved.go moved/moved.go
+@@ -1,1 +1,1 @@
+-package moved
++package main
+`
+	res, err := ParseDiffString(input)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	require.Equal(t, "old.go", res[0].OrigName)
+	require.Equal(t, "new.go", res[0].NewName)
+	require.Empty(t, res[0].Hunks)
+	require.Len(t, res[1].Hunks, 1)
+
+	commitDiff := &CommitDiffMatch{DiffFile: &res[0]}
+	require.Equal(t, Renamed, commitDiff.PathStatus())
+	require.Equal(t, "new.go", commitDiff.Path())
+
+	require.Equal(t, input, FormatDiffFiles(res))
+}
+
+func TestCommitDiffMatch_Copies(t *testing.T) {
+	input := `old.go new.go
+old.go copy.go
+@@ -1,1 +1,1 @@
+-package old
++package copy
+`
+	res, err := ParseDiffString(input)
+	require.NoError(t, err)
+	MarkCopiedFiles(res, []string{"copy.go"})
+	require.Equal(t, []string{"copy.go"}, CopiedFiles(res))
+
+	matches := (&CommitMatch{Diff: res, DiffPreview: &MatchedString{Content: input}}).CommitToDiffMatches()
+	require.Len(t, matches, 2)
+	require.Equal(t, Renamed, matches[0].PathStatus())
+	require.Equal(t, Copied, matches[1].PathStatus())
+	require.Equal(t, "copy.go", matches[1].Path())
+
+	// Selecting the added lines of a copy keeps its status.
+	selected := matches[1].Select(filter.SelectPath{filter.Commit, "diff", "added"})
+	require.NotNil(t, selected)
+	require.Equal(t, Copied, selected.(*CommitDiffMatch).PathStatus())
+
+	// The copy status isn't part of the formatted diff.
+	require.Equal(t, input, FormatDiffFiles(res))
+}
@@ -31,6 +31,7 @@ type stableCommitMatchJSON struct {
 	MessagePreview  *MatchedString            `json:"messagePreview,omitempty"`
 	DiffPreview     *MatchedString            `json:"diffPreview,omitempty"`
 	ModifiedFiles   []string                  `json:"modifiedFiles,omitempty"`
+	CopiedFiles     []string                  `json:"copiedFiles,omitempty"`
 }
 
 type stableSignatureMarshaler struct {
@@ -72,6 +73,7 @@ func (cm CommitMatch) MarshalJSON() ([]byte, error) {
 		MessagePreview:  cm.MessagePreview,
 		DiffPreview:     cm.DiffPreview,
 		ModifiedFiles:   cm.ModifiedFiles,
+		CopiedFiles:     CopiedFiles(cm.Diff),
 	}
 
 	return json.Marshal(marshaler)
@@ -103,6 +105,7 @@ func (cm *CommitMatch) UnmarshalJSON(input []byte) (err error) {
 		if err != nil {
 			return err
 		}
+		MarkCopiedFiles(structuredDiff, unmarshaler.CopiedFiles)
 	}
 
 	*cm = CommitMatch{
@@ -47,6 +47,7 @@ func TestCommitMatchMarshaling(t *testing.T) {
 			},
 			Diff: func() []DiffFile {
 				dfs, _ := ParseDiffString(`drinks/coffee\ with\ milk.md drinks/coffee.md`)
+				MarkCopiedFiles(dfs, []string{"drinks/coffee.md"})
 				return dfs
 			}(),
 			ModifiedFiles: []string{"drinks/coffee.md", "drinks/tea.md"},
@@ -660,6 +660,9 @@ func (t *GlobalTextSearchJob) Run(ctx context.Context, clients job.RuntimeClient
 
 	userPrivateRepos := privateReposForActor(ctx, clients.Logger, clients.DB, t.RepoOpts)
 	t.GlobalZoektQuery.ApplyPrivateFilter(userPrivateRepos)
+	// 🚨 SECURITY: Access tokens restricted to some repositories must not
+	// find results in any other repository.
+	t.GlobalZoektQuery.ApplyAccessTokenRepoFilter(actor.FromContext(ctx).AccessTokenRepoIDs)
 	t.ZoektParams.Query = t.GlobalZoektQuery.Generate()
 
 	return nil, DoZoektSearchGlobal(ctx, clients.Zoekt, t.ZoektParams, t.GlobalZoektQueryRegexps, stream)
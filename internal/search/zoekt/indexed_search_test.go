@@ -19,7 +19,10 @@ import (
 
 	"github.com/RoaringBitmap/roaring"
 
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	searchbackend "github.com/sourcegraph/sourcegraph/internal/search/backend"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
@@ -1022,3 +1025,67 @@ func TestGetRepoRevsFromBranchRepos_SingleRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestGlobalTextSearchJob_AccessTokenRepoFilter(t *testing.T) {
+	conf.Mock(&conf.Unified{})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultReturn([]types.MinimalRepo{{ID: 1}}, nil)
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	cases := []struct {
+		name      string
+		actor     *actor.Actor
+		wantRepos []uint32
+	}{{
+		name:  "unrestricted",
+		actor: &actor.Actor{UID: 1},
+	}, {
+		name:      "restricted access token",
+		actor:     &actor.Actor{UID: 1, AccessTokenScopes: []string{"search:read"}, AccessTokenRepoIDs: []api.RepoID{1, 3}},
+		wantRepos: []uint32{1, 3},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := search.RepoOptions{Visibility: query.Any}
+			defaultScope, err := DefaultGlobalQueryScope(opts)
+			require.NoError(t, err)
+
+			streamer := &queryRecordingStreamer{}
+			j := &GlobalTextSearchJob{
+				GlobalZoektQuery: NewGlobalZoektQuery(&zoektquery.Substring{Pattern: "foo"}, defaultScope, true),
+				ZoektParams:      &search.ZoektParameters{Typ: search.TextRequest, FileMatchLimit: 10},
+				RepoOpts:         opts,
+			}
+			ctx := actor.WithActor(context.Background(), tc.actor)
+			_, err = j.Run(ctx, job.RuntimeClients{Logger: logtest.Scoped(t), DB: db, Zoekt: streamer}, streaming.NewAggregatingStream())
+			require.NoError(t, err)
+
+			// Public repositories are only restricted by a filter on the
+			// whole query.
+			and, ok := streamer.query.(*zoektquery.And)
+			require.True(t, ok, "unexpected query %s", streamer.query)
+			var gotRepos []uint32
+			for _, child := range and.Children {
+				if br, ok := child.(*zoektquery.BranchesRepos); ok {
+					gotRepos = br.List[0].Repos.ToArray()
+				}
+			}
+			require.Equal(t, tc.wantRepos, gotRepos)
+		})
+	}
+}
+
+// queryRecordingStreamer records the query of the last search.
+type queryRecordingStreamer struct {
+	searchbackend.FakeStreamer
+	query zoektquery.Q
+}
+
+func (s *queryRecordingStreamer) StreamSearch(ctx context.Context, q zoektquery.Q, opts *zoekt.SearchOptions, z zoekt.Sender) error {
+	s.query = q
+	return s.FakeStreamer.StreamSearch(ctx, q, opts, z)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/grafana/regexp"
 	zoektquery "github.com/sourcegraph/zoekt/query"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -51,6 +52,9 @@ type GlobalZoektQuery struct {
 	Query          zoektquery.Q
 	RepoScope      []zoektquery.Q
 	IncludePrivate bool
+	// RepoFilter, if set, restricts the query to a set of repositories on top
+	// of RepoScope.
+	RepoFilter zoektquery.Q
 }
 
 func NewGlobalZoektQuery(query zoektquery.Q, scope zoektquery.Q, includePrivate bool) *GlobalZoektQuery {
@@ -80,9 +84,28 @@ func (q *GlobalZoektQuery) ApplyPrivateFilter(userPrivateRepos []types.MinimalRe
 	}
 }
 
+// ApplyAccessTokenRepoFilter restricts a Global Zoekt search to the
+// repositories that the access token of the actor is restricted to, if any.
+// Public repositories are searched without consulting the database, so the
+// restriction of the access token has to be applied to the query itself.
+func (q *GlobalZoektQuery) ApplyAccessTokenRepoFilter(repoIDs []api.RepoID) {
+	if len(repoIDs) == 0 {
+		return
+	}
+	ids := make([]uint32, 0, len(repoIDs))
+	for _, id := range repoIDs {
+		ids = append(ids, uint32(id))
+	}
+	q.RepoFilter = zoektquery.NewSingleBranchesRepos("HEAD", ids...)
+}
+
 // Generate generates a Global Zoekt query that ensures the appropriate repo
 // scope (i.e., whether to either exclusively public, exclusively private, or
 // either public or private repositories)
 func (q *GlobalZoektQuery) Generate() zoektquery.Q {
-	return zoektquery.Simplify(zoektquery.NewAnd(q.Query, zoektquery.NewOr(q.RepoScope...)))
+	children := []zoektquery.Q{q.Query, zoektquery.NewOr(q.RepoScope...)}
+	if q.RepoFilter != nil {
+		children = append(children, q.RepoFilter)
+	}
+	return zoektquery.Simplify(zoektquery.NewAnd(children...))
 }
@@ -85,6 +85,16 @@ func TestExternalService_RedactedConfig(t *testing.T) {
 			in:   schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: "bar"},
 			out:  schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: RedactedSecret},
 		},
+		{
+			kind: extsvc.KindMercurial,
+			in:   schema.MercurialConnection{Url: "https://hg.example.org", Username: "foo", Password: "bar"},
+			out:  schema.MercurialConnection{Url: "https://hg.example.org", Username: "foo", Password: RedactedSecret},
+		},
+		{
+			kind: extsvc.KindGitea,
+			in:   schema.GiteaConnection{Url: "https://gitea.example.org", Token: "bar"},
+			out:  schema.GiteaConnection{Url: "https://gitea.example.org", Token: RedactedSecret},
+		},
 		{
 			kind: extsvc.KindJVMPackages,
 			in:   schema.JVMPackagesConnection{Maven: schema.Maven{Credentials: "foobar", Dependencies: []string{"baz"}}},
@@ -294,6 +304,32 @@ func TestExternalService_UnredactConfig(t *testing.T) {
 			in:   schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: RedactedSecret},
 			out:  schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: "bar"},
 		},
+		{
+			kind: extsvc.KindMercurial,
+			old:  schema.MercurialConnection{Url: "https://hg.example.org", Username: "foo", Password: "bar"},
+			in:   schema.MercurialConnection{Url: "https://hg.example.org", Username: "foo", Password: RedactedSecret},
+			out:  schema.MercurialConnection{Url: "https://hg.example.org", Username: "foo", Password: "bar"},
+		},
+		{
+			kind:    extsvc.KindMercurial,
+			old:     schema.MercurialConnection{Url: "https://hg.example.org", Username: "foo", Password: "bar"},
+			in:      schema.MercurialConnection{Url: "https://hg.other.org", Username: "foo", Password: RedactedSecret},
+			out:     schema.MercurialConnection{Url: "https://hg.other.org", Username: "foo", Password: "bar"},
+			wantErr: errCodeHostIdentityChanged{"url", "password"},
+		},
+		{
+			kind: extsvc.KindGitea,
+			old:  schema.GiteaConnection{Url: "https://gitea.example.org", Token: "bar"},
+			in:   schema.GiteaConnection{Url: "https://gitea.example.org", Token: RedactedSecret},
+			out:  schema.GiteaConnection{Url: "https://gitea.example.org", Token: "bar"},
+		},
+		{
+			kind:    extsvc.KindGitea,
+			old:     schema.GiteaConnection{Url: "https://gitea.example.org", Token: "bar"},
+			in:      schema.GiteaConnection{Url: "https://gitea.other.org", Token: RedactedSecret},
+			out:     schema.GiteaConnection{Url: "https://gitea.other.org", Token: "bar"},
+			wantErr: errCodeHostIdentityChanged{"url", "token"},
+		},
 		{
 			kind: extsvc.KindJVMPackages,
 			old:  schema.JVMPackagesConnection{Maven: schema.Maven{Credentials: "foobar", Dependencies: []string{"baz"}}},
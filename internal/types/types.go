@@ -563,12 +563,16 @@ const (
 	CloneStatusNotCloned CloneStatus = "not_cloned"
 	CloneStatusCloning   CloneStatus = "cloning"
 	CloneStatusCloned    CloneStatus = "cloned"
+	// CloneStatusTiered is set for repositories that gitserver has offloaded to
+	// cold storage because they haven't been accessed in a while. They are
+	// restored on first access.
+	CloneStatusTiered CloneStatus = "tiered"
 )
 
 func ParseCloneStatus(s string) CloneStatus {
 	cs := CloneStatus(s)
 	switch cs {
-	case CloneStatusNotCloned, CloneStatusCloning, CloneStatusCloned:
+	case CloneStatusNotCloned, CloneStatusCloning, CloneStatusCloned, CloneStatusTiered:
 		return cs
 	default:
 		return CloneStatusUnknown
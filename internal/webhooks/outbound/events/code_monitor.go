@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// codeMonitorTrigger represents a code monitor run that found new results in
+// a webhook payload.
+type codeMonitorTrigger struct {
+	ID          graphql.ID                 `json:"id"`
+	Description string                     `json:"description"`
+	Owner       graphql.ID                 `json:"owner_user_id"`
+	Query       string                     `json:"query"`
+	URL         string                     `json:"url"`
+	TriggeredAt time.Time                  `json:"triggered_at"`
+	Results     []codeMonitorTriggerResult `json:"results"`
+}
+
+// codeMonitorTriggerResult represents a search result of a code monitor run.
+type codeMonitorTriggerResult struct {
+	RepositoryID   graphql.ID `json:"repository_id"`
+	RepositoryName string     `json:"repository_name"`
+	Commit         string     `json:"commit"`
+	URL            string     `json:"url"`
+}
+
+func newCodeMonitorTrigger(monitor *database.Monitor, query string, results []*result.CommitMatch, triggeredAt time.Time) codeMonitorTrigger {
+	// The kind matches the one used by the code monitor resolvers.
+	id := relay.MarshalID("CodeMonitor", monitor.ID)
+
+	payload := codeMonitorTrigger{
+		ID:          id,
+		Description: monitor.Description,
+		Owner:       relay.MarshalID("User", monitor.UserID),
+		Query:       query,
+		URL:         "/code-monitoring/" + string(id),
+		TriggeredAt: triggeredAt,
+		Results:     make([]codeMonitorTriggerResult, 0, len(results)),
+	}
+	for _, r := range results {
+		payload.Results = append(payload.Results, codeMonitorTriggerResult{
+			RepositoryID:   relay.MarshalID("Repository", r.Repo.ID),
+			RepositoryName: string(r.Repo.Name),
+			Commit:         string(r.Commit.ID),
+			URL:            r.URL().String(),
+		})
+	}
+	return payload
+}
+
+// EnqueueCodeMonitorTrigger enqueues a CodeMonitorTrigger event for a code
+// monitor whose query found the given new results.
+func EnqueueCodeMonitorTrigger(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	monitor *database.Monitor, query string, results []*result.CommitMatch, triggeredAt time.Time,
+) {
+	payload := newCodeMonitorTrigger(monitor, query, results, triggeredAt)
+	scope := string(payload.ID)
+	Enqueue(ctx, logger, db, CodeMonitorTrigger, &scope, payload)
+}
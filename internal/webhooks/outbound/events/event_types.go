@@ -0,0 +1,67 @@
+package events
+
+import "github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+
+// Repository and precise index events are scoped by the repository name,
+// search job events by the ID of the user who created the search job, and
+// code monitor events by the ID of the code monitor. Outbound webhooks
+// subscribed to an event type without a scope receive every event of that
+// type.
+const (
+	RepositoryAdd             = "repository:add"
+	RepositoryClone           = "repository:clone"
+	RepositoryCloneError      = "repository:clone_error"
+	RepositoryDelete          = "repository:delete"
+	RepositoryPermissionsSync = "repository:permissions_sync"
+	PreciseIndexProcess       = "precise_index:process"
+	PreciseIndexProcessError  = "precise_index:process_error"
+	SearchJobFinish           = "search_job:finish"
+	CodeMonitorTrigger        = "code_monitor:trigger"
+)
+
+func init() {
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepositoryAdd,
+		Description: "sent when a repository is added from a code host connection",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepositoryClone,
+		Description: "sent when a repository is cloned for the first time",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepositoryCloneError,
+		Description: "sent when an attempt to clone a repository fails",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepositoryDelete,
+		Description: "sent when a repository is deleted",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepositoryPermissionsSync,
+		Description: "sent when the permissions of a repository are synced from the code host",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         PreciseIndexProcess,
+		Description: "sent when a precise index upload is processed",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         PreciseIndexProcessError,
+		Description: "sent when an attempt to process a precise index upload fails",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         SearchJobFinish,
+		Description: "sent when a search job is completed, failed or canceled",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         CodeMonitorTrigger,
+		Description: "sent when a code monitor finds new results",
+	})
+}
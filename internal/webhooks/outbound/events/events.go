@@ -0,0 +1,74 @@
+// Package events defines the outbound webhook events that are sent for
+// repositories, precise indexes, search jobs and code monitors, along with the
+// payloads of these events.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+)
+
+var service struct {
+	once sync.Once
+	key  encryption.Key
+}
+
+func getService(db basestore.ShareableStore) outbound.OutboundWebhookService {
+	service.once.Do(func() {
+		service.key = keyring.Default().OutboundWebhookKey
+	})
+	return outbound.NewOutboundWebhookService(db, service.key)
+}
+
+// Enqueue creates an outbound webhook job that will dispatch a webhook of the
+// given type and scope with the given payload marshalled to JSON.
+//
+// Note the typed helpers in this package — if you're sending a webhook for a
+// type that is already handled, you may as well use them.
+func Enqueue(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	eventType string, scope *string, payload any,
+) {
+	enqueue(ctx, logger, getService(db), eventType, scope, payload)
+}
+
+func enqueue(
+	ctx context.Context, logger log.Logger, svc outbound.OutboundWebhookService,
+	eventType string, scope *string, payload any,
+) {
+	// Webhooks are generally intended to be fire and forget from the point of
+	// view of calling code, so we'll simply log on error and carry on.
+	logger = logger.With(
+		log.String("event_type", eventType),
+		log.Stringp("scope", scope),
+	)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("error marshalling webhook payload", log.Error(err))
+		return
+	}
+
+	if err := svc.Enqueue(ctx, eventType, scope, data); err != nil {
+		logger.Error("error enqueuing webhook job", log.Error(err))
+		return
+	}
+}
+
+// errorMessage returns the message of the given error, or nil if there is no
+// error.
+func errorMessage(err error) *string {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	return &msg
+}
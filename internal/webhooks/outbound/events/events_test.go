@@ -0,0 +1,158 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	internaltypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeService struct {
+	eventType string
+	scope     *string
+	payload   []byte
+	err       error
+}
+
+func (s *fakeService) Enqueue(_ context.Context, eventType string, scope *string, payload []byte) error {
+	s.eventType = eventType
+	s.scope = scope
+	s.payload = payload
+	return s.err
+}
+
+func TestEnqueue(t *testing.T) {
+	ctx := context.Background()
+	scope := "github.com/sourcegraph/sourcegraph"
+
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeService{}
+		enqueue(ctx, logtest.Scoped(t), svc, RepositoryAdd, &scope, map[string]string{"name": scope})
+
+		assert.Equal(t, RepositoryAdd, svc.eventType)
+		assert.Equal(t, &scope, svc.scope)
+		assert.JSONEq(t, `{"name":"github.com/sourcegraph/sourcegraph"}`, string(svc.payload))
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		svc := &fakeService{err: errors.New("mock error")}
+		// Errors are logged, so all we can check is that the job was attempted.
+		enqueue(ctx, logtest.Scoped(t), svc, RepositoryAdd, &scope, map[string]string{})
+		assert.Equal(t, RepositoryAdd, svc.eventType)
+	})
+}
+
+func TestPayloads(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assertPayload := func(t *testing.T, payload any, want string) {
+		t.Helper()
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		assert.JSONEq(t, want, string(data))
+	}
+
+	repo := &internaltypes.Repo{ID: 1, Name: "github.com/sourcegraph/sourcegraph", Private: true}
+
+	t.Run("repository", func(t *testing.T) {
+		assertPayload(t, newRepository(repo, errors.New("clone failed")), `{
+			"id": "UmVwb3NpdG9yeTox",
+			"name": "github.com/sourcegraph/sourcegraph",
+			"url": "/github.com/sourcegraph/sourcegraph",
+			"private": true,
+			"error": "clone failed"
+		}`)
+	})
+
+	t.Run("repository permissions", func(t *testing.T) {
+		assertPayload(t, newRepositoryPermissions(repo, &database.SetPermissionsResult{Added: 2, Removed: 1, Found: 5}), `{
+			"repository_id": "UmVwb3NpdG9yeTox",
+			"repository_name": "github.com/sourcegraph/sourcegraph",
+			"users_added": 2,
+			"users_removed": 1,
+			"users_found": 5
+		}`)
+	})
+
+	t.Run("precise index", func(t *testing.T) {
+		upload := uploadsshared.Upload{
+			ID:             42,
+			RepositoryID:   1,
+			RepositoryName: "github.com/sourcegraph/sourcegraph",
+			Commit:         "deadbeef",
+			Root:           "lib/",
+			Indexer:        "scip-go",
+			IndexerVersion: "0.1.0",
+			UploadedAt:     now,
+		}
+		assertPayload(t, newPreciseIndex(upload, nil), `{
+			"id": "UHJlY2lzZUluZGV4OiJVOjQyIg==",
+			"repository_id": "UmVwb3NpdG9yeTox",
+			"repository_name": "github.com/sourcegraph/sourcegraph",
+			"commit": "deadbeef",
+			"root": "lib/",
+			"indexer": "scip-go",
+			"indexer_version": "0.1.0",
+			"uploaded_at": "2024-01-02T03:04:05Z",
+			"error": null
+		}`)
+	})
+
+	t.Run("search job", func(t *testing.T) {
+		resultCount := int32(12)
+		job := &types.ExhaustiveSearchJob{
+			ID:          3,
+			InitiatorID: 1,
+			Query:       "repo:^github\\.com/sourcegraph/sourcegraph$ TODO",
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			ScheduleID:  7,
+			ResultCount: &resultCount,
+		}
+		assertPayload(t, newSearchJob(job, types.JobStateCompleted), `{
+			"id": "U2VhcmNoSm9iOjM=",
+			"query": "repo:^github\\.com/sourcegraph/sourcegraph$ TODO",
+			"state": "COMPLETED",
+			"creator_user_id": "VXNlcjox",
+			"schedule_id": "U2VhcmNoSm9iU2NoZWR1bGU6Nw==",
+			"result_count": 12,
+			"created_at": "2024-01-02T03:04:05Z",
+			"updated_at": "2024-01-02T03:04:05Z"
+		}`)
+	})
+
+	t.Run("code monitor trigger", func(t *testing.T) {
+		monitor := &database.Monitor{ID: 5, Description: "new TODOs", UserID: 1}
+		results := []*result.CommitMatch{{
+			Repo:   internaltypes.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"},
+			Commit: gitdomain.Commit{ID: api.CommitID("deadbeef")},
+		}}
+		assertPayload(t, newCodeMonitorTrigger(monitor, "TODO type:diff", results, now), `{
+			"id": "Q29kZU1vbml0b3I6NQ==",
+			"description": "new TODOs",
+			"owner_user_id": "VXNlcjox",
+			"query": "TODO type:diff",
+			"url": "/code-monitoring/Q29kZU1vbml0b3I6NQ==",
+			"triggered_at": "2024-01-02T03:04:05Z",
+			"results": [{
+				"repository_id": "UmVwb3NpdG9yeTox",
+				"repository_name": "github.com/sourcegraph/sourcegraph",
+				"commit": "deadbeef",
+				"url": "/github.com/sourcegraph/sourcegraph/-/commit/deadbeef"
+			}]
+		}`)
+	})
+}
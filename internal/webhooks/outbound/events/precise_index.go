@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+)
+
+// preciseIndex represents a precise index upload in a webhook payload.
+type preciseIndex struct {
+	ID             graphql.ID `json:"id"`
+	RepositoryID   graphql.ID `json:"repository_id"`
+	RepositoryName string     `json:"repository_name"`
+	Commit         string     `json:"commit"`
+	Root           string     `json:"root"`
+	Indexer        string     `json:"indexer"`
+	IndexerVersion string     `json:"indexer_version"`
+	UploadedAt     time.Time  `json:"uploaded_at"`
+	Error          *string    `json:"error"`
+}
+
+func newPreciseIndex(upload uploadsshared.Upload, err error) preciseIndex {
+	return preciseIndex{
+		// Precise indexes are identified by their upload in the GraphQL API.
+		ID:             relay.MarshalID("PreciseIndex", fmt.Sprintf("U:%d", upload.ID)),
+		RepositoryID:   relay.MarshalID("Repository", int32(upload.RepositoryID)),
+		RepositoryName: upload.RepositoryName,
+		Commit:         upload.Commit,
+		Root:           upload.Root,
+		Indexer:        upload.Indexer,
+		IndexerVersion: upload.IndexerVersion,
+		UploadedAt:     upload.UploadedAt,
+		Error:          errorMessage(err),
+	}
+}
+
+// EnqueuePreciseIndex enqueues a PreciseIndexProcess event, or a
+// PreciseIndexProcessError event if err is set.
+func EnqueuePreciseIndex(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	upload uploadsshared.Upload, err error,
+) {
+	eventType := PreciseIndexProcess
+	if err != nil {
+		eventType = PreciseIndexProcessError
+	}
+
+	scope := upload.RepositoryName
+	Enqueue(ctx, logger, db, eventType, &scope, newPreciseIndex(upload, err))
+}
@@ -0,0 +1,87 @@
+package events
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// repository represents a repository in a webhook payload.
+type repository struct {
+	ID      graphql.ID `json:"id"`
+	Name    string     `json:"name"`
+	URL     string     `json:"url"`
+	Private bool       `json:"private"`
+	Error   *string    `json:"error"`
+}
+
+// repositoryPermissions represents a permissions sync of a repository in a
+// webhook payload.
+type repositoryPermissions struct {
+	RepositoryID   graphql.ID `json:"repository_id"`
+	RepositoryName string     `json:"repository_name"`
+	UsersAdded     int        `json:"users_added"`
+	UsersRemoved   int        `json:"users_removed"`
+	UsersFound     int        `json:"users_found"`
+}
+
+func marshalRepositoryID(repo *types.Repo) graphql.ID {
+	return relay.MarshalID("Repository", repo.ID)
+}
+
+// repositoryName returns the name of the repository, without the prefix that
+// is added to the names of soft-deleted repositories.
+func repositoryName(repo *types.Repo) string {
+	return string(api.UndeletedRepoName(repo.Name))
+}
+
+func newRepository(repo *types.Repo, err error) repository {
+	name := repositoryName(repo)
+	return repository{
+		ID:      marshalRepositoryID(repo),
+		Name:    name,
+		URL:     "/" + name,
+		Private: repo.Private,
+		Error:   errorMessage(err),
+	}
+}
+
+func newRepositoryPermissions(repo *types.Repo, result *database.SetPermissionsResult) repositoryPermissions {
+	payload := repositoryPermissions{
+		RepositoryID:   marshalRepositoryID(repo),
+		RepositoryName: repositoryName(repo),
+	}
+	if result != nil {
+		payload.UsersAdded = result.Added
+		payload.UsersRemoved = result.Removed
+		payload.UsersFound = result.Found
+	}
+	return payload
+}
+
+// EnqueueRepository enqueues a repository event. The error is only set for
+// events that report a failure, such as RepositoryCloneError.
+func EnqueueRepository(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	eventType string, repo *types.Repo, err error,
+) {
+	scope := repositoryName(repo)
+	Enqueue(ctx, logger, db, eventType, &scope, newRepository(repo, err))
+}
+
+// EnqueueRepositoryPermissionsSync enqueues a RepositoryPermissionsSync event
+// with the result of the sync.
+func EnqueueRepositoryPermissionsSync(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	repo *types.Repo, result *database.SetPermissionsResult,
+) {
+	scope := repositoryName(repo)
+	Enqueue(ctx, logger, db, RepositoryPermissionsSync, &scope, newRepositoryPermissions(repo, result))
+}
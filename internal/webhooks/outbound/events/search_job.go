@@ -0,0 +1,53 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+// searchJob represents a search job in a webhook payload.
+type searchJob struct {
+	ID          graphql.ID  `json:"id"`
+	Query       string      `json:"query"`
+	State       string      `json:"state"`
+	Creator     graphql.ID  `json:"creator_user_id"`
+	Schedule    *graphql.ID `json:"schedule_id"`
+	ResultCount *int32      `json:"result_count"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+func newSearchJob(job *types.ExhaustiveSearchJob, state types.JobState) searchJob {
+	payload := searchJob{
+		ID:          relay.MarshalID("SearchJob", job.ID),
+		Query:       job.Query,
+		State:       state.ToGraphQL(),
+		Creator:     relay.MarshalID("User", job.InitiatorID),
+		ResultCount: job.ResultCount,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+	if job.ScheduleID != 0 {
+		id := relay.MarshalID("SearchJobSchedule", job.ScheduleID)
+		payload.Schedule = &id
+	}
+	return payload
+}
+
+// EnqueueSearchJob enqueues a SearchJobFinish event for a search job that
+// reached the given terminal aggregate state.
+func EnqueueSearchJob(
+	ctx context.Context, logger log.Logger, db basestore.ShareableStore,
+	job *types.ExhaustiveSearchJob, state types.JobState,
+) {
+	payload := newSearchJob(job, state)
+	scope := string(payload.Creator)
+	Enqueue(ctx, logger, db, SearchJobFinish, &scope, payload)
+}
@@ -47,6 +47,11 @@ type ChangesetTemplate struct {
 	Fork      *bool                        `json:"fork,omitempty" yaml:"fork"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Reviewers []string                     `json:"reviewers,omitempty" yaml:"reviewers"`
+	Labels    []string                     `json:"labels,omitempty" yaml:"labels"`
+	Assignees []string                     `json:"assignees,omitempty" yaml:"assignees"`
+	Milestone string                       `json:"milestone,omitempty" yaml:"milestone"`
+	AutoMerge bool                         `json:"autoMerge,omitempty" yaml:"autoMerge"`
 }
 
 type GitCommitAuthor struct {
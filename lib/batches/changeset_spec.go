@@ -78,6 +78,12 @@ type ChangesetSpec struct {
 	Body  string `json:"body,omitempty"`
 	Fork  *bool  `json:"fork,omitempty"`
 
+	Reviewers []string `json:"reviewers,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone string   `json:"milestone,omitempty"`
+	AutoMerge bool     `json:"autoMerge,omitempty"`
+
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
@@ -104,6 +110,11 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
 		Fork           *bool                  `json:"fork,omitempty"`
+		Reviewers      []string               `json:"reviewers,omitempty"`
+		Labels         []string               `json:"labels,omitempty"`
+		Assignees      []string               `json:"assignees,omitempty"`
+		Milestone      string                 `json:"milestone,omitempty"`
+		AutoMerge      bool                   `json:"autoMerge,omitempty"`
 	}{
 		BaseRepository: c.BaseRepository,
 		ExternalID:     c.ExternalID,
@@ -115,6 +126,11 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Body:           c.Body,
 		Commits:        c.Commits,
 		Fork:           c.Fork,
+		Reviewers:      c.Reviewers,
+		Labels:         c.Labels,
+		Assignees:      c.Assignees,
+		Milestone:      c.Milestone,
+		AutoMerge:      c.AutoMerge,
 	}
 	if !c.Published.Nil() {
 		v.Published = &c.Published
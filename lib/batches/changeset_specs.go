@@ -102,6 +102,26 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 		return nil, err
 	}
 
+	reviewers, err := renderChangesetTemplateList("reviewers", input.Template.Reviewers, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := renderChangesetTemplateList("labels", input.Template.Labels, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignees, err := renderChangesetTemplateList("assignees", input.Template.Assignees, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, err := template.RenderChangesetTemplateField("milestone", input.Template.Milestone, tmplCtx)
+	if err != nil {
+		return nil, err
+	}
+
 	newSpec := func(branch string, diff []byte) *ChangesetSpec {
 		var published any = nil
 		if input.Template.Published != nil {
@@ -121,10 +141,15 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 			BaseRef:        input.Repository.BaseRef,
 			BaseRev:        input.Repository.BaseRev,
 
-			HeadRef: git.EnsureRefPrefix(branch),
-			Title:   title,
-			Body:    body,
-			Fork:    fork,
+			HeadRef:   git.EnsureRefPrefix(branch),
+			Title:     title,
+			Body:      body,
+			Fork:      fork,
+			Reviewers: reviewers,
+			Labels:    labels,
+			Assignees: assignees,
+			Milestone: milestone,
+			AutoMerge: input.Template.AutoMerge,
 			Commits: []GitCommitDescription{
 				{
 					Version:     version,
@@ -165,6 +190,24 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 	return specs, nil
 }
 
+// renderChangesetTemplateList renders each of the given templates. Entries
+// that render to an empty string are dropped, so that templates can be used
+// to only set a value for some repositories.
+func renderChangesetTemplateList(name string, tmpls []string, tmplCtx *template.ChangesetTemplateContext) ([]string, error) {
+	var rendered []string
+	for _, tmpl := range tmpls {
+		value, err := template.RenderChangesetTemplateField(name, tmpl, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			rendered = append(rendered, value)
+		}
+	}
+
+	return rendered, nil
+}
+
 type RepoFetcher func(context.Context, []string) (map[string]string, error)
 
 func BuildImportChangesetSpecs(ctx context.Context, importChangesets []ImportChangeset, repoFetcher RepoFetcher) (specs []*ChangesetSpec, errs error) {
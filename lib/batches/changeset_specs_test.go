@@ -158,6 +158,27 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "reviewers, labels, assignees and auto-merge",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Reviewers = []string{"alice", "my-org/my-team"}
+				input.Template.Labels = []string{"batch-change", "${{ if eq repository.name \"github.com/sourcegraph/sourcegraph\" }}monorepo${{ end }}"}
+				input.Template.Assignees = []string{"bob"}
+				input.Template.Milestone = "${{ batch_change.name }}"
+				input.Template.AutoMerge = true
+				input.Template.Published = parsePublishedFieldString(t, "false")
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Reviewers = []string{"alice", "my-org/my-team"}
+					s.Labels = []string{"batch-change"}
+					s.Assignees = []string{"bob"}
+					s.Milestone = "the name"
+					s.AutoMerge = true
+				}),
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -364,6 +364,39 @@ const BatchSpecJSON = `{
             }
           }
         },
+        "reviewers": {
+          "type": "array",
+          "description": "The users (or, on GitHub and Gitea, teams in the form ` + "`" + `org/team` + "`" + `) to request a review from on each changeset. Only supported on GitHub, GitLab, Gitea and Bitbucket Server. Reviewers requested on the code host by other means are kept, reviewers removed from this list are removed from the changeset.",
+          "items": {
+            "type": "string"
+          },
+          "examples": [["alice", "my-org/my-team"]]
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to each changeset. Only supported on GitHub, GitLab and Gitea. Labels added on the code host by other means are kept, labels removed from this list are removed from the changeset.",
+          "items": {
+            "type": "string"
+          },
+          "examples": [["batch-change", "dependencies"]]
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The users to assign each changeset to. Only supported on GitHub, GitLab and Gitea. Assignees added on the code host by other means are kept, assignees removed from this list are removed from the changeset.",
+          "items": {
+            "type": "string"
+          },
+          "examples": [["alice"]]
+        },
+        "milestone": {
+          "type": "string",
+          "description": "The title of the milestone to add each changeset to. The milestone must already exist in the repository. Only supported on GitHub, GitLab and Gitea.",
+          "examples": ["v1.0"]
+        },
+        "autoMerge": {
+          "type": "boolean",
+          "description": "Whether to enable auto-merge on each published changeset, so that it is merged by the code host once all of its requirements are met. Draft changesets have auto-merge enabled when they are undrafted. Only supported on GitHub, GitLab and Gitea."
+        },
         "published": {
           "description": "Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.",
           "oneOf": [
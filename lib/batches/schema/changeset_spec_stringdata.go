@@ -72,6 +72,23 @@ const ChangesetSpecJSON = `{
         },
         "title": { "type": "string", "description": "The title of the changeset on the code host." },
         "body": { "type": "string", "description": "The body (description) of the changeset on the code host." },
+        "reviewers": {
+          "type": "array",
+          "description": "The users (or, on GitHub, teams in the form ` + "`" + `org/team` + "`" + `) to request a review from on the code host.",
+          "items": { "type": "string" }
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset on the code host.",
+          "items": { "type": "string" }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The users to assign the changeset to on the code host.",
+          "items": { "type": "string" }
+        },
+        "milestone": { "type": "string", "description": "The title of the milestone to add the changeset to on the code host." },
+        "autoMerge": { "type": "boolean", "description": "Whether to enable auto-merge for the changeset on the code host." },
         "commits": {
           "type": "array",
           "description": "The Git commits with the proposed changes. These commits are pushed to the head ref.",
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS exhaustive_search_jobs_schedule_id;
+
+ALTER TABLE exhaustive_search_jobs
+    DROP COLUMN IF EXISTS schedule_id,
+    DROP COLUMN IF EXISTS result_count,
+    DROP COLUMN IF EXISTS diff_base_job_id,
+    DROP COLUMN IF EXISTS results_added,
+    DROP COLUMN IF EXISTS results_removed;
+
+DROP TABLE IF EXISTS exhaustive_search_job_schedules;
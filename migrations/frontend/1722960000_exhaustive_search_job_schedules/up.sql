@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS exhaustive_search_job_schedules (
+    id SERIAL PRIMARY KEY,
+    initiator_id integer NOT NULL REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE,
+    query text NOT NULL,
+    schedule text NOT NULL,
+    retain_runs integer NOT NULL DEFAULT 10,
+    next_run_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS exhaustive_search_job_schedules_next_run_at ON exhaustive_search_job_schedules USING btree (next_run_at);
+
+ALTER TABLE exhaustive_search_jobs
+    ADD COLUMN IF NOT EXISTS schedule_id integer REFERENCES exhaustive_search_job_schedules(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS result_count integer,
+    ADD COLUMN IF NOT EXISTS diff_base_job_id integer,
+    ADD COLUMN IF NOT EXISTS results_added integer,
+    ADD COLUMN IF NOT EXISTS results_removed integer;
+
+CREATE INDEX IF NOT EXISTS exhaustive_search_jobs_schedule_id ON exhaustive_search_jobs USING btree (schedule_id);
+
+COMMENT ON COLUMN exhaustive_search_jobs.schedule_id IS 'The schedule that created this job. NULL for one-off jobs.';
+COMMENT ON COLUMN exhaustive_search_jobs.diff_base_job_id IS 'The previous run of the schedule that results_added and results_removed are relative to. Not a foreign key, since older runs are deleted by the retention policy.';
@@ -0,0 +1,6 @@
+ALTER TABLE changeset_specs
+    DROP COLUMN IF EXISTS reviewers,
+    DROP COLUMN IF EXISTS labels,
+    DROP COLUMN IF EXISTS assignees,
+    DROP COLUMN IF EXISTS milestone,
+    DROP COLUMN IF EXISTS auto_merge;
@@ -0,0 +1,6 @@
+ALTER TABLE changeset_specs
+    ADD COLUMN IF NOT EXISTS reviewers text[],
+    ADD COLUMN IF NOT EXISTS labels text[],
+    ADD COLUMN IF NOT EXISTS assignees text[],
+    ADD COLUMN IF NOT EXISTS milestone text,
+    ADD COLUMN IF NOT EXISTS auto_merge boolean NOT NULL DEFAULT false;
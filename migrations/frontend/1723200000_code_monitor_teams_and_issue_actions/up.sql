@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS cm_teams_webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    url text NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cm_teams_webhooks_monitor ON cm_teams_webhooks USING btree (monitor);
+
+COMMENT ON TABLE cm_teams_webhooks IS 'Microsoft Teams webhook actions configured on code monitors';
+COMMENT ON COLUMN cm_teams_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_teams_webhooks.url IS 'The Microsoft Teams incoming webhook URL we send the code monitor event to';
+
+CREATE TABLE IF NOT EXISTS cm_issue_actions (
+    id BIGSERIAL PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    issue_number integer,
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cm_issue_actions_monitor ON cm_issue_actions USING btree (monitor);
+
+COMMENT ON TABLE cm_issue_actions IS 'Actions configured on code monitors that open or comment on an issue in a GitHub or GitLab repository';
+COMMENT ON COLUMN cm_issue_actions.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_issue_actions.repo_id IS 'The repository the issue is opened in';
+COMMENT ON COLUMN cm_issue_actions.issue_number IS 'The number of the issue opened by this action. While the issue is open, new results are added to it as comments.';
+
+ALTER TABLE cm_action_jobs
+    ADD COLUMN IF NOT EXISTS teams_webhook bigint REFERENCES cm_teams_webhooks(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS issue_action bigint REFERENCES cm_issue_actions(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.teams_webhook IS 'The ID of the cm_teams_webhooks action to execute if this is a Microsoft Teams webhook job. Mutually exclusive with the other action types';
+COMMENT ON COLUMN cm_action_jobs.issue_action IS 'The ID of the cm_issue_actions action to execute if this is an issue job. Mutually exclusive with the other action types';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK (
+    num_nonnulls(email, webhook, slack_webhook, teams_webhook, issue_action) = 1
+);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS cm_content_snapshots (
+    monitor_id bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    match_keys text[] NOT NULL,
+    PRIMARY KEY (monitor_id, repo_id)
+);
+
+COMMENT ON TABLE cm_content_snapshots IS 'The file content matches found by the last run of a code monitor over a content search';
+COMMENT ON COLUMN cm_content_snapshots.match_keys IS 'The newline separated path and line of the matches found in the repository on the last run';
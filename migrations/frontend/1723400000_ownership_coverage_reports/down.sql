@@ -0,0 +1,6 @@
+DELETE FROM own_signal_configurations
+WHERE name = 'coverage-reports';
+
+DROP TABLE IF EXISTS ownership_coverage_team_stats;
+DROP TABLE IF EXISTS ownership_coverage_directory_stats;
+DROP TABLE IF EXISTS ownership_coverage_snapshots;
@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS ownership_coverage_snapshots (
+    id SERIAL PRIMARY KEY,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    commit_id text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS ownership_coverage_snapshots_repo_id_created_at_idx ON ownership_coverage_snapshots (repo_id, created_at DESC);
+
+COMMENT ON TABLE ownership_coverage_snapshots IS 'Ownership coverage reports computed for a repository at a given commit. Kept over time to show coverage trends.';
+
+CREATE TABLE IF NOT EXISTS ownership_coverage_directory_stats (
+    snapshot_id integer NOT NULL REFERENCES ownership_coverage_snapshots(id) ON DELETE CASCADE,
+    path text NOT NULL,
+    parent_path text,
+    total_files_count integer NOT NULL,
+    codeowned_files_count integer NOT NULL,
+    assigned_ownership_files_count integer NOT NULL,
+    recent_contributor_files_count integer NOT NULL,
+    any_ownership_files_count integer NOT NULL,
+    PRIMARY KEY (snapshot_id, path)
+);
+
+CREATE INDEX IF NOT EXISTS ownership_coverage_directory_stats_parent_path_idx ON ownership_coverage_directory_stats (snapshot_id, parent_path);
+
+COMMENT ON TABLE ownership_coverage_directory_stats IS 'File counts by ownership source for the directories of an ownership coverage snapshot. Only directories up to a fixed depth are recorded.';
+COMMENT ON COLUMN ownership_coverage_directory_stats.path IS 'Path of the directory without a leading slash. The empty path is the repository root.';
+COMMENT ON COLUMN ownership_coverage_directory_stats.parent_path IS 'Path of the parent directory, NULL for the repository root.';
+COMMENT ON COLUMN ownership_coverage_directory_stats.any_ownership_files_count IS 'Files owned via CODEOWNERS or assigned ownership. Recent contributors are not counted as owners.';
+
+CREATE TABLE IF NOT EXISTS ownership_coverage_team_stats (
+    snapshot_id integer NOT NULL REFERENCES ownership_coverage_snapshots(id) ON DELETE CASCADE,
+    team_id integer NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    owned_files_count integer NOT NULL,
+    PRIMARY KEY (snapshot_id, team_id)
+);
+
+COMMENT ON TABLE ownership_coverage_team_stats IS 'Number of files owned by each team in an ownership coverage snapshot, via CODEOWNERS or assigned ownership.';
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'coverage-reports',
+        FALSE,
+        'Periodically records ownership coverage by directory and team to show coverage trends over time.'
+    ) ON CONFLICT DO NOTHING;
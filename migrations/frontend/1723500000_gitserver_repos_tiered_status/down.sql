@@ -0,0 +1 @@
+DROP INDEX IF EXISTS gitserver_repos_tiered_status_idx;
@@ -0,0 +1,3 @@
+-- Repositories offloaded to cold storage keep their row in gitserver_repos
+-- with clone_status = 'tiered'. gitserver sums them up per shard for DiskInfo.
+CREATE INDEX IF NOT EXISTS gitserver_repos_tiered_status_idx ON gitserver_repos USING btree (shard_id) WHERE clone_status = 'tiered'::text;
@@ -0,0 +1,3 @@
+ALTER TABLE access_tokens ADD COLUMN IF NOT EXISTS repo_ids integer[];
+
+COMMENT ON COLUMN access_tokens.repo_ids IS 'The repositories the access token is restricted to. NULL means all repositories the subject user can access.';
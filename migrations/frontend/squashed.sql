@@ -502,7 +502,7 @@ $$;
 CREATE FUNCTION recalc_gitserver_repos_statistics_on_delete() RETURNS trigger
     LANGUAGE plpgsql
     AS $$ BEGIN
-      INSERT INTO gitserver_repos_statistics AS grs (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted)
+      INSERT INTO gitserver_repos_statistics AS grs (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
       SELECT
         oldtab.shard_id,
         (-COUNT(*)),
@@ -510,7 +510,8 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_delete() RETURNS trigger
         (-COUNT(*) FILTER(WHERE clone_status = 'cloning')),
         (-COUNT(*) FILTER(WHERE clone_status = 'cloned')),
         (-COUNT(*) FILTER(WHERE last_error IS NOT NULL)),
-        (-COUNT(*) FILTER(WHERE corrupted_at IS NOT NULL))
+        (-COUNT(*) FILTER(WHERE corrupted_at IS NOT NULL)),
+        (-COUNT(*) FILTER(WHERE clone_status = 'tiered'))
       FROM oldtab
       GROUP BY oldtab.shard_id;
 
@@ -524,7 +525,7 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_insert() RETURNS trigger
       -------------------------------------------------
       -- THIS IS CHANGED TO APPEND
       -------------------------------------------------
-      INSERT INTO gitserver_repos_statistics AS grs (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted)
+      INSERT INTO gitserver_repos_statistics AS grs (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
       SELECT
         shard_id,
         COUNT(*) AS total,
@@ -532,7 +533,8 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_insert() RETURNS trigger
         COUNT(*) FILTER(WHERE clone_status = 'cloning') AS cloning,
         COUNT(*) FILTER(WHERE clone_status = 'cloned') AS cloned,
         COUNT(*) FILTER(WHERE last_error IS NOT NULL) AS failed_fetch,
-        COUNT(*) FILTER(WHERE corrupted_at IS NOT NULL) AS corrupted
+        COUNT(*) FILTER(WHERE corrupted_at IS NOT NULL) AS corrupted,
+        COUNT(*) FILTER(WHERE clone_status = 'tiered') AS tiered
       FROM
         newtab
       GROUP BY shard_id
@@ -549,7 +551,7 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_update() RETURNS trigger
       -------------------------------------------------
       -- THIS IS CHANGED TO APPEND
       -------------------------------------------------
-      WITH diff(shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted) AS (
+      WITH diff(shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered) AS (
         SELECT
             COALESCE(newtab.shard_id, oldtab.shard_id) AS shard_id,
             COUNT(newtab.repo_id) - COUNT(oldtab.repo_id) AS total,
@@ -557,7 +559,8 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_update() RETURNS trigger
             COUNT(newtab.repo_id) FILTER (WHERE newtab.clone_status = 'cloning')    - COUNT(oldtab.repo_id) FILTER (WHERE oldtab.clone_status = 'cloning') AS cloning,
             COUNT(newtab.repo_id) FILTER (WHERE newtab.clone_status = 'cloned')     - COUNT(oldtab.repo_id) FILTER (WHERE oldtab.clone_status = 'cloned') AS cloned,
             COUNT(newtab.repo_id) FILTER (WHERE newtab.last_error IS NOT NULL)      - COUNT(oldtab.repo_id) FILTER (WHERE oldtab.last_error IS NOT NULL) AS failed_fetch,
-            COUNT(newtab.repo_id) FILTER (WHERE newtab.corrupted_at IS NOT NULL)    - COUNT(oldtab.repo_id) FILTER (WHERE oldtab.corrupted_at IS NOT NULL) AS corrupted
+            COUNT(newtab.repo_id) FILTER (WHERE newtab.corrupted_at IS NOT NULL)    - COUNT(oldtab.repo_id) FILTER (WHERE oldtab.corrupted_at IS NOT NULL) AS corrupted,
+            COUNT(newtab.repo_id) FILTER (WHERE newtab.clone_status = 'tiered')     - COUNT(oldtab.repo_id) FILTER (WHERE oldtab.clone_status = 'tiered') AS tiered
         FROM
             newtab
         FULL OUTER JOIN
@@ -565,8 +568,8 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_update() RETURNS trigger
         GROUP BY
             COALESCE(newtab.shard_id, oldtab.shard_id)
       )
-      INSERT INTO gitserver_repos_statistics AS grs (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted)
-      SELECT shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted
+      INSERT INTO gitserver_repos_statistics AS grs (shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
+      SELECT shard_id, total, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered
       FROM diff
       WHERE
             total != 0
@@ -575,12 +578,13 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_update() RETURNS trigger
         OR cloned != 0
         OR failed_fetch != 0
         OR corrupted != 0
+        OR tiered != 0
       ;
 
       -------------------------------------------------
       -- UNCHANGED
       -------------------------------------------------
-      WITH diff(not_cloned, cloning, cloned, failed_fetch, corrupted) AS (
+      WITH diff(not_cloned, cloning, cloned, failed_fetch, corrupted, tiered) AS (
         VALUES (
           (
             (SELECT COUNT(*) FROM newtab JOIN repo r ON newtab.repo_id = r.id WHERE r.deleted_at is NULL AND r.blocked IS NULL AND newtab.clone_status = 'not_cloned')
@@ -606,12 +610,17 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_update() RETURNS trigger
             (SELECT COUNT(*) FROM newtab JOIN repo r ON newtab.repo_id = r.id WHERE r.deleted_at is NULL AND r.blocked IS NULL AND newtab.corrupted_at IS NOT NULL)
             -
             (SELECT COUNT(*) FROM oldtab JOIN repo r ON oldtab.repo_id = r.id WHERE r.deleted_at is NULL AND r.blocked IS NULL AND oldtab.corrupted_at IS NOT NULL)
+          ),
+          (
+            (SELECT COUNT(*) FROM newtab JOIN repo r ON newtab.repo_id = r.id WHERE r.deleted_at is NULL AND r.blocked IS NULL AND newtab.clone_status = 'tiered')
+            -
+            (SELECT COUNT(*) FROM oldtab JOIN repo r ON oldtab.repo_id = r.id WHERE r.deleted_at is NULL AND r.blocked IS NULL AND oldtab.clone_status = 'tiered')
           )
 
         )
       )
-      INSERT INTO repo_statistics (not_cloned, cloning, cloned, failed_fetch, corrupted)
-      SELECT not_cloned, cloning, cloned, failed_fetch, corrupted
+      INSERT INTO repo_statistics (not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
+      SELECT not_cloned, cloning, cloned, failed_fetch, corrupted, tiered
       FROM diff
       WHERE
            not_cloned != 0
@@ -619,6 +628,7 @@ CREATE FUNCTION recalc_gitserver_repos_statistics_on_update() RETURNS trigger
         OR cloned != 0
         OR failed_fetch != 0
         OR corrupted != 0
+        OR tiered != 0
       ;
 
       RETURN NULL;
@@ -629,7 +639,7 @@ CREATE FUNCTION recalc_repo_statistics_on_repo_delete() RETURNS trigger
     LANGUAGE plpgsql
     AS $$ BEGIN
       INSERT INTO
-        repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch)
+        repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, tiered)
       VALUES (
         -- Insert negative counts
         (SELECT -COUNT(*) FROM oldtab WHERE deleted_at IS NULL     AND blocked IS NULL),
@@ -637,7 +647,8 @@ CREATE FUNCTION recalc_repo_statistics_on_repo_delete() RETURNS trigger
         (SELECT -COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.clone_status = 'not_cloned'),
         (SELECT -COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.clone_status = 'cloning'),
         (SELECT -COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.clone_status = 'cloned'),
-        (SELECT -COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.last_error IS NOT NULL)
+        (SELECT -COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.last_error IS NOT NULL),
+        (SELECT -COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.clone_status = 'tiered')
       );
       RETURN NULL;
   END
@@ -663,7 +674,7 @@ CREATE FUNCTION recalc_repo_statistics_on_repo_update() RETURNS trigger
     LANGUAGE plpgsql
     AS $$ BEGIN
       -- Insert diff of changes
-      WITH diff(total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted) AS (
+      WITH diff(total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered) AS (
         VALUES (
           (SELECT COUNT(*) FROM newtab WHERE deleted_at IS NULL     AND blocked IS NULL) - (SELECT COUNT(*) FROM oldtab WHERE deleted_at IS NULL     AND blocked IS NULL),
           (SELECT COUNT(*) FROM newtab WHERE deleted_at IS NOT NULL AND blocked IS NULL) - (SELECT COUNT(*) FROM oldtab WHERE deleted_at IS NOT NULL AND blocked IS NULL),
@@ -691,12 +702,17 @@ CREATE FUNCTION recalc_repo_statistics_on_repo_update() RETURNS trigger
             (SELECT COUNT(*) FROM newtab JOIN gitserver_repos gr ON gr.repo_id = newtab.id WHERE newtab.deleted_at is NULL AND newtab.blocked IS NULL AND gr.corrupted_at IS NOT NULL)
             -
             (SELECT COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.corrupted_at IS NOT NULL)
+          ),
+          (
+            (SELECT COUNT(*) FROM newtab JOIN gitserver_repos gr ON gr.repo_id = newtab.id WHERE newtab.deleted_at is NULL AND newtab.blocked IS NULL AND gr.clone_status = 'tiered')
+            -
+            (SELECT COUNT(*) FROM oldtab JOIN gitserver_repos gr ON gr.repo_id = oldtab.id WHERE oldtab.deleted_at is NULL AND oldtab.blocked IS NULL AND gr.clone_status = 'tiered')
           )
         )
       )
       INSERT INTO
-        repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted)
-      SELECT total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted
+        repo_statistics (total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered)
+      SELECT total, soft_deleted, not_cloned, cloning, cloned, failed_fetch, corrupted, tiered
       FROM diff
       WHERE
            total != 0
@@ -706,6 +722,7 @@ CREATE FUNCTION recalc_repo_statistics_on_repo_update() RETURNS trigger
         OR cloned != 0
         OR failed_fetch != 0
         OR corrupted != 0
+        OR tiered != 0
       ;
       RETURN NULL;
   END
@@ -901,9 +918,12 @@ CREATE TABLE access_tokens (
     creator_user_id integer NOT NULL,
     scopes text[] NOT NULL,
     internal boolean DEFAULT false,
-    expires_at timestamp with time zone
+    expires_at timestamp with time zone,
+    repo_ids integer[]
 );
 
+COMMENT ON COLUMN access_tokens.repo_ids IS 'The repositories the access token is restricted to. NULL means all repositories the subject user can access.';
+
 CREATE SEQUENCE access_tokens_id_seq
     START WITH 1
     INCREMENT BY 1
@@ -1232,6 +1252,11 @@ CREATE TABLE changeset_specs (
     commit_author_name text,
     commit_author_email text,
     type text NOT NULL,
+    reviewers text[],
+    labels text[],
+    assignees text[],
+    milestone text,
+    auto_merge boolean DEFAULT false NOT NULL,
     CONSTRAINT changeset_specs_published_valid_values CHECK (((published = 'true'::text) OR (published = 'false'::text) OR (published = '"draft"'::text) OR (published IS NULL)))
 );
 
@@ -1440,19 +1465,9 @@ CREATE TABLE cm_action_jobs (
     slack_webhook bigint,
     queued_at timestamp with time zone DEFAULT now(),
     cancel boolean DEFAULT false NOT NULL,
-    CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((((
-CASE
-    WHEN (email IS NULL) THEN 0
-    ELSE 1
-END +
-CASE
-    WHEN (webhook IS NULL) THEN 0
-    ELSE 1
-END) +
-CASE
-    WHEN (slack_webhook IS NULL) THEN 0
-    ELSE 1
-END) = 1))
+    teams_webhook bigint,
+    issue_action bigint,
+    CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((num_nonnulls(email, webhook, slack_webhook, teams_webhook, issue_action) = 1))
 );
 
 COMMENT ON COLUMN cm_action_jobs.email IS 'The ID of the cm_emails action to execute if this is an email job. Mutually exclusive with webhook and slack_webhook';
@@ -1461,6 +1476,10 @@ COMMENT ON COLUMN cm_action_jobs.webhook IS 'The ID of the cm_webhooks action to
 
 COMMENT ON COLUMN cm_action_jobs.slack_webhook IS 'The ID of the cm_slack_webhook action to execute if this is a slack webhook job. Mutually exclusive with email and webhook';
 
+COMMENT ON COLUMN cm_action_jobs.teams_webhook IS 'The ID of the cm_teams_webhooks action to execute if this is a Microsoft Teams webhook job. Mutually exclusive with the other action types';
+
+COMMENT ON COLUMN cm_action_jobs.issue_action IS 'The ID of the cm_issue_actions action to execute if this is an issue job. Mutually exclusive with the other action types';
+
 COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
 
 CREATE SEQUENCE cm_action_jobs_id_seq
@@ -1473,6 +1492,16 @@ CREATE SEQUENCE cm_action_jobs_id_seq
 
 ALTER SEQUENCE cm_action_jobs_id_seq OWNED BY cm_action_jobs.id;
 
+CREATE TABLE cm_content_snapshots (
+    monitor_id bigint NOT NULL,
+    repo_id integer NOT NULL,
+    match_keys text[] NOT NULL
+);
+
+COMMENT ON TABLE cm_content_snapshots IS 'The file content matches found by the last run of a code monitor over a content search';
+
+COMMENT ON COLUMN cm_content_snapshots.match_keys IS 'The newline separated path and line of the matches found in the repository on the last run';
+
 CREATE TABLE cm_emails (
     id bigint NOT NULL,
     monitor bigint NOT NULL,
@@ -1495,6 +1524,36 @@ CREATE SEQUENCE cm_emails_id_seq
 
 ALTER SEQUENCE cm_emails_id_seq OWNED BY cm_emails.id;
 
+CREATE TABLE cm_issue_actions (
+    id bigint NOT NULL,
+    monitor bigint NOT NULL,
+    repo_id integer NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean DEFAULT false NOT NULL,
+    issue_number integer,
+    created_by integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    changed_by integer NOT NULL,
+    changed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE cm_issue_actions IS 'Actions configured on code monitors that open or comment on an issue in a GitHub or GitLab repository';
+
+COMMENT ON COLUMN cm_issue_actions.monitor IS 'The code monitor that the action is defined on';
+
+COMMENT ON COLUMN cm_issue_actions.repo_id IS 'The repository the issue is opened in';
+
+COMMENT ON COLUMN cm_issue_actions.issue_number IS 'The number of the issue opened by this action. While the issue is open, new results are added to it as comments.';
+
+CREATE SEQUENCE cm_issue_actions_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE cm_issue_actions_id_seq OWNED BY cm_issue_actions.id;
+
 CREATE TABLE cm_last_searched (
     monitor_id bigint NOT NULL,
     commit_oids text[] NOT NULL,
@@ -1592,6 +1651,33 @@ CREATE SEQUENCE cm_slack_webhooks_id_seq
 
 ALTER SEQUENCE cm_slack_webhooks_id_seq OWNED BY cm_slack_webhooks.id;
 
+CREATE TABLE cm_teams_webhooks (
+    id bigint NOT NULL,
+    monitor bigint NOT NULL,
+    url text NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean DEFAULT false NOT NULL,
+    created_by integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    changed_by integer NOT NULL,
+    changed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE cm_teams_webhooks IS 'Microsoft Teams webhook actions configured on code monitors';
+
+COMMENT ON COLUMN cm_teams_webhooks.monitor IS 'The code monitor that the action is defined on';
+
+COMMENT ON COLUMN cm_teams_webhooks.url IS 'The Microsoft Teams incoming webhook URL we send the code monitor event to';
+
+CREATE SEQUENCE cm_teams_webhooks_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE cm_teams_webhooks_id_seq OWNED BY cm_teams_webhooks.id;
+
 CREATE TABLE cm_trigger_jobs (
     id integer NOT NULL,
     query bigint NOT NULL,
@@ -2436,6 +2522,27 @@ CREATE SEQUENCE executor_secrets_id_seq
 
 ALTER SEQUENCE executor_secrets_id_seq OWNED BY executor_secrets.id;
 
+CREATE TABLE exhaustive_search_job_schedules (
+    id integer NOT NULL,
+    initiator_id integer NOT NULL,
+    query text NOT NULL,
+    schedule text NOT NULL,
+    retain_runs integer DEFAULT 10 NOT NULL,
+    next_run_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE SEQUENCE exhaustive_search_job_schedules_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE exhaustive_search_job_schedules_id_seq OWNED BY exhaustive_search_job_schedules.id;
+
 CREATE TABLE exhaustive_search_jobs (
     id integer NOT NULL,
     state text DEFAULT 'queued'::text,
@@ -2454,9 +2561,18 @@ CREATE TABLE exhaustive_search_jobs (
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
     queued_at timestamp with time zone DEFAULT now(),
-    is_aggregated boolean DEFAULT false NOT NULL
+    is_aggregated boolean DEFAULT false NOT NULL,
+    schedule_id integer,
+    result_count integer,
+    diff_base_job_id integer,
+    results_added integer,
+    results_removed integer
 );
 
+COMMENT ON COLUMN exhaustive_search_jobs.schedule_id IS 'The schedule that created this job. NULL for one-off jobs.';
+
+COMMENT ON COLUMN exhaustive_search_jobs.diff_base_job_id IS 'The previous run of the schedule that results_added and results_removed are relative to. Not a foreign key, since older runs are deleted by the retention policy.';
+
 CREATE SEQUENCE exhaustive_search_jobs_id_seq
     AS integer
     START WITH 1
@@ -2826,7 +2942,8 @@ CREATE TABLE gitserver_repos_statistics (
     cloning bigint DEFAULT 0 NOT NULL,
     cloned bigint DEFAULT 0 NOT NULL,
     failed_fetch bigint DEFAULT 0 NOT NULL,
-    corrupted bigint DEFAULT 0 NOT NULL
+    corrupted bigint DEFAULT 0 NOT NULL,
+    tiered bigint DEFAULT 0 NOT NULL
 );
 
 COMMENT ON COLUMN gitserver_repos_statistics.shard_id IS 'ID of this gitserver shard. If an empty string then the repositories havent been assigned a shard.';
@@ -2843,6 +2960,8 @@ COMMENT ON COLUMN gitserver_repos_statistics.failed_fetch IS 'Number of reposito
 
 COMMENT ON COLUMN gitserver_repos_statistics.corrupted IS 'Number of repositories that are NOT soft-deleted and not blocked and have corrupted_at set in gitserver_repos table';
 
+COMMENT ON COLUMN gitserver_repos_statistics.tiered IS 'Number of repositories in gitserver_repos table on this shard that are tiered to cold storage';
+
 CREATE TABLE gitserver_repos_sync_output (
     repo_id integer NOT NULL,
     last_output text DEFAULT ''::text NOT NULL,
@@ -3986,6 +4105,52 @@ CREATE SEQUENCE own_signal_recent_contribution_id_seq
 
 ALTER SEQUENCE own_signal_recent_contribution_id_seq OWNED BY own_signal_recent_contribution.id;
 
+CREATE TABLE ownership_coverage_directory_stats (
+    snapshot_id integer NOT NULL,
+    path text NOT NULL,
+    parent_path text,
+    total_files_count integer NOT NULL,
+    codeowned_files_count integer NOT NULL,
+    assigned_ownership_files_count integer NOT NULL,
+    recent_contributor_files_count integer NOT NULL,
+    any_ownership_files_count integer NOT NULL
+);
+
+COMMENT ON TABLE ownership_coverage_directory_stats IS 'File counts by ownership source for the directories of an ownership coverage snapshot. Only directories up to a fixed depth are recorded.';
+
+COMMENT ON COLUMN ownership_coverage_directory_stats.path IS 'Path of the directory without a leading slash. The empty path is the repository root.';
+
+COMMENT ON COLUMN ownership_coverage_directory_stats.parent_path IS 'Path of the parent directory, NULL for the repository root.';
+
+COMMENT ON COLUMN ownership_coverage_directory_stats.any_ownership_files_count IS 'Files owned via CODEOWNERS or assigned ownership. Recent contributors are not counted as owners.';
+
+CREATE TABLE ownership_coverage_snapshots (
+    id integer NOT NULL,
+    repo_id integer NOT NULL,
+    commit_id text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE ownership_coverage_snapshots IS 'Ownership coverage reports computed for a repository at a given commit. Kept over time to show coverage trends.';
+
+CREATE SEQUENCE ownership_coverage_snapshots_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE ownership_coverage_snapshots_id_seq OWNED BY ownership_coverage_snapshots.id;
+
+CREATE TABLE ownership_coverage_team_stats (
+    snapshot_id integer NOT NULL,
+    team_id integer NOT NULL,
+    owned_files_count integer NOT NULL
+);
+
+COMMENT ON TABLE ownership_coverage_team_stats IS 'Number of files owned by each team in an ownership coverage snapshot, via CODEOWNERS or assigned ownership.';
+
 CREATE TABLE ownership_path_stats (
     file_path_id integer NOT NULL,
     tree_codeowned_files_count integer,
@@ -4486,7 +4651,8 @@ CREATE TABLE repo_statistics (
     cloning bigint DEFAULT 0 NOT NULL,
     cloned bigint DEFAULT 0 NOT NULL,
     failed_fetch bigint DEFAULT 0 NOT NULL,
-    corrupted bigint DEFAULT 0 NOT NULL
+    corrupted bigint DEFAULT 0 NOT NULL,
+    tiered bigint DEFAULT 0 NOT NULL
 );
 
 COMMENT ON COLUMN repo_statistics.total IS 'Number of repositories that are not soft-deleted and not blocked';
@@ -4503,6 +4669,8 @@ COMMENT ON COLUMN repo_statistics.failed_fetch IS 'Number of repositories that a
 
 COMMENT ON COLUMN repo_statistics.corrupted IS 'Number of repositories that are NOT soft-deleted and not blocked and have corrupted_at set in gitserver_repos table';
 
+COMMENT ON COLUMN repo_statistics.tiered IS 'Number of repositories that are NOT soft-deleted and not blocked and tiered to cold storage by gitserver';
+
 CREATE TABLE role_permissions (
     role_id integer NOT NULL,
     permission_id integer NOT NULL,
@@ -5193,6 +5361,8 @@ ALTER TABLE ONLY cm_action_jobs ALTER COLUMN id SET DEFAULT nextval('cm_action_j
 
 ALTER TABLE ONLY cm_emails ALTER COLUMN id SET DEFAULT nextval('cm_emails_id_seq'::regclass);
 
+ALTER TABLE ONLY cm_issue_actions ALTER COLUMN id SET DEFAULT nextval('cm_issue_actions_id_seq'::regclass);
+
 ALTER TABLE ONLY cm_monitors ALTER COLUMN id SET DEFAULT nextval('cm_monitors_id_seq'::regclass);
 
 ALTER TABLE ONLY cm_queries ALTER COLUMN id SET DEFAULT nextval('cm_queries_id_seq'::regclass);
@@ -5201,6 +5371,8 @@ ALTER TABLE ONLY cm_recipients ALTER COLUMN id SET DEFAULT nextval('cm_recipient
 
 ALTER TABLE ONLY cm_slack_webhooks ALTER COLUMN id SET DEFAULT nextval('cm_slack_webhooks_id_seq'::regclass);
 
+ALTER TABLE ONLY cm_teams_webhooks ALTER COLUMN id SET DEFAULT nextval('cm_teams_webhooks_id_seq'::regclass);
+
 ALTER TABLE ONLY cm_trigger_jobs ALTER COLUMN id SET DEFAULT nextval('cm_trigger_jobs_id_seq'::regclass);
 
 ALTER TABLE ONLY cm_webhooks ALTER COLUMN id SET DEFAULT nextval('cm_webhooks_id_seq'::regclass);
@@ -5267,6 +5439,8 @@ ALTER TABLE ONLY executor_secret_access_logs ALTER COLUMN id SET DEFAULT nextval
 
 ALTER TABLE ONLY executor_secrets ALTER COLUMN id SET DEFAULT nextval('executor_secrets_id_seq'::regclass);
 
+ALTER TABLE ONLY exhaustive_search_job_schedules ALTER COLUMN id SET DEFAULT nextval('exhaustive_search_job_schedules_id_seq'::regclass);
+
 ALTER TABLE ONLY exhaustive_search_jobs ALTER COLUMN id SET DEFAULT nextval('exhaustive_search_jobs_id_seq'::regclass);
 
 ALTER TABLE ONLY exhaustive_search_repo_jobs ALTER COLUMN id SET DEFAULT nextval('exhaustive_search_repo_jobs_id_seq'::regclass);
@@ -5345,6 +5519,8 @@ ALTER TABLE ONLY own_signal_configurations ALTER COLUMN id SET DEFAULT nextval('
 
 ALTER TABLE ONLY own_signal_recent_contribution ALTER COLUMN id SET DEFAULT nextval('own_signal_recent_contribution_id_seq'::regclass);
 
+ALTER TABLE ONLY ownership_coverage_snapshots ALTER COLUMN id SET DEFAULT nextval('ownership_coverage_snapshots_id_seq'::regclass);
+
 ALTER TABLE ONLY package_repo_filters ALTER COLUMN id SET DEFAULT nextval('package_repo_filters_id_seq'::regclass);
 
 ALTER TABLE ONLY package_repo_versions ALTER COLUMN id SET DEFAULT nextval('package_repo_versions_id_seq'::regclass);
@@ -5489,9 +5665,15 @@ ALTER TABLE ONLY changesets
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY cm_content_snapshots
+    ADD CONSTRAINT cm_content_snapshots_pkey PRIMARY KEY (monitor_id, repo_id);
+
 ALTER TABLE ONLY cm_emails
     ADD CONSTRAINT cm_emails_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY cm_issue_actions
+    ADD CONSTRAINT cm_issue_actions_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY cm_last_searched
     ADD CONSTRAINT cm_last_searched_pkey PRIMARY KEY (monitor_id, repo_id);
 
@@ -5507,6 +5689,9 @@ ALTER TABLE ONLY cm_recipients
 ALTER TABLE ONLY cm_slack_webhooks
     ADD CONSTRAINT cm_slack_webhooks_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY cm_teams_webhooks
+    ADD CONSTRAINT cm_teams_webhooks_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY cm_trigger_jobs
     ADD CONSTRAINT cm_trigger_jobs_pkey PRIMARY KEY (id);
 
@@ -5630,6 +5815,9 @@ ALTER TABLE ONLY executor_secret_access_logs
 ALTER TABLE ONLY executor_secrets
     ADD CONSTRAINT executor_secrets_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY exhaustive_search_job_schedules
+    ADD CONSTRAINT exhaustive_search_job_schedules_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY exhaustive_search_jobs
     ADD CONSTRAINT exhaustive_search_jobs_pkey PRIMARY KEY (id);
 
@@ -5798,6 +5986,15 @@ ALTER TABLE ONLY own_signal_configurations
 ALTER TABLE ONLY own_signal_recent_contribution
     ADD CONSTRAINT own_signal_recent_contribution_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY ownership_coverage_directory_stats
+    ADD CONSTRAINT ownership_coverage_directory_stats_pkey PRIMARY KEY (snapshot_id, path);
+
+ALTER TABLE ONLY ownership_coverage_snapshots
+    ADD CONSTRAINT ownership_coverage_snapshots_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY ownership_coverage_team_stats
+    ADD CONSTRAINT ownership_coverage_team_stats_pkey PRIMARY KEY (snapshot_id, team_id);
+
 ALTER TABLE ONLY ownership_path_stats
     ADD CONSTRAINT ownership_path_stats_pkey PRIMARY KEY (file_path_id);
 
@@ -6069,8 +6266,12 @@ CREATE INDEX cm_action_jobs_state_idx ON cm_action_jobs USING btree (state);
 
 CREATE INDEX cm_action_jobs_trigger_event ON cm_action_jobs USING btree (trigger_event);
 
+CREATE INDEX cm_issue_actions_monitor ON cm_issue_actions USING btree (monitor);
+
 CREATE INDEX cm_slack_webhooks_monitor ON cm_slack_webhooks USING btree (monitor);
 
+CREATE INDEX cm_teams_webhooks_monitor ON cm_teams_webhooks USING btree (monitor);
+
 CREATE INDEX cm_trigger_jobs_finished_at ON cm_trigger_jobs USING btree (finished_at);
 
 CREATE INDEX cm_trigger_jobs_state_idx ON cm_trigger_jobs USING btree (state);
@@ -6161,6 +6362,10 @@ CREATE UNIQUE INDEX executor_secrets_unique_key_namespace_org ON executor_secret
 
 CREATE UNIQUE INDEX executor_secrets_unique_key_namespace_user ON executor_secrets USING btree (key, namespace_user_id, scope) WHERE (namespace_user_id IS NOT NULL);
 
+CREATE INDEX exhaustive_search_job_schedules_next_run_at ON exhaustive_search_job_schedules USING btree (next_run_at);
+
+CREATE INDEX exhaustive_search_jobs_schedule_id ON exhaustive_search_jobs USING btree (schedule_id);
+
 CREATE INDEX exhaustive_search_jobs_state ON exhaustive_search_jobs USING btree (state);
 
 CREATE INDEX exhaustive_search_repo_jobs_state ON exhaustive_search_repo_jobs USING btree (state);
@@ -6209,6 +6414,8 @@ CREATE INDEX gitserver_repos_not_explicitly_cloned_idx ON gitserver_repos USING
 
 CREATE INDEX gitserver_repos_shard_id ON gitserver_repos USING btree (shard_id, repo_id);
 
+CREATE INDEX gitserver_repos_tiered_status_idx ON gitserver_repos USING btree (shard_id) WHERE (clone_status = 'tiered'::text);
+
 CREATE INDEX gitserver_repos_statistics_shard_id ON gitserver_repos_statistics USING btree (shard_id);
 
 CREATE INDEX idx_repo_topics ON repo USING gin (topics);
@@ -6341,6 +6548,10 @@ CREATE INDEX own_background_jobs_state_idx ON own_background_jobs USING btree (s
 
 CREATE UNIQUE INDEX own_signal_configurations_name_uidx ON own_signal_configurations USING btree (name);
 
+CREATE INDEX ownership_coverage_directory_stats_parent_path_idx ON ownership_coverage_directory_stats USING btree (snapshot_id, parent_path);
+
+CREATE INDEX ownership_coverage_snapshots_repo_id_created_at_idx ON ownership_coverage_snapshots USING btree (repo_id, created_at DESC);
+
 CREATE UNIQUE INDEX package_repo_filters_unique_matcher_per_scheme ON package_repo_filters USING btree (scheme, matcher);
 
 CREATE INDEX package_repo_versions_blocked ON package_repo_versions USING btree (blocked);
@@ -6675,15 +6886,27 @@ ALTER TABLE ONLY changesets
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_email_fk FOREIGN KEY (email) REFERENCES cm_emails(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_action_jobs
+    ADD CONSTRAINT cm_action_jobs_issue_action_fkey FOREIGN KEY (issue_action) REFERENCES cm_issue_actions(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_slack_webhook_fkey FOREIGN KEY (slack_webhook) REFERENCES cm_slack_webhooks(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_action_jobs
+    ADD CONSTRAINT cm_action_jobs_teams_webhook_fkey FOREIGN KEY (teams_webhook) REFERENCES cm_teams_webhooks(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_trigger_event_fk FOREIGN KEY (trigger_event) REFERENCES cm_trigger_jobs(id) ON DELETE CASCADE;
 
 ALTER TABLE ONLY cm_action_jobs
     ADD CONSTRAINT cm_action_jobs_webhook_fkey FOREIGN KEY (webhook) REFERENCES cm_webhooks(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_content_snapshots
+    ADD CONSTRAINT cm_content_snapshots_monitor_id_fkey FOREIGN KEY (monitor_id) REFERENCES cm_monitors(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_content_snapshots
+    ADD CONSTRAINT cm_content_snapshots_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_emails
     ADD CONSTRAINT cm_emails_changed_by_fk FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE CASCADE;
 
@@ -6693,6 +6916,18 @@ ALTER TABLE ONLY cm_emails
 ALTER TABLE ONLY cm_emails
     ADD CONSTRAINT cm_emails_monitor FOREIGN KEY (monitor) REFERENCES cm_monitors(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_issue_actions
+    ADD CONSTRAINT cm_issue_actions_changed_by_fkey FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_issue_actions
+    ADD CONSTRAINT cm_issue_actions_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_issue_actions
+    ADD CONSTRAINT cm_issue_actions_monitor_fkey FOREIGN KEY (monitor) REFERENCES cm_monitors(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_issue_actions
+    ADD CONSTRAINT cm_issue_actions_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_last_searched
     ADD CONSTRAINT cm_last_searched_monitor_id_fkey FOREIGN KEY (monitor_id) REFERENCES cm_monitors(id) ON DELETE CASCADE;
 
@@ -6729,6 +6964,15 @@ ALTER TABLE ONLY cm_slack_webhooks
 ALTER TABLE ONLY cm_slack_webhooks
     ADD CONSTRAINT cm_slack_webhooks_monitor_fkey FOREIGN KEY (monitor) REFERENCES cm_monitors(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY cm_teams_webhooks
+    ADD CONSTRAINT cm_teams_webhooks_changed_by_fkey FOREIGN KEY (changed_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_teams_webhooks
+    ADD CONSTRAINT cm_teams_webhooks_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY cm_teams_webhooks
+    ADD CONSTRAINT cm_teams_webhooks_monitor_fkey FOREIGN KEY (monitor) REFERENCES cm_monitors(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY cm_trigger_jobs
     ADD CONSTRAINT cm_trigger_jobs_query_fk FOREIGN KEY (query) REFERENCES cm_queries(id) ON DELETE CASCADE;
 
@@ -6813,9 +7057,15 @@ ALTER TABLE ONLY executor_secrets
 ALTER TABLE ONLY executor_secrets
     ADD CONSTRAINT executor_secrets_namespace_user_id_fkey FOREIGN KEY (namespace_user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY exhaustive_search_job_schedules
+    ADD CONSTRAINT exhaustive_search_job_schedules_initiator_id_fkey FOREIGN KEY (initiator_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY exhaustive_search_jobs
     ADD CONSTRAINT exhaustive_search_jobs_initiator_id_fkey FOREIGN KEY (initiator_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY exhaustive_search_jobs
+    ADD CONSTRAINT exhaustive_search_jobs_schedule_id_fkey FOREIGN KEY (schedule_id) REFERENCES exhaustive_search_job_schedules(id) ON DELETE SET NULL;
+
 ALTER TABLE ONLY exhaustive_search_repo_jobs
     ADD CONSTRAINT exhaustive_search_repo_jobs_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE;
 
@@ -6990,6 +7240,18 @@ ALTER TABLE ONLY own_signal_recent_contribution
 ALTER TABLE ONLY own_signal_recent_contribution
     ADD CONSTRAINT own_signal_recent_contribution_commit_author_id_fkey FOREIGN KEY (commit_author_id) REFERENCES commit_authors(id);
 
+ALTER TABLE ONLY ownership_coverage_directory_stats
+    ADD CONSTRAINT ownership_coverage_directory_stats_snapshot_id_fkey FOREIGN KEY (snapshot_id) REFERENCES ownership_coverage_snapshots(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY ownership_coverage_snapshots
+    ADD CONSTRAINT ownership_coverage_snapshots_repo_id_fkey FOREIGN KEY (repo_id) REFERENCES repo(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY ownership_coverage_team_stats
+    ADD CONSTRAINT ownership_coverage_team_stats_snapshot_id_fkey FOREIGN KEY (snapshot_id) REFERENCES ownership_coverage_snapshots(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY ownership_coverage_team_stats
+    ADD CONSTRAINT ownership_coverage_team_stats_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY ownership_path_stats
     ADD CONSTRAINT ownership_path_stats_file_path_id_fkey FOREIGN KEY (file_path_id) REFERENCES repo_paths(id);
 
@@ -162,6 +162,7 @@ type AuthProviders struct {
 	Bitbucketcloud *BitbucketCloudAuthProvider
 	Builtin        *BuiltinAuthProvider
 	Gerrit         *GerritAuthProvider
+	Gitea          *GiteaAuthProvider
 	Github         *GitHubAuthProvider
 	Gitlab         *GitLabAuthProvider
 	HttpHeader     *HTTPHeaderAuthProvider
@@ -182,6 +183,9 @@ func (v AuthProviders) MarshalJSON() ([]byte, error) {
 	if v.Gerrit != nil {
 		return json.Marshal(v.Gerrit)
 	}
+	if v.Gitea != nil {
+		return json.Marshal(v.Gitea)
+	}
 	if v.Github != nil {
 		return json.Marshal(v.Github)
 	}
@@ -215,6 +219,8 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 		return json.Unmarshal(data, &v.Builtin)
 	case "gerrit":
 		return json.Unmarshal(data, &v.Gerrit)
+	case "gitea":
+		return json.Unmarshal(data, &v.Gitea)
 	case "github":
 		return json.Unmarshal(data, &v.Github)
 	case "gitlab":
@@ -226,7 +232,7 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	case "saml":
 		return json.Unmarshal(data, &v.Saml)
 	}
-	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "github", "gitlab", "http-header", "openidconnect", "saml"})
+	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "gitea", "github", "gitlab", "http-header", "openidconnect", "saml"})
 }
 
 // AzureDevOpsAuthProvider description: Azure auth provider for dev.azure.com
@@ -375,6 +381,8 @@ type BitbucketCloudConnection struct {
 	//
 	// If "ssh", Sourcegraph will access Bitbucket Cloud repositories using Git URLs of the form git@bitbucket.org:myteam/myproject.git. See the documentation for how to provide SSH private keys and known_hosts: https://sourcegraph.com/docs/admin/repo/auth#repositories-that-need-http-s-or-ssh-authentication.
 	GitURLType string `json:"gitURLType,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool `json:"partialClone,omitempty"`
 	// RateLimit description: Rate limit applied when making background API requests to Bitbucket Cloud.
 	RateLimit *BitbucketCloudRateLimit `json:"rateLimit,omitempty"`
 	// Repos description: An array of repository "projectKey/repositorySlug" strings specifying repositories to mirror on Sourcegraph.
@@ -433,6 +441,8 @@ type BitbucketServerConnection struct {
 	GitURLType string `json:"gitURLType,omitempty"`
 	// InitialRepositoryEnablement description: Deprecated and ignored field which will be removed entirely in the next release. BitBucket repositories can no longer be enabled or disabled explicitly.
 	InitialRepositoryEnablement bool `json:"initialRepositoryEnablement,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool `json:"partialClone,omitempty"`
 	// Password description: The password to use when authenticating to the Bitbucket Server / Bitbucket Data Center instance. Also set the corresponding "username" field.
 	//
 	// For Bitbucket Server / Bitbucket Data Center instances that support personal access tokens (Bitbucket Server / Bitbucket Data Center version 5.5 and newer), it is recommended to provide a token instead (in the "token" field).
@@ -528,6 +538,10 @@ type BitbucketServerUsernameIdentity struct {
 	Type string `json:"type"`
 }
 type BranchChangesetSpec struct {
+	// Assignees description: The users to assign the changeset to on the code host.
+	Assignees []string `json:"assignees,omitempty"`
+	// AutoMerge description: Whether to enable auto-merge for the changeset on the code host.
+	AutoMerge bool `json:"autoMerge,omitempty"`
 	// BaseRef description: The full name of the Git ref in the base repository that this changeset is based on (and is proposing to be merged into). This ref must exist on the base repository.
 	BaseRef string `json:"baseRef"`
 	// BaseRepository description: The GraphQL ID of the repository that this changeset spec is proposing to change.
@@ -544,8 +558,14 @@ type BranchChangesetSpec struct {
 	HeadRef string `json:"headRef"`
 	// HeadRepository description: The GraphQL ID of the repository that contains the branch with this changeset's changes. Fork repositories and cross-repository changesets are not yet supported. Therefore, headRepository must be equal to baseRepository.
 	HeadRepository string `json:"headRepository"`
+	// Labels description: The labels to add to the changeset on the code host.
+	Labels []string `json:"labels,omitempty"`
+	// Milestone description: The title of the milestone to add the changeset to on the code host.
+	Milestone string `json:"milestone,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The users (or, on GitHub, teams in the form `org/team`) to request a review from on the code host.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset on the code host.
 	Title string `json:"title"`
 	// Version description: A field for versioning the payload.
@@ -589,6 +609,10 @@ type CapabilitiesResult struct {
 
 // ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 type ChangesetTemplate struct {
+	// Assignees description: The users to assign each changeset to. Only supported on GitHub, GitLab and Gitea. Assignees added on the code host by other means are kept, assignees removed from this list are removed from the changeset.
+	Assignees []string `json:"assignees,omitempty"`
+	// AutoMerge description: Whether to enable auto-merge on each published changeset, so that it is merged by the code host once all of its requirements are met. Draft changesets have auto-merge enabled when they are undrafted. Only supported on GitHub, GitLab and Gitea.
+	AutoMerge bool `json:"autoMerge,omitempty"`
 	// Body description: The body (description) of the changeset.
 	Body string `json:"body,omitempty"`
 	// Branch description: The name of the Git branch to create or update on each repository with the changes.
@@ -597,8 +621,14 @@ type ChangesetTemplate struct {
 	Commit ExpandedGitCommitDescription `json:"commit"`
 	// Fork description: Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global `batches.enforceFork` setting is enabled. If set, this property will override any global setting.
 	Fork bool `json:"fork,omitempty"`
+	// Labels description: The labels to add to each changeset. Only supported on GitHub, GitLab and Gitea. Labels added on the code host by other means are kept, labels removed from this list are removed from the changeset.
+	Labels []string `json:"labels,omitempty"`
+	// Milestone description: The title of the milestone to add each changeset to. The milestone must already exist in the repository. Only supported on GitHub, GitLab and Gitea.
+	Milestone string `json:"milestone,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The users (or, on GitHub and Gitea, teams in the form `org/team`) to request a review from on each changeset. Only supported on GitHub, GitLab, Gitea and Bitbucket Server. Reviewers requested on the code host by other means are kept, reviewers removed from this list are removed from the changeset.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset.
 	Title string `json:"title"`
 }
@@ -693,6 +723,16 @@ type Codeintel struct {
 	// Weight description: The relative weight of this queue. Higher weights mean a higher chance of being picked at random.
 	Weight int `json:"weight"`
 }
+
+// CodyCompletionsCache description: Caches LLM completion responses in Redis, so that identical completion requests of the same user are answered without calling the LLM provider again. Concurrent identical requests of the same user are also coalesced into a single provider request.
+type CodyCompletionsCache struct {
+	// Enabled description: Enables the completions response cache.
+	Enabled bool `json:"enabled"`
+	// MaxEntryBytes description: Completion responses larger than this many bytes are not cached. Defaults to 65536 (64 KiB).
+	MaxEntryBytes int `json:"maxEntryBytes,omitempty"`
+	// TtlSeconds description: How long, in seconds, a cached completion response is served for. Defaults to 3600 (1 hour).
+	TtlSeconds int `json:"ttlSeconds,omitempty"`
+}
 type CodyContextFilterItem struct {
 	// RepoNamePattern description: Regular expression which matches a set of repository names. The pattern is evaluated using Go regular expression syntax (https://golang.org/pkg/regexp/). By default, the pattern matches partially. Use \"^...$\" for whole-string matching.
 	RepoNamePattern string `json:"repoNamePattern"`
@@ -1080,12 +1120,30 @@ type ExcludedGitLabProject struct {
 	// Pattern description: Regular expression which matches against the name of a GitLab project ("group/name").
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedGiteaRepo struct {
+	// Archived description: If set to true, archived repositories will be excluded.
+	Archived bool `json:"archived,omitempty"`
+	// Forks description: If set to true, forks will be excluded.
+	Forks bool `json:"forks,omitempty"`
+	// Id description: The ID of a Gitea repository (as returned by the Gitea API) to exclude from mirroring.
+	Id int `json:"id,omitempty"`
+	// Name description: The name of a Gitea repository ("owner/name") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Gitea repository ("owner/name").
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedGitoliteRepo struct {
 	// Name description: The name of a Gitolite repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
 	// Pattern description: Regular expression which matches against the name of a Gitolite repo to exclude from mirroring.
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedMercurialRepo struct {
+	// Name description: The path of a Mercurial repository relative to the url ("my/repo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the path of a Mercurial repository relative to the url to exclude from mirroring.
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedOtherRepo struct {
 	// Name description: The name of a Other repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -1347,6 +1405,8 @@ type GerritConnection struct {
 	//
 	// If "ssh", Sourcegraph will access Gerrit repositories using Git URLs of the form git@gerrit.example.com:myteam/myproject.git. The exact hostname and port will be fetched from /ssh_info. See the documentation for how to provide SSH private keys and known_hosts: https://sourcegraph.com/docs/admin/repo/auth.
 	GitURLType string `json:"gitURLType,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool `json:"partialClone,omitempty"`
 	// Password description: The password associated with the Gerrit username used for authentication.
 	Password string `json:"password"`
 	// Projects description: An array of project strings specifying which Gerrit projects to mirror on Sourcegraph. If empty, all projects will be mirrored.
@@ -1471,6 +1531,8 @@ type GitHubConnection struct {
 	InitialRepositoryEnablement bool `json:"initialRepositoryEnablement,omitempty"`
 	// Orgs description: An array of organization names identifying GitHub organizations whose repositories should be mirrored on Sourcegraph.
 	Orgs []string `json:"orgs,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool `json:"partialClone,omitempty"`
 	// Pending description: Whether the code host connection is in a pending state.
 	Pending bool `json:"pending,omitempty"`
 	// RateLimit description: Rate limit applied when making background API requests to GitHub.
@@ -1578,6 +1640,8 @@ type GitLabConnection struct {
 	MarkInternalReposAsPublic bool `json:"markInternalReposAsPublic,omitempty"`
 	// NameTransformations description: An array of transformations will apply to the repository name. Currently, only regex replacement is supported. All transformations happen after "repositoryPathPattern" is processed.
 	NameTransformations []*GitLabNameTransformation `json:"nameTransformations,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool `json:"partialClone,omitempty"`
 	// ProjectQuery description: An array of strings specifying which GitLab projects to mirror on Sourcegraph. Each string is a URL path and query that targets a GitLab API endpoint returning a list of projects. If the string only contains a query, then "projects" is used as the path. Examples: "?membership=true&search=foo", "groups/mygroup/projects".
 	//
 	// The special string "none" can be used as the only element to disable this feature. Projects matched by multiple query strings are only imported once. Here are a few endpoints that return a list of projects: https://docs.gitlab.com/ee/api/projects.html#list-all-projects, https://docs.gitlab.com/ee/api/groups.html#list-a-groups-projects, https://docs.gitlab.com/ee/api/search.html#scope-projects.
@@ -1640,6 +1704,72 @@ type GitRecorder struct {
 	Size int `json:"size,omitempty"`
 }
 
+// GiteaAuthProvider description: Configures the Gitea OAuth authentication provider for SSO. This also works with Forgejo. In addition to specifying this configuration object, you must also create an OAuth2 application on your Gitea instance (in the user or organization settings under Applications). The redirect URI of the application should be set to the concatenation of your Sourcegraph instance URL and "/.auth/gitea/callback".
+type GiteaAuthProvider struct {
+	// AllowSignup description: Allows new visitors to sign up for accounts via Gitea authentication. If false, users signing in via Gitea must have an existing Sourcegraph account, which will be linked to their Gitea identity after sign-in.
+	AllowSignup *bool `json:"allowSignup,omitempty"`
+	// ClientID description: The Client ID of the Gitea OAuth2 application.
+	ClientID string `json:"clientID"`
+	// ClientSecret description: The Client Secret of the Gitea OAuth2 application.
+	ClientSecret  string  `json:"clientSecret"`
+	DisplayName   string  `json:"displayName,omitempty"`
+	DisplayPrefix *string `json:"displayPrefix,omitempty"`
+	Hidden        bool    `json:"hidden,omitempty"`
+	NoSignIn      bool    `json:"noSignIn,omitempty"`
+	Order         int     `json:"order,omitempty"`
+	Type          string  `json:"type"`
+	// Url description: URL of the Gitea instance, such as https://gitea.example.com or https://codeberg.org.
+	Url string `json:"url"`
+}
+
+// GiteaAuthorization description: If non-null, enforces Gitea repository permissions. This requires that there is an item in the [site configuration json](https://sourcegraph.com/docs/admin/config/site_config#auth-providers) `auth.providers` field, of type "gitea" with the same `url` field as specified in this `GiteaConnection`.
+type GiteaAuthorization struct {
+	// IdentityProvider description: The identity provider to use for user information. If not set, the `url` field is used.
+	IdentityProvider string `json:"identityProvider,omitempty"`
+}
+
+// GiteaConnection description: Configuration for a connection to Gitea or Forgejo.
+type GiteaConnection struct {
+	// Authorization description: If non-null, enforces Gitea repository permissions. This requires that there is an item in the [site configuration json](https://sourcegraph.com/docs/admin/config/site_config#auth-providers) `auth.providers` field, of type "gitea" with the same `url` field as specified in this `GiteaConnection`.
+	Authorization *GiteaAuthorization `json:"authorization,omitempty"`
+	// Exclude description: A list of repositories to never mirror from this Gitea instance. Takes precedence over "repos", "orgs" and "repositoryQuery" configuration.
+	//
+	// Supports excluding by name ({"name": "owner/name"}), by ID ({"id": 42}) or by regular expression ({"pattern": "^owner/.*-archive$"}).
+	Exclude []*ExcludedGiteaRepo `json:"exclude,omitempty"`
+	// GitURLType description: The type of Git URLs to use for cloning and fetching Git repositories on this Gitea instance.
+	//
+	// If "http", Sourcegraph will access Gitea repositories using Git URLs of the form https://gitea.example.com/myteam/myproject.git (using the token for authentication).
+	//
+	// If "ssh", Sourcegraph will access Gitea repositories using Git URLs of the form git@gitea.example.com:myteam/myproject.git. See the documentation for how to provide SSH private keys and known_hosts: https://sourcegraph.com/docs/admin/repo/auth#repositories-that-need-http-s-or-ssh-authentication.
+	GitURLType string `json:"gitURLType,omitempty"`
+	// Orgs description: An array of organization names identifying Gitea organizations whose repositories should be mirrored on Sourcegraph.
+	Orgs []string `json:"orgs,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool `json:"partialClone,omitempty"`
+	// Repos description: An array of repository "owner/name" strings specifying which Gitea repositories to mirror on Sourcegraph.
+	Repos []string `json:"repos,omitempty"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for a Gitea repository. In the pattern, the variable "{host}" is replaced with the Gitea URL's host (such as gitea.example.com), and "{nameWithOwner}" is replaced with the Gitea repository's "owner/name" (such as "myorg/myrepo").
+	//
+	// For example, if your Gitea is https://gitea.example.com and your Sourcegraph is https://src.example.com, then a repositoryPathPattern of "{host}/{nameWithOwner}" would mean that a Gitea repository at https://gitea.example.com/myorg/myrepo is available on Sourcegraph at https://src.example.com/gitea.example.com/myorg/myrepo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// RepositoryQuery description: An array of strings specifying which Gitea repositories to mirror on Sourcegraph. The valid values are:
+	//
+	// - `affiliated` mirrors all repositories the token's user owns, collaborates on or can access through organization membership.
+	//
+	// - `all` mirrors all repositories visible to the token's user on the instance.
+	//
+	// - `none` mirrors no repositories (except those specified in the `repos` or `orgs` configuration property).
+	//
+	// If not set, it defaults to `affiliated` when neither `repos` nor `orgs` are set, and to `none` otherwise.
+	RepositoryQuery []string `json:"repositoryQuery,omitempty"`
+	// Token description: An access token for the Gitea or Forgejo instance. The token needs the read:repository, read:organization and read:user scopes. To use Batch Changes with this code host connection, the token also needs the write:repository and write:issue scopes.
+	Token string `json:"token"`
+	// Url description: URL of a Gitea or Forgejo instance, such as https://gitea.example.com or https://codeberg.org.
+	Url string `json:"url"`
+}
+
 // Github description: GitHub configuration, both for queries and receiving release webhooks.
 type Github struct {
 	// Repository description: The repository to get the latest version of.
@@ -1668,6 +1798,14 @@ type GitoliteConnection struct {
 	Prefix string `json:"prefix"`
 }
 
+// GitserverLfs description: Configures how gitserver handles Git LFS. By default, Git LFS pointer files are served as they are stored in the repository. If enabled, gitserver fetches the objects they point to from the LFS server of the repository on demand, and serves their content when reading files and in raw archives. Search indexing is not affected. Only code hosts reachable via HTTP(S) are supported.
+type GitserverLfs struct {
+	// Enabled description: Enables fetching and caching LFS objects on gitserver.
+	Enabled bool `json:"enabled"`
+	// MaxBytesPerRepo description: The maximum total size of the LFS objects cached for a single repository. The least recently read objects are evicted to make room for new ones. Objects larger than this are never fetched and their pointer file is served instead. Defaults to 1073741824 (1 GiB).
+	MaxBytesPerRepo int `json:"maxBytesPerRepo,omitempty"`
+}
+
 // GoModulesConnection description: Configuration for a connection to Go module proxies
 type GoModulesConnection struct {
 	// Dependencies description: An array of strings specifying Go modules to mirror in Sourcegraph.
@@ -1801,6 +1939,26 @@ type MavenRateLimit struct {
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
 
+// MercurialConnection description: Configuration for a connection to Mercurial repositories served by hgweb or a compatible server.
+type MercurialConnection struct {
+	// Exclude description: A list of repositories to never mirror by their path relative to the url. Supports excluding by exact name ({"name": "my/repo"}) or regular expression ({"pattern": ".*secret.*"}).
+	Exclude []*ExcludedMercurialRepo `json:"exclude,omitempty"`
+	// Password description: The password used to authenticate against the hgweb server with HTTP basic authentication.
+	Password string `json:"password,omitempty"`
+	// Repos description: A list of repository paths relative to the url to mirror. If not set, all repositories listed on the hgweb index page of the url are mirrored.
+	Repos []string `json:"repos,omitempty"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for Mercurial repositories. In the pattern, the variable "{host}" is replaced with the hgweb server's URL host, and "{name}" is replaced with the repository path relative to the url.
+	//
+	// For example, if your hgweb server is https://hg.example.com and your Sourcegraph is https://src.example.com, then a repositoryPathPattern of "{host}/{name}" would mean that the Mercurial repository at https://hg.example.com/my/repo is available on Sourcegraph at https://src.example.com/hg.example.com/my/repo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: URL of the hgweb server. Repositories are cloned from URLs relative to this URL.
+	Url string `json:"url"`
+	// Username description: The username used to authenticate against the hgweb server with HTTP basic authentication.
+	Username string `json:"username,omitempty"`
+}
+
 // ModelFilters description: Filters that allow you to constrain which models are available to users.
 type ModelFilters struct {
 	// Allow description: Constrain models to only those in this allow list. Wildcards may be used here, but not regexp.
@@ -2155,8 +2313,10 @@ type OtherExternalServiceConnection struct {
 	// Exclude description: A list of repositories to never mirror by name after applying repositoryPathPattern. Supports excluding by exact name ({"name": "myrepo"}) or regular expression ({"pattern": ".*secret.*"}).
 	Exclude []*ExcludedOtherRepo `json:"exclude,omitempty"`
 	// MakeReposPublicOnDotCom description: Whether or not these repositories should be marked as public on Sourcegraph.com. Defaults to false.
-	MakeReposPublicOnDotCom bool     `json:"makeReposPublicOnDotCom,omitempty"`
-	Repos                   []string `json:"repos"`
+	MakeReposPublicOnDotCom bool `json:"makeReposPublicOnDotCom,omitempty"`
+	// PartialClone description: EXPERIMENTAL: If true, repositories from this code host are cloned as partial clones without file contents (using git's --filter=blob:none). File contents are fetched on demand the first time they are read, archived or searched. This can greatly reduce clone times and disk usage for very large repositories, at the cost of slower first access to file contents. The code host must support partial clones.
+	PartialClone bool     `json:"partialClone,omitempty"`
+	Repos        []string `json:"repos"`
 	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for the repositories. In the pattern, the variable "{base}" is replaced with the Git clone base URL host and path, and "{repo}" is replaced with the repository path taken from the `repos` field.
 	//
 	// For example, if your Git clone base URL is https://git.example.com/repos and `repos` contains the value "my/repo", then a repositoryPathPattern of "{base}/{repo}" would mean that a repository at https://git.example.com/repos/my/repo is available on Sourcegraph at https://sourcegraph.example.com/git.example.com/repos/my/repo.
@@ -3169,7 +3329,7 @@ type SiteConfiguration struct {
 	CodeIntelAutoIndexingAllowGlobalPolicies *bool `json:"codeIntelAutoIndexing.allowGlobalPolicies,omitempty"`
 	// CodeIntelAutoIndexingEnabled description: Enables/disables the code intel auto-indexing feature. Currently experimental.
 	CodeIntelAutoIndexingEnabled *bool `json:"codeIntelAutoIndexing.enabled,omitempty"`
-	// CodeIntelAutoIndexingIndexerMap description: Overrides the default Docker images used by auto-indexing.
+	// CodeIntelAutoIndexingIndexerMap description: Overrides the default Docker images used by auto-indexing. Keys are languages (e.g. "go", "java"). C and C++ projects have no default image: "cpp" sets the scip-clang image, which indexes checked-in compile_commands.json files. CMake projects additionally need "cmake", an image with CMake and a compiler toolchain that generates the compilation database. Bazel workspaces using hedron_compile_commands need "bazel", an image with both Bazel and scip-clang.
 	CodeIntelAutoIndexingIndexerMap map[string]string `json:"codeIntelAutoIndexing.indexerMap,omitempty"`
 	// CodeIntelAutoIndexingPolicyRepositoryMatchLimit description: The maximum number of repositories to which a single auto-indexing policy can apply. Default is -1, which is unlimited.
 	CodeIntelAutoIndexingPolicyRepositoryMatchLimit *int `json:"codeIntelAutoIndexing.policyRepositoryMatchLimit,omitempty"`
@@ -3185,6 +3345,8 @@ type SiteConfiguration struct {
 	CodeIntelRankingStaleResultsAge int `json:"codeIntelRanking.staleResultsAge,omitempty"`
 	// CodeMonitors description: Configuration options for code monitors
 	CodeMonitors *CodeMonitors `json:"codeMonitors,omitempty"`
+	// CodyCompletionsCache description: Caches LLM completion responses in Redis, so that identical completion requests of the same user are answered without calling the LLM provider again. Concurrent identical requests of the same user are also coalesced into a single provider request.
+	CodyCompletionsCache *CodyCompletionsCache `json:"cody.completionsCache,omitempty"`
 	// CodyContextFilters description: Rules defining the repositories that will never be shared by Cody with third-party LLM providers.
 	CodyContextFilters *CodyContextFilters `json:"cody.contextFilters,omitempty"`
 	// CodyEnabled description: Enable or disable Cody instance-wide. When Cody is disabled, all Cody endpoints and GraphQL queries will return errors, Cody will not show up in the site-admin sidebar, and Cody in the global navbar will only show a call-to-action for site-admins to enable Cody.
@@ -3266,6 +3428,8 @@ type SiteConfiguration struct {
 	GitUpdateInterval []*UpdateIntervalRule `json:"gitUpdateInterval,omitempty"`
 	// GitserverDiskUsageWarningThreshold description: Disk usage threshold at which to display warning notification. Value is a percentage.
 	GitserverDiskUsageWarningThreshold *int `json:"gitserver.diskUsageWarningThreshold,omitempty"`
+	// GitserverLfs description: Configures how gitserver handles Git LFS. By default, Git LFS pointer files are served as they are stored in the repository. If enabled, gitserver fetches the objects they point to from the LFS server of the repository on demand, and serves their content when reading files and in raw archives. Search indexing is not affected. Only code hosts reachable via HTTP(S) are supported.
+	GitserverLfs *GitserverLfs `json:"gitserver.lfs,omitempty"`
 	// HtmlBodyBottom description: HTML to inject at the bottom of the `<body>` element on each page, for analytics scripts. Requires env var ENABLE_INJECT_HTML=true.
 	HtmlBodyBottom string `json:"htmlBodyBottom,omitempty"`
 	// HtmlBodyTop description: HTML to inject at the top of the `<body>` element on each page, for analytics scripts. Requires env var ENABLE_INJECT_HTML=true.